@@ -0,0 +1,70 @@
+package decimal
+
+// AccrualSchedule returns the interest accrued on the principal in each of
+// the given number of consecutive days, with the annual rate converted to
+// a daily rate using the day count convention, as in [PerAnnumToPerDay],
+// and compounded daily.
+// Every amount is rounded to the given scale using half-to-even rounding.
+//
+// The rounding remainder is distributed deterministically, so the amounts
+// sum exactly to the total accrued interest:
+// the cumulative interest after day i is computed independently as
+// principal × ((1 + dailyRate)^i - 1) and rounded to the scale,
+// and the amount for day i is the difference between the rounded cumulative
+// interest after days i and i - 1.
+// Consequently, the rounding error does not accumulate over the schedule,
+// as it does when the daily amounts are rounded one by one.
+//
+// AccrualSchedule returns an error if:
+//   - the number of periods is not positive;
+//   - the scale is negative or greater than [MaxScale];
+//   - the day count convention is unknown;
+//   - the integer part of any intermediate result has more than [MaxPrec] digits.
+func AccrualSchedule(principal, annualRate Decimal, periods int, dayCount DayCount, scale int) ([]Decimal, error) {
+	if periods <= 0 {
+		return nil, errorf("computing accrual schedule of %v at %v: %w: number of periods %v is not positive", redact(principal), redact(annualRate), errInvalidOperation, periods)
+	}
+	if scale < MinScale || scale > MaxScale {
+		return nil, errorf("computing accrual schedule of %v at %v: %w", redact(principal), redact(annualRate), scaleRangeError(scale))
+	}
+	rate, err := PerAnnumToPerDay(annualRate, dayCount)
+	if err != nil {
+		return nil, errorf("computing accrual schedule of %v at %v: %w", redact(principal), redact(annualRate), err)
+	}
+	factor, err := One.Add(rate)
+	if err != nil {
+		return nil, errorf("computing accrual schedule of %v at %v: %w", redact(principal), redact(annualRate), err)
+	}
+
+	amounts := make([]Decimal, periods)
+	prev := newUnsafe(false, 0, scale)
+	for i := range amounts {
+		curr, err := accrued(principal, factor, i+1, scale)
+		if err != nil {
+			return nil, errorf("computing accrual schedule of %v at %v: %w", redact(principal), redact(annualRate), err)
+		}
+		amounts[i], err = curr.SubExact(prev, scale)
+		if err != nil {
+			return nil, errorf("computing accrual schedule of %v at %v: %w", redact(principal), redact(annualRate), err)
+		}
+		prev = curr
+	}
+	return amounts, nil
+}
+
+// accrued returns principal × (factor^n - 1) rounded to the given scale.
+func accrued(principal, factor Decimal, n, scale int) (Decimal, error) {
+	g, err := factor.PowInt(n)
+	if err != nil {
+		return Decimal{}, err
+	}
+	g, err = g.Sub(One)
+	if err != nil {
+		return Decimal{}, err
+	}
+	c, err := principal.Mul(g)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return c.Round(scale).padExact(scale)
+}
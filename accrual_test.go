@@ -0,0 +1,87 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAccrualSchedule(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			principal, rate string
+			periods         int
+			dayCount        DayCount
+			scale           int
+			want            []string
+		}{
+			{"1000000.00", "0.05", 5, Actual360, 2, []string{"138.89", "138.91", "138.92", "138.95", "138.97"}},
+			{"1000", "0.10", 3, Actual365Fixed, 2, []string{"0.27", "0.28", "0.27"}},
+			{"-100", "0.03", 2, Thirty360, 4, []string{"-0.0083", "-0.0084"}},
+			{"100", "-0.01", 2, Actual360, 6, []string{"-0.002778", "-0.002777"}},
+			{"100", "0", 3, Actual360, 2, []string{"0.00", "0.00", "0.00"}},
+			{"0", "0.05", 2, Actual360, 0, []string{"0", "0"}},
+			{"1", "0.05", 1, Actual360, 0, []string{"0"}},
+		}
+		for _, tt := range tests {
+			principal, rate := MustParse(tt.principal), MustParse(tt.rate)
+			got, err := AccrualSchedule(principal, rate, tt.periods, tt.dayCount, tt.scale)
+			if err != nil {
+				t.Errorf("AccrualSchedule(%q, %q, %v, %v, %v) failed: %v", principal, rate, tt.periods, tt.dayCount, tt.scale, err)
+				continue
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("AccrualSchedule(%q, %q, %v, %v, %v) = %v, want %v", principal, rate, tt.periods, tt.dayCount, tt.scale, got, tt.want)
+				continue
+			}
+			for i := range got {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("AccrualSchedule(%q, %q, %v, %v, %v) = %v, want %v", principal, rate, tt.periods, tt.dayCount, tt.scale, got, tt.want)
+					break
+				}
+			}
+		}
+	})
+
+	t.Run("sum", func(t *testing.T) {
+		principal, rate := MustParse("1000000.00"), MustParse("0.05")
+		got, err := AccrualSchedule(principal, rate, 365, Actual365Fixed, 2)
+		if err != nil {
+			t.Fatalf("AccrualSchedule(%q, %q, 365, %v, 2) failed: %v", principal, rate, Actual365Fixed, err)
+		}
+		sum, err := Sum(got...)
+		if err != nil {
+			t.Fatalf("Sum(%v) failed: %v", got, err)
+		}
+		if want := MustParse("51267.50"); sum != want {
+			t.Errorf("Sum(AccrualSchedule(%q, %q, 365, %v, 2)) = %q, want %q", principal, rate, Actual365Fixed, sum, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			principal, rate string
+			periods         int
+			dayCount        DayCount
+			scale           int
+			wantErr         error
+		}{
+			{"100", "0.05", 0, Actual360, 2, errInvalidOperation},
+			{"100", "0.05", -1, Actual360, 2, errInvalidOperation},
+			{"100", "0.05", 1, DayCount(-1), 2, errInvalidOperation},
+			{"100", "0.05", 1, DayCount(3), 2, errInvalidOperation},
+			{"100", "0.05", 1, Actual360, -1, errScaleRange},
+			{"100", "0.05", 1, Actual360, 20, errScaleRange},
+			{"9999999999999999999", "0.05", 1, Actual360, 5, errDecimalOverflow},
+			{"9999999999999999", "1000", 100, Actual360, 0, errDecimalOverflow},
+		}
+		for _, tt := range tests {
+			principal, rate := MustParse(tt.principal), MustParse(tt.rate)
+			_, err := AccrualSchedule(principal, rate, tt.periods, tt.dayCount, tt.scale)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AccrualSchedule(%q, %q, %v, %v, %v) error = %v, want %v", principal, rate, tt.periods, tt.dayCount, tt.scale, err, tt.wantErr)
+			}
+		}
+	})
+}
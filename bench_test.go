@@ -0,0 +1,332 @@
+//go:build !decimalnobig
+
+package decimal_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+// This file contains the benchmark suite.
+// Benchmark names have the form Benchmark<Method>/path=<path>/<operands>,
+// where path is "fint" if the result can be computed using uint64 arithmetic
+// and "bint" if the method needs to fall back to big.Int arithmetic.
+// Run "make bench" to collect results and "make benchcmp" to compare them
+// with another revision using benchstat.
+
+// Sinks prevent the compiler from eliminating benchmarked calls.
+var (
+	sinkDecimal  decimal.Decimal
+	sinkDecimals []decimal.Decimal
+	sinkString   string
+	sinkInt      int
+)
+
+type benchUnary struct {
+	path, d string
+}
+
+type benchBinary struct {
+	path, d, e string
+}
+
+func BenchmarkParse(b *testing.B) {
+	tests := []benchUnary{
+		{"fint", "1"},
+		{"fint", "123.456"},
+		{"fint", "123456789.1234567890"},
+		{"fint", "0.0000000000000000001"},
+		{"fint", "-9999999999999999999"},
+		{"bint", "1234567890123456789.0123456789"},
+		{"bint", "1e10"},
+	}
+	for _, tt := range tests {
+		b.Run("path="+tt.path+"/"+tt.d, func(b *testing.B) {
+			for range b.N {
+				d, err := decimal.Parse(tt.d)
+				if err != nil {
+					b.Fatal(err)
+				}
+				sinkDecimal = d
+			}
+		})
+	}
+}
+
+func BenchmarkScanner_Next(b *testing.B) {
+	tests := []benchUnary{
+		{"fint", "123.456"},
+		{"bint", "1e10"},
+	}
+	for _, tt := range tests {
+		b.Run("path="+tt.path+"/"+tt.d, func(b *testing.B) {
+			input := strings.Repeat(tt.d+"\n", b.N)
+			s := decimal.NewScanner(strings.NewReader(input))
+			b.ResetTimer()
+			for range b.N {
+				d, err := s.Next()
+				if err != nil {
+					b.Fatal(err)
+				}
+				sinkDecimal = d
+			}
+		})
+	}
+}
+
+func BenchmarkCmpStrings(b *testing.B) {
+	tests := []benchBinary{
+		{"fint", "123.456", "123.45"},
+		{"fint", "-9999999999999999999", "0.0000000000000000001"},
+		{"bint", "1234567890123456789.0123456789", "1e10"},
+	}
+	for _, tt := range tests {
+		b.Run("path="+tt.path+"/"+tt.d+"_"+tt.e, func(b *testing.B) {
+			for range b.N {
+				r, err := decimal.CmpStrings(tt.d, tt.e)
+				if err != nil {
+					b.Fatal(err)
+				}
+				sinkInt = r
+			}
+		})
+	}
+}
+
+func BenchmarkDecimal_String(b *testing.B) {
+	tests := []benchUnary{
+		{"fint", "1"},
+		{"fint", "123.456"},
+		{"fint", "123456789.1234567890"},
+		{"fint", "0.0000000000000000001"},
+		{"fint", "-9999999999999999999"},
+	}
+	for _, tt := range tests {
+		d := decimal.MustParse(tt.d)
+		b.Run("path="+tt.path+"/"+tt.d, func(b *testing.B) {
+			for range b.N {
+				sinkString = d.String()
+			}
+		})
+	}
+}
+
+func benchmarkBinary(b *testing.B, tests []benchBinary, f func(d, e decimal.Decimal) (decimal.Decimal, error)) {
+	b.Helper()
+	for _, tt := range tests {
+		d := decimal.MustParse(tt.d)
+		e := decimal.MustParse(tt.e)
+		b.Run("path="+tt.path+"/"+tt.d+"_"+tt.e, func(b *testing.B) {
+			for range b.N {
+				g, err := f(d, e)
+				if err != nil {
+					b.Fatal(err)
+				}
+				sinkDecimal = g
+			}
+		})
+	}
+}
+
+func benchmarkUnary(b *testing.B, tests []benchUnary, f func(d decimal.Decimal) (decimal.Decimal, error)) {
+	b.Helper()
+	for _, tt := range tests {
+		d := decimal.MustParse(tt.d)
+		b.Run("path="+tt.path+"/"+tt.d, func(b *testing.B) {
+			for range b.N {
+				e, err := f(d)
+				if err != nil {
+					b.Fatal(err)
+				}
+				sinkDecimal = e
+			}
+		})
+	}
+}
+
+func BenchmarkDecimal_Add(b *testing.B) {
+	tests := []benchBinary{
+		{"fint", "5", "6"},
+		{"fint", "123.456", "0.001"},
+		{"fint", "-9999999999999999998", "1"},
+		{"bint", "9999999999999999999", "0.0000000000000000001"},
+		{"bint", "1234567890.123456789", "0.1234567890123456789"},
+	}
+	benchmarkBinary(b, tests, decimal.Decimal.Add)
+}
+
+func BenchmarkDecimal_Mul(b *testing.B) {
+	tests := []benchBinary{
+		{"fint", "2", "3"},
+		{"fint", "123.456", "0.001"},
+		{"fint", "999999999", "999999999"},
+		{"bint", "9999999999999999999", "0.9999999999999999999"},
+		{"bint", "1.234567890123456789", "0.1234567890123456789"},
+	}
+	benchmarkBinary(b, tests, decimal.Decimal.Mul)
+}
+
+// BenchmarkContext_Mul multiplies a chain of factors, where every product
+// after the first few needs big.Int arithmetic unless the scale is capped.
+func BenchmarkContext_Mul(b *testing.B) {
+	factors := make([]decimal.Decimal, 30)
+	for i := range factors {
+		factors[i] = decimal.MustParse("1.0325")
+	}
+	tests := []struct {
+		policy string
+		c      decimal.Context
+	}{
+		{"default", decimal.Context{}},
+		{"capped", decimal.Context{ScalePolicy: decimal.ScaleCapped, Scale: 9}},
+	}
+	for _, tt := range tests {
+		b.Run("policy="+tt.policy, func(b *testing.B) {
+			for range b.N {
+				d := decimal.One
+				for _, e := range factors {
+					var err error
+					d, err = tt.c.Mul(d, e)
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+				sinkDecimal = d
+			}
+		})
+	}
+}
+
+func BenchmarkDecimal_Quo(b *testing.B) {
+	tests := []benchBinary{
+		{"fint", "2", "4"},
+		{"fint", "123.456", "0.001"},
+		{"bint", "2", "3"},
+		{"bint", "0.0000000000000000001", "9999999999999999999"},
+		{"bint", "1", "0.1234567890123456789"},
+	}
+	benchmarkBinary(b, tests, decimal.Decimal.Quo)
+}
+
+func BenchmarkDecimal_PowInt(b *testing.B) {
+	tests := []struct {
+		path, d string
+		power   int
+	}{
+		{"fint", "2", 10},
+		{"fint", "1.1", 6},
+		{"fint", "2", -3},
+		{"bint", "1.1", 60},
+		{"bint", "1.01", 600},
+		{"bint", "1.001", 6000},
+		{"bint", "3", -3},
+	}
+	for _, tt := range tests {
+		d := decimal.MustParse(tt.d)
+		b.Run("path="+tt.path+"/"+tt.d+"_"+strconv.Itoa(tt.power), func(b *testing.B) {
+			for range b.N {
+				e, err := d.PowInt(tt.power)
+				if err != nil {
+					b.Fatal(err)
+				}
+				sinkDecimal = e
+			}
+		})
+	}
+}
+
+func BenchmarkDecimal_Sqrt(b *testing.B) {
+	tests := []benchUnary{
+		{"fint", "4"},
+		{"fint", "2.25"},
+		{"bint", "2"},
+		{"bint", "0.0000000000000000002"},
+		{"bint", "9999999999999999999"},
+	}
+	benchmarkUnary(b, tests, decimal.Decimal.Sqrt)
+}
+
+func BenchmarkDecimal_Exp(b *testing.B) {
+	tests := []benchUnary{
+		{"bint", "1"},
+		{"bint", "0.5"},
+		{"bint", "-0.5"},
+		{"bint", "12.345"},
+	}
+	benchmarkUnary(b, tests, decimal.Decimal.Exp)
+}
+
+func BenchmarkDecimal_Log(b *testing.B) {
+	tests := []benchUnary{
+		{"bint", "10"},
+		{"bint", "0.5"},
+		{"bint", "2"},
+		{"bint", "12345.678"},
+	}
+	benchmarkUnary(b, tests, decimal.Decimal.Log)
+}
+
+// simulationDecimals returns decimals of similar magnitudes,
+// as typically seen in Monte Carlo simulations.
+func simulationDecimals(n int) []decimal.Decimal {
+	d := make([]decimal.Decimal, n)
+	for i := range d {
+		d[i] = decimal.MustNew(int64(i%1000)+1000, 3)
+	}
+	return d
+}
+
+func BenchmarkExpSlice(b *testing.B) {
+	d := simulationDecimals(1000)
+
+	b.Run("loop", func(b *testing.B) {
+		for range b.N {
+			for _, f := range d {
+				e, err := f.Exp()
+				if err != nil {
+					b.Fatal(err)
+				}
+				sinkDecimal = e
+			}
+		}
+	})
+
+	b.Run("slice", func(b *testing.B) {
+		for range b.N {
+			e, err := decimal.ExpSlice(d)
+			if err != nil {
+				b.Fatal(err)
+			}
+			sinkDecimals = e
+		}
+	})
+}
+
+func BenchmarkLogSlice(b *testing.B) {
+	d := simulationDecimals(1000)
+
+	b.Run("loop", func(b *testing.B) {
+		for range b.N {
+			for _, f := range d {
+				e, err := f.Log()
+				if err != nil {
+					b.Fatal(err)
+				}
+				sinkDecimal = e
+			}
+		}
+	})
+
+	b.Run("slice", func(b *testing.B) {
+		for range b.N {
+			e, err := decimal.LogSlice(d)
+			if err != nil {
+				b.Fatal(err)
+			}
+			sinkDecimals = e
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package decimal
+
+// benfordProb is a cache of probabilities of leading digits according to
+// Benford's law, where benfordProb[d] = log10(1 + 1/d).
+var benfordProb = [...]Decimal{
+	{},
+	MustNew(3_010_299_956_639_811_952, 19),
+	MustNew(1_760_912_590_556_812_421, 19),
+	MustNew(1_249_387_366_082_999_531, 19),
+	MustNew(969_100_130_080_564_144, 19),
+	MustNew(791_812_460_476_248_277, 19),
+	MustNew(669_467_896_306_131_982, 19),
+	MustNew(579_919_469_776_867_549, 19),
+	MustNew(511_525_224_473_812_889, 19),
+	MustNew(457_574_905_606_751_254, 19),
+}
+
+// BenfordProb returns the expected probability of the leading digit
+// according to [Benford's law], which is equal to log10(1 + 1/digit),
+// rounded to 19 digits after the decimal point.
+// BenfordProb returns an error if the digit is not between 1 and 9.
+//
+// [Benford's law]: https://en.wikipedia.org/wiki/Benford%27s_law
+func BenfordProb(digit int) (Decimal, error) {
+	if digit < 1 || digit > 9 {
+		return Decimal{}, errorf("computing benford probability of %v: %w", digit, errInvalidOperation)
+	}
+	return benfordProb[digit], nil
+}
+
+// BenfordCounter accumulates the distribution of leading digits of decimals
+// and scores its conformity to [Benford's law].
+// It is used in fraud analytics to detect anomalies in sets of amounts,
+// such as invoices or expense claims.
+// The zero value is an empty counter ready to use.
+// BenfordCounter is not safe for concurrent use by multiple goroutines.
+//
+// [Benford's law]: https://en.wikipedia.org/wiki/Benford%27s_law
+type BenfordCounter struct {
+	counts [10]int
+	total  int
+}
+
+// Add counts the leading digit of the decimal.
+// Zeros have no leading digit and are ignored.
+// See also method [Decimal.LeadingDigit].
+func (c *BenfordCounter) Add(d ...Decimal) {
+	for _, e := range d {
+		digit := e.LeadingDigit()
+		if digit == 0 {
+			continue
+		}
+		c.counts[digit]++
+		c.total++
+	}
+}
+
+// Count returns the number of decimals with the given leading digit.
+// Count returns 0 if the digit is not between 1 and 9.
+func (c *BenfordCounter) Count(digit int) int {
+	if digit < 1 || digit > 9 {
+		return 0
+	}
+	return c.counts[digit]
+}
+
+// Total returns the number of counted decimals.
+func (c *BenfordCounter) Total() int {
+	return c.total
+}
+
+// Freq returns the observed frequency of the leading digit, which is equal
+// to the ratio of the count of the digit to the total count.
+// Freq returns an error if the digit is not between 1 and 9
+// or if the counter is empty.
+// See also function [BenfordProb].
+func (c *BenfordCounter) Freq(digit int) (Decimal, error) {
+	if digit < 1 || digit > 9 {
+		return Decimal{}, errorf("computing frequency of %v: %w", digit, errInvalidOperation)
+	}
+	if c.total == 0 {
+		return Decimal{}, errorf("computing frequency of %v: %w", digit, errDivisionByZero)
+	}
+	count, err := New(int64(c.counts[digit]), 0)
+	if err != nil {
+		return Decimal{}, errorf("computing frequency of %v: %w", digit, err)
+	}
+	total, err := New(int64(c.total), 0)
+	if err != nil {
+		return Decimal{}, errorf("computing frequency of %v: %w", digit, err)
+	}
+	freq, err := count.Quo(total)
+	if err != nil {
+		return Decimal{}, errorf("computing frequency of %v: %w", digit, err)
+	}
+	return freq, nil
+}
+
+// MAD returns the mean absolute deviation of the observed frequencies
+// of leading digits from the probabilities expected by Benford's law.
+// The closer the result is to 0, the better the conformity.
+// According to Nigrini, values above 0.015 indicate nonconformity.
+// MAD returns an error if the counter is empty.
+// See also method [BenfordCounter.ChiSquare].
+func (c *BenfordCounter) MAD() (Decimal, error) {
+	var sum Decimal
+	for digit := 1; digit <= 9; digit++ {
+		freq, err := c.Freq(digit)
+		if err != nil {
+			return Decimal{}, errorf("computing mad: %w", err)
+		}
+		diff, err := freq.SubAbs(benfordProb[digit])
+		if err != nil {
+			return Decimal{}, errorf("computing mad: %w", err)
+		}
+		sum, err = sum.Add(diff)
+		if err != nil {
+			return Decimal{}, errorf("computing mad: %w", err)
+		}
+	}
+	mad, err := sum.Quo(MustNew(9, 0))
+	if err != nil {
+		return Decimal{}, errorf("computing mad: %w", err)
+	}
+	return mad, nil
+}
+
+// ChiSquare returns Pearson's chi-squared statistic of the observed counts
+// of leading digits against the counts expected by Benford's law.
+// With 8 degrees of freedom, values above 15.507 indicate nonconformity
+// at the 5% significance level.
+// ChiSquare returns an error if the counter is empty.
+// See also method [BenfordCounter.MAD].
+func (c *BenfordCounter) ChiSquare() (Decimal, error) {
+	if c.total == 0 {
+		return Decimal{}, errorf("computing chi-square: %w", errDivisionByZero)
+	}
+	total, err := New(int64(c.total), 0)
+	if err != nil {
+		return Decimal{}, errorf("computing chi-square: %w", err)
+	}
+	var sum Decimal
+	for digit := 1; digit <= 9; digit++ {
+		count, err := New(int64(c.counts[digit]), 0)
+		if err != nil {
+			return Decimal{}, errorf("computing chi-square: %w", err)
+		}
+		want, err := total.Mul(benfordProb[digit])
+		if err != nil {
+			return Decimal{}, errorf("computing chi-square: %w", err)
+		}
+		diff, err := count.Sub(want)
+		if err != nil {
+			return Decimal{}, errorf("computing chi-square: %w", err)
+		}
+		diff, err = diff.Mul(diff)
+		if err != nil {
+			return Decimal{}, errorf("computing chi-square: %w", err)
+		}
+		sum, err = sum.AddQuo(diff, want)
+		if err != nil {
+			return Decimal{}, errorf("computing chi-square: %w", err)
+		}
+	}
+	return sum, nil
+}
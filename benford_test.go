@@ -0,0 +1,102 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"testing"
+)
+
+func TestBenfordProb(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			digit int
+			want  string
+		}{
+			{1, "0.3010299956639811952"},
+			{2, "0.1760912590556812421"},
+			{5, "0.0791812460476248277"},
+			{9, "0.0457574905606751254"},
+		}
+		for _, tt := range tests {
+			got, err := BenfordProb(tt.digit)
+			if err != nil {
+				t.Errorf("BenfordProb(%v) failed: %v", tt.digit, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("BenfordProb(%v) = %q, want %q", tt.digit, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		for _, digit := range []int{-1, 0, 10} {
+			_, err := BenfordProb(digit)
+			if err == nil {
+				t.Errorf("BenfordProb(%v) did not fail", digit)
+			}
+		}
+	})
+}
+
+func TestBenfordCounter(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var c BenfordCounter
+		c.Add(MustParse("1.5"), MustParse("-12"), MustParse("0"), MustParse("0.003"), MustParse("250"))
+		c.Add(MustParse("199.99"))
+		if got, want := c.Total(), 5; got != want {
+			t.Errorf("Total() = %v, want %v", got, want)
+		}
+		counts := [...]int{0, 3, 1, 1, 0, 0, 0, 0, 0, 0}
+		for digit := -1; digit <= 10; digit++ {
+			want := 0
+			if digit >= 0 && digit < len(counts) {
+				want = counts[digit]
+			}
+			if got := c.Count(digit); got != want {
+				t.Errorf("Count(%v) = %v, want %v", digit, got, want)
+			}
+		}
+		got, err := c.Freq(1)
+		if err != nil {
+			t.Fatalf("Freq(1) failed: %v", err)
+		}
+		if want := MustParse("0.6"); got != want {
+			t.Errorf("Freq(1) = %q, want %q", got, want)
+		}
+		got, err = c.MAD()
+		if err != nil {
+			t.Fatalf("MAD() failed: %v", err)
+		}
+		if want := MustParse("0.0884311130382305799"); got != want {
+			t.Errorf("MAD() = %q, want %q", got, want)
+		}
+		got, err = c.ChiSquare()
+		if err != nil {
+			t.Fatalf("ChiSquare() failed: %v", err)
+		}
+		if want := MustParse("3.716029844180985495"); got != want {
+			t.Errorf("ChiSquare() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var c BenfordCounter
+		if _, err := c.Freq(1); err == nil {
+			t.Errorf("Freq(1) did not fail")
+		}
+		if _, err := c.MAD(); err == nil {
+			t.Errorf("MAD() did not fail")
+		}
+		if _, err := c.ChiSquare(); err == nil {
+			t.Errorf("ChiSquare() did not fail")
+		}
+		c.Add(One)
+		for _, digit := range []int{-1, 0, 10} {
+			if _, err := c.Freq(digit); err == nil {
+				t.Errorf("Freq(%v) did not fail", digit)
+			}
+		}
+	})
+}
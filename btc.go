@@ -0,0 +1,87 @@
+package decimal
+
+import (
+	"math"
+)
+
+// satoshiScale is a number of satoshi digits after the decimal point of a bitcoin amount.
+const satoshiScale = 8
+
+// DefaultDustThreshold is the smallest non-dust amount of a P2PKH output
+// in bitcoins, as defined by the default policy of Bitcoin Core (546 satoshis).
+var DefaultDustThreshold = MustNew(546, satoshiScale)
+
+// NewFromSatoshi converts an amount in satoshis to a decimal amount
+// in bitcoins with 8 digits after the decimal point,
+// where 1 bitcoin is equal to 10^8 satoshis.
+// See also method [Decimal.Satoshi].
+func NewFromSatoshi(s int64) Decimal {
+	return newFromInt64Scale(s, satoshiScale)
+}
+
+// Satoshi returns the decimal amount in bitcoins converted to satoshis,
+// where 1 bitcoin is equal to 10^8 satoshis.
+// See also constructor [NewFromSatoshi].
+//
+// Satoshi returns an error if:
+//   - the amount has non-zero digits beyond 8 digits after the decimal point;
+//   - the result cannot be represented as an int64 value.
+func (d Decimal) Satoshi() (int64, error) {
+	if d.MinScale() > satoshiScale {
+		return 0, errorf("converting %v to satoshis: %w: fractions of a satoshi are not allowed", redact(d), errInvalidOperation)
+	}
+	d = d.Trunc(satoshiScale)
+	coef, ok := d.coef.lsh(satoshiScale - d.Scale())
+	if d.IsNeg() {
+		if !ok || coef > -math.MinInt64 {
+			return 0, errorf("converting %v to satoshis: %w", redact(d), errDecimalOverflow)
+		}
+		//nolint:gosec
+		return -int64(coef), nil
+	}
+	if !ok || coef > math.MaxInt64 {
+		return 0, errorf("converting %v to satoshis: %w", redact(d), errDecimalOverflow)
+	}
+	//nolint:gosec
+	return int64(coef), nil
+}
+
+// DustValidator validates bitcoin amounts of transaction outputs against
+// a dust threshold.
+// Outputs with amounts below the threshold cost more in fees to spend
+// than they are worth, and they are rejected by the network.
+// The zero value uses [DefaultDustThreshold].
+type DustValidator struct {
+	// Threshold is the smallest non-dust amount in bitcoins.
+	// If Threshold is zero, DefaultDustThreshold is used.
+	Threshold Decimal
+}
+
+func (v DustValidator) threshold() Decimal {
+	if v.Threshold.IsZero() {
+		return DefaultDustThreshold
+	}
+	return v.Threshold
+}
+
+// IsDust returns true if the amount in bitcoins is less than the dust threshold.
+func (v DustValidator) IsDust(d Decimal) bool {
+	return d.Less(v.threshold())
+}
+
+// Validate returns an error if:
+//   - the amount is negative;
+//   - the amount has non-zero digits beyond 8 digits after the decimal point;
+//   - the amount is less than the dust threshold.
+func (v DustValidator) Validate(d Decimal) error {
+	if d.IsNeg() {
+		return errorf("validating %v: %w: negative amount", redact(d), errInvalidOperation)
+	}
+	if _, err := d.Satoshi(); err != nil {
+		return errorf("validating %v: %w", redact(d), err)
+	}
+	if v.IsDust(d) {
+		return errorf("validating %v: %w: amount is below the dust threshold of %v", redact(d), errInvalidOperation, redact(v.threshold()))
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewFromSatoshi(t *testing.T) {
+	tests := []struct {
+		s    int64
+		want string
+	}{
+		{0, "0.00000000"},
+		{1, "0.00000001"},
+		{-1, "-0.00000001"},
+		{546, "0.00000546"},
+		{100_000_000, "1.00000000"},
+		{2_100_000_000_000_000, "21000000.00000000"},
+		{math.MaxInt64, "92233720368.54775807"},
+		{math.MinInt64, "-92233720368.54775808"},
+	}
+	for _, tt := range tests {
+		got := NewFromSatoshi(tt.s)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("NewFromSatoshi(%v) = %q, want %q", tt.s, got, want)
+		}
+	}
+}
+
+func TestDecimal_Satoshi(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			want int64
+		}{
+			{"0", 0},
+			{"0.0000000000", 0},
+			{"0.00000001", 1},
+			{"-0.00000001", -1},
+			{"0.000000010", 1},
+			{"1", 100_000_000},
+			{"1.5", 150_000_000},
+			{"21000000", 2_100_000_000_000_000},
+			{"92233720368.54775807", math.MaxInt64},
+			{"-92233720368.54775808", math.MinInt64},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Satoshi()
+			if err != nil {
+				t.Errorf("%q.Satoshi() failed: %v", d, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.Satoshi() = %v, want %v", d, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"0.000000001",
+			"-0.000000005",
+			"1.0000000001",
+			"92233720368.54775808",
+			"-92233720368.54775809",
+			"100000000000",
+			"9999999999999999999",
+		}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			_, err := d.Satoshi()
+			if err == nil {
+				t.Errorf("%q.Satoshi() did not fail", d)
+			}
+		}
+	})
+}
+
+func TestDustValidator(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			threshold string
+			d         string
+		}{
+			{"0", "0.00000546"},
+			{"0", "0.000005460"},
+			{"0", "1"},
+			{"0.00000294", "0.00000294"},
+			{"0.00000294", "0.00000300"},
+		}
+		for _, tt := range tests {
+			v := DustValidator{Threshold: MustParse(tt.threshold)}
+			d := MustParse(tt.d)
+			if err := v.Validate(d); err != nil {
+				t.Errorf("Validate(%q) with threshold %q failed: %v", d, tt.threshold, err)
+			}
+			if v.IsDust(d) {
+				t.Errorf("IsDust(%q) with threshold %q = true, want false", d, tt.threshold)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			threshold string
+			d         string
+			isDust    bool
+		}{
+			{"0", "0", true},
+			{"0", "0.00000545", true},
+			{"0", "-1", true},
+			{"0", "1.000000001", false},
+			{"0.00000294", "0.00000293", true},
+			{"0.001", "0.00099999", true},
+		}
+		for _, tt := range tests {
+			v := DustValidator{Threshold: MustParse(tt.threshold)}
+			d := MustParse(tt.d)
+			if err := v.Validate(d); err == nil {
+				t.Errorf("Validate(%q) with threshold %q did not fail", d, tt.threshold)
+			}
+			if got := v.IsDust(d); got != tt.isDust {
+				t.Errorf("IsDust(%q) with threshold %q = %v, want %v", d, tt.threshold, got, tt.isDust)
+			}
+		}
+	})
+}
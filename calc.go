@@ -0,0 +1,139 @@
+//go:build !decimalnobig
+
+package decimal
+
+// Calc is a builder for multi-step formulas, for example:
+//
+//	total, err := price.Calc().Mul(qty).Add(fee).Round(2).Result()
+//
+// Unlike the methods of [Decimal], which round each result to [MaxPrec]
+// digits, Calc keeps intermediate results exact, see [Xp] for details.
+// So the formula is rounded only once, when [Calc.Round] or [Calc.Result]
+// is called.
+// Because of this, the result may differ from the result of the same
+// sequence of Decimal methods in the last digit.
+//
+// Calc records the first error and ignores all subsequent operations.
+// The error is returned by [Calc.Result].
+//
+// Calc is not thread-safe.
+type Calc struct {
+	x Xp
+}
+
+// Calc returns a builder with d as its initial value.
+func (d Decimal) Calc() *Calc {
+	c := new(Calc)
+	c.x.SetDecimal(d)
+	return c
+}
+
+// Add adds e to the intermediate result.
+func (c *Calc) Add(e Decimal) *Calc {
+	c.x.Add(&c.x, e.Xp())
+	return c
+}
+
+// Sub subtracts e from the intermediate result.
+func (c *Calc) Sub(e Decimal) *Calc {
+	c.x.Sub(&c.x, e.Xp())
+	return c
+}
+
+// Mul multiplies the intermediate result by e.
+func (c *Calc) Mul(e Decimal) *Calc {
+	c.x.Mul(&c.x, e.Xp())
+	return c
+}
+
+// Quo divides the intermediate result by e.
+// The quotient is exact, see [Xp.Quo].
+// If e is 0, Quo records a division by zero error.
+func (c *Calc) Quo(e Decimal) *Calc {
+	c.x.Quo(&c.x, e.Xp())
+	return c
+}
+
+// AddMul adds the product e * f to the intermediate result.
+// Together with [Calc.SubMul], [Calc.AddQuo], and [Calc.SubQuo], it extends
+// the fused methods of [Decimal], such as [Decimal.AddMul], to formulas with
+// any number of terms, for example, a * b + c / d:
+//
+//	d, err := a.Calc().Mul(b).AddQuo(c, d).Result()
+func (c *Calc) AddMul(e, f Decimal) *Calc {
+	var y Xp
+	c.x.Add(&c.x, y.Mul(e.Xp(), f.Xp()))
+	return c
+}
+
+// SubMul subtracts the product e * f from the intermediate result.
+// See also method [Calc.AddMul].
+func (c *Calc) SubMul(e, f Decimal) *Calc {
+	var y Xp
+	c.x.Sub(&c.x, y.Mul(e.Xp(), f.Xp()))
+	return c
+}
+
+// AddQuo adds the quotient e / f to the intermediate result.
+// If f is 0, AddQuo records a division by zero error.
+func (c *Calc) AddQuo(e, f Decimal) *Calc {
+	var y Xp
+	c.x.Add(&c.x, y.Quo(e.Xp(), f.Xp()))
+	return c
+}
+
+// SubQuo subtracts the quotient e / f from the intermediate result.
+// If f is 0, SubQuo records a division by zero error.
+func (c *Calc) SubQuo(e, f Decimal) *Calc {
+	var y Xp
+	c.x.Sub(&c.x, y.Quo(e.Xp(), f.Xp()))
+	return c
+}
+
+// Abs replaces the intermediate result with its absolute value.
+func (c *Calc) Abs() *Calc {
+	c.x.Abs(&c.x)
+	return c
+}
+
+// Neg replaces the intermediate result with its opposite value.
+func (c *Calc) Neg() *Calc {
+	c.x.Neg(&c.x)
+	return c
+}
+
+// Round rounds the exact intermediate result to the specified number
+// of digits after the decimal point using half-to-even rounding.
+// If the given scale is negative, it is redefined to zero.
+// Round is typically called once, right before [Calc.Result].
+// See also method [Decimal.Round].
+func (c *Calc) Round(scale int) *Calc {
+	if c.x.err == nil {
+		c.x.round(max(scale, MinScale))
+	}
+	return c
+}
+
+// Result returns the (possibly rounded) result of the formula.
+// If the result has more than [MaxPrec] digits, it is rounded to [MaxPrec]
+// digits using half-to-even rounding.
+//
+// Result returns an error if:
+//   - any of the previous operations failed;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (c *Calc) Result() (Decimal, error) {
+	if c.x.err != nil {
+		return Decimal{}, c.x.err
+	}
+	d, err := c.x.decimal(0)
+	if err != nil {
+		return Decimal{}, errorf("computing [%v]: %w", redact(&c.x), err)
+	}
+	return d, nil
+}
+
+// String returns the intermediate result as a string, see [Xp.String].
+// It is intended for debugging purposes only.
+func (c *Calc) String() string {
+	return c.x.String()
+}
@@ -0,0 +1,213 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCalc(t *testing.T) {
+	type step struct {
+		op string
+		e  string
+	}
+	apply := func(d Decimal, steps []step) *Calc {
+		c := d.Calc()
+		for _, s := range steps {
+			switch s.op {
+			case "Add":
+				c = c.Add(MustParse(s.e))
+			case "Sub":
+				c = c.Sub(MustParse(s.e))
+			case "Mul":
+				c = c.Mul(MustParse(s.e))
+			case "Quo":
+				c = c.Quo(MustParse(s.e))
+			case "Abs":
+				c = c.Abs()
+			case "Neg":
+				c = c.Neg()
+			case "Round":
+				scale, err := strconv.Atoi(s.e)
+				if err != nil {
+					t.Fatalf("strconv.Atoi(%q) failed: %v", s.e, err)
+				}
+				c = c.Round(scale)
+			}
+		}
+		return c
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			steps []step
+			want  string
+		}{
+			{"0", nil, "0"},
+			{"-1.50", nil, "-1.50"},
+			{"1.5", []step{{"Add", "2.25"}}, "3.75"},
+			{"1.5", []step{{"Sub", "2.25"}}, "-0.75"},
+			{"-1.5", []step{{"Sub", "-1.5"}}, "0.0"},
+			{"1.5", []step{{"Mul", "-2"}}, "-3.0"},
+			{"1.5", []step{{"Quo", "-2"}}, "-0.75"},
+			{"1.50", []step{{"Quo", "0.5"}}, "3.0"},
+			{"0.00", []step{{"Quo", "7"}}, "0.00"},
+			{"10", []step{{"Quo", "3"}, {"Mul", "3"}}, "10.00000000000000000"},
+			{"10", []step{{"Quo", "3"}, {"Mul", "3"}, {"Round", "2"}}, "10.00"},
+			{"19.99", []step{{"Mul", "3"}, {"Mul", "0.0825"}, {"Round", "2"}}, "4.95"},
+			{"1", []step{{"Round", "2"}}, "1"},
+			{"2.5", []step{{"Round", "-1"}}, "2"},
+
+			// Single rounding
+			{"1", []step{{"Quo", "3"}, {"Quo", "3"}, {"Mul", "9"}}, "1.000000000000000000"},
+			{"0.125", []step{{"Mul", "0.1"}, {"Add", "0.0375"}, {"Round", "2"}}, "0.05"},
+			{"1", []step{{"Quo", "3"}, {"Mul", "3"}, {"Add", "0.5"}}, "1.500000000000000000"},
+			{"1", []step{{"Quo", "3"}, {"Mul", "3"}, {"Add", "0.5"}, {"Round", "0"}}, "2"},
+			{"2", []step{{"Quo", "3"}, {"Round", "2"}}, "0.67"},
+
+			// Sign
+			{"-1.50", []step{{"Abs", ""}}, "1.50"},
+			{"1.50", []step{{"Abs", ""}}, "1.50"},
+			{"1.50", []step{{"Neg", ""}}, "-1.50"},
+			{"1", []step{{"Quo", "-3"}, {"Abs", ""}, {"Mul", "3"}}, "1.000000000000000000"},
+			{"1", []step{{"Sub", "3"}, {"Neg", ""}, {"Add", "1"}}, "3"},
+
+			// Intermediate overflow
+			{"9999999999999999999", []step{{"Mul", "10"}, {"Quo", "100"}}, "999999999999999999.9"},
+			{"9999999999999999999", []step{{"Add", "1"}, {"Sub", "2"}}, "9999999999999999998"},
+
+			// Extended scale
+			{"0.0000000000000000001", []step{{"Mul", "0.01"}, {"Mul", "1000"}}, "0.0000000000000000010"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := apply(d, tt.steps).Result()
+			if err != nil {
+				t.Errorf("%q.Calc()%v.Result() failed: %v", d, tt.steps, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Calc()%v.Result() = %q, want %q", d, tt.steps, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			steps []step
+		}{
+			{"1", []step{{"Quo", "0"}}},
+			{"1", []step{{"Quo", "0"}, {"Add", "1"}, {"Round", "2"}}},
+			{"1", []step{{"Quo", "0"}, {"Neg", ""}, {"Abs", ""}}},
+			{"9999999999999999999", []step{{"Add", "1"}}},
+			{"9999999999999999999", []step{{"Mul", "10"}}},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := apply(d, tt.steps).Result()
+			if err == nil {
+				t.Errorf("%q.Calc()%v.Result() did not fail", d, tt.steps)
+			}
+		}
+	})
+}
+
+func TestCalc_fused(t *testing.T) {
+	type step struct {
+		op   string
+		e, f string
+	}
+	apply := func(d Decimal, steps []step) *Calc {
+		c := d.Calc()
+		for _, s := range steps {
+			e, f := MustParse(s.e), MustParse(s.f)
+			switch s.op {
+			case "AddMul":
+				c = c.AddMul(e, f)
+			case "SubMul":
+				c = c.SubMul(e, f)
+			case "AddQuo":
+				c = c.AddQuo(e, f)
+			case "SubQuo":
+				c = c.SubQuo(e, f)
+			}
+		}
+		return c
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			steps []step
+			want  string
+		}{
+			{"1.5", []step{{"AddMul", "2", "3"}}, "7.5"},
+			{"1.5", []step{{"SubMul", "0.5", "3"}}, "0.0"},
+			{"10", []step{{"SubQuo", "1", "4"}}, "9.75"},
+			{"-1", []step{{"AddQuo", "3", "-4"}}, "-1.75"},
+			{"0", []step{{"AddMul", "1.5", "2"}, {"SubMul", "0.25", "4"}, {"AddQuo", "1", "8"}}, "2.125"},
+
+			// Single rounding
+			{"0", []step{{"AddQuo", "1", "3"}, {"AddQuo", "1", "3"}, {"AddQuo", "1", "3"}}, "1.000000000000000000"},
+			{"1", []step{{"SubQuo", "2", "3"}, {"SubQuo", "1", "3"}}, "0.0000000000000000000"},
+
+			// Intermediate overflow
+			{"-9999999999999999999", []step{{"AddMul", "9999999999999999999", "2"}}, "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := apply(d, tt.steps).Result()
+			if err != nil {
+				t.Errorf("%q.Calc()%v.Result() failed: %v", d, tt.steps, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Calc()%v.Result() = %q, want %q", d, tt.steps, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			steps []step
+		}{
+			{"1", []step{{"AddQuo", "1", "0"}}},
+			{"1", []step{{"SubQuo", "1", "0"}, {"AddMul", "2", "3"}}},
+			{"9999999999999999999", []step{{"AddMul", "1", "1"}}},
+			{"-9999999999999999999", []step{{"SubMul", "10", "1"}}},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := apply(d, tt.steps).Result()
+			if err == nil {
+				t.Errorf("%q.Calc()%v.Result() did not fail", d, tt.steps)
+			}
+		}
+	})
+}
+
+func TestCalc_String(t *testing.T) {
+	tests := []struct {
+		d    string
+		want string
+	}{
+		{"0", "0"},
+		{"0.00", "0.00"},
+		{"-0.05", "-0.05"},
+		{"123.456", "123.456"},
+		{"-9999999999999999999", "-9999999999999999999"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.Calc().String()
+		if got != tt.want {
+			t.Errorf("%q.Calc().String() = %q, want %q", d, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package decimal
+
+// Caps describes the limits and the behavior of decimals,
+// as documented in the "Mathematical Context" section of the package
+// documentation.
+// It allows generic code, such as persistence layers, to choose column types
+// at runtime instead of hard-coding the limits of this package.
+// See also function [Capabilities].
+type Caps struct {
+	Precision     int          // Precision is the maximum number of significant digits, equal to MaxPrec.
+	MinScale      int          // MinScale is the minimum number of digits after the decimal point.
+	MaxScale      int          // MaxScale is the maximum number of digits after the decimal point.
+	Emax          int          // Emax is the maximum adjusted exponent.
+	Emin          int          // Emin is the minimum adjusted exponent.
+	Etiny         int          // Etiny is the minimum exponent of a subnormal number, equal to Emin as subnormals are not supported.
+	Rounding      RoundingMode // Rounding is the method used for implicit rounding.
+	EnabledTraps  []string     // EnabledTraps are the conditions reported as errors.
+	DisabledTraps []string     // DisabledTraps are the conditions that are silently ignored.
+	BigInt        bool         // BigInt reports whether operations can fall back to big.Int arithmetic, which is false with the decimalnobig build tag.
+}
+
+// Capabilities returns the limits and the behavior of decimals.
+// The traps are named as in the General Decimal Arithmetic Specification.
+// The underflow and inexact traps are disabled by default, but can be enabled
+// with [Context.TrapUnderflow] and [Context.TrapInexact].
+func Capabilities() Caps {
+	return Caps{
+		Precision:     MaxPrec,
+		MinScale:      MinScale,
+		MaxScale:      MaxScale,
+		Emax:          MaxPrec - 1,
+		Emin:          -MaxScale,
+		Etiny:         -MaxScale,
+		Rounding:      HalfEven,
+		EnabledTraps:  []string{"Division by Zero", "Invalid Operation", "Overflow"},
+		DisabledTraps: []string{"Inexact", "Clamped", "Rounded", "Subnormal", "Underflow"},
+		BigInt:        hasBint,
+	}
+}
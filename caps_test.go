@@ -0,0 +1,47 @@
+package decimal
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCapabilities(t *testing.T) {
+	got := Capabilities()
+	if got.Precision != MaxPrec || got.MinScale != MinScale || got.MaxScale != MaxScale {
+		t.Errorf("Capabilities() = %+v, want precision %v and scale range [%v, %v]", got, MaxPrec, MinScale, MaxScale)
+	}
+	if got.Emax != 18 || got.Emin != -19 || got.Etiny != -19 {
+		t.Errorf("Capabilities() = %+v, want Emax 18, Emin -19, Etiny -19", got)
+	}
+	if got.Rounding != HalfEven {
+		t.Errorf("Capabilities().Rounding = %v, want %v", got.Rounding, HalfEven)
+	}
+	if got.BigInt != hasBint {
+		t.Errorf("Capabilities().BigInt = %v, want %v", got.BigInt, hasBint)
+	}
+	if !slices.Contains(got.EnabledTraps, "Overflow") || !slices.Contains(got.DisabledTraps, "Underflow") {
+		t.Errorf("Capabilities() = %+v, want Overflow enabled and Underflow disabled", got)
+	}
+
+	// The largest and the smallest positive decimals must match the limits
+	largest, err := Parse("9999999999999999999")
+	if err != nil {
+		t.Fatalf("Parse(9999999999999999999) failed: %v", err)
+	}
+	if got := largest.Prec() - 1; got != Capabilities().Emax {
+		t.Errorf("adjusted exponent of %v = %v, want %v", largest, got, Capabilities().Emax)
+	}
+	smallest, err := New(1, MaxScale)
+	if err != nil {
+		t.Fatalf("New(1, %v) failed: %v", MaxScale, err)
+	}
+	if got := -smallest.Scale(); got != Capabilities().Emin {
+		t.Errorf("adjusted exponent of %v = %v, want %v", smallest, got, Capabilities().Emin)
+	}
+
+	// The result must not be shared between calls
+	got.EnabledTraps[0] = ""
+	if Capabilities().EnabledTraps[0] == "" {
+		t.Errorf("Capabilities() returned a shared slice")
+	}
+}
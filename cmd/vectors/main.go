@@ -0,0 +1,250 @@
+//go:build !decimalnobig
+
+// Vectors generates a deterministic set of test vectors for decimal operations.
+//
+// The vectors are written as a JSON array, where each element describes
+// an operation, its arguments and the expected result.
+// The output does not depend on the platform or the Go version,
+// so it can be committed and used to verify that implementations of the
+// same semantics in other languages produce identical results.
+// The vectors depend on exact *big.Int arithmetic, so the generator is not
+// built with the decimalnobig build tag.
+//
+// Usage:
+//
+//	go run github.com/govalues/decimal/cmd/vectors [-o file]
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/govalues/decimal"
+)
+
+// Vector represents a single test case.
+// Either Want is set or Error is true.
+type Vector struct {
+	Op    string   `json:"op"`
+	Args  []string `json:"args"`
+	Scale *int     `json:"scale,omitempty"`
+	Mode  string   `json:"mode,omitempty"`
+	Want  []string `json:"want,omitempty"`
+	Error bool     `json:"error,omitempty"`
+}
+
+// operands is a corpus of decimals used as arguments for all operations.
+var operands = []string{
+	"0", "0.00", "1", "-1", "2", "-2", "3", "10",
+	"0.5", "-0.5", "1.5", "-1.5", "2.5", "-2.5",
+	"0.1", "0.01", "0.001", "1.0000000000000000001",
+	"1.23", "-1.23", "12.345", "-12.345", "123.456789",
+	"0.3333333333333333333", "-0.6666666666666666667",
+	"9999999999999999999", "-9999999999999999999",
+	"0.9999999999999999999", "0.0000000000000000001",
+}
+
+// scales is a list of scales used by rounding operations.
+var scales = []int{0, 1, 2, 3, 10, 19}
+
+// modes is a list of rounding modes used by rounding and arithmetic
+// operations.
+// The names of the modes are the same as in the General Decimal Arithmetic
+// specification.
+// Stochastic rounding is omitted, since its results are not deterministic.
+var modes = []decimal.RoundingMode{
+	decimal.HalfEven,
+	decimal.HalfUp,
+	decimal.HalfDown,
+	decimal.Up,
+	decimal.Down,
+	decimal.Ceiling,
+	decimal.Floor,
+}
+
+// domainScales is a list of scales used by arithmetic operations
+// rounded to a domain.
+var domainScales = []int{0, 2, 19}
+
+func main() {
+	out := flag.String("o", "", "output file (default stdout)")
+	flag.Parse()
+	if err := run(*out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(out string) error {
+	if out == "" {
+		return write(os.Stdout, generate())
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	err = write(f, generate())
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// write encodes vectors as a JSON array with one vector per line.
+func write(w io.Writer, vectors []Vector) error {
+	if _, err := io.WriteString(w, "[\n"); err != nil {
+		return err
+	}
+	for i, v := range vectors {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if i < len(vectors)-1 {
+			b = append(b, ',')
+		}
+		b = append(b, '\n')
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "]\n")
+	return err
+}
+
+// generate returns test vectors in a deterministic order.
+func generate() []Vector {
+	var vectors []Vector
+
+	// Unary operations
+	for _, s := range operands {
+		d := decimal.MustParse(s)
+		vectors = append(vectors,
+			unary("string", s, d.String(), nil),
+			unary("neg", s, d.Neg().String(), nil),
+			unary("abs", s, d.Abs().String(), nil),
+			unary("trim", s, d.Trim(0).String(), nil),
+		)
+		e, err := d.Sqrt()
+		vectors = append(vectors, unary("sqrt", s, e.String(), err))
+		e, err = d.Exp()
+		vectors = append(vectors, unary("exp", s, e.String(), err))
+		e, err = d.Log()
+		vectors = append(vectors, unary("log", s, e.String(), err))
+		e, err = d.Inv()
+		vectors = append(vectors, unary("inv", s, e.String(), err))
+	}
+
+	// Rounding
+	for _, s := range operands {
+		d := decimal.MustParse(s)
+		for _, scale := range scales {
+			for _, m := range modes {
+				vectors = append(vectors, Vector{
+					Op:    "round",
+					Args:  []string{s},
+					Scale: &scale,
+					Mode:  m.String(),
+					Want:  []string{d.RoundMode(scale, m).String()},
+				})
+			}
+		}
+	}
+
+	// Binary operations
+	for _, s := range operands {
+		d := decimal.MustParse(s)
+		for _, t := range operands {
+			e := decimal.MustParse(t)
+			f, err := d.Add(e)
+			vectors = append(vectors, halfEven(binary("add", s, t, err, f)))
+			f, err = d.Sub(e)
+			vectors = append(vectors, halfEven(binary("sub", s, t, err, f)))
+			f, err = d.Mul(e)
+			vectors = append(vectors, halfEven(binary("mul", s, t, err, f)))
+			f, err = d.Quo(e)
+			vectors = append(vectors, halfEven(binary("quo", s, t, err, f)))
+			q, r, err := d.QuoRem(e)
+			vectors = append(vectors, binary("quorem", s, t, err, q, r))
+			vectors = append(vectors, Vector{
+				Op:   "cmp",
+				Args: []string{s, t},
+				Want: []string{fmt.Sprint(d.Cmp(e))},
+			})
+		}
+	}
+
+	// Binary operations rounded to a domain
+	for _, s := range operands {
+		d := decimal.MustParse(s)
+		for _, t := range operands {
+			e := decimal.MustParse(t)
+			for _, scale := range domainScales {
+				for _, m := range modes {
+					dom := decimal.Domain{Scale: scale, Mode: m}
+					f, err := dom.Add(d, e)
+					vectors = append(vectors, rounded("add", s, t, dom, f, err))
+					f, err = dom.Sub(d, e)
+					vectors = append(vectors, rounded("sub", s, t, dom, f, err))
+					f, err = dom.Mul(d, e)
+					vectors = append(vectors, rounded("mul", s, t, dom, f, err))
+					f, err = dom.Quo(d, e)
+					vectors = append(vectors, rounded("quo", s, t, dom, f, err))
+				}
+			}
+		}
+	}
+
+	// Integer powers
+	for _, s := range operands {
+		d := decimal.MustParse(s)
+		for _, power := range []int{-2, -1, 0, 1, 2, 3, 10} {
+			e, err := d.PowInt(power)
+			vectors = append(vectors, binary("powint", s, fmt.Sprint(power), err, e))
+		}
+	}
+
+	return vectors
+}
+
+func unary(op, s, want string, err error) Vector {
+	v := Vector{Op: op, Args: []string{s}}
+	if err != nil {
+		v.Error = true
+		return v
+	}
+	v.Want = []string{want}
+	return v
+}
+
+// halfEven sets the mode of a vector to half-to-even rounding,
+// which is used by arithmetic operations when the result does not fit
+// into [decimal.MaxPrec] digits.
+func halfEven(v Vector) Vector {
+	v.Mode = decimal.HalfEven.String()
+	return v
+}
+
+// rounded returns a vector of a binary operation rounded to the scale
+// of the domain using its rounding mode.
+func rounded(op, s, t string, dom decimal.Domain, want decimal.Decimal, err error) Vector {
+	v := binary(op, s, t, err, want)
+	v.Scale = &dom.Scale
+	v.Mode = dom.Mode.String()
+	return v
+}
+
+func binary(op, s, t string, err error, want ...decimal.Decimal) Vector {
+	v := Vector{Op: op, Args: []string{s, t}}
+	if err != nil {
+		v.Error = true
+		return v
+	}
+	for _, w := range want {
+		v.Want = append(v.Want, w.String())
+	}
+	return v
+}
@@ -0,0 +1,64 @@
+//go:build !decimalnobig
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	vectors := generate()
+	if len(vectors) == 0 {
+		t.Fatalf("generate() returned no vectors")
+	}
+	for _, v := range vectors {
+		if v.Op == "" || len(v.Args) == 0 {
+			t.Errorf("vector %+v has no operation or arguments", v)
+		}
+		if v.Error == (len(v.Want) > 0) {
+			t.Errorf("vector %+v must have either result or error", v)
+		}
+	}
+}
+
+func TestGenerate_modes(t *testing.T) {
+	got := map[[2]string]bool{}
+	for _, v := range generate() {
+		switch v.Op {
+		case "add", "sub", "mul", "quo":
+			if v.Mode == "" {
+				t.Errorf("vector %+v has no rounding mode", v)
+			}
+			got[[2]string{v.Op, v.Mode}] = true
+		}
+	}
+	for _, op := range []string{"add", "sub", "mul", "quo"} {
+		for _, m := range modes {
+			if !got[[2]string{op, m.String()}] {
+				t.Errorf("generate() returned no %q vectors with %q rounding", op, m)
+			}
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var b1, b2 bytes.Buffer
+	if err := write(&b1, generate()); err != nil {
+		t.Fatalf("write() failed: %v", err)
+	}
+	if err := write(&b2, generate()); err != nil {
+		t.Fatalf("write() failed: %v", err)
+	}
+	if !bytes.Equal(b1.Bytes(), b2.Bytes()) {
+		t.Errorf("write() is not deterministic")
+	}
+	var got []Vector
+	if err := json.Unmarshal(b1.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+	if len(got) != len(generate()) {
+		t.Errorf("json.Unmarshal() returned %v vectors, want %v", len(got), len(generate()))
+	}
+}
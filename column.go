@@ -0,0 +1,145 @@
+package decimal
+
+import "fmt"
+
+// maxColumnPrec is a maximum precision of fixed-point columns
+// in Oracle and SQL Server.
+const maxColumnPrec = 38
+
+// Column describes a fixed-point database column, such as NUMBER(p, s)
+// in Oracle or DECIMAL(p, s) in SQL Server, where p is the precision
+// (the total number of digits) and s is the scale (the number of digits
+// after the decimal point).
+// A column can store decimals with at most (p - s) digits in the integer part.
+// Use [OracleNumber] or [SQLServerDecimal] to create a column with the
+// limits and rounding of the respective database.
+//
+// Both databases silently round decimals with more than s digits
+// after the decimal point when they are inserted, and reject decimals
+// with too many digits in the integer part.
+// Use [Column.Round] to perform the same rounding on the client side,
+// or [Column.Validate] to reject such decimals instead.
+type Column struct {
+	Precision int          // Precision is the total number of digits.
+	Scale     int          // Scale is the number of digits after the decimal point.
+	Mode      RoundingMode // Mode is the method used to round decimals to the scale.
+}
+
+// OracleNumber returns a column of the Oracle NUMBER(p, s) type.
+// Decimals are rounded half away from zero, as in Oracle.
+// NUMBER(p) is equivalent to NUMBER(p, 0).
+//
+// OracleNumber returns an error if:
+//   - the precision is not within the range [1, 38];
+//   - the scale is not within the range [0, 127].
+//
+// Negative scales, which round the integer part, are not supported.
+func OracleNumber(precision, scale int) (Column, error) {
+	if precision < 1 || precision > maxColumnPrec {
+		return Column{}, errorf("creating NUMBER(%v, %v): %w: precision %v is not within the range [1, %v]", precision, scale, errInvalidOperation, precision, maxColumnPrec)
+	}
+	if scale < 0 || scale > 127 {
+		return Column{}, errorf("creating NUMBER(%v, %v): %w: scale %v is not within the range [0, 127]", precision, scale, errInvalidOperation, scale)
+	}
+	return Column{Precision: precision, Scale: scale, Mode: HalfUp}, nil
+}
+
+// SQLServerDecimal returns a column of the SQL Server DECIMAL(p, s) type,
+// which is also known as NUMERIC(p, s).
+// Decimals are rounded half away from zero, as in SQL Server.
+//
+// SQLServerDecimal returns an error if:
+//   - the precision is not within the range [1, 38];
+//   - the scale is not within the range [0, p].
+func SQLServerDecimal(precision, scale int) (Column, error) {
+	if precision < 1 || precision > maxColumnPrec {
+		return Column{}, errorf("creating DECIMAL(%v, %v): %w: precision %v is not within the range [1, %v]", precision, scale, errInvalidOperation, precision, maxColumnPrec)
+	}
+	if scale < 0 || scale > precision {
+		return Column{}, errorf("creating DECIMAL(%v, %v): %w: scale %v is not within the range [0, %v]", precision, scale, errInvalidOperation, scale, precision)
+	}
+	return Column{Precision: precision, Scale: scale, Mode: HalfUp}, nil
+}
+
+// Round returns a decimal rounded to the scale of the column using
+// the rounding mode of the column, which is the value the database
+// stores when the decimal is inserted.
+// Unlike [Domain.Rescale], Round does not pad the result with trailing zeros.
+//
+// Round returns an error if:
+//   - the precision of the column is not positive or its scale is negative;
+//   - the integer part of the result has more than (p - s) digits,
+//     in which case the error wraps a [*ColumnRangeError].
+func (c Column) Round(d Decimal) (Decimal, error) {
+	if err := c.validate(); err != nil {
+		return Decimal{}, errorf("rounding %v: %w", redact(d), err)
+	}
+	f := d.RoundMode(c.Scale, c.Mode)
+	if !c.fits(f) {
+		return Decimal{}, errorf("rounding %v: %w", redact(d), &ColumnRangeError{Value: f, Precision: c.Precision, Scale: c.Scale})
+	}
+	return f, nil
+}
+
+// Validate checks that the decimal can be stored in the column
+// without rounding.
+//
+// Validate returns an error if:
+//   - the precision of the column is not positive or its scale is negative;
+//   - the decimal has non-zero digits beyond s digits after the decimal point;
+//   - the integer part of the decimal has more than (p - s) digits,
+//     in which case the error wraps a [*ColumnRangeError].
+func (c Column) Validate(d Decimal) error {
+	if err := c.validate(); err != nil {
+		return errorf("validating %v: %w", redact(d), err)
+	}
+	if d.MinScale() > c.Scale {
+		return errorf("validating %v: %w: more than %v digits after the decimal point", redact(d), errInvalidOperation, c.Scale)
+	}
+	if !c.fits(d) {
+		return errorf("validating %v: %w", redact(d), &ColumnRangeError{Value: d, Precision: c.Precision, Scale: c.Scale})
+	}
+	return nil
+}
+
+// validate checks the precision and scale of the column.
+func (c Column) validate() error {
+	if c.Precision < 1 || c.Scale < 0 {
+		return errorf("%w: invalid column (%v, %v)", errInvalidOperation, c.Precision, c.Scale)
+	}
+	return nil
+}
+
+// fits returns true if the integer part of the decimal has at most
+// (p - s) digits.
+func (c Column) fits(d Decimal) bool {
+	if d.IsZero() {
+		return true
+	}
+	return d.Prec()-d.Scale() <= c.Precision-c.Scale
+}
+
+// ColumnRangeError is returned by methods of [Column] when the integer
+// part of a decimal has too many digits to be stored in the column.
+type ColumnRangeError struct {
+	Value     Decimal // value that does not fit into the column
+	Precision int     // precision of the column
+	Scale     int     // scale of the column
+}
+
+func (e *ColumnRangeError) Error() string {
+	return e.message(e.Value.String())
+}
+
+func (e *ColumnRangeError) redactedError() string {
+	return e.message(mask(e.Value.String()))
+}
+
+func (e *ColumnRangeError) message(value string) string {
+	return fmt.Sprintf("%v: %v does not fit into a column with precision %v and scale %v", errDecimalOverflow, value, e.Precision, e.Scale)
+}
+
+// Unwrap returns the underlying error, which is a decimal overflow error.
+func (e *ColumnRangeError) Unwrap() error {
+	return errDecimalOverflow
+}
@@ -0,0 +1,202 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOracleNumber(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			precision, scale int
+		}{
+			{1, 0},
+			{38, 0},
+			{10, 2},
+			{2, 5},
+			{38, 127},
+		}
+		for _, tt := range tests {
+			got, err := OracleNumber(tt.precision, tt.scale)
+			if err != nil {
+				t.Errorf("OracleNumber(%v, %v) failed: %v", tt.precision, tt.scale, err)
+				continue
+			}
+			want := Column{Precision: tt.precision, Scale: tt.scale, Mode: HalfUp}
+			if got != want {
+				t.Errorf("OracleNumber(%v, %v) = %v, want %v", tt.precision, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			precision, scale int
+		}{
+			{0, 0},
+			{39, 0},
+			{10, -1},
+			{10, 128},
+		}
+		for _, tt := range tests {
+			_, err := OracleNumber(tt.precision, tt.scale)
+			if err == nil {
+				t.Errorf("OracleNumber(%v, %v) did not fail", tt.precision, tt.scale)
+			}
+		}
+	})
+}
+
+func TestSQLServerDecimal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			precision, scale int
+		}{
+			{1, 0},
+			{38, 0},
+			{38, 38},
+			{19, 4},
+		}
+		for _, tt := range tests {
+			got, err := SQLServerDecimal(tt.precision, tt.scale)
+			if err != nil {
+				t.Errorf("SQLServerDecimal(%v, %v) failed: %v", tt.precision, tt.scale, err)
+				continue
+			}
+			want := Column{Precision: tt.precision, Scale: tt.scale, Mode: HalfUp}
+			if got != want {
+				t.Errorf("SQLServerDecimal(%v, %v) = %v, want %v", tt.precision, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			precision, scale int
+		}{
+			{0, 0},
+			{39, 0},
+			{10, -1},
+			{2, 5},
+		}
+		for _, tt := range tests {
+			_, err := SQLServerDecimal(tt.precision, tt.scale)
+			if err == nil {
+				t.Errorf("SQLServerDecimal(%v, %v) did not fail", tt.precision, tt.scale)
+			}
+		}
+	})
+}
+
+func TestColumn_Round(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			precision, scale int
+			d, want          string
+		}{
+			{38, 0, "9999999999999999999", "9999999999999999999"},
+			{38, 0, "2.5", "3"},
+			{38, 0, "-2.5", "-3"},
+			{5, 2, "999.994", "999.99"},
+			{5, 2, "1", "1"},
+			{5, 2, "-0.005", "-0.01"},
+			{2, 5, "0.00099", "0.00099"},
+			{2, 5, "0.000994", "0.00099"},
+			{2, 5, "0.000001", "0.00000"},
+			{38, 30, "0.1234567890123456789", "0.1234567890123456789"},
+			{1, 127, "0", "0"},
+		}
+		for _, tt := range tests {
+			c := Column{Precision: tt.precision, Scale: tt.scale, Mode: HalfUp}
+			d := MustParse(tt.d)
+			got, err := c.Round(d)
+			if err != nil {
+				t.Errorf("%v.Round(%q) failed: %v", c, d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%v.Round(%q) = %q, want %q", c, d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			precision, scale int
+			d                string
+		}{
+			{5, 2, "999.995"},
+			{5, 2, "-1000"},
+			{2, 5, "0.000995"},
+			{18, 0, "9999999999999999999"},
+			{0, 0, "1"},
+			{10, -1, "1"},
+		}
+		for _, tt := range tests {
+			c := Column{Precision: tt.precision, Scale: tt.scale, Mode: HalfUp}
+			d := MustParse(tt.d)
+			_, err := c.Round(d)
+			if err == nil {
+				t.Errorf("%v.Round(%q) did not fail", c, d)
+			}
+		}
+
+		c := Column{Precision: 5, Scale: 2}
+		_, err := c.Round(MustParse("1000"))
+		var rerr *ColumnRangeError
+		if !errors.As(err, &rerr) {
+			t.Errorf("%v.Round(1000) = %v, want *ColumnRangeError", c, err)
+		} else if rerr.Precision != 5 || rerr.Scale != 2 || rerr.Value != MustParse("1000") {
+			t.Errorf("%v.Round(1000) = %+v, want {1000 5 2}", c, rerr)
+		}
+		if !errors.Is(err, errDecimalOverflow) {
+			t.Errorf("%v.Round(1000) = %v, want overflow error", c, err)
+		}
+	})
+}
+
+func TestColumn_Validate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			precision, scale int
+			d                string
+		}{
+			{38, 0, "9999999999999999999"},
+			{38, 0, "1.000"},
+			{5, 2, "999.99"},
+			{5, 2, "-999.990"},
+			{2, 5, "0.00099"},
+			{1, 0, "0"},
+		}
+		for _, tt := range tests {
+			c := Column{Precision: tt.precision, Scale: tt.scale}
+			d := MustParse(tt.d)
+			err := c.Validate(d)
+			if err != nil {
+				t.Errorf("%v.Validate(%q) failed: %v", c, d, err)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			precision, scale int
+			d                string
+		}{
+			{38, 0, "1.5"},
+			{5, 2, "999.991"},
+			{5, 2, "1000"},
+			{2, 5, "0.001"},
+			{0, 0, "0"},
+		}
+		for _, tt := range tests {
+			c := Column{Precision: tt.precision, Scale: tt.scale}
+			d := MustParse(tt.d)
+			err := c.Validate(d)
+			if err == nil {
+				t.Errorf("%v.Validate(%q) did not fail", c, d)
+			}
+		}
+	})
+}
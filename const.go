@@ -0,0 +1,65 @@
+package decimal
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// consts holds the decimals declared using [Const].
+var consts struct {
+	mu      sync.Mutex
+	entries []ConstEntry
+}
+
+// ConstEntry describes a decimal declared using [Const].
+type ConstEntry struct {
+	Value Decimal // Value is the parsed decimal.
+	Text  string  // Text is the string passed to Const.
+	File  string  // File is the source file of the declaration, or "" if unknown.
+	Line  int     // Line is the line number of the declaration, or 0 if unknown.
+}
+
+// String implements the [fmt.Stringer] interface and returns
+// the declaration site and the value, for example, "rates.go:12: 0.0025".
+func (c ConstEntry) String() string {
+	if c.File == "" {
+		return fmt.Sprintf("unknown: %v", c.Value)
+	}
+	return fmt.Sprintf("%v:%v: %v", c.File, c.Line, c.Value)
+}
+
+// Const is like [MustParse], but it also records the decimal together with
+// its declaration site in a process-wide registry, which can be listed using [Consts].
+// It is intended for hard-coded rates and limits declared in global variables,
+// so that configuration audits can find every such constant in a binary:
+//
+//	var feeRate = decimal.Const("0.0025")
+//
+// Const panics if the string cannot be parsed.
+// It is safe to call Const concurrently with other functions.
+func Const(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("Const(%q) failed: %v", s, err))
+	}
+	c := ConstEntry{Value: d, Text: s}
+	if _, file, line, ok := runtime.Caller(1); ok {
+		c.File, c.Line = file, line
+	}
+	consts.mu.Lock()
+	consts.entries = append(consts.entries, c)
+	consts.mu.Unlock()
+	return d
+}
+
+// Consts returns the decimals declared using [Const] so far,
+// in the order of declaration.
+// The returned slice is a copy and can be modified by the caller.
+// It is safe to call Consts concurrently with other functions.
+func Consts() []ConstEntry {
+	consts.mu.Lock()
+	defer consts.mu.Unlock()
+	return slices.Clone(consts.entries)
+}
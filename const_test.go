@@ -0,0 +1,75 @@
+package decimal
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+var testConstRate = Const("0.0025")
+
+func TestConst(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		before := len(Consts())
+		d := Const("-1.50")
+		if got, want := d, MustParse("-1.50"); got != want {
+			t.Errorf("Const(%q) = %q, want %q", "-1.50", got, want)
+		}
+
+		got := Consts()
+		if len(got) != before+1 {
+			t.Fatalf("len(Consts()) = %v, want %v", len(got), before+1)
+		}
+		c := got[len(got)-1]
+		if c.Value != d || c.Text != "-1.50" {
+			t.Errorf("Consts() last entry = %v %q, want %v %q", c.Value, c.Text, d, "-1.50")
+		}
+		if filepath.Base(c.File) != "const_test.go" || c.Line == 0 {
+			t.Errorf("Consts() last entry location = %v:%v, want const_test.go", c.File, c.Line)
+		}
+
+		// Global declarations are registered during package initialization
+		found := false
+		for _, c := range got {
+			if c.Value == testConstRate && filepath.Base(c.File) == "const_test.go" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Consts() does not contain %q", testConstRate)
+		}
+
+		// Modifying the result does not affect the registry
+		got[0] = ConstEntry{}
+		if Consts()[0] == (ConstEntry{}) {
+			t.Errorf("Consts() returned the registry itself")
+		}
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		before := len(Consts())
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Const(%q) did not panic", "1.2.3")
+			}
+			if got := len(Consts()); got != before {
+				t.Errorf("len(Consts()) = %v, want %v", got, before)
+			}
+		}()
+		Const("1.2.3")
+	})
+}
+
+func TestConstEntry_String(t *testing.T) {
+	tests := []struct {
+		c    ConstEntry
+		want string
+	}{
+		{ConstEntry{Value: MustParse("0.0025"), Text: "0.0025", File: "rates.go", Line: 12}, "rates.go:12: 0.0025"},
+		{ConstEntry{Value: MustParse("1.50"), Text: "1.50"}, "unknown: 1.50"},
+	}
+	for _, tt := range tests {
+		if got := tt.c.String(); got != tt.want {
+			t.Errorf("%#v.String() = %q, want %q", tt.c, got, tt.want)
+		}
+	}
+}
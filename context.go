@@ -0,0 +1,361 @@
+package decimal
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+// ScalePolicy specifies how a [Context] chooses the scale of its results.
+// The zero value is [ScaleDefault].
+type ScalePolicy int
+
+const (
+	ScaleDefault ScalePolicy = iota // ScaleDefault keeps the scale chosen by the corresponding Decimal method, for example, the maximum of the operand scales for Decimal.Add.
+	ScaleFixed                      // ScaleFixed rounds or pads results to Context.Scale, like a MySQL DECIMAL(p, s) column.
+	ScaleMinimal                    // ScaleMinimal removes all trailing zeros from results.
+	ScaleCapped                     // ScaleCapped keeps the scale chosen by the corresponding Decimal method, but rounds results with a scale greater than Context.Scale to Context.Scale.
+)
+
+// Context specifies how the scale of arithmetic results is chosen,
+// so that computed values match the scale of the destination schema
+// without calling [Decimal.Pad] or [Decimal.Trim] after every operation:
+//
+//	mysql := decimal.Context{ScalePolicy: decimal.ScaleFixed, Scale: 4, Mode: decimal.HalfUp}
+//
+// Results that are too small to be represented, such as 1e-19 / 3,
+// are silently rounded to zero, unless TrapUnderflow is set:
+//
+//	risk := decimal.Context{TrapUnderflow: true}
+//
+// Long chains of operations, such as multiplying many factors, tend to end
+// with results of [MaxScale] digits after the decimal point, which makes
+// subsequent operations fall back to slower *big.Int arithmetic.
+// To limit the growth of the scale without padding short results, use
+// ScaleCapped:
+//
+//	capped := decimal.Context{ScalePolicy: decimal.ScaleCapped, Scale: 9}
+//
+// With ScaleCapped, a result is exact if its exact scale does not exceed
+// the cap, and rounded using Mode otherwise, so rounding can be detected
+// with OnRounded as usual.
+// As with [Domain], the exact result is rounded only once.
+// If Scale is negative, the cap is 0.
+//
+// Like the context of the General Decimal Arithmetic specification,
+// a context can also limit the precision and the adjusted exponent of results,
+// that is, the exponent of their most significant digit, such as 2 for 123.45:
+//
+//	engine := decimal.Context{Precision: 12, Emax: 9, Emin: -9, Mode: decimal.HalfUp, TrapInexact: true}
+//
+// The limits are applied together with the scale policy, so that the exact
+// result is rounded only once.
+// Results with more than Precision significant digits are rounded using Mode,
+// which may reduce their scale below Scale, and results whose integer part has
+// more than Precision digits are reported as overflow.
+// Results whose adjusted exponent is greater than Emax are also reported
+// as overflow, while non-zero results whose adjusted exponent is less than
+// Emin are rounded to -Emin digits after the decimal point using Mode.
+// If TrapInexact is set, methods return an error instead of any rounded result.
+// Since all settings are fields of a context value, engines with different
+// rounding policies can coexist in one process without global variables.
+//
+// To find out where results are rounded, for example, to demonstrate
+// to auditors where rounding occurs in a pricing pipeline, set OnRounded:
+//
+//	audit := decimal.Context{OnRounded: func(ev decimal.RoundingEvent) { log.Println(ev) }}
+//
+// To keep sensitive amounts out of logs, set Redact:
+//
+//	private := decimal.Context{Redact: true}
+//
+// The zero value uses [ScaleDefault], so its methods behave exactly
+// like the corresponding methods of [Decimal].
+// Context is designed to be safe for concurrent use by multiple goroutines,
+// provided that OnRounded is and Rand is nil, since [rand.Rand] is not.
+type Context struct {
+	ScalePolicy   ScalePolicy         // ScalePolicy is the method used to choose the scale of results.
+	Scale         int                 // Scale is the scale of results when ScalePolicy is ScaleFixed, or the maximum scale of results when ScalePolicy is ScaleCapped.
+	Mode          RoundingMode        // Mode is the method used to round results when ScalePolicy is ScaleFixed or ScaleCapped, or when Precision, Emin are exceeded.
+	Rand          *rand.Rand          // Rand, if not nil, is the source of random numbers when Mode is Stochastic; otherwise, the global generator is used.
+	Precision     int                 // Precision is the maximum number of significant digits of results; zero or values greater than MaxPrec mean MaxPrec.
+	Emax          int                 // Emax, if positive, is the maximum adjusted exponent of results.
+	Emin          int                 // Emin, if negative, is the minimum adjusted exponent of non-zero results.
+	TrapUnderflow bool                // TrapUnderflow makes methods return an error instead of rounding a non-zero result to zero.
+	TrapInexact   bool                // TrapInexact makes methods return an error instead of a rounded result.
+	OnRounded     func(RoundingEvent) // OnRounded, if not nil, is called every time a method returns a rounded result.
+	Redact        bool                // Redact hides the digits of operands in error messages, as described in RedactError.
+}
+
+// RoundingEvent describes an operation of a [Context] whose result
+// is not equal to the exact result, that is, the operation signalled
+// the Inexact and Rounded conditions of the General Decimal Arithmetic
+// specification.
+type RoundingEvent struct {
+	Op        string  // Op is the operator: "+", "-", "*", or "/".
+	D, E      Decimal // D and E are the operands.
+	Result    Decimal // Result is the rounded result returned by the method.
+	Remainder Decimal // Remainder is the exact result minus Result, rounded to MaxScale digits after the decimal point if necessary.
+}
+
+// String implements the [fmt.Stringer] interface and returns a string
+// like "1 / 3 = 0.3333333333333333333 (remainder 0.0000000000000000000)".
+func (ev RoundingEvent) String() string {
+	return fmt.Sprintf("%v %v %v = %v (remainder %v)", ev.D, ev.Op, ev.E, ev.Result, ev.Remainder)
+}
+
+// finish checks the traps for the result f of the operation on decimals
+// d and e, and calls the OnRounded hook if the result is not exact.
+// The zero argument reports whether the exact result is zero.
+func (c Context) finish(op string, d, e, f Decimal, zero bool) (Decimal, error) {
+	if c.TrapUnderflow && f.IsZero() && !zero {
+		return Decimal{}, c.errorf("computing [%v %v %v]: %w", redact(d), op, redact(e), errDecimalUnderflow)
+	}
+	if c.OnRounded == nil && !c.TrapInexact {
+		return f, nil
+	}
+	r, inexact := remainder(op, d, e, f)
+	if !inexact {
+		return f, nil
+	}
+	if c.TrapInexact {
+		return Decimal{}, c.errorf("computing [%v %v %v]: %w: result is rounded to %v", redact(d), op, redact(e), errInexact, redact(f))
+	}
+	c.OnRounded(RoundingEvent{Op: op, D: d, E: e, Result: f, Remainder: r})
+	return f, nil
+}
+
+// round computes the exact result of the operation on decimals d and e
+// and rounds it only once to the scale chosen by the scale policy and
+// to the precision and the exponent limits of the context.
+func (c Context) round(op string, d, e Decimal) (Decimal, error) {
+	f, err := c.compute(op, d, e)
+	if err != nil {
+		return Decimal{}, c.errorf("computing [%v %v %v]: %w", redact(d), op, redact(e), err)
+	}
+	return f, nil
+}
+
+// errorf creates an error as described in [fmt.Errorf] and redacts it
+// if Redact is set.
+func (c Context) errorf(format string, a ...any) error {
+	err := errorf(format, a...)
+	if c.Redact {
+		return RedactError(err)
+	}
+	return err
+}
+
+// compute is like round, but it does not wrap errors.
+func (c Context) compute(op string, d, e Decimal) (Decimal, error) {
+	// Scale
+	var scale int
+	switch op {
+	case "+", "-":
+		scale = max(d.Scale(), e.Scale())
+	case "*":
+		scale = min(d.Scale()+e.Scale(), MaxScale)
+	default:
+		scale = MaxScale
+	}
+	switch c.ScalePolicy {
+	case ScaleFixed:
+		if c.Scale < MinScale || c.Scale > MaxScale {
+			return Decimal{}, scaleRangeError(c.Scale)
+		}
+		scale = c.Scale
+	case ScaleCapped:
+		scale = min(scale, max(c.Scale, MinScale))
+	}
+
+	// Precision
+	prec := c.Precision
+	if prec <= 0 || prec > MaxPrec {
+		prec = MaxPrec
+	}
+	f, err := roundOp(op, d, e, scale, prec, c.Mode, c.Rand)
+	if err != nil {
+		return Decimal{}, err
+	}
+	if c.ScalePolicy == ScaleFixed && f.Prec()-f.Scale() > MaxPrec-c.Scale {
+		return Decimal{}, overflowError(f.Prec(), f.Scale(), c.Scale)
+	}
+
+	// Exponent limits
+	if !f.IsZero() {
+		exp := f.Prec() - 1 - f.Scale()
+		if c.Emax > 0 && exp > c.Emax {
+			return Decimal{}, errorf("%w: the adjusted exponent of a result can be at most %v, but it is %v", errDecimalOverflow, c.Emax, exp)
+		}
+		if c.Emin < 0 && exp < c.Emin && f.Scale() > -c.Emin {
+			f, err = roundOp(op, d, e, -c.Emin, prec, c.Mode, c.Rand)
+			if err != nil {
+				return Decimal{}, err
+			}
+		}
+	}
+
+	// Preferred scale
+	switch {
+	case c.ScalePolicy == ScaleMinimal:
+		f = f.Trim(0)
+	case c.ScalePolicy != ScaleFixed && op == "/":
+		f = f.Trim(d.Scale() - e.Scale())
+	}
+	return f, nil
+}
+
+// Add returns the (possibly rounded) sum of decimals d and e with the scale
+// chosen by the scale policy of the context.
+// See also methods [Decimal.Add], [Domain.Add].
+func (c Context) Add(d, e Decimal) (Decimal, error) {
+	f, err := c.round("+", d, e)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return c.finish("+", d, e, f, d.Cmp(e.Neg()) == 0)
+}
+
+// Sub returns the (possibly rounded) difference between decimals d and e
+// with the scale chosen by the scale policy of the context.
+// See also methods [Decimal.Sub], [Domain.Sub].
+func (c Context) Sub(d, e Decimal) (Decimal, error) {
+	f, err := c.round("-", d, e)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return c.finish("-", d, e, f, d.Cmp(e) == 0)
+}
+
+// Mul returns the (possibly rounded) product of decimals d and e with
+// the scale chosen by the scale policy of the context.
+// See also methods [Decimal.Mul], [Domain.Mul].
+func (c Context) Mul(d, e Decimal) (Decimal, error) {
+	f, err := c.round("*", d, e)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return c.finish("*", d, e, f, d.IsZero() || e.IsZero())
+}
+
+// Quo returns the (possibly rounded) quotient of decimals d and e with
+// the scale chosen by the scale policy of the context.
+// See also methods [Decimal.Quo], [Domain.Quo].
+func (c Context) Quo(d, e Decimal) (Decimal, error) {
+	f, err := c.round("/", d, e)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return c.finish("/", d, e, f, d.IsZero())
+}
+
+// remainder computes the exact result of the operation on decimals d and e
+// minus the rounded result f, and reports whether the exact remainder
+// is non-zero.
+// If the remainder cannot be computed, which is possible only without
+// *big.Int arithmetic, remainder returns 0 and reports that f is not exact.
+func remainder(op string, d, e, f Decimal) (Decimal, bool) {
+	if op == "-" {
+		op, e = "+", e.Neg()
+	}
+	r, inexact, err := remainderFint(op, d, e, f)
+	if err != nil {
+		r, inexact, err = remainderBint(op, d, e, f)
+		if err != nil {
+			return Decimal{}, true
+		}
+	}
+	return r, inexact
+}
+
+// remainderFint computes the remainder using uint64 arithmetic.
+//
+//nolint:gocyclo
+func remainderFint(op string, d, e, f Decimal) (Decimal, bool, error) {
+	var ok bool
+	var nneg, xneg bool
+	var ncoef, xcoef fint
+	var scale, nscale int
+
+	// Compute n = x - f, where x is the exact result of the operation
+	// for addition and multiplication, or n = d - f * e for division
+	switch op {
+	case "+":
+		scale = max(d.Scale(), e.Scale(), f.Scale())
+		dcoef, ok := d.coef.lsh(scale - d.Scale())
+		if !ok {
+			return Decimal{}, false, errDecimalOverflow
+		}
+		ecoef, ok := e.coef.lsh(scale - e.Scale())
+		if !ok {
+			return Decimal{}, false, errDecimalOverflow
+		}
+		xneg, xcoef, ok = addSigned(d.IsNeg(), dcoef, e.IsNeg(), ecoef)
+		if !ok {
+			return Decimal{}, false, errDecimalOverflow
+		}
+		nneg, ncoef, nscale = !f.IsNeg(), f.coef, scale-f.Scale()
+	case "*":
+		scale = max(d.Scale()+e.Scale(), f.Scale())
+		xcoef, ok = d.coef.mul(e.coef)
+		if !ok {
+			return Decimal{}, false, errDecimalOverflow
+		}
+		xcoef, ok = xcoef.lsh(scale - d.Scale() - e.Scale())
+		if !ok {
+			return Decimal{}, false, errDecimalOverflow
+		}
+		xneg = d.IsNeg() != e.IsNeg()
+		nneg, ncoef, nscale = !f.IsNeg(), f.coef, scale-f.Scale()
+	case "/":
+		scale = max(d.Scale(), f.Scale()+e.Scale())
+		xcoef, ok = d.coef.lsh(scale - d.Scale())
+		if !ok {
+			return Decimal{}, false, errDecimalOverflow
+		}
+		xneg = d.IsNeg()
+		ncoef, ok = f.coef.mul(e.coef)
+		if !ok {
+			return Decimal{}, false, errDecimalOverflow
+		}
+		nneg, nscale = f.IsNeg() == e.IsNeg(), scale-f.Scale()-e.Scale()
+	default:
+		return Decimal{}, false, errInvalidOperation
+	}
+	ncoef, ok = ncoef.lsh(nscale)
+	if !ok {
+		return Decimal{}, false, errDecimalOverflow
+	}
+	nneg, ncoef, ok = addSigned(xneg, xcoef, nneg, ncoef)
+	if !ok {
+		return Decimal{}, false, errDecimalOverflow
+	}
+	if ncoef == 0 {
+		return Zero, false, nil
+	}
+
+	// Compute r = n or r = n / e
+	if op != "/" {
+		r, err := newFromFint(nneg, ncoef, scale, 0)
+		return r, true, err
+	}
+	if scale > MaxScale {
+		return Decimal{}, true, errDecimalOverflow
+	}
+	r, err := newUnsafe(nneg, ncoef, scale).quoFint(e, 0)
+	if err != nil {
+		return Decimal{}, true, err
+	}
+	return r.Trim(scale - e.Scale()), true, nil
+}
+
+// addSigned computes x + y, where the signs of x and y are given separately.
+func addSigned(xneg bool, x fint, yneg bool, y fint) (bool, fint, bool) {
+	if xneg == yneg {
+		z, ok := x.add(y)
+		return xneg, z, ok
+	}
+	if y > x {
+		xneg = yneg
+	}
+	return xneg, x.subAbs(y), true
+}
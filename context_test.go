@@ -0,0 +1,390 @@
+package decimal
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	ops := map[string]func(c Context, d, e Decimal) (Decimal, error){
+		"Add": Context.Add,
+		"Sub": Context.Sub,
+		"Mul": Context.Mul,
+		"Quo": Context.Quo,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			c    Context
+			op   string
+			d, e string
+			want string
+		}{
+			// Default
+			{Context{}, "Add", "1.10", "2.2", "3.30"},
+			{Context{}, "Sub", "1.10", "2.2", "-1.10"},
+			{Context{}, "Mul", "1.10", "2.2", "2.420"},
+			{Context{}, "Quo", "1.10", "2", "0.55"},
+
+			// Minimal
+			{Context{ScalePolicy: ScaleMinimal}, "Add", "1.10", "2.2", "3.3"},
+			{Context{ScalePolicy: ScaleMinimal}, "Sub", "1.10", "1.1", "0"},
+			{Context{ScalePolicy: ScaleMinimal}, "Mul", "1.10", "2.2", "2.42"},
+			{Context{ScalePolicy: ScaleMinimal}, "Quo", "5.00", "2", "2.5"},
+
+			// Fixed
+			{Context{ScalePolicy: ScaleFixed, Scale: 4}, "Add", "1.10", "2.2", "3.3000"},
+			{Context{ScalePolicy: ScaleFixed, Scale: 1}, "Sub", "1.15", "2.2", "-1.0"},
+			{Context{ScalePolicy: ScaleFixed, Scale: 1, Mode: HalfUp}, "Sub", "1.15", "2.2", "-1.1"},
+			{Context{ScalePolicy: ScaleFixed, Scale: 2, Mode: HalfUp}, "Mul", "1.15", "0.5", "0.58"},
+			{Context{ScalePolicy: ScaleFixed, Scale: 2, Mode: Down}, "Quo", "5", "8", "0.62"},
+			{Context{ScalePolicy: ScaleFixed}, "Quo", "5", "2", "2"},
+
+			// Capped
+			{Context{ScalePolicy: ScaleCapped, Scale: 2}, "Add", "1.10", "2.2", "3.30"},
+			{Context{ScalePolicy: ScaleCapped, Scale: 2}, "Sub", "1.1", "2", "-0.9"},
+			{Context{ScalePolicy: ScaleCapped, Scale: 2}, "Mul", "1.1", "2", "2.2"},
+			{Context{ScalePolicy: ScaleCapped, Scale: 2}, "Mul", "1.15", "0.5", "0.58"},
+			{Context{ScalePolicy: ScaleCapped, Scale: 2, Mode: Down}, "Quo", "5", "8", "0.62"},
+			{Context{ScalePolicy: ScaleCapped, Scale: 9}, "Quo", "1", "4", "0.25"},
+			{Context{ScalePolicy: ScaleCapped, Scale: 9}, "Mul", "1.00001", "1.00001", "1.000020000"},
+			{Context{ScalePolicy: ScaleCapped, Scale: -1}, "Mul", "1.5", "1.5", "2"},
+			{Context{ScalePolicy: ScaleCapped, Scale: 20}, "Mul", "0.0000000001", "0.0000000005", "0.0000000000000000000"},
+
+			// Unknown
+			{Context{ScalePolicy: -1}, "Mul", "1.10", "2.2", "2.420"},
+
+			// Underflow
+			{Context{}, "Quo", "0.0000000000000000001", "4", "0.0000000000000000000"},
+			{Context{}, "Mul", "0.0000000001", "0.0000000001", "0.0000000000000000000"},
+			{Context{TrapUnderflow: true}, "Add", "1.10", "-1.1", "0.00"},
+			{Context{TrapUnderflow: true}, "Sub", "1.10", "1.1", "0.00"},
+			{Context{TrapUnderflow: true}, "Mul", "0", "0.0000000001", "0.0000000000"},
+			{Context{TrapUnderflow: true}, "Quo", "0", "3", "0"},
+			{Context{TrapUnderflow: true}, "Quo", "0.0000000000000000003", "3", "0.0000000000000000001"},
+			{Context{TrapUnderflow: true, ScalePolicy: ScaleFixed, Scale: 2}, "Mul", "0.1", "0.1", "0.01"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := ops[tt.op](tt.c, d, e)
+			if err != nil {
+				t.Errorf("%v.%v(%q, %q) failed: %v", tt.c, tt.op, d, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%v.%v(%q, %q) = %q, want %q", tt.c, tt.op, d, e, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			c    Context
+			op   string
+			d, e string
+		}{
+			{Context{}, "Quo", "1", "0"},
+			{Context{ScalePolicy: ScaleMinimal}, "Quo", "1", "0"},
+			{Context{ScalePolicy: ScaleFixed}, "Quo", "1", "0"},
+			{Context{}, "Add", "9999999999999999999", "1"},
+			{Context{ScalePolicy: ScaleMinimal}, "Mul", "9999999999999999999", "2"},
+			{Context{ScalePolicy: ScaleFixed, Scale: 2}, "Add", "99999999999999999.99", "1"},
+			{Context{ScalePolicy: ScaleFixed, Scale: 20}, "Add", "1", "1"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			_, err := ops[tt.op](tt.c, d, e)
+			if err == nil {
+				t.Errorf("%v.%v(%q, %q) did not fail", tt.c, tt.op, d, e)
+			}
+		}
+	})
+
+	t.Run("underflow", func(t *testing.T) {
+		tests := []struct {
+			c    Context
+			op   string
+			d, e string
+		}{
+			{Context{TrapUnderflow: true}, "Quo", "0.0000000000000000001", "4"},
+			{Context{TrapUnderflow: true}, "Quo", "-0.0000000000000000002", "4"},
+			{Context{TrapUnderflow: true}, "Mul", "0.0000000001", "0.0000000001"},
+			{Context{TrapUnderflow: true, ScalePolicy: ScaleMinimal}, "Mul", "0.0000000001", "0.0000000001"},
+			{Context{TrapUnderflow: true, ScalePolicy: ScaleFixed, Scale: 2}, "Mul", "0.01", "0.1"},
+			{Context{TrapUnderflow: true, ScalePolicy: ScaleFixed, Scale: 2}, "Add", "0.001", "0.002"},
+			{Context{TrapUnderflow: true, ScalePolicy: ScaleFixed, Scale: 2}, "Sub", "0.003", "0.001"},
+			{Context{TrapUnderflow: true, ScalePolicy: ScaleCapped, Scale: 2}, "Mul", "0.01", "0.1"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			_, err := ops[tt.op](tt.c, d, e)
+			if !errors.Is(err, errDecimalUnderflow) {
+				t.Errorf("%v.%v(%q, %q) error = %v, want %v", tt.c, tt.op, d, e, err, errDecimalUnderflow)
+			}
+		}
+	})
+}
+
+func TestContext_ScaleCapped(t *testing.T) {
+	var rounded int
+	c := Context{
+		ScalePolicy: ScaleCapped,
+		Scale:       9,
+		OnRounded:   func(RoundingEvent) { rounded++ },
+	}
+	d := MustParse("1")
+	e := MustParse("1.01")
+	var err error
+	for i := range 30 {
+		d, err = c.Mul(d, e)
+		if err != nil {
+			t.Fatalf("Mul() failed at step %v: %v", i, err)
+		}
+		if d.Scale() > c.Scale {
+			t.Fatalf("Mul() at step %v = %q, scale is greater than %v", i, d, c.Scale)
+		}
+		// 1.01^n has 2n digits after the decimal point, so only the first 4 powers are exact.
+		if want := max(i+1-4, 0); rounded != want {
+			t.Errorf("OnRounded was called %v times after step %v, want %v", rounded, i, want)
+		}
+	}
+	if want := MustParse("1.347848915"); d != want {
+		t.Errorf("1.01^30 = %q, want %q", d, want)
+	}
+}
+
+func TestContext_OnRounded(t *testing.T) {
+	ops := map[string]func(c Context, d, e Decimal) (Decimal, error){
+		"+": Context.Add,
+		"-": Context.Sub,
+		"*": Context.Mul,
+		"/": Context.Quo,
+	}
+	tests := []struct {
+		c       Context
+		op      string
+		d, e    string
+		rounded bool
+		want    string
+	}{
+		// Exact
+		{Context{}, "+", "1.10", "2.2", false, ""},
+		{Context{}, "-", "1.10", "1.1", false, ""},
+		{Context{}, "*", "1.10", "2.2", false, ""},
+		{Context{}, "/", "1", "4", false, ""},
+		{Context{ScalePolicy: ScaleMinimal}, "/", "5.00", "2", false, ""},
+		{Context{ScalePolicy: ScaleFixed, Scale: 4}, "+", "1.10", "2.2", false, ""},
+
+		// Rounded
+		{Context{ScalePolicy: ScaleFixed, Scale: 2}, "/", "5", "8", true, "0.005"},
+		{Context{ScalePolicy: ScaleFixed, Scale: 1, Mode: HalfUp}, "-", "1.15", "2.2", true, "0.05"},
+		{Context{ScalePolicy: ScaleFixed, Scale: 1}, "+", "-1.15", "-2.2", true, "0.05"},
+		{Context{ScalePolicy: ScaleFixed, Scale: 2, Mode: HalfUp}, "*", "1.15", "0.5", true, "-0.005"},
+		{Context{ScalePolicy: ScaleFixed, Scale: 2, Mode: HalfUp}, "*", "-1.15", "0.5", true, "0.005"},
+		{Context{ScalePolicy: ScaleFixed, Scale: 2, Mode: Down}, "/", "-5", "8", true, "-0.005"},
+		{Context{ScalePolicy: ScaleFixed}, "/", "5", "2", true, "0.5"},
+		{Context{ScalePolicy: ScaleFixed}, "/", "5", "-2", true, "-0.5"},
+		{Context{}, "*", "0.0000000001", "0.0000000001", true, "0.0000000000000000000"},
+		{Context{}, "/", "0.0000000000000000001", "4", true, "0.0000000000000000000"},
+	}
+	for _, tt := range tests {
+		var got []RoundingEvent
+		tt.c.OnRounded = func(ev RoundingEvent) { got = append(got, ev) }
+		d := MustParse(tt.d)
+		e := MustParse(tt.e)
+		f, err := ops[tt.op](tt.c, d, e)
+		if err != nil {
+			t.Errorf("Context.%v(%q, %q) failed: %v", tt.op, d, e, err)
+			continue
+		}
+		if !tt.rounded {
+			if len(got) != 0 {
+				t.Errorf("Context(%q %v %q) reported %v, want no events", d, tt.op, e, got)
+			}
+			continue
+		}
+		want := RoundingEvent{Op: tt.op, D: d, E: e, Result: f, Remainder: MustParse(tt.want)}
+		if len(got) != 1 || got[0] != want {
+			t.Errorf("Context(%q %v %q) reported %v, want [%v]", d, tt.op, e, got, want)
+		}
+	}
+}
+
+func TestContext_Limits(t *testing.T) {
+	ops := map[string]func(c Context, d, e Decimal) (Decimal, error){
+		"+": Context.Add,
+		"-": Context.Sub,
+		"*": Context.Mul,
+		"/": Context.Quo,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			c    Context
+			op   string
+			d, e string
+			want string
+		}{
+			// Precision
+			{Context{Precision: 5}, "+", "1.23456", "1", "2.2346"},
+			{Context{Precision: 5}, "+", "1.2345", "1", "2.2345"},
+			{Context{Precision: 2, Mode: HalfUp}, "+", "9.9", "0.06", "10"},
+			{Context{Precision: 4}, "*", "0.123456", "1", "0.1235"},
+			{Context{Precision: 4, ScalePolicy: ScaleFixed, Scale: 6}, "*", "0.1234567", "1", "0.1235"},
+			{Context{Precision: 4, ScalePolicy: ScaleFixed, Scale: 6}, "*", "0.5", "0.5", "0.2500"},
+			{Context{Precision: 30}, "+", "1.23", "1", "2.23"},
+
+			// Exponent limits
+			{Context{Emax: 3}, "+", "999", "1", "1000"},
+			{Context{Emin: -3}, "*", "0.01", "0.02", "0.000"},
+			{Context{Emin: -3, Mode: Up}, "*", "0.01", "0.02", "0.001"},
+			{Context{Emin: -3}, "*", "0.01", "0.2", "0.002"},
+			{Context{Emin: -3}, "-", "1", "1", "0"},
+
+			// Traps
+			{Context{TrapInexact: true, Precision: 4}, "/", "1", "8", "0.125"},
+			{Context{TrapInexact: true, ScalePolicy: ScaleFixed, Scale: 2}, "*", "1.5", "0.5", "0.75"},
+			{Context{TrapInexact: true, TrapUnderflow: true, Emin: -3}, "-", "0.0001", "0.0001", "0.0000"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := ops[tt.op](tt.c, d, e)
+			if err != nil {
+				t.Errorf("Context(%q %v %q) failed: %v", d, tt.op, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("Context(%q %v %q) = %q, want %q", d, tt.op, e, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			c       Context
+			op      string
+			d, e    string
+			wantErr error
+		}{
+			{Context{Precision: 3}, "+", "999", "1", errDecimalOverflow},
+			{Context{Precision: 1}, "*", "5", "3", errDecimalOverflow},
+			{Context{Emax: 3}, "+", "9999", "1", errDecimalOverflow},
+			{Context{Emax: 1}, "/", "1000", "2", errDecimalOverflow},
+			{Context{Emin: -3, TrapUnderflow: true}, "*", "0.01", "0.02", errDecimalUnderflow},
+			{Context{TrapInexact: true, Precision: 4}, "*", "0.12345", "1", errInexact},
+			{Context{TrapInexact: true, ScalePolicy: ScaleFixed, Scale: 2}, "/", "1", "8", errInexact},
+			{Context{TrapInexact: true, Emin: -2}, "-", "0.101", "0.1", errInexact},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			_, err := ops[tt.op](tt.c, d, e)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Context(%q %v %q) did not fail with %v, got %v", d, tt.op, e, tt.wantErr, err)
+			}
+		}
+	})
+}
+
+func TestRoundingEvent_String(t *testing.T) {
+	ev := RoundingEvent{
+		Op:        "*",
+		D:         MustParse("1.15"),
+		E:         MustParse("0.5"),
+		Result:    MustParse("0.58"),
+		Remainder: MustParse("-0.005"),
+	}
+	got := ev.String()
+	want := "1.15 * 0.5 = 0.58 (remainder -0.005)"
+	if got != want {
+		t.Errorf("%v.String() = %q, want %q", ev, got, want)
+	}
+}
+
+func TestContext_singleRounding(t *testing.T) {
+	if !hasBint {
+		t.Skip("exact intermediate results require *big.Int arithmetic")
+	}
+
+	ops := map[string]func(c Context, d, e Decimal) (Decimal, error){
+		"+": Context.Add,
+		"-": Context.Sub,
+		"*": Context.Mul,
+		"/": Context.Quo,
+	}
+	tests := []struct {
+		c         Context
+		op        string
+		d, e      string
+		want      string
+		remainder string
+	}{
+		// Fixed
+		{Context{ScalePolicy: ScaleFixed, Scale: 0, Mode: Down}, "+", "999999999999999999.9", "0.05", "999999999999999999", "0.95"},
+		{Context{ScalePolicy: ScaleFixed, Scale: 0, Mode: Down}, "-", "-999999999999999999.9", "0.05", "-999999999999999999", "-0.95"},
+		{Context{ScalePolicy: ScaleFixed, Scale: 0, Mode: Floor}, "+", "-999999999999999998.9", "-0.05", "-999999999999999999", "0.05"},
+		{Context{ScalePolicy: ScaleFixed, Scale: 19, Mode: Up}, "*", "0.1234567890123456789", "0.5", "0.0617283945061728395", "0.0000000000000000000"},
+		{Context{ScalePolicy: ScaleFixed, Scale: 19, Mode: HalfUp}, "*", "0.1234567890123456789", "0.5", "0.0617283945061728395", "0.0000000000000000000"},
+
+		// Capped
+		{Context{ScalePolicy: ScaleCapped, Scale: 19, Mode: Up}, "*", "0.1234567890123456789", "0.5", "0.0617283945061728395", "0.0000000000000000000"},
+		{Context{ScalePolicy: ScaleCapped, Scale: 19, Mode: HalfUp}, "*", "0.1234567890123456789", "0.5", "0.0617283945061728395", "0.0000000000000000000"},
+		{Context{ScalePolicy: ScaleCapped, Scale: 19, Mode: Floor}, "*", "-0.1234567890123456789", "0.5", "-0.0617283945061728395", "0.0000000000000000000"},
+		{Context{ScalePolicy: ScaleCapped, Scale: 1, Mode: Down}, "+", "99999999999999999.99", "0.005", "99999999999999999.9", "0.095"},
+		{Context{ScalePolicy: ScaleCapped, Scale: 19, Mode: Down}, "/", "2", "3", "0.6666666666666666666", "0.0000000000000000001"},
+		{Context{ScalePolicy: ScaleCapped, Scale: 19, Mode: Up}, "/", "1", "3", "0.3333333333333333334", "-0.0000000000000000001"},
+
+		// Limits
+		{Context{Precision: 18, Mode: Down}, "+", "999999999999999999.9", "0.05", "999999999999999999", "0.95"},
+		{Context{Precision: 18, Mode: Ceiling}, "-", "-999999999999999999.9", "0.05", "-999999999999999999", "-0.95"},
+		{Context{Precision: 19, Mode: Up}, "*", "0.1234567890123456789", "0.5", "0.0617283945061728395", "0.0000000000000000000"},
+		{Context{Precision: 5, Mode: Down}, "*", "9.9999", "1.00001", "9.9999", "0.000099999"},
+		{Context{Precision: 5, Mode: Down, ScalePolicy: ScaleFixed, Scale: 6}, "+", "0.99999", "0.0000099", "0.99999", "0.0000099"},
+		{Context{Emin: -3, Mode: Up}, "*", "0.00019", "0.99999", "0.001", "-0.0008100019"},
+		{Context{Emin: -18, Mode: Up, Precision: 18}, "/", "1", "3000000000000000000", "0.000000000000000001", "-0.0000000000000000007"},
+	}
+	for _, tt := range tests {
+		var got []RoundingEvent
+		tt.c.OnRounded = func(ev RoundingEvent) { got = append(got, ev) }
+		d := MustParse(tt.d)
+		e := MustParse(tt.e)
+		f, err := ops[tt.op](tt.c, d, e)
+		if err != nil {
+			t.Errorf("Context(%q %v %q) failed: %v", d, tt.op, e, err)
+			continue
+		}
+		if want := MustParse(tt.want); f != want {
+			t.Errorf("Context(%q %v %q) = %q, want %q", d, tt.op, e, f, want)
+		}
+		want := RoundingEvent{Op: tt.op, D: d, E: e, Result: f, Remainder: MustParse(tt.remainder)}
+		if len(got) != 1 || got[0] != want {
+			t.Errorf("Context(%q %v %q) reported %v, want [%v]", d, tt.op, e, got, want)
+		}
+	}
+}
+
+func TestContext_Stochastic(t *testing.T) {
+	c := Context{ScalePolicy: ScaleFixed, Scale: 2, Mode: Stochastic, Rand: rand.New(rand.NewPCG(42, 42))}
+	m := Domain{Scale: 2, Mode: Stochastic, Rand: rand.New(rand.NewPCG(42, 42))}
+	d, e := MustParse("1"), MustParse("3")
+	down, up := MustParse("0.33"), MustParse("0.34")
+	for range 100 {
+		got, err := c.Quo(d, e)
+		if err != nil {
+			t.Fatalf("%v.Quo(%q, %q) failed: %v", c, d, e, err)
+		}
+		if got != down && got != up {
+			t.Errorf("%v.Quo(%q, %q) = %q, want %q or %q", c, d, e, got, down, up)
+		}
+		if want, _ := m.Quo(d, e); got != want {
+			t.Errorf("%v.Quo(%q, %q) = %q, want %q", c, d, e, got, want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package decimal
+
+import "math/bits"
+
+// Op specifies an operation whose cost is estimated by [EstimateCost].
+type Op int
+
+const (
+	OpAdd    Op = iota // OpAdd corresponds to Decimal.Add.
+	OpSub              // OpSub corresponds to Decimal.Sub.
+	OpMul              // OpMul corresponds to Decimal.Mul.
+	OpQuo              // OpQuo corresponds to Decimal.Quo.
+	OpPowInt           // OpPowInt corresponds to Decimal.PowInt, where the power is the integer part of the second operand.
+	OpSqrt             // OpSqrt corresponds to Decimal.Sqrt. The second operand is ignored.
+	OpExp              // OpExp corresponds to Decimal.Exp. The second operand is ignored.
+	OpLog              // OpLog corresponds to Decimal.Log. The second operand is ignored.
+)
+
+// Approximate costs of the operations in units of a Decimal.Add that
+// does not need big.Int arithmetic.
+const (
+	costFint = 1
+	costQuo  = 8
+	costBint = 30
+	costSqrt = 350
+	costExp  = 1500
+	costLog  = 15000
+)
+
+// EstimateCost returns a rough estimate of the CPU cost of applying
+// the operation to d and e.
+// The cost is measured in units of a single [Decimal.Add] that fits into
+// uint64 arithmetic, so it can be compared against a budget before executing
+// expensive operations, such as [Decimal.PowInt] or [Decimal.Log],
+// on untrusted input:
+//
+//	if decimal.EstimateCost(decimal.OpPowInt, d, e) > 1000 {
+//		return errTooExpensive
+//	}
+//
+// The estimate is not exact and depends on the hardware, but it is monotonic
+// in the size of the input: for example, the cost of [OpPowInt] grows
+// with the magnitude of the power.
+// All operations are bounded, so the cost never exceeds the cost of [OpLog].
+// If the operation is unknown, EstimateCost returns 0.
+// See also methods [Decimal.PowCtx], [Decimal.ExpCtx], [Decimal.LogCtx].
+func EstimateCost(op Op, d, e Decimal) int {
+	switch op {
+	case OpAdd, OpSub:
+		scale := max(d.Scale(), e.Scale())
+		if d.Prec()-d.Scale()+scale <= MaxPrec && e.Prec()-e.Scale()+scale <= MaxPrec {
+			return costFint
+		}
+		return costBint
+	case OpMul:
+		if d.Prec()+e.Prec() <= MaxPrec {
+			return costFint
+		}
+		return costBint
+	case OpQuo:
+		return costQuo
+	case OpPowInt:
+		return estimatePowInt(d, e)
+	case OpSqrt:
+		if d.IsZero() || d.IsNeg() {
+			return costFint
+		}
+		return costSqrt
+	case OpExp:
+		if d.IsZero() {
+			return costFint
+		}
+		return costExp
+	case OpLog:
+		if !d.IsPos() || d.IsOne() {
+			return costFint
+		}
+		return costLog
+	}
+	return 0
+}
+
+// estimatePowInt estimates the cost of raising d to the integer part of e.
+func estimatePowInt(d, e Decimal) int {
+	power, _, ok := e.Trunc(0).Int64(0)
+	if !ok {
+		power = 1<<63 - 1
+	}
+	if power < 0 && d.IsZero() {
+		return costFint
+	}
+	neg := power < 0
+	abs := uint64(power)
+	if neg {
+		abs = -abs
+	}
+
+	// Exponentiation by squaring performs at most two multiplications per bit
+	steps := 2 * bits.Len64(abs)
+	if !neg && uint64(d.Prec())*abs <= MaxPrec {
+		return max(costFint, costFint*steps)
+	}
+	cost := costBint * steps
+	if neg {
+		cost += costBint
+	}
+	return max(costFint, cost)
+}
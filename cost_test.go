@@ -0,0 +1,57 @@
+package decimal
+
+import "testing"
+
+func TestEstimateCost(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			op   Op
+			d, e string
+			want int
+		}{
+			{OpAdd, "1.5", "2", costFint},
+			{OpAdd, "1234567890.123456789", "0.1234567890123456789", costBint},
+			{OpSub, "1.5", "2", costFint},
+			{OpSub, "9999999999999999999", "0.1", costBint},
+			{OpMul, "1.5", "2", costFint},
+			{OpMul, "1234567890.123456789", "0.1234567890123456789", costBint},
+			{OpQuo, "1", "3", costQuo},
+			{OpPowInt, "2", "0", costFint},
+			{OpPowInt, "2", "10", 8 * costFint},
+			{OpPowInt, "0", "-1", costFint},
+			{OpPowInt, "0.1234567890123456789", "2", 4 * costBint},
+			{OpPowInt, "0.1234567890123456789", "-2", 5 * costBint},
+			{OpSqrt, "0", "0", costFint},
+			{OpSqrt, "-1", "0", costFint},
+			{OpSqrt, "2", "0", costSqrt},
+			{OpExp, "0", "0", costFint},
+			{OpExp, "1", "0", costExp},
+			{OpLog, "1", "0", costFint},
+			{OpLog, "-1", "0", costFint},
+			{OpLog, "2", "0", costLog},
+			{Op(-1), "2", "2", 0},
+		}
+		for _, tt := range tests {
+			d, e := MustParse(tt.d), MustParse(tt.e)
+			got := EstimateCost(tt.op, d, e)
+			if got != tt.want {
+				t.Errorf("EstimateCost(%v, %q, %q) = %v, want %v", tt.op, d, e, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("monotonic", func(t *testing.T) {
+		d := MustParse("1.000000000000000001")
+		prev := 0
+		for _, s := range []string{"1", "10", "1000", "1000000", "1000000000000", "9999999999999999999", "-9999999999999999999"} {
+			got := EstimateCost(OpPowInt, d, MustParse(s))
+			if got < prev {
+				t.Errorf("EstimateCost(OpPowInt, %q, %q) = %v, want >= %v", d, s, got, prev)
+			}
+			if got > costLog {
+				t.Errorf("EstimateCost(OpPowInt, %q, %q) = %v, want <= %v", d, s, got, costLog)
+			}
+			prev = got
+		}
+	})
+}
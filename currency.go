@@ -0,0 +1,384 @@
+package decimal
+
+import (
+	"unicode/utf8"
+)
+
+// currency holds the minor units and the cash rounding increment of a currency.
+type currency struct {
+	scale int     // number of digits after the decimal point
+	cash  Decimal // smallest cash denomination, zero if not different from the minor unit
+}
+
+// currencies is a table of active [ISO 4217] currencies.
+//
+// [ISO 4217]: https://www.iso.org/iso-4217-currency-codes.html
+var currencies = map[string]currency{
+	"AED": {scale: 2},
+	"AFN": {scale: 2},
+	"ALL": {scale: 2},
+	"AMD": {scale: 2},
+	"ANG": {scale: 2},
+	"AOA": {scale: 2},
+	"ARS": {scale: 2},
+	"AUD": {scale: 2, cash: MustNew(5, 2)},
+	"AWG": {scale: 2},
+	"AZN": {scale: 2},
+	"BAM": {scale: 2},
+	"BBD": {scale: 2},
+	"BDT": {scale: 2},
+	"BGN": {scale: 2},
+	"BHD": {scale: 3},
+	"BIF": {scale: 0},
+	"BMD": {scale: 2},
+	"BND": {scale: 2},
+	"BOB": {scale: 2},
+	"BOV": {scale: 2},
+	"BRL": {scale: 2},
+	"BSD": {scale: 2},
+	"BTN": {scale: 2},
+	"BWP": {scale: 2},
+	"BYN": {scale: 2},
+	"BZD": {scale: 2},
+	"CAD": {scale: 2, cash: MustNew(5, 2)},
+	"CDF": {scale: 2},
+	"CHE": {scale: 2},
+	"CHF": {scale: 2, cash: MustNew(5, 2)},
+	"CHW": {scale: 2},
+	"CLF": {scale: 4},
+	"CLP": {scale: 0},
+	"CNY": {scale: 2},
+	"COP": {scale: 2},
+	"COU": {scale: 2},
+	"CRC": {scale: 2},
+	"CUP": {scale: 2},
+	"CVE": {scale: 2},
+	"CZK": {scale: 2, cash: MustNew(1, 0)},
+	"DJF": {scale: 0},
+	"DKK": {scale: 2, cash: MustNew(50, 2)},
+	"DOP": {scale: 2},
+	"DZD": {scale: 2},
+	"EGP": {scale: 2},
+	"ERN": {scale: 2},
+	"ETB": {scale: 2},
+	"EUR": {scale: 2},
+	"FJD": {scale: 2},
+	"FKP": {scale: 2},
+	"GBP": {scale: 2},
+	"GEL": {scale: 2},
+	"GHS": {scale: 2},
+	"GIP": {scale: 2},
+	"GMD": {scale: 2},
+	"GNF": {scale: 0},
+	"GTQ": {scale: 2},
+	"GYD": {scale: 2},
+	"HKD": {scale: 2, cash: MustNew(10, 2)},
+	"HNL": {scale: 2},
+	"HTG": {scale: 2},
+	"HUF": {scale: 2, cash: MustNew(5, 0)},
+	"IDR": {scale: 2},
+	"ILS": {scale: 2, cash: MustNew(10, 2)},
+	"INR": {scale: 2},
+	"IQD": {scale: 3},
+	"IRR": {scale: 2},
+	"ISK": {scale: 0},
+	"JMD": {scale: 2},
+	"JOD": {scale: 3},
+	"JPY": {scale: 0},
+	"KES": {scale: 2},
+	"KGS": {scale: 2},
+	"KHR": {scale: 2},
+	"KMF": {scale: 0},
+	"KPW": {scale: 2},
+	"KRW": {scale: 0},
+	"KWD": {scale: 3},
+	"KYD": {scale: 2},
+	"KZT": {scale: 2},
+	"LAK": {scale: 2},
+	"LBP": {scale: 2},
+	"LKR": {scale: 2},
+	"LRD": {scale: 2},
+	"LSL": {scale: 2},
+	"LYD": {scale: 3},
+	"MAD": {scale: 2},
+	"MDL": {scale: 2},
+	"MGA": {scale: 2},
+	"MKD": {scale: 2},
+	"MMK": {scale: 2},
+	"MNT": {scale: 2},
+	"MOP": {scale: 2},
+	"MRU": {scale: 2},
+	"MUR": {scale: 2},
+	"MVR": {scale: 2},
+	"MWK": {scale: 2},
+	"MXN": {scale: 2},
+	"MXV": {scale: 2},
+	"MYR": {scale: 2},
+	"MZN": {scale: 2},
+	"NAD": {scale: 2},
+	"NGN": {scale: 2},
+	"NIO": {scale: 2},
+	"NOK": {scale: 2, cash: MustNew(1, 0)},
+	"NPR": {scale: 2},
+	"NZD": {scale: 2, cash: MustNew(10, 2)},
+	"OMR": {scale: 3},
+	"PAB": {scale: 2},
+	"PEN": {scale: 2},
+	"PGK": {scale: 2},
+	"PHP": {scale: 2},
+	"PKR": {scale: 2},
+	"PLN": {scale: 2},
+	"PYG": {scale: 0},
+	"QAR": {scale: 2},
+	"RON": {scale: 2},
+	"RSD": {scale: 2},
+	"RUB": {scale: 2},
+	"RWF": {scale: 0},
+	"SAR": {scale: 2},
+	"SBD": {scale: 2},
+	"SCR": {scale: 2},
+	"SDG": {scale: 2},
+	"SEK": {scale: 2, cash: MustNew(1, 0)},
+	"SGD": {scale: 2, cash: MustNew(5, 2)},
+	"SHP": {scale: 2},
+	"SLE": {scale: 2},
+	"SOS": {scale: 2},
+	"SRD": {scale: 2},
+	"SSP": {scale: 2},
+	"STN": {scale: 2},
+	"SVC": {scale: 2},
+	"SYP": {scale: 2},
+	"SZL": {scale: 2},
+	"THB": {scale: 2},
+	"TJS": {scale: 2},
+	"TMT": {scale: 2},
+	"TND": {scale: 3},
+	"TOP": {scale: 2},
+	"TRY": {scale: 2},
+	"TTD": {scale: 2},
+	"TWD": {scale: 2, cash: MustNew(1, 0)},
+	"TZS": {scale: 2},
+	"UAH": {scale: 2},
+	"UGX": {scale: 0},
+	"USD": {scale: 2},
+	"USN": {scale: 2},
+	"UYI": {scale: 0},
+	"UYU": {scale: 2},
+	"UYW": {scale: 4},
+	"UZS": {scale: 2},
+	"VED": {scale: 2},
+	"VES": {scale: 2},
+	"VND": {scale: 0},
+	"VUV": {scale: 0},
+	"WST": {scale: 2},
+	"XAF": {scale: 0},
+	"XCD": {scale: 2},
+	"XCG": {scale: 2},
+	"XOF": {scale: 0},
+	"XPF": {scale: 0},
+	"YER": {scale: 2},
+	"ZAR": {scale: 2, cash: MustNew(10, 2)},
+	"ZMW": {scale: 2},
+	"ZWG": {scale: 2},
+}
+
+// currencySymbols is a table of widely recognized symbols of currencies.
+// Symbols shared by several currencies, such as "$", are qualified
+// for all but the most common of them, as in the English locale
+// of the [Unicode CLDR].
+//
+// [Unicode CLDR]: https://cldr.unicode.org
+var currencySymbols = map[string]string{
+	"AUD": "A$",
+	"BRL": "R$",
+	"CAD": "CA$",
+	"CNY": "CN¥",
+	"EUR": "€",
+	"GBP": "£",
+	"HKD": "HK$",
+	"ILS": "₪",
+	"INR": "₹",
+	"JPY": "¥",
+	"KRW": "₩",
+	"MXN": "MX$",
+	"NZD": "NZ$",
+	"PHP": "₱",
+	"TWD": "NT$",
+	"USD": "$",
+	"VND": "₫",
+}
+
+// CurrencySymbol returns the symbol of the currency with the given
+// [ISO 4217] code, for example, "€" for "EUR" or "CA$" for "CAD".
+// Currencies without a widely recognized symbol, such as "CHF",
+// are represented by their code.
+// If the currency is unknown, CurrencySymbol returns false.
+// See also method [Decimal.FormatCurrency].
+//
+// [ISO 4217]: https://www.iso.org/iso-4217-currency-codes.html
+func CurrencySymbol(code string) (symbol string, ok bool) {
+	if _, ok := currencies[code]; !ok {
+		return "", false
+	}
+	if symbol, ok := currencySymbols[code]; ok {
+		return symbol, true
+	}
+	return code, true
+}
+
+// CurrencyScale returns the number of digits after the decimal point
+// (minor units) of the currency with the given [ISO 4217] code, for example,
+// 2 for "USD", 0 for "JPY", and 3 for "KWD".
+// If the currency is unknown, CurrencyScale returns false.
+// See also method [Decimal.RoundForCurrency].
+//
+// [ISO 4217]: https://www.iso.org/iso-4217-currency-codes.html
+func CurrencyScale(code string) (scale int, ok bool) {
+	c, ok := currencies[code]
+	if !ok {
+		return 0, false
+	}
+	return c.scale, true
+}
+
+// CashIncrement returns the smallest cash denomination of the currency with
+// the given [ISO 4217] code, for example, 0.05 for "CHF" or 1 for "SEK".
+// If cash amounts are not rounded differently from non-cash amounts,
+// the increment is equal to the minor unit of the currency, for example,
+// 0.01 for "USD".
+// If the currency is unknown, CashIncrement returns false.
+// See also method [Decimal.RoundCash].
+//
+// [ISO 4217]: https://www.iso.org/iso-4217-currency-codes.html
+func CashIncrement(code string) (inc Decimal, ok bool) {
+	c, ok := currencies[code]
+	if !ok {
+		return Decimal{}, false
+	}
+	if c.cash.IsZero() {
+		return newUnsafe(false, 1, c.scale), true
+	}
+	return c.cash.Pad(c.scale), true
+}
+
+// RoundForCurrency returns a decimal rounded or zero-padded to the number
+// of digits after the decimal point of the currency with the given [ISO 4217]
+// code using [rounding half to even] (banker's rounding).
+// See also function [CurrencyScale].
+//
+// RoundForCurrency returns an error if:
+//   - the currency is unknown;
+//   - the integer part of the result has more than ([MaxPrec] - [CurrencyScale]) digits.
+//
+// [ISO 4217]: https://www.iso.org/iso-4217-currency-codes.html
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal) RoundForCurrency(code string) (Decimal, error) {
+	scale, ok := CurrencyScale(code)
+	if !ok {
+		return Decimal{}, errorf("rounding %v: %w: unknown currency %q", redact(d), errInvalidOperation, code)
+	}
+	e, err := Domain{Scale: scale}.Rescale(d)
+	if err != nil {
+		return Decimal{}, errorf("rounding %v for %v: %w", redact(d), code, err)
+	}
+	return e, nil
+}
+
+// RoundCash returns a decimal rounded to the nearest multiple of the smallest
+// cash denomination of the currency with the given [ISO 4217] code, using
+// rounding half away from zero, as required for cash payments in countries
+// that have withdrawn their smallest coins.
+// For example, 1.025 CHF is rounded to 1.05, and 12.49 SEK is rounded to 12.00.
+// The result has the same scale as the currency.
+// See also function [CashIncrement].
+//
+// RoundCash returns an error if:
+//   - the currency is unknown;
+//   - the integer part of the result has more than ([MaxPrec] - [CurrencyScale]) digits.
+//
+// [ISO 4217]: https://www.iso.org/iso-4217-currency-codes.html
+func (d Decimal) RoundCash(code string) (Decimal, error) {
+	inc, ok := CashIncrement(code)
+	if !ok {
+		return Decimal{}, errorf("rounding %v: %w: unknown currency %q", redact(d), errInvalidOperation, code)
+	}
+	q, r, err := d.QuoRem(inc)
+	if err != nil {
+		return Decimal{}, errorf("rounding %v for %v: %w", redact(d), code, err)
+	}
+	// Half away from zero
+	r, err = r.Add(r)
+	if err != nil {
+		return Decimal{}, errorf("rounding %v for %v: %w", redact(d), code, err)
+	}
+	if r.CmpAbs(inc) >= 0 {
+		q, err = q.Add(One.CopySign(d))
+		if err != nil {
+			return Decimal{}, errorf("rounding %v for %v: %w", redact(d), code, err)
+		}
+	}
+	e, err := q.MulExact(inc, inc.Scale())
+	if err != nil {
+		return Decimal{}, errorf("rounding %v for %v: %w", redact(d), code, err)
+	}
+	return e, nil
+}
+
+// FormatCurrency returns a string representation of a monetary amount
+// in the currency with the given [ISO 4217] code, in the style of the locale
+// with the given [BCP 47] language tag, for example:
+//
+//	d.FormatCurrency("USD", "en-US") // $1,234.56
+//	d.FormatCurrency("EUR", "de-DE") // 1.234,56 €
+//	d.FormatCurrency("JPY", "ja-JP") // ¥1,235
+//
+// The decimal is rounded or zero-padded to the scale of the currency,
+// as described in [Decimal.RoundForCurrency].
+// The symbol is the one returned by [CurrencySymbol], and it is placed before
+// or after the number, with or without a no-break space (U+00A0), as defined
+// by the [Unicode CLDR] for the locale.
+// The minus sign always precedes both the symbol and the number.
+// See also function [LocaleOptions].
+//
+// FormatCurrency returns an error if:
+//   - the currency is unknown;
+//   - the locale is unknown;
+//   - the integer part of the result has more than ([MaxPrec] - [CurrencyScale]) digits.
+//
+// [ISO 4217]: https://www.iso.org/iso-4217-currency-codes.html
+// [BCP 47]: https://www.rfc-editor.org/info/bcp47
+// [Unicode CLDR]: https://cldr.unicode.org
+func (d Decimal) FormatCurrency(code, tag string) (string, error) {
+	loc, ok := lookupLocale(tag)
+	if !ok {
+		return "", errorf("formatting %v: %w: unknown locale %q", redact(d), errInvalidOperation, tag)
+	}
+	symbol, ok := CurrencySymbol(code)
+	if !ok {
+		return "", errorf("formatting %v: %w: unknown currency %q", redact(d), errInvalidOperation, code)
+	}
+	e, err := d.RoundForCurrency(code)
+	if err != nil {
+		return "", err
+	}
+
+	opts := FormatOptions{Grouping: true, GroupSeparator: loc.group, DecimalSeparator: loc.decimal}
+	b := make([]byte, 0, 32)
+	if e.IsNeg() {
+		b = append(b, '-')
+	}
+	if loc.prefix {
+		b = append(b, symbol...)
+		if loc.space {
+			b = utf8.AppendRune(b, '\u00a0')
+		}
+	}
+	b = opts.Append(b, e.Abs())
+	if !loc.prefix {
+		if loc.space {
+			b = utf8.AppendRune(b, '\u00a0')
+		}
+		b = append(b, symbol...)
+	}
+	return string(b), nil
+}
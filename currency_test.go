@@ -0,0 +1,242 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCurrencySymbol(t *testing.T) {
+	tests := []struct {
+		code   string
+		want   string
+		wantOk bool
+	}{
+		{"USD", "$", true},
+		{"EUR", "€", true},
+		{"CAD", "CA$", true},
+		{"JPY", "¥", true},
+		{"CHF", "CHF", true},
+		{"OMR", "OMR", true},
+		{"usd", "", false},
+		{"XXX", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := CurrencySymbol(tt.code)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("CurrencySymbol(%q) = %q, %v, want %q, %v", tt.code, got, ok, tt.want, tt.wantOk)
+		}
+	}
+	for code := range currencySymbols {
+		if _, ok := CurrencyScale(code); !ok {
+			t.Errorf("CurrencyScale(%q) failed", code)
+		}
+	}
+}
+
+func TestCurrencyScale(t *testing.T) {
+	tests := []struct {
+		code   string
+		want   int
+		wantOk bool
+	}{
+		{"USD", 2, true},
+		{"EUR", 2, true},
+		{"JPY", 0, true},
+		{"KRW", 0, true},
+		{"KWD", 3, true},
+		{"BHD", 3, true},
+		{"CLF", 4, true},
+		{"usd", 0, false},
+		{"XXX", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := CurrencyScale(tt.code)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("CurrencyScale(%q) = %v, %v, want %v, %v", tt.code, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestCashIncrement(t *testing.T) {
+	tests := []struct {
+		code   string
+		want   string
+		wantOk bool
+	}{
+		{"USD", "0.01", true},
+		{"JPY", "1", true},
+		{"KWD", "0.001", true},
+		{"CHF", "0.05", true},
+		{"SEK", "1.00", true},
+		{"DKK", "0.50", true},
+		{"HUF", "5.00", true},
+		{"XXX", "0", false},
+	}
+	for _, tt := range tests {
+		got, ok := CashIncrement(tt.code)
+		want := MustParse(tt.want)
+		if got != want || ok != tt.wantOk {
+			t.Errorf("CashIncrement(%q) = %q, %v, want %q, %v", tt.code, got, ok, want, tt.wantOk)
+		}
+	}
+}
+
+func TestDecimal_RoundForCurrency(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			code string
+			want string
+		}{
+			{"1", "USD", "1.00"},
+			{"1.005", "USD", "1.00"},
+			{"1.015", "USD", "1.02"},
+			{"-1.015", "USD", "-1.02"},
+			{"1234.5", "JPY", "1234"},
+			{"1235.5", "JPY", "1236"},
+			{"1.2345", "KWD", "1.234"},
+			{"1.23456", "CLF", "1.2346"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.RoundForCurrency(tt.code)
+			if err != nil {
+				t.Errorf("%q.RoundForCurrency(%q) failed: %v", d, tt.code, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.RoundForCurrency(%q) = %q, want %q", d, tt.code, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			code string
+		}{
+			{"1", "XXX"},
+			{"1", ""},
+			{"1000000000000000000", "USD"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := d.RoundForCurrency(tt.code)
+			if err == nil {
+				t.Errorf("%q.RoundForCurrency(%q) did not fail", d, tt.code)
+			}
+		}
+	})
+}
+
+func TestDecimal_RoundCash(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			code string
+			want string
+		}{
+			{"1.024", "CHF", "1.00"},
+			{"1.025", "CHF", "1.05"},
+			{"1.074", "CHF", "1.05"},
+			{"1.075", "CHF", "1.10"},
+			{"-1.025", "CHF", "-1.05"},
+			{"-1.024", "CHF", "-1.00"},
+			{"0", "CHF", "0.00"},
+			{"12.49", "SEK", "12.00"},
+			{"12.50", "SEK", "13.00"},
+			{"12.24", "DKK", "12.00"},
+			{"12.25", "DKK", "12.50"},
+			{"12.74", "DKK", "12.50"},
+			{"12", "HUF", "10.00"},
+			{"12.5", "HUF", "15.00"},
+			{"1.005", "USD", "1.01"},
+			{"1.004", "USD", "1.00"},
+			{"1234.5", "JPY", "1235"},
+			{"99999999999999999.97", "CHF", "99999999999999999.95"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.RoundCash(tt.code)
+			if err != nil {
+				t.Errorf("%q.RoundCash(%q) failed: %v", d, tt.code, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.RoundCash(%q) = %q, want %q", d, tt.code, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			code string
+		}{
+			{"1", "XXX"},
+			{"99999999999999999.98", "CHF"},
+			{"9999999999999999999", "USD"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := d.RoundCash(tt.code)
+			if err == nil {
+				t.Errorf("%q.RoundCash(%q) did not fail", d, tt.code)
+			}
+		}
+	})
+}
+
+func TestDecimal_FormatCurrency(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, code, tag string
+			want         string
+		}{
+			{"1234.56", "USD", "en-US", "$1,234.56"},
+			{"-1234.56", "USD", "en-US", "-$1,234.56"},
+			{"1234.5", "EUR", "de-DE", "1.234,50\u00a0€"},
+			{"-1234.5", "EUR", "de-DE", "-1.234,50\u00a0€"},
+			{"1234.5", "EUR", "fr-FR", "1\u202f234,50\u00a0€"},
+			{"1234.5", "EUR", "nl-NL", "€\u00a01.234,50"},
+			{"1234.56", "JPY", "ja-JP", "¥1,235"},
+			{"1234.5", "JPY", "en", "¥1,234"},
+			{"1.2345", "OMR", "en", "OMR1.234"},
+			{"1234.5", "CHF", "de-CH", "CHF\u00a01’234.50"},
+			{"0", "GBP", "en-GB", "£0.00"},
+			{"-0.001", "GBP", "en-GB", "£0.00"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.FormatCurrency(tt.code, tt.tag)
+			if err != nil {
+				t.Errorf("%q.FormatCurrency(%q, %q) failed: %v", d, tt.code, tt.tag, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.FormatCurrency(%q, %q) = %q, want %q", d, tt.code, tt.tag, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d, code, tag string
+			wantErr      error
+		}{
+			{"1", "XXX", "en", errInvalidOperation},
+			{"1", "USD", "xx", errInvalidOperation},
+			{"9999999999999999999", "USD", "en", errDecimalOverflow},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := d.FormatCurrency(tt.code, tt.tag)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%q.FormatCurrency(%q, %q) did not fail with %v, got %v", d, tt.code, tt.tag, tt.wantErr, err)
+			}
+		}
+	})
+}
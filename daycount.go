@@ -0,0 +1,54 @@
+package decimal
+
+import (
+	"fmt"
+	"time"
+)
+
+// DayCount specifies the day count convention used to convert annual rates
+// into rates per day, see [PerAnnumToPerDay].
+// The zero value is [Actual360].
+type DayCount int
+
+const (
+	Actual360      DayCount = iota // Actual360 assumes 360 days per year (ACT/360).
+	Actual365Fixed                 // Actual365Fixed assumes 365 days per year (ACT/365F).
+	Thirty360                      // Thirty360 assumes 12 months of 30 days each (30/360).
+)
+
+// DaysPerYear returns the number of days in a year assumed by the convention.
+// If the convention is unknown, DaysPerYear returns 0.
+func (b DayCount) DaysPerYear() int {
+	switch b {
+	case Actual360, Thirty360:
+		return 360
+	case Actual365Fixed:
+		return 365
+	}
+	return 0
+}
+
+// Year returns the length of a year assumed by the convention, where
+// every day lasts 24 hours.
+// It can be used with [ConvertRate] to convert annual rates into rates
+// per arbitrary period.
+// If the convention is unknown, Year returns 0.
+func (b DayCount) Year() time.Duration {
+	return time.Duration(b.DaysPerYear()) * 24 * time.Hour
+}
+
+// String implements the [fmt.Stringer] interface and returns
+// the conventional name, such as "ACT/360".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (b DayCount) String() string {
+	switch b {
+	case Actual360:
+		return "ACT/360"
+	case Actual365Fixed:
+		return "ACT/365F"
+	case Thirty360:
+		return "30/360"
+	}
+	return fmt.Sprintf("DayCount(%d)", int(b))
+}
@@ -0,0 +1,31 @@
+package decimal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDayCount(t *testing.T) {
+	tests := []struct {
+		basis DayCount
+		days  int
+		year  time.Duration
+		str   string
+	}{
+		{Actual360, 360, 360 * 24 * time.Hour, "ACT/360"},
+		{Actual365Fixed, 365, 365 * 24 * time.Hour, "ACT/365F"},
+		{Thirty360, 360, 360 * 24 * time.Hour, "30/360"},
+		{DayCount(-1), 0, 0, "DayCount(-1)"},
+	}
+	for _, tt := range tests {
+		if got := tt.basis.DaysPerYear(); got != tt.days {
+			t.Errorf("%v.DaysPerYear() = %v, want %v", tt.basis, got, tt.days)
+		}
+		if got := tt.basis.Year(); got != tt.year {
+			t.Errorf("%v.Year() = %v, want %v", tt.basis, got, tt.year)
+		}
+		if got := tt.basis.String(); got != tt.str {
+			t.Errorf("%v.String() = %q, want %q", int(tt.basis), got, tt.str)
+		}
+	}
+}
@@ -1,11 +1,20 @@
 package decimal
 
 import (
+	"bytes"
+	"context"
 	"database/sql/driver"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
+	"math/bits"
+	"math/rand/v2"
+	"runtime"
+	"slices"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
 // Decimal represents a finite floating-point decimal number.
@@ -35,19 +44,26 @@ var (
 	E                   = MustNew(2_718_281_828_459_045_235, 18) // E represents Euler’s number rounded to 18 digits.
 	Pi                  = MustNew(3_141_592_653_589_793_238, 18) // Pi represents the value of π rounded to 18 digits.
 	errDecimalOverflow  = errors.New("decimal overflow")
+	errDecimalUnderflow = errors.New("decimal underflow")
 	errInvalidDecimal   = errors.New("invalid decimal")
 	errScaleRange       = errors.New("scale out of range")
 	errInvalidOperation = errors.New("invalid operation")
 	errInexactDivision  = errors.New("inexact division")
+	errInexact          = errors.New("inexact result")
 	errDivisionByZero   = errors.New("division by zero")
 )
 
 // newUnsafe creates a new decimal without checking scale and coefficient.
 // Use it only if you are absolutely sure that the arguments are valid.
+// If the package is built with the decimaldebug tag, newUnsafe panics
+// if the arguments are not valid.
 func newUnsafe(neg bool, coef fint, scale int) Decimal {
 	if coef == 0 {
 		neg = false
 	}
+	if debug {
+		assertDecimal(neg, coef, scale)
+	}
 	//nolint:gosec
 	return Decimal{neg: neg, coef: coef, scale: int8(scale)}
 }
@@ -56,7 +72,7 @@ func newUnsafe(neg bool, coef fint, scale int) Decimal {
 func newSafe(neg bool, coef fint, scale int) (Decimal, error) {
 	switch {
 	case scale < MinScale || scale > MaxScale:
-		return Decimal{}, errScaleRange
+		return Decimal{}, scaleRangeError(scale)
 	case coef > maxCoef:
 		return Decimal{}, errDecimalOverflow
 	}
@@ -83,42 +99,14 @@ func newFromFint(neg bool, coef fint, scale, minScale int) (Decimal, error) {
 	return newSafe(neg, coef, scale)
 }
 
-// newFromBint creates a new decimal from *big.Int coefficient.
-// This method uses overflowError to return descriptive errors.
-func newFromBint(neg bool, coef *bint, scale, minScale int) (Decimal, error) {
-	// Overflow validation
-	prec := coef.prec()
-	if prec-scale > MaxPrec-minScale {
-		return Decimal{}, overflowError(prec, scale, minScale)
-	}
-	// Scale normalization
-	switch {
-	case scale < minScale:
-		coef.lsh(coef, minScale-scale)
-		scale = minScale
-	case scale >= prec && scale > MaxScale: // no integer part
-		coef.rshHalfEven(coef, scale-MaxScale)
-		scale = MaxScale
-	case prec > scale && prec > MaxPrec: // there is an integer part
-		coef.rshHalfEven(coef, prec-MaxPrec)
-		scale = MaxPrec - prec + scale
-	}
-	// Handling the rare case when rshHalfEven rounded
-	// a 19-digit coefficient to a 20-digit coefficient.
-	if coef.hasPrec(MaxPrec + 1) {
-		return newFromBint(neg, coef, scale, minScale)
-	}
-	return newSafe(neg, coef.fint(), scale)
-}
-
 func overflowError(gotPrec, gotScale, wantScale int) error {
 	maxDigits := MaxPrec - wantScale
 	gotDigits := gotPrec - gotScale
 	switch wantScale {
 	case 0:
-		return fmt.Errorf("%w: the integer part of a %T can have at most %v digits, but it has %v digits", errDecimalOverflow, Decimal{}, maxDigits, gotDigits)
+		return errorf("%w: the integer part of a %T can have at most %v digits, but it has %v digits", errDecimalOverflow, Decimal{}, maxDigits, gotDigits)
 	default:
-		return fmt.Errorf("%w: with %v significant digits after the decimal point, the integer part of a %T can have at most %v digits, but it has %v digits", errDecimalOverflow, wantScale, Decimal{}, maxDigits, gotDigits)
+		return errorf("%w: with %v significant digits after the decimal point, the integer part of a %T can have at most %v digits, but it has %v digits", errDecimalOverflow, wantScale, Decimal{}, maxDigits, gotDigits)
 	}
 }
 
@@ -126,12 +114,16 @@ func unknownOverflowError(wantScale int) error {
 	maxDigits := MaxPrec - wantScale
 	switch wantScale {
 	case 0:
-		return fmt.Errorf("%w: the integer part of a %T can have at most %v digits, but it has significantly more digits", errDecimalOverflow, Decimal{}, maxDigits)
+		return errorf("%w: the integer part of a %T can have at most %v digits, but it has significantly more digits", errDecimalOverflow, Decimal{}, maxDigits)
 	default:
-		return fmt.Errorf("%w: with %v significant digits after the decimal point, the integer part of a %T can have at most %v digits, but it has significantly more digits", errDecimalOverflow, wantScale, Decimal{}, maxDigits)
+		return errorf("%w: with %v significant digits after the decimal point, the integer part of a %T can have at most %v digits, but it has significantly more digits", errDecimalOverflow, wantScale, Decimal{}, maxDigits)
 	}
 }
 
+func scaleRangeError(scale int) error {
+	return errorf("%w: scale %v is not within the range [%v, %v]", errScaleRange, scale, MinScale, MaxScale)
+}
+
 // New returns a decimal equal to coef / 10^scale.
 // New keeps trailing zeros in the fractional part to preserve scale.
 //
@@ -146,6 +138,104 @@ func New(coef int64, scale int) (Decimal, error) {
 	return newSafe(neg, fint(coef), scale)
 }
 
+// newFromUint64 returns a decimal equal to the unsigned integer.
+func newFromUint64(coef uint64) (Decimal, error) {
+	if fint(coef) > maxCoef {
+		return Decimal{}, errorf("converting integer: %w", overflowError(fint(coef).prec(), 0, 0))
+	}
+	return newSafe(false, fint(coef), 0)
+}
+
+// NewFromParts returns a decimal with the given sign, coefficient and scale,
+// which is equal to -coef / 10^scale if neg is true, and coef / 10^scale
+// otherwise.
+// It is useful for binary protocol decoders that already have the raw parts,
+// since [New] cannot express coefficients greater than 2^63 - 1.
+// If the coefficient is zero, the sign is ignored, since the decimal type
+// has no negative zeros.
+// NewFromParts keeps trailing zeros in the fractional part to preserve scale.
+// See also methods [Decimal.IsNeg], [Decimal.Coef], [Decimal.Scale].
+//
+// NewFromParts returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - the coefficient has more than [MaxPrec] digits.
+func NewFromParts(neg bool, coef uint64, scale int) (Decimal, error) {
+	if coef > uint64(maxCoef) {
+		return Decimal{}, errorf("%w: the coefficient %v has more than %v digits", errDecimalOverflow, redact(coef), MaxPrec)
+	}
+	return newSafe(neg, fint(coef), scale)
+}
+
+// NewFromPartsString returns a (possibly rounded) decimal equal to
+// -coef / 10^scale if neg is true, and coef / 10^scale otherwise, where coef is
+// a string of decimal digits without a sign.
+// It is the inverse of [Decimal.Parts] and follows the (unscaledValue, scale)
+// model of Java's BigDecimal, which simplifies bridges to JVM systems that
+// exchange the two fields separately.
+// Unlike [NewFromParts], the coefficient can have any number of digits
+// and the scale can be negative, as in BigDecimal.
+// If the result has more than [MaxScale] digits after the decimal point,
+// it is rounded using half-to-even rounding, as in [Parse].
+// NewFromPartsString keeps trailing zeros in the fractional part to preserve scale.
+//
+// NewFromPartsString returns an error if:
+//   - the coefficient is empty or has characters other than digits;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func NewFromPartsString(coef string, scale int, neg bool) (Decimal, error) {
+	if coef == "" {
+		return Decimal{}, errorf("converting parts: %w: no coefficient", errInvalidDecimal)
+	}
+	for i := 0; i < len(coef); i++ {
+		if coef[i] < '0' || coef[i] > '9' {
+			return Decimal{}, errorf("converting parts: %w: unexpected character %q", errInvalidDecimal, coef[i])
+		}
+	}
+
+	// Fast path: coefficient and scale within the range
+	if scale >= MinScale && scale <= MaxScale && len(coef) <= MaxPrec {
+		c, err := strconv.ParseUint(coef, 10, 64)
+		if err == nil {
+			d, err := NewFromParts(neg, c, scale)
+			if err == nil {
+				return d, nil
+			}
+		}
+	}
+
+	// General case
+	var b strings.Builder
+	b.Grow(len(coef) + 24)
+	if neg {
+		b.WriteByte('-')
+	}
+	b.WriteString(coef)
+	b.WriteByte('e')
+	b.WriteString(strconv.Itoa(-scale))
+	d, err := Parse(b.String())
+	if err != nil {
+		return Decimal{}, errorf("converting parts: %w", err)
+	}
+	return d, nil
+}
+
+// newFromInt64 converts an integer to a decimal with zero scale.
+// Unlike [New], it never fails.
+func newFromInt64(v int64) Decimal {
+	return newFromInt64Scale(v, 0)
+}
+
+// newFromInt64Scale returns a decimal equal to v / 10^scale.
+// Unlike [New], it never fails, so the scale must be within the valid range.
+func newFromInt64Scale(v int64, scale int) Decimal {
+	var neg bool
+	if v < 0 {
+		neg = true
+		v = -v
+	}
+	// nolint:gosec
+	return newUnsafe(neg, fint(v), scale)
+}
+
 // MustNew is like [New] but panics if the decimal cannot be constructed.
 // It simplifies safe initialization of global variables holding decimals.
 func MustNew(coef int64, scale int) Decimal {
@@ -156,6 +246,31 @@ func MustNew(coef int64, scale int) Decimal {
 	return d
 }
 
+// NewCents returns a decimal equal to v / 100, that is, the amount in
+// currency units for an amount of v cents, with 2 digits after
+// the decimal point.
+// Unlike [New], NewCents never fails, since any int64 value fits into a decimal.
+// See also constructors [NewMicros], [NewNanos].
+func NewCents(v int64) Decimal {
+	return newFromInt64Scale(v, 2)
+}
+
+// NewMicros returns a decimal equal to v / 10^6, with 6 digits after
+// the decimal point.
+// Unlike [New], NewMicros never fails, since any int64 value fits into a decimal.
+// See also constructors [NewCents], [NewNanos].
+func NewMicros(v int64) Decimal {
+	return newFromInt64Scale(v, 6)
+}
+
+// NewNanos returns a decimal equal to v / 10^9, with 9 digits after
+// the decimal point.
+// Unlike [New], NewNanos never fails, since any int64 value fits into a decimal.
+// See also constructors [NewCents], [NewMicros].
+func NewNanos(v int64) Decimal {
+	return newFromInt64Scale(v, 9)
+}
+
 // NewFromInt64 converts a pair of integers, representing the whole and
 // fractional parts, to a (possibly rounded) decimal equal to whole + frac / 10^scale.
 // NewFromInt64 removes all trailing zeros from the fractional part.
@@ -172,24 +287,24 @@ func NewFromInt64(whole, frac int64, scale int) (Decimal, error) {
 	// Whole
 	d, err := New(whole, 0)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("converting integers: %w", err)
+		return Decimal{}, errorf("converting integers: %w", err)
 	}
 	// Fraction
 	f, err := New(frac, scale)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("converting integers: %w", err)
+		return Decimal{}, errorf("converting integers: %w", err)
 	}
 	if !f.IsZero() {
 		if !d.IsZero() && d.Sign() != f.Sign() {
-			return Decimal{}, fmt.Errorf("converting integers: inconsistent signs")
+			return Decimal{}, errorf("converting integers: inconsistent signs")
 		}
 		if !f.WithinOne() {
-			return Decimal{}, fmt.Errorf("converting integers: inconsistent fraction")
+			return Decimal{}, errorf("converting integers: inconsistent fraction")
 		}
 		f = f.Trim(0)
 		d, err = d.Add(f)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("converting integers: %w", err)
+			return Decimal{}, errorf("converting integers: %w", err)
 		}
 	}
 	return d, nil
@@ -204,13 +319,42 @@ func NewFromInt64(whole, frac int64, scale int) (Decimal, error) {
 func NewFromFloat64(f float64) (Decimal, error) {
 	// Float
 	if math.IsNaN(f) || math.IsInf(f, 0) {
-		return Decimal{}, fmt.Errorf("converting float: special value %v", f)
+		return Decimal{}, errorf("converting float: special value %v", f)
 	}
 	s := strconv.FormatFloat(f, 'f', -1, 64)
 	// Decimal
 	d, err := Parse(s)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("converting float: %w", err)
+		return Decimal{}, errorf("converting float: %w", err)
+	}
+	return d, nil
+}
+
+// NewFromQ converts a binary fixed-point number in [Q format] to a (possibly
+// rounded) decimal.
+// The value of the result is equal to value / 2^fracBits, where fracBits is
+// the number of fractional bits, for example, 16 for Q16.16 numbers.
+// See also method [Decimal.Q].
+//
+// NewFromQ returns an error if the number of fractional bits is negative
+// or greater than 63.
+//
+// [Q format]: https://en.wikipedia.org/wiki/Q_(number_format)
+func NewFromQ(value int64, fracBits int) (Decimal, error) {
+	if fracBits < 0 || fracBits > 63 {
+		return Decimal{}, errorf("converting Q-format: %w: number of fractional bits %v is out of range", errInvalidOperation, fracBits)
+	}
+	d, err := New(value, 0)
+	if err != nil {
+		return Decimal{}, errorf("converting Q-format: %w", err)
+	}
+	e, err := newSafe(false, fint(1)<<fracBits, 0)
+	if err != nil {
+		return Decimal{}, errorf("converting Q-format: %w", err)
+	}
+	d, err = d.Quo(e)
+	if err != nil {
+		return Decimal{}, errorf("converting Q-format: %w", err)
 	}
 	return d, nil
 }
@@ -261,6 +405,10 @@ func (d Decimal) ULP() Decimal {
 //   - the exponent is less than -330 or greater than 330;
 //   - the string does not represent a valid decimal number;
 //   - the integer part of the result has more than [MaxPrec] digits.
+//
+// If the string is too long or the exponent is too large,
+// the returned error wraps a [*LimitError].
+// To enforce stricter limits, use [ParseLimits].
 func Parse(s string) (Decimal, error) {
 	return ParseExact(s, 0)
 }
@@ -271,27 +419,243 @@ func Parse(s string) (Decimal, error) {
 // This method is useful for parsing monetary amounts, where the scale should be
 // equal to or greater than the currency's scale.
 func ParseExact(s string, scale int) (Decimal, error) {
-	if len(s) > 330 {
-		return Decimal{}, fmt.Errorf("parsing decimal: %w", errInvalidDecimal)
+	return ParseLimits{}.ParseExact(s, scale)
+}
+
+// Validate checks whether the string represents a valid decimal
+// as described in [Parse], without returning the decimal.
+// It is useful for request validators that only need to reject invalid
+// input before passing strings downstream.
+// Like [Parse], Validate does not allocate memory for valid strings without
+// an exponent that have at most 19 digits.
+//
+// Validate returns the same error as [Parse] would.
+func Validate(s string) error {
+	return ValidateExact(s, 0)
+}
+
+// ValidateExact is similar to [Validate], but it checks the string as
+// described in [ParseExact].
+//
+// ValidateExact returns the same error as [ParseExact] would.
+func ValidateExact(s string, scale int) error {
+	_, err := ParseExact(s, scale)
+	return err
+}
+
+const (
+	maxParseLength   = 330 // maxParseLength is a maximum length of a string accepted by Parse.
+	maxParseExponent = 330 // maxParseExponent is a maximum absolute value of an exponent accepted by Parse.
+)
+
+// ParseLimits specifies limits on the input of parsing methods.
+// It is intended to protect internet-facing services from adversarial
+// inputs, such as "1e999999999" or very long strings.
+// A zero or negative field means the default limit, which is also used by [Parse].
+// Limits greater than 330 are silently capped at 330, so ParseLimits can only
+// make parsing stricter.
+//
+// Besides [ParseLimits.Parse] and [ParseLimits.ParseExact], the limits can be
+// applied to decoding with [encoding/json], [encoding.TextUnmarshaler], and
+// [sql.Scanner] using the wrappers returned by [ParseLimits.Decimal],
+// [ParseLimits.NullDecimal], and [ParseLimits.Decimal128], for example:
+//
+//	limits := decimal.ParseLimits{MaxLength: 40, MaxExponent: 20}
+//	err := json.Unmarshal(data, limits.Decimal(&amount))
+//	err = row.Scan(limits.NullDecimal(&fee))
+//
+// See also option [WithLimits].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+type ParseLimits struct {
+	MaxLength   int // maximum length of the string in bytes, default is 330
+	MaxExponent int // maximum absolute value of the exponent, default is 330
+}
+
+// Parse is similar to [Parse], but it checks the input against the limits.
+//
+// Parse returns an error wrapping a [*LimitError] if the string is longer than
+// l.MaxLength or the absolute value of the exponent is greater than l.MaxExponent.
+func (l ParseLimits) Parse(s string) (Decimal, error) {
+	return l.ParseExact(s, 0)
+}
+
+// ParseExact is similar to [ParseExact], but it checks the input against the limits.
+//
+// ParseExact returns an error wrapping a [*LimitError] if the string is longer than
+// l.MaxLength or the absolute value of the exponent is greater than l.MaxExponent.
+func (l ParseLimits) ParseExact(s string, scale int) (Decimal, error) {
+	maxLen, maxExp := l.maxLength(), l.maxExponent()
+	if len(s) > maxLen {
+		return Decimal{}, errorf("parsing decimal: %w", &LimitError{Limit: "length", Max: maxLen})
 	}
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("parsing decimal: %w", errScaleRange)
+		return Decimal{}, errorf("parsing decimal: %w", scaleRangeError(scale))
 	}
 	d, err := parseFint(s, scale)
 	if err != nil {
-		d, err = parseBint(s, scale)
+		d, err = parseBint(s, scale, maxExp)
+		if err != nil {
+			return Decimal{}, errorf("parsing decimal: %w", err)
+		}
+	}
+	return d, nil
+}
+
+// parseMode is similar to [ParseLimits.ParseExact], but it rounds the decimal
+// to the given scale using the given rounding mode, as described in
+// [Domain.Rescale].
+// The exact value of the string is rounded only once.
+func (l ParseLimits) parseMode(s string, scale int, mode RoundingMode, rnd *rand.Rand) (Decimal, error) {
+	maxLen, maxExp := l.maxLength(), l.maxExponent()
+	if len(s) > maxLen {
+		return Decimal{}, errorf("parsing decimal: %w", &LimitError{Limit: "length", Max: maxLen})
+	}
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, errorf("parsing decimal: %w", scaleRangeError(scale))
+	}
+	// parseFint rounds strings with more than MaxScale digits after
+	// the decimal point, so such strings are parsed by parseModeBint.
+	var d Decimal
+	err := errDecimalOverflow
+	if fracLen(s) <= MaxScale {
+		d, err = parseFint(s, 0)
+	}
+	if err == nil {
+		d, err = roundQuoFint(d.IsNeg(), d.coef, 1, d.Scale(), scale, MaxPrec, mode, rnd)
+	}
+	if err != nil {
+		d, err = parseModeBint(s, scale, maxExp, mode, rnd)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("parsing decimal: %w", err)
+			return Decimal{}, errorf("parsing decimal: %w", err)
 		}
 	}
+	if d.Scale() != scale {
+		return Decimal{}, errorf("parsing decimal: %w", overflowError(d.Prec(), d.Scale(), scale))
+	}
 	return d, nil
 }
 
+// fracLen returns the number of characters after the decimal point.
+func fracLen(s string) int {
+	for i := range len(s) {
+		if s[i] == '.' {
+			return len(s) - i - 1
+		}
+	}
+	return 0
+}
+
+func (l ParseLimits) maxLength() int {
+	if l.MaxLength <= 0 || l.MaxLength > maxParseLength {
+		return maxParseLength
+	}
+	return l.MaxLength
+}
+
+func (l ParseLimits) maxExponent() int {
+	if l.MaxExponent <= 0 || l.MaxExponent > maxParseExponent {
+		return maxParseExponent
+	}
+	return l.MaxExponent
+}
+
+// Decimal returns a wrapper that decodes into d, checking the input against
+// the limits.
+// See also type [LimitedDecimal].
+func (l ParseLimits) Decimal(d *Decimal) *LimitedDecimal {
+	return &LimitedDecimal{limits: l, d: d}
+}
+
+// NullDecimal returns a wrapper that decodes into n, checking the input
+// against the limits.
+// See also type [LimitedNullDecimal].
+func (l ParseLimits) NullDecimal(n *NullDecimal) *LimitedNullDecimal {
+	return &LimitedNullDecimal{limits: l, n: n}
+}
+
+// LimitedDecimal decodes a decimal like [Decimal.UnmarshalText] and
+// [Decimal.Scan], but it checks the input against [ParseLimits].
+// It is created by [ParseLimits.Decimal].
+type LimitedDecimal struct {
+	limits ParseLimits
+	d      *Decimal
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// See also method [Decimal.UnmarshalText].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (w *LimitedDecimal) UnmarshalText(text []byte) error {
+	var err error
+	*w.d, err = w.limits.Parse(string(text))
+	return err
+}
+
+// Scan implements the [sql.Scanner] interface.
+// See also method [Decimal.Scan].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (w *LimitedDecimal) Scan(value any) error {
+	return w.d.scan(value, w.limits)
+}
+
+// LimitedNullDecimal decodes a decimal like [NullDecimal.UnmarshalText],
+// [NullDecimal.UnmarshalJSON], and [NullDecimal.Scan], but it checks the input
+// against [ParseLimits].
+// It is created by [ParseLimits.NullDecimal].
+type LimitedNullDecimal struct {
+	limits ParseLimits
+	n      *NullDecimal
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// See also method [NullDecimal.UnmarshalText].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (w *LimitedNullDecimal) UnmarshalText(text []byte) error {
+	return w.n.unmarshalText(text, w.limits)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// See also method [NullDecimal.UnmarshalJSON].
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (w *LimitedNullDecimal) UnmarshalJSON(data []byte) error {
+	return w.n.unmarshalJSON(data, w.limits)
+}
+
+// Scan implements the [sql.Scanner] interface.
+// See also method [NullDecimal.Scan].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (w *LimitedNullDecimal) Scan(value any) error {
+	return w.n.scan(value, w.limits)
+}
+
+// LimitError is returned by parsing methods when the input exceeds
+// the limits specified by [ParseLimits].
+type LimitError struct {
+	Limit string // name of the exceeded limit, either "length" or "exponent"
+	Max   int    // value of the exceeded limit
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%v: %v exceeds limit of %v", errInvalidDecimal, e.Limit, e.Max)
+}
+
+// Unwrap returns the underlying error, which is an invalid decimal error.
+func (e *LimitError) Unwrap() error {
+	return errInvalidDecimal
+}
+
 // parseFint parses a decimal string using uint64 arithmetic.
 // parseFint does not support exponential notation to make it as fast as possible.
+// parseFint also accepts byte slices, so that they can be parsed without
+// allocating a string.
 //
 //nolint:gocyclo
-func parseFint(s string, minScale int) (Decimal, error) {
+func parseFint[T string | []byte](s T, minScale int) (Decimal, error) {
 	var pos int
 	width := len(s)
 
@@ -337,129 +701,75 @@ func parseFint(s string, minScale int) (Decimal, error) {
 	}
 
 	if pos != width {
-		return Decimal{}, fmt.Errorf("%w: unexpected character %q", errInvalidDecimal, s[pos])
+		return Decimal{}, errorf("%w: unexpected character %q", errInvalidDecimal, s[pos])
 	}
 	if !hasCoef {
-		return Decimal{}, fmt.Errorf("%w: no coefficient", errInvalidDecimal)
+		return Decimal{}, errorf("%w: no coefficient", errInvalidDecimal)
 	}
 	return newFromFint(neg, coef, scale, minScale)
 }
 
-// parseBint parses a decimal string using *big.Int arithmetic.
-// parseBint supports exponential notation.
+// ParseInt converts a string representing an integer in the given base
+// to a decimal with a scale of 0.
+// The base must be 0, 2, 8, 10, or 16.
+// If the base is 0, it is implied by the prefix of the string:
+// 2 for "0b", 8 for "0o" or "0", 16 for "0x", and 10 otherwise.
+// If the base is 2, 8, or 16, the corresponding prefix is optional.
+// Underscores are permitted only if the base is 0, as in [strconv.ParseInt].
+// For example, the following strings represent the same decimal:
 //
-//nolint:gocyclo
-func parseBint(s string, minScale int) (Decimal, error) {
-	var pos int
-	width := len(s)
+//	0x2a
+//	-0x2A
+//	0b101010
+//	0o52
+//
+// ParseInt is useful for parsing token amounts returned by blockchain
+// RPC APIs as hexadecimal strings.
+// See also function [Parse].
+//
+// ParseInt returns an error if:
+//   - the base is not supported;
+//   - the string does not represent a valid integer in the given base;
+//   - the result has more than [MaxPrec] digits.
+//
+// [strconv.ParseInt]: https://pkg.go.dev/strconv#ParseInt
+func ParseInt(s string, base int) (Decimal, error) {
+	switch base {
+	case 0, 2, 8, 10, 16:
+	default:
+		return Decimal{}, errorf("parsing integer: %w: base %v is not supported", errInvalidOperation, base)
+	}
 
 	// Sign
+	t := s
 	var neg bool
-	switch {
-	case pos == width:
-		// skip
-	case s[pos] == '-':
-		neg = true
-		pos++
-	case s[pos] == '+':
-		pos++
-	}
-
-	// Coefficient
-	bcoef := getBint()
-	defer putBint(bcoef)
-	bcoef.setFint(0)
-	var fcoef fint
-	var shift, scale int
-	var hasCoef, ok bool
-
-	// Algorithm:
-	// 	1. Add as many digits as possible to the uint64 coefficient (fast).
-	// 	2. Once the uint64 coefficient has reached its maximum value,
-	//     add it to the *big.Int coefficient (slow).
-	// 	3. Repeat until all digits are processed.
-
-	// Integer
-	for pos < width && s[pos] >= '0' && s[pos] <= '9' {
-		fcoef, ok = fcoef.fsa(1, s[pos]-'0')
-		if !ok {
-			return Decimal{}, errDecimalOverflow // Should never happen
-		}
-		pos++
-		shift++
-		hasCoef = true
-		if fcoef.hasPrec(MaxPrec) {
-			bcoef.fsa(bcoef, shift, fcoef)
-			fcoef, shift = 0, 0
-		}
-	}
-
-	// Fraction
-	if pos < width && s[pos] == '.' {
-		pos++
-		for pos < width && s[pos] >= '0' && s[pos] <= '9' {
-			fcoef, ok = fcoef.fsa(1, s[pos]-'0')
-			if !ok {
-				return Decimal{}, errDecimalOverflow // Should never happen
-			}
-			pos++
-			scale++
-			shift++
-			hasCoef = true
-			if fcoef.hasPrec(MaxPrec) {
-				bcoef.fsa(bcoef, shift, fcoef)
-				fcoef, shift = 0, 0
-			}
-		}
-	}
-	if shift > 0 {
-		bcoef.fsa(bcoef, shift, fcoef)
+	if len(t) > 0 && (t[0] == '-' || t[0] == '+') {
+		neg = t[0] == '-'
+		t = t[1:]
 	}
 
-	// Exponent
-	var exp int
-	var eneg, hasExp, hasE bool
-	if pos < width && (s[pos] == 'e' || s[pos] == 'E') {
-		pos++
-		hasE = true
-		// Sign
+	// Prefix
+	if len(t) > 2 && t[0] == '0' {
 		switch {
-		case pos == width:
-			// skip
-		case s[pos] == '-':
-			eneg = true
-			pos++
-		case s[pos] == '+':
-			pos++
-		}
-		// Integer
-		for pos < width && s[pos] >= '0' && s[pos] <= '9' {
-			exp = exp*10 + int(s[pos]-'0')
-			if exp > 330 {
-				return Decimal{}, errInvalidDecimal
-			}
-			pos++
-			hasExp = true
+		case base == 2 && (t[1] == 'b' || t[1] == 'B'),
+			base == 8 && (t[1] == 'o' || t[1] == 'O'),
+			base == 16 && (t[1] == 'x' || t[1] == 'X'):
+			t = t[2:]
 		}
 	}
 
-	if pos != width {
-		return Decimal{}, fmt.Errorf("%w: unexpected character %q", errInvalidDecimal, s[pos])
-	}
-	if !hasCoef {
-		return Decimal{}, fmt.Errorf("%w: no coefficient", errInvalidDecimal)
-	}
-	if hasE && !hasExp {
-		return Decimal{}, fmt.Errorf("%w: no exponent", errInvalidDecimal)
+	// Coefficient
+	coef, err := strconv.ParseUint(t, base, 64)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return Decimal{}, errorf("parsing integer: %w", unknownOverflowError(0))
+		}
+		return Decimal{}, errorf("parsing integer: %w", errInvalidDecimal)
 	}
-
-	if eneg {
-		scale = scale + exp
-	} else {
-		scale = scale - exp
+	if coef > maxCoef {
+		return Decimal{}, errorf("parsing integer: %w", overflowError(fint(coef).prec(), 0, 0))
 	}
-
-	return newFromBint(neg, bcoef, scale, minScale)
+	return newSafe(neg, fint(coef), 0)
 }
 
 // MustParse is like [Parse] but panics if the string cannot be parsed.
@@ -523,6 +833,46 @@ func (d Decimal) String() string {
 	return string(buf[pos+1:])
 }
 
+// IntPartString returns the digits of the integer part of the decimal
+// without a sign, for example, "1234" for -1234.56.
+// If the decimal is between -1 and 1, IntPartString returns "0".
+// It is useful for templates that render the integer and fractional parts
+// in separate UI elements.
+// See also method [Decimal.FracPartString].
+func (d Decimal) IntPartString() string {
+	coef := d.coef.rshDown(d.Scale())
+	return strconv.FormatUint(uint64(coef), 10)
+}
+
+// FracPartString returns exactly scale digits of the fractional part of
+// the decimal, for example, "56" for -1234.56 and a scale of 2.
+// If the given scale is greater than the scale of the decimal, then
+// the fractional part is zero-padded to the right.
+// If the given scale is smaller than the scale of the decimal, then
+// the fractional part is rounded using [rounding half to even] (banker's rounding).
+// Rounding may carry over to the integer part, for example, for 9.996 and
+// a scale of 2, so round the decimal using [Decimal.Round] before calling
+// both FracPartString and [Decimal.IntPartString].
+// If the given scale is not positive, FracPartString returns an empty string.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal) FracPartString(scale int) string {
+	if scale <= 0 {
+		return ""
+	}
+	d = d.Round(scale)
+	buf := make([]byte, scale)
+	for i := range buf {
+		buf[i] = '0'
+	}
+	coef := d.coef
+	for i := d.Scale() - 1; i >= 0; i-- {
+		buf[i] = byte(coef%10) + '0'
+		coef /= 10
+	}
+	return string(buf)
+}
+
 // parseBCD converts a [packed BCD] representation to a decimal.
 //
 // [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
@@ -539,7 +889,7 @@ func parseBCD(b []byte) (Decimal, error) {
 		lo := b[pos] & 0x0f
 
 		if hi > 9 {
-			return Decimal{}, fmt.Errorf("%w: invalid high nibble \"%x\"", errInvalidDecimal, b[pos])
+			return Decimal{}, errorf("%w: invalid high nibble \"%x\"", errInvalidDecimal, b[pos])
 		}
 		coef, ok = coef.fsa(1, hi)
 		if !ok {
@@ -550,7 +900,7 @@ func parseBCD(b []byte) (Decimal, error) {
 			if lo == 0x0d {
 				neg = true
 			} else if lo != 0x0c {
-				return Decimal{}, fmt.Errorf("%w: invalid low nibble \"%x\"", errInvalidDecimal, b[pos])
+				return Decimal{}, errorf("%w: invalid low nibble \"%x\"", errInvalidDecimal, b[pos])
 			}
 			pos++
 			break
@@ -571,12 +921,12 @@ func parseBCD(b []byte) (Decimal, error) {
 		hasScale = true
 
 		if hi > 1 {
-			return Decimal{}, fmt.Errorf("%w: invalid high nibble \"%x\"", errInvalidDecimal, b[pos])
+			return Decimal{}, errorf("%w: invalid high nibble \"%x\"", errInvalidDecimal, b[pos])
 		}
 		scale = int(hi) * 10
 
 		if lo > 9 {
-			return Decimal{}, fmt.Errorf("%w: invalid low nibble \"%x\"", errInvalidDecimal, b[pos])
+			return Decimal{}, errorf("%w: invalid low nibble \"%x\"", errInvalidDecimal, b[pos])
 		}
 		scale += int(lo)
 
@@ -584,10 +934,10 @@ func parseBCD(b []byte) (Decimal, error) {
 	}
 
 	if pos != width {
-		return Decimal{}, fmt.Errorf("%w: unexpected byte \"%x\"", errInvalidDecimal, b[pos])
+		return Decimal{}, errorf("%w: unexpected byte \"%x\"", errInvalidDecimal, b[pos])
 	}
 	if !hasScale {
-		return Decimal{}, fmt.Errorf("%w: no scale", errInvalidDecimal)
+		return Decimal{}, errorf("%w: no scale", errInvalidDecimal)
 	}
 
 	return newSafe(neg, coef, scale)
@@ -692,7 +1042,55 @@ func (d Decimal) Int64(scale int) (whole, frac int64, ok bool) {
 	return int64(q), int64(r), true
 }
 
+// Q returns the decimal as a binary fixed-point number in [Q format]
+// with the given number of fractional bits, for example, 16 for Q16.16 numbers.
+// The relationship between the decimal and the returned value can be expressed
+// as d = q / 2^fracBits.
+// Bits beyond fracBits are rounded using [rounding half to even] (banker's rounding).
+// See also constructor [NewFromQ].
+//
+// If the number of fractional bits is negative or greater than 63, or
+// the result cannot be represented as an int64 value, then false is returned.
+//
+// [Q format]: https://en.wikipedia.org/wiki/Q_(number_format)
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal) Q(fracBits int) (q int64, ok bool) {
+	if fracBits < 0 || fracBits > 63 {
+		return 0, false
+	}
+	// Compute z = round(coef * 2^fracBits / 10^scale) using 128-bit arithmetic
+	hi, lo := bits.Mul64(uint64(d.coef), 1<<fracBits)
+	y := uint64(pow10[d.Scale()])
+	if hi >= y {
+		return 0, false
+	}
+	z, r := bits.Div64(hi, lo, y)
+	if z > -math.MinInt64 {
+		return 0, false
+	}
+	if y > 1 {
+		half := y >> 1                           // half = y / 2, which is safe as y is a multiple of 10
+		if half < r || (half == r && z&1 != 0) { // half-to-even
+			z++
+		}
+	}
+	if d.IsNeg() {
+		if z > -math.MinInt64 {
+			return 0, false
+		}
+		//nolint:gosec
+		return -int64(z), true
+	}
+	if z > math.MaxInt64 {
+		return 0, false
+	}
+	//nolint:gosec
+	return int64(z), true
+}
+
 // UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// When used with [encoding/json], only quoted strings are accepted,
+// and unquoted JSON numbers are rejected.
 // See also constructor [Parse].
 //
 // [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
@@ -710,41 +1108,246 @@ func (d Decimal) MarshalText() ([]byte, error) {
 	return []byte(d.String()), nil
 }
 
-// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
-//
-// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
-func (d *Decimal) UnmarshalBinary(data []byte) error {
-	var err error
-	*d, err = parseBCD(data)
-	return err
+// CompliesWithXSD reports whether the text produced by [Decimal.MarshalText]
+// matches the lexical space of the XML Schema "xs:decimal" type,
+// that is, it has an optional sign, at least one digit, an optional decimal
+// point, and no exponent.
+// Schema validators reject scientific notation, so CompliesWithXSD
+// can be used to check that decimals written to XML elements and attributes,
+// such as `xml:"price,attr"`, will pass schema validation.
+// Since MarshalText never uses scientific notation, CompliesWithXSD
+// currently always returns true.
+func (d Decimal) CompliesWithXSD() bool {
+	text, err := d.MarshalText()
+	if err != nil {
+		return false
+	}
+	return isXSDDecimal(text)
 }
 
-// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// isXSDDecimal reports whether the text matches the pattern of the "xs:decimal"
+// type: (\+|-)?([0-9]+(\.[0-9]*)?|\.[0-9]+).
+func isXSDDecimal(text []byte) bool {
+	if len(text) > 0 && (text[0] == '+' || text[0] == '-') {
+		text = text[1:]
+	}
+	var digits, points int
+	for _, b := range text {
+		switch {
+		case b >= '0' && b <= '9':
+			digits++
+		case b == '.':
+			points++
+		default:
+			return false
+		}
+	}
+	return digits > 0 && points <= 1
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+//
+// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	var err error
+	*d, err = parseBCD(data)
+	return err
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
 //
 // [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
 func (d Decimal) MarshalBinary() ([]byte, error) {
 	return d.bcd(), nil
 }
 
+// BSON types used by [Decimal.UnmarshalBSONValue] and [Decimal.MarshalBSONValue].
+const (
+	bsonDouble     byte = 0x01
+	bsonString     byte = 0x02
+	bsonNull       byte = 0x0a
+	bsonInt32      byte = 0x10
+	bsonInt64      byte = 0x12
+	bsonDecimal128 byte = 0x13
+)
+
+// UnmarshalBSONValue implements the [v2/bson.ValueUnmarshaler] interface.
+// The following BSON types are supported:
+//
+//   - Decimal128, which is rounded to [MaxPrec] digits if necessary;
+//   - String, which is parsed as described in [Parse];
+//   - Double, which is converted as described in [NewFromFloat64];
+//   - Int32 and Int64;
+//   - Null, which leaves the decimal unchanged, use [NullDecimal]
+//     if the field can be null.
+//
+// UnmarshalBSONValue returns an error if the type is not supported,
+// the data is malformed, or the value cannot be represented as a decimal,
+// such as Decimal128 infinities and NaNs.
+//
+// [v2/bson.ValueUnmarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueUnmarshaler
+func (d *Decimal) UnmarshalBSONValue(typ byte, data []byte) error {
+	var err error
+	switch typ {
+	case bsonNull:
+		return nil
+	case bsonDouble:
+		if len(data) != 8 {
+			return errorf("%w: invalid BSON double length %v", errInvalidDecimal, len(data))
+		}
+		*d, err = NewFromFloat64(math.Float64frombits(binary.LittleEndian.Uint64(data)))
+	case bsonString:
+		//nolint:gosec
+		if len(data) < 5 || int(int32(binary.LittleEndian.Uint32(data))) != len(data)-4 || data[len(data)-1] != 0 {
+			return errorf("%w: invalid BSON string", errInvalidDecimal)
+		}
+		*d, err = Parse(string(data[4 : len(data)-1]))
+	case bsonInt32:
+		if len(data) != 4 {
+			return errorf("%w: invalid BSON int32 length %v", errInvalidDecimal, len(data))
+		}
+		//nolint:gosec
+		*d, err = New(int64(int32(binary.LittleEndian.Uint32(data))), 0)
+	case bsonInt64:
+		if len(data) != 8 {
+			return errorf("%w: invalid BSON int64 length %v", errInvalidDecimal, len(data))
+		}
+		//nolint:gosec
+		*d, err = New(int64(binary.LittleEndian.Uint64(data)), 0)
+	case bsonDecimal128:
+		if len(data) != 16 {
+			return errorf("%w: invalid BSON decimal128 length %v", errInvalidDecimal, len(data))
+		}
+		*d, err = parseIEEEDecimal128(binary.LittleEndian.Uint64(data[8:]), binary.LittleEndian.Uint64(data))
+	default:
+		return errorf("%w: BSON type %#02x is not supported", errInvalidDecimal, typ)
+	}
+	return err
+}
+
+// MarshalBSONValue implements the [v2/bson.ValueMarshaler] interface.
+// The decimal is encoded as BSON Decimal128, preserving its scale.
+//
+// [v2/bson.ValueMarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueMarshaler
+func (d Decimal) MarshalBSONValue() (typ byte, data []byte, err error) {
+	hi, lo := d.ieeeDecimal128()
+	data = make([]byte, 16)
+	binary.LittleEndian.PutUint64(data, lo)
+	binary.LittleEndian.PutUint64(data[8:], hi)
+	return bsonDecimal128, data, nil
+}
+
+// ieeeBias is the exponent bias of the IEEE 754 decimal128 format.
+const ieeeBias = 6176
+
+// ieeeDecimal128 returns the high and low halves of the IEEE 754 decimal128
+// representation of the decimal, using the binary integer decimal encoding.
+func (d Decimal) ieeeDecimal128() (hi, lo uint64) {
+	//nolint:gosec
+	hi = uint64(ieeeBias-d.Scale()) << 49
+	if d.IsNeg() {
+		hi |= 1 << 63
+	}
+	return hi, uint64(d.coef)
+}
+
+// parseIEEEDecimal128 converts the IEEE 754 decimal128 representation,
+// using the binary integer decimal encoding, to a decimal.
+func parseIEEEDecimal128(hi, lo uint64) (Decimal, error) {
+	neg, exp, hi, lo, err := ieeeDecimal128Parts(hi, lo)
+	if err != nil {
+		return Decimal{}, err
+	}
+	if hi == 0 && lo == 0 {
+		return New(0, min(max(-exp, 0), MaxScale))
+	}
+	b := make([]byte, 0, 48)
+	if neg {
+		b = append(b, '-')
+	}
+	b = appendUint128(b, hi, lo)
+	b = append(b, 'e')
+	b = strconv.AppendInt(b, int64(exp), 10)
+	return Parse(string(b))
+}
+
+// ieeeDecimal128Parts returns the sign, the exponent, and the high and low
+// halves of the coefficient of the IEEE 754 decimal128 representation,
+// using the binary integer decimal encoding.
+func ieeeDecimal128Parts(hi, lo uint64) (neg bool, exp int, chi, clo uint64, err error) {
+	neg = hi>>63 == 1
+	if hi>>61&3 == 3 {
+		if hi>>59&3 == 3 {
+			return false, 0, 0, 0, errorf("%w: infinity or NaN", errInvalidDecimal)
+		}
+		// Non-canonical coefficients are treated as zero
+		return neg, int(hi>>47&0x3fff) - ieeeBias, 0, 0, nil
+	}
+	return neg, int(hi>>49&0x3fff) - ieeeBias, hi & (1<<49 - 1), lo, nil
+}
+
+// appendUint128 appends the decimal digits of the 128-bit unsigned integer
+// to the byte slice.
+func appendUint128(b []byte, hi, lo uint64) []byte {
+	if hi == 0 {
+		return strconv.AppendUint(b, lo, 10)
+	}
+	const pow19 = 10_000_000_000_000_000_000
+	q, r := bits.Div64(hi%pow19, lo, pow19)
+	b = appendUint128(b, hi/pow19, q)
+	var digits [19]byte
+	for i := len(digits) - 1; i >= 0; i-- {
+		digits[i] = byte('0' + r%10)
+		r /= 10
+	}
+	return append(b, digits[:]...)
+}
+
 // Scan implements the [sql.Scanner] interface.
+// Besides the types returned by the standard drivers, Scan accepts
+// all signed and unsigned integer types, since some drivers return int,
+// int32, or uint64 for NUMERIC columns of small precision.
 // See also constructor [Parse].
 //
 // [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
 func (d *Decimal) Scan(value any) error {
+	return d.scan(value, ParseLimits{})
+}
+
+// scan is like [Decimal.Scan], but it parses strings with the given limits.
+func (d *Decimal) scan(value any, l ParseLimits) error {
 	var err error
 	switch value := value.(type) {
 	case string:
-		*d, err = Parse(value)
+		*d, err = l.Parse(value)
 	case []byte:
-		*d, err = Parse(string(value))
+		*d, err = l.Parse(string(value))
 	case int64:
 		*d, err = New(value, 0)
+	case int:
+		*d, err = New(int64(value), 0)
+	case int8:
+		*d, err = New(int64(value), 0)
+	case int16:
+		*d, err = New(int64(value), 0)
+	case int32:
+		*d, err = New(int64(value), 0)
+	case uint:
+		*d, err = newFromUint64(uint64(value))
+	case uint8:
+		*d, err = newFromUint64(uint64(value))
+	case uint16:
+		*d, err = newFromUint64(uint64(value))
+	case uint32:
+		*d, err = newFromUint64(uint64(value))
+	case uint64:
+		*d, err = newFromUint64(value)
 	case float64:
 		*d, err = NewFromFloat64(value)
 	case nil:
-		err = fmt.Errorf("converting to %T: nil is not supported", d)
+		err = errorf("converting to %T: nil is not supported", d)
 	default:
-		err = fmt.Errorf("converting from %T to %T: type %T is not supported", value, d, value)
+		err = errorf("converting from %T to %T: type %T is not supported", value, d, value)
 	}
 	return err
 }
@@ -760,45 +1363,163 @@ func (d Decimal) Value() (driver.Value, error) {
 // Format implements the [fmt.Formatter] interface.
 // The following [format verbs] are available:
 //
-//	| Verb       | Example | Description    |
-//	| ---------- | ------- | -------------- |
-//	| %f, %s, %v | 5.67    | Decimal        |
-//	| %q         | "5.67"  | Quoted decimal |
-//	| %k         | 567%    | Percentage     |
+//	| Verb       | Example  | Description                    |
+//	| ---------- | -------- | ------------------------------ |
+//	| %f, %s, %v | 5.67     | Decimal                        |
+//	| %q         | "5.67"   | Quoted decimal                 |
+//	| %k         | 567%     | Percentage                     |
+//	| %e, %E     | 5.67e+00 | Scientific notation            |
+//	| %g, %G     | 5.67     | Decimal or scientific notation |
 //
-// The following format flags can be used with all verbs: '+', ' ', '0', '-'.
+// The following format flags can be used with all verbs: '+', ' ', '0', '-', '#'.
+// The '#' flag selects the accounting style, where digits of the integer part
+// are grouped by thousands and negative decimals are enclosed in parentheses,
+// for example, "(1,234.50)".
 //
-// Precision is only supported for %f and %k verbs.
+// Precision is only supported for %f, %k, %e, and %g verbs.
 // For %f verb, the default precision is equal to the actual scale of the decimal,
 // whereas, for verb %k the default precision is the actual scale of the decimal minus 2.
+// The %k verb shifts the decimal point instead of multiplying by 100,
+// so it never overflows, see also method [Decimal.Percent].
+//
+// The %e, %g, and their upper-case variants are provided for compatibility
+// with code shared with floats, but the digits are always decimal-exact.
+// For %e verb, the significand has one digit before the decimal point and
+// the exponent has at least two digits, as in "1.234e+01".
+// The default precision keeps all digits of the coefficient, and zero is
+// formatted with the exponent of 0.
+// For %g verb, precision is the maximum number of significant digits,
+// and scientific notation is used if the exponent is less than -4 or
+// greater than or equal to the precision, or 6 if the precision is not specified.
+// Otherwise, the result is the same as for %f verb, so, for example, 5.670 is
+// formatted as "5.670", but 1234567 is formatted as "1.234567e+06".
+// The '#' flag selects only parentheses for these verbs.
+// See also method [FormatOptions.Format].
 //
 // [format verbs]: https://pkg.go.dev/fmt#hdr-Printing
 // [fmt.Formatter]: https://pkg.go.dev/fmt#Formatter
+func (d Decimal) Format(state fmt.State, verb rune) {
+	opts := FormatOptions{
+		Plus:        state.Flag('+'),
+		Space:       state.Flag(' '),
+		ZeroPad:     state.Flag('0'),
+		LeftAlign:   state.Flag('-'),
+		Grouping:    state.Flag('#'),
+		Parentheses: state.Flag('#'),
+	}
+	opts.Width, _ = state.Width()
+	opts.Scale, opts.FixedScale = state.Precision()
+
+	buf := d.appendFormat(nil, verb, opts)
+
+	// Writing result
+	//nolint:errcheck
+	switch verb {
+	case 'q', 'Q', 's', 'S', 'v', 'V', 'f', 'F', 'k', 'K', 'e', 'E', 'g', 'G':
+		state.Write(buf)
+	default:
+		state.Write([]byte("%!"))
+		state.Write([]byte{byte(verb)})
+		state.Write([]byte("(decimal.Decimal="))
+		state.Write(buf)
+		state.Write([]byte(")"))
+	}
+}
+
+// FormatOptions specifies how a decimal is converted to a string
+// without using the [fmt] package.
+// Its zero value produces the same result as [Decimal.String].
+// The fields correspond to the flags, width and precision of the %f verb
+// in [Decimal.Format].
+//
+// The separators can be changed to render amounts in regional styles
+// without guessing the locale, for example:
+//
+//	swiss := decimal.FormatOptions{Grouping: true, GroupSeparator: '\''}                    // 1'234.50
+//	si := decimal.FormatOptions{Grouping: true, GroupSeparator: ' ', DecimalSeparator: ','} // 1 234,50
+type FormatOptions struct {
+	Width            int  // minimum number of characters in the result
+	Scale            int  // number of digits after the decimal point, used only if FixedScale is true
+	FixedScale       bool // round or zero-pad the decimal to the given scale
+	Plus             bool // always print a sign, same as '+' flag
+	Space            bool // print a space instead of a plus sign, same as ' ' flag
+	ZeroPad          bool // pad with leading zeros instead of spaces, same as '0' flag
+	LeftAlign        bool // pad with trailing spaces instead of leading ones, same as '-' flag
+	Grouping         bool // separate groups of thousands in the integer part, same as '#' flag
+	Parentheses      bool // enclose negative decimals in parentheses instead of printing a minus sign, same as '#' flag
+	GroupSeparator   rune // separator of groups of thousands, used only if Grouping is true; zero means ','
+	DecimalSeparator rune // separator of the integer and fractional parts; zero means '.'
+}
+
+// groupSeparator returns the separator of groups of thousands.
+func (o FormatOptions) groupSeparator() rune {
+	if o.GroupSeparator == 0 || !utf8.ValidRune(o.GroupSeparator) {
+		return ','
+	}
+	return o.GroupSeparator
+}
+
+// decimalSeparator returns the separator of the integer and fractional parts.
+func (o FormatOptions) decimalSeparator() rune {
+	if o.DecimalSeparator == 0 || !utf8.ValidRune(o.DecimalSeparator) {
+		return '.'
+	}
+	return o.DecimalSeparator
+}
+
+// Format returns a string representation of the decimal formatted according
+// to the options.
+// For example, a decimal 1234.5 formatted with the options
+// {Width: 13, Scale: 2, FixedScale: true, Plus: true, ZeroPad: true}
+// results in "+000001234.50".
+// See also methods [FormatOptions.Append], [Decimal.Format].
+func (o FormatOptions) Format(d Decimal) string {
+	var buf [64]byte
+	return string(o.Append(buf[:0], d))
+}
+
+// Append appends a string representation of the decimal formatted according
+// to the options to the byte slice and returns the extended slice.
+// Append does not allocate if the byte slice has enough capacity.
+// See also method [FormatOptions.Format].
+func (o FormatOptions) Append(b []byte, d Decimal) []byte {
+	return d.appendFormat(b, 'f', o)
+}
+
+// appendFormat appends a string representation of the decimal formatted
+// according to the verb and options to the byte slice.
 //
 //nolint:gocyclo
-func (d Decimal) Format(state fmt.State, verb rune) {
-	var err error
+func (d Decimal) appendFormat(b []byte, verb rune, opts FormatOptions) []byte {
+	// Scientific notation
+	switch verb {
+	case 'e', 'E':
+		return d.appendExp(b, verb, opts)
+	case 'g', 'G':
+		return d.appendGeneral(b, verb, opts)
+	}
 
-	// Percentage multiplier
+	// Percentage multiplier.
+	// The decimal point is shifted instead of multiplying by 100,
+	// so the percentage of any decimal can be formatted without overflow.
+	var izeros int
 	if verb == 'k' || verb == 'K' {
-		d, err = d.Mul(Hundred)
-		if err != nil {
-			// This panic is handled inside the fmt package.
-			panic(fmt.Errorf("formatting percent: %w", err))
+		if d.Scale() >= 2 {
+			d = newUnsafe(d.IsNeg(), d.coef, d.Scale()-2)
+		} else {
+			if !d.IsZero() {
+				izeros = 2 - d.Scale()
+			}
+			d = newUnsafe(d.IsNeg(), d.coef, 0)
 		}
 	}
 
 	// Rescaling
 	var tzeros int
 	if verb == 'f' || verb == 'F' || verb == 'k' || verb == 'K' {
-		var scale int
-		switch p, ok := state.Precision(); {
-		case ok:
-			scale = p
-		case verb == 'k' || verb == 'K':
-			scale = d.Scale() - 2
-		case verb == 'f' || verb == 'F':
-			scale = d.Scale()
+		scale := d.Scale()
+		if opts.FixedScale {
+			scale = opts.Scale
 		}
 		scale = max(scale, MinScale)
 		switch {
@@ -813,7 +1534,7 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 	var intdigs int
 	fracdigs := d.Scale()
 	if dprec := d.Prec(); dprec > fracdigs {
-		intdigs = dprec - fracdigs
+		intdigs = dprec - fracdigs + izeros
 	}
 	if d.WithinOne() {
 		intdigs++ // leading 0
@@ -825,9 +1546,22 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 		dpoint = 1
 	}
 
-	// Arithmetic sign
-	var rsign int
-	if d.IsNeg() || state.Flag('+') || state.Flag(' ') {
+	// Group separators
+	var gseps int
+	if opts.Grouping {
+		gseps = (intdigs - 1) / 3
+	}
+
+	// Separators may occupy several bytes
+	gsep, dsep := opts.groupSeparator(), opts.decimalSeparator()
+	gseplen, dseplen := utf8.RuneLen(gsep), utf8.RuneLen(dsep)
+
+	// Arithmetic sign or parentheses
+	var rsign, lparen, rparen int
+	switch {
+	case d.IsNeg() && opts.Parentheses:
+		lparen, rparen = 1, 1
+	case d.IsNeg() || opts.Plus || opts.Space:
 		rsign = 1
 	}
 
@@ -844,21 +1578,24 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 	}
 
 	// Calculating padding
-	width := lquote + rsign + intdigs + dpoint + fracdigs + tzeros + psign + tquote
+	width := lquote + rsign + lparen + intdigs + gseps + dpoint + fracdigs + tzeros + psign + rparen + tquote
 	var lspaces, tspaces, lzeros int
-	if w, ok := state.Width(); ok && w > width {
+	if opts.Width > width {
 		switch {
-		case state.Flag('-'):
-			tspaces = w - width
-		case state.Flag('0'):
-			lzeros = w - width
+		case opts.LeftAlign:
+			tspaces = opts.Width - width
+		case opts.ZeroPad:
+			lzeros = opts.Width - width
 		default:
-			lspaces = w - width
+			lspaces = opts.Width - width
 		}
-		width = w
+		width = opts.Width
 	}
+	width += gseps*(gseplen-1) + dpoint*(dseplen-1)
 
-	buf := make([]byte, width)
+	b = slices.Grow(b, width)
+	b = b[:len(b)+width]
+	buf := b[len(b)-width:]
 	pos := width - 1
 
 	// Trailing spaces
@@ -873,6 +1610,12 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 		pos--
 	}
 
+	// Closing parenthesis
+	for range rparen {
+		buf[pos] = ')'
+		pos--
+	}
+
 	// Percentage sign
 	for range psign {
 		buf[pos] = '%'
@@ -895,15 +1638,23 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 
 	// Decimal point
 	for range dpoint {
-		buf[pos] = '.'
-		pos--
+		pos -= dseplen
+		utf8.EncodeRune(buf[pos+1:], dsep)
 	}
 
 	// Integer digits
-	for range intdigs {
-		buf[pos] = byte(dcoef%10) + '0'
+	for i := range intdigs {
+		if gseps > 0 && i > 0 && i%3 == 0 {
+			pos -= gseplen
+			utf8.EncodeRune(buf[pos+1:], gsep)
+		}
+		if i < izeros {
+			buf[pos] = '0'
+		} else {
+			buf[pos] = byte(dcoef%10) + '0'
+			dcoef /= 10
+		}
 		pos--
-		dcoef /= 10
 	}
 
 	// Leading zeros
@@ -912,11 +1663,17 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 		pos--
 	}
 
+	// Opening parenthesis
+	for range lparen {
+		buf[pos] = '('
+		pos--
+	}
+
 	// Arithmetic sign
 	for range rsign {
 		if d.IsNeg() {
 			buf[pos] = '-'
-		} else if state.Flag(' ') {
+		} else if opts.Space {
 			buf[pos] = ' '
 		} else {
 			buf[pos] = '+'
@@ -936,18 +1693,130 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 		pos--
 	}
 
-	// Writing result
-	//nolint:errcheck
-	switch verb {
-	case 'q', 'Q', 's', 'S', 'v', 'V', 'f', 'F', 'k', 'K':
-		state.Write(buf)
-	default:
-		state.Write([]byte("%!"))
-		state.Write([]byte{byte(verb)})
-		state.Write([]byte("(decimal.Decimal="))
-		state.Write(buf)
-		state.Write([]byte(")"))
+	return b
+}
+
+// sigDigits returns the coefficient of the decimal rounded half to even
+// to at most n significant digits, along with the number of its digits and
+// its adjusted exponent, that is, the exponent of the first digit.
+// If n is not positive, all digits are kept.
+// The adjusted exponent of zero is 0.
+func (d Decimal) sigDigits(n int) (coef fint, prec, exp int) {
+	if d.IsZero() {
+		return 0, 1, 0
+	}
+	coef, prec = d.coef, d.Prec()
+	exp = prec - 1 - d.Scale()
+	if n > 0 && n < prec {
+		coef = coef.rshHalfEven(prec - n)
+		prec = n
+		if coef.prec() > n { // carry, such as 9.99 rounded to 10.0
+			coef /= 10
+			exp++
+		}
+	}
+	return coef, prec, exp
+}
+
+// appendExp appends the decimal in scientific notation, such as "1.234e+01",
+// to the byte slice according to the verb ('e' or 'E') and options.
+// By default, all digits of the coefficient are kept.
+// If opts.FixedScale is true, the significand is rounded or zero-padded
+// to opts.Scale digits after the decimal point.
+func (d Decimal) appendExp(b []byte, verb rune, opts FormatOptions) []byte {
+	n := 0
+	if opts.FixedScale {
+		n = max(opts.Scale, 0) + 1
+	}
+	coef, prec, exp := d.sigDigits(n)
+	return appendSignificand(b, d.IsNeg(), coef, prec, exp, verb, opts)
+}
+
+// appendGeneral appends the decimal to the byte slice according to the verb
+// ('g' or 'G') and options, using scientific notation for large and small
+// exponents, as described in [Decimal.Format].
+// Trailing zeros produced by rounding are removed, while trailing zeros
+// of the original decimal are kept.
+func (d Decimal) appendGeneral(b []byte, verb rune, opts FormatOptions) []byte {
+	n, eprec := 0, 6
+	if opts.FixedScale {
+		n = max(opts.Scale, 1)
+		eprec = n
+	}
+	coef, prec, exp := d.sigDigits(n)
+	rounded := prec < d.Prec()
+	if eprec > prec && prec > exp {
+		eprec = prec
+	}
+	if d.IsZero() || (exp >= -4 && exp < eprec) {
+		// Scale cannot be negative here, because the integer part
+		// is never rounded in this branch.
+		scale := prec - 1 - exp
+		if d.IsZero() {
+			scale = d.Scale()
+		}
+		f := newUnsafe(d.IsNeg(), coef, scale)
+		if rounded {
+			f = f.Trim(0)
+		}
+		opts.FixedScale, opts.Grouping = false, false
+		return f.appendFormat(b, 'f', opts)
+	}
+	if rounded {
+		for prec > 1 && coef%10 == 0 {
+			coef /= 10
+			prec--
+		}
+	}
+	if verb == 'G' {
+		verb = 'E'
+	} else {
+		verb = 'e'
 	}
+	opts.FixedScale = false
+	return appendSignificand(b, d.IsNeg(), coef, prec, exp, verb, opts)
+}
+
+// appendSignificand appends a number in scientific notation with the given
+// significand digits and exponent, such as "1.234e+01",
+// to the byte slice according to the verb ('e' or 'E') and options.
+func appendSignificand(b []byte, neg bool, coef fint, prec, exp int, verb rune, opts FormatOptions) []byte {
+	// Exponent suffix
+	var suffix [8]byte
+	sfx := append(suffix[:0], byte(verb))
+	if exp < 0 {
+		sfx = append(sfx, '-')
+		exp = -exp
+	} else {
+		sfx = append(sfx, '+')
+	}
+	if exp < 10 {
+		sfx = append(sfx, '0')
+	}
+	sfx = strconv.AppendInt(sfx, int64(exp), 10)
+
+	// Significand is formatted as a decimal between 1 and 10,
+	// and the padding is adjusted for the suffix.
+	m := newUnsafe(neg, coef, prec-1)
+	width := opts.Width
+	opts.Width = max(width-len(sfx), 0)
+	opts.Grouping = false
+	if opts.LeftAlign {
+		opts.Width = 0
+	}
+	start := len(b)
+	b = m.appendFormat(b, 'f', opts)
+	// The closing parenthesis follows the exponent
+	if len(b) > start && b[len(b)-1] == ')' {
+		b = append(b[:len(b)-1], sfx...)
+		b = append(b, ')')
+	} else {
+		b = append(b, sfx...)
+	}
+	for len(b)-start < width {
+		b = append(b, ' ')
+	}
+	return b
 }
 
 // Prec returns the number of digits in the coefficient.
@@ -956,6 +1825,27 @@ func (d Decimal) Prec() int {
 	return d.coef.prec()
 }
 
+// LeadingDigit returns the most significant digit of the coefficient.
+// LeadingDigit returns 0 if the decimal is zero.
+// See also methods [Decimal.Prec], [Decimal.Coef].
+func (d Decimal) LeadingDigit() int {
+	if d.coef == 0 {
+		return 0
+	}
+	return int(d.coef / pow10[d.Prec()-1])
+}
+
+// Parts returns the coefficient of the decimal as a string of digits,
+// the scale, and the sign, such that the decimal is equal to
+// -coef / 10^scale if neg is true, and coef / 10^scale otherwise.
+// The coefficient and scale correspond to the unscaledValue and scale
+// of Java's BigDecimal, except that the sign is returned separately.
+// For example, -12.340 has parts ("12340", 3, true).
+// See also constructor [NewFromPartsString].
+func (d Decimal) Parts() (coef string, scale int, neg bool) {
+	return strconv.FormatUint(d.Coef(), 10), d.Scale(), d.IsNeg()
+}
+
 // Coef returns the coefficient of the decimal.
 // See also method [Decimal.Prec].
 func (d Decimal) Coef() uint64 {
@@ -1037,9 +1927,26 @@ func (d Decimal) Pad(scale int) Decimal {
 	return newUnsafe(d.IsNeg(), coef, scale)
 }
 
+// padExact pads a (possibly rounded) result to the given number of digits
+// after the decimal point.
+// padExact returns an overflow error if the integer part of the result
+// is too long to keep that many digits, that is, if any of the significant
+// digits have been lost during rounding.
+func (d Decimal) padExact(scale int) (Decimal, error) {
+	if d.Prec()-d.Scale() > MaxPrec-scale {
+		return Decimal{}, overflowError(d.Prec(), d.Scale(), scale)
+	}
+	return d.Pad(scale), nil
+}
+
 // Rescale returns a decimal rounded or zero-padded to the given number of digits
 // after the decimal point.
-// If the given scale is negative, it is redefined to zero.
+// Rescale never fails, so it can be used to normalize untrusted input:
+//   - if the given scale is negative, it is redefined to zero;
+//   - if the given scale is greater than [MaxScale], it is redefined to [MaxScale];
+//   - if padding would make the coefficient longer than [MaxPrec] digits,
+//     the decimal is padded only up to [MaxPrec] digits.
+//
 // For financial calculations, the scale should be equal to or greater than
 // the scale of the currency.
 // See also methods [Decimal.Round], [Decimal.Pad].
@@ -1052,7 +1959,7 @@ func (d Decimal) Rescale(scale int) Decimal {
 
 // Quantize returns a decimal rescaled to the same scale as decimal e.
 // The sign and the coefficient of decimal e are ignored.
-// See also methods [Decimal.SameScale] and [Decimal.Rescale].
+// See also methods [Decimal.SameScale], [Decimal.Rescale], [Decimal.QuantizeMode].
 func (d Decimal) Quantize(e Decimal) Decimal {
 	return d.Rescale(e.Scale())
 }
@@ -1092,6 +1999,71 @@ func (d Decimal) Trim(scale int) Decimal {
 	return d.Trunc(scale)
 }
 
+// maxPow10Shift is a shift of the decimal point large enough to move
+// all digits of any decimal out of the representable range.
+const maxPow10Shift = MaxPrec + MaxScale + 1
+
+// MulPow10 returns the decimal multiplied by 10^n, which is computed by
+// shifting the decimal point, so it is faster than [Decimal.Mul].
+// The result keeps all digits of the decimal: if n is positive, the scale is
+// reduced by n, and the coefficient is multiplied only if the scale is not
+// sufficient; if n is negative, the result is equal to [Decimal.DivPow10].
+// See also method [Decimal.DivPow10].
+//
+// MulPow10 returns an error if:
+//   - the integer part of the result has more than [MaxPrec] digits;
+//   - n is negative and the result cannot be represented exactly
+//     with [MaxScale] digits after the decimal point.
+func (d Decimal) MulPow10(n int) (Decimal, error) {
+	if n < 0 {
+		e, ok := d.DivPow10(-max(n, -maxPow10Shift))
+		if !ok {
+			return Decimal{}, errorf("computing [%v * 10^%v]: %w: the result has more than %v digits after the decimal point", redact(d), n, errInexactDivision, MaxScale)
+		}
+		return e, nil
+	}
+	switch {
+	case d.Scale() >= n:
+		return newUnsafe(d.IsNeg(), d.coef, d.Scale()-n), nil
+	case d.IsZero():
+		return Zero, nil
+	}
+	n = min(n, maxPow10Shift)
+	coef, ok := d.coef.lsh(n - d.Scale())
+	if !ok {
+		return Decimal{}, errorf("computing [%v * 10^%v]: %w", redact(d), n, overflowError(d.Prec()+n, d.Scale(), 0))
+	}
+	return newUnsafe(d.IsNeg(), coef, 0), nil
+}
+
+// DivPow10 returns the (possibly rounded) decimal divided by 10^n,
+// which is computed by shifting the decimal point, so it is faster than
+// [Decimal.Quo].
+// If n is positive, the scale is increased by n; if the result would have
+// more than [MaxScale] digits after the decimal point, it is rounded to
+// [MaxScale] digits using half-to-even rounding.
+// If n is negative, the decimal is multiplied by 10^-n, as in
+// [Decimal.MulPow10].
+// DivPow10 returns true if the result is exact, and false if it was rounded
+// or if its integer part has more than [MaxPrec] digits, in which case
+// the result is zero.
+// See also method [Decimal.MulPow10].
+func (d Decimal) DivPow10(n int) (Decimal, bool) {
+	if n < 0 {
+		e, err := d.MulPow10(-max(n, -maxPow10Shift))
+		return e, err == nil
+	}
+	n = min(n, maxPow10Shift)
+	scale := d.Scale() + n
+	if scale <= MaxScale {
+		return newUnsafe(d.IsNeg(), d.coef, scale), true
+	}
+	shift := scale - MaxScale
+	exact := d.coef == 0 || d.coef.ntz() >= shift
+	coef := d.coef.rshHalfEven(shift)
+	return newUnsafe(d.IsNeg(), coef, MaxScale), exact
+}
+
 // Ceil returns a decimal rounded up to the given number of digits
 // after the decimal point using [rounding toward positive infinity].
 // If the given scale is negative, it is redefined to zero.
@@ -1197,6 +2169,18 @@ func (d Decimal) IsZero() bool {
 	return d.coef == 0
 }
 
+// NilIfZero returns nil if d = 0, and a pointer to a copy of d otherwise.
+// It is intended for pointer fields tagged with `json:",omitempty"`,
+// since encoding/json never omits struct values, such as [Decimal],
+// when this option is used.
+// See also method [Decimal.IsZero].
+func (d Decimal) NilIfZero() *Decimal {
+	if d.IsZero() {
+		return nil
+	}
+	return &d
+}
+
 // Prod returns the (possibly rounded) product of decimals with at least
 // double precision.
 //
@@ -1207,7 +2191,7 @@ func Prod(d ...Decimal) (Decimal, error) {
 	// Special cases
 	switch len(d) {
 	case 0:
-		return Decimal{}, fmt.Errorf("computing [prod([])]: %w: no arguments", errInvalidOperation)
+		return Decimal{}, errorf("computing [prod([])]: %w: no arguments", errInvalidOperation)
 	case 1:
 		return d[0], nil
 	}
@@ -1217,13 +2201,44 @@ func Prod(d ...Decimal) (Decimal, error) {
 	if err != nil {
 		e, err = prodBint(d...)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [prod(%v)]: %w", d, err)
+			return Decimal{}, errorf("computing [prod(%v)]: %w", redact(d), err)
 		}
 	}
 
 	return e, nil
 }
 
+// ProdChecked is like [Prod], but if the product overflows, it also returns
+// the index of the first decimal at which the running product overflows and
+// the (possibly rounded) product of the decimals preceding it.
+// If the product does not overflow, the total is equal to the result
+// of [Prod], and failedAt is -1.
+// See also function [SumChecked].
+//
+// ProdChecked returns an error if:
+//   - no arguments are provided, in which case failedAt is -1;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func ProdChecked(d []Decimal) (total Decimal, failedAt int, err error) {
+	total, err = Prod(d...)
+	if err == nil || len(d) == 0 {
+		return total, -1, err
+	}
+
+	// Slow path
+	total = d[0]
+	for i := 1; i < len(d); i++ {
+		e, err := total.Mul(d[i])
+		if err != nil {
+			return total, i, errorf("computing [prod(...)] at index %v: %w", i, err)
+		}
+		total = e
+	}
+	// The running product was rounded just below the limit
+	i := len(d) - 1
+	total, _ = Prod(d[:i]...)
+	return total, i, errorf("computing [prod(...)] at index %v: %w", i, errDecimalOverflow)
+}
+
 // prodFint computes the product of decimals using uint64 arithmetic.
 func prodFint(d ...Decimal) (Decimal, error) {
 	ecoef := One.coef
@@ -1246,36 +2261,6 @@ func prodFint(d ...Decimal) (Decimal, error) {
 	return newFromFint(eneg, ecoef, escale, 0)
 }
 
-// prodBint computes the product of decimals using *big.Int arithmetic.
-func prodBint(d ...Decimal) (Decimal, error) {
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setFint(One.coef)
-	escale := One.Scale()
-	eneg := One.IsNeg()
-
-	fcoef := getBint()
-	defer putBint(fcoef)
-
-	for _, f := range d {
-		fcoef.setFint(f.coef)
-
-		// Compute e = e * f
-		ecoef.mul(ecoef, fcoef)
-		eneg = eneg != f.IsNeg()
-		escale = escale + f.Scale()
-
-		// Intermediate truncation
-		if escale > 2*MaxScale {
-			shift := escale - 2*MaxScale
-			ecoef.rshDown(ecoef, shift)
-			escale = 2 * MaxScale
-		}
-	}
-
-	return newFromBint(eneg, ecoef, escale, 0)
-}
-
 // Mul returns the (possibly rounded) product of decimals d and e.
 //
 // Mul returns an overflow error if the integer part of the result has
@@ -1284,6 +2269,16 @@ func (d Decimal) Mul(e Decimal) (Decimal, error) {
 	return d.MulExact(e, 0)
 }
 
+// MulInt64 returns the (possibly rounded) product of decimal d and integer v.
+// It is equivalent to [Decimal.Mul] with the integer converted to a decimal,
+// but does not require constructing the decimal and checking the error.
+//
+// MulInt64 returns an overflow error if the integer part of the result has
+// more than [MaxPrec] digits.
+func (d Decimal) MulInt64(v int64) (Decimal, error) {
+	return d.Mul(newFromInt64(v))
+}
+
 // MulExact is similar to [Decimal.Mul], but it allows you to specify the number
 // of digits after the decimal point that should be considered significant.
 // If any of the significant digits are lost during rounding, the method will
@@ -1292,7 +2287,7 @@ func (d Decimal) Mul(e Decimal) (Decimal, error) {
 // equal to or greater than the currency's scale.
 func (d Decimal) MulExact(e Decimal, scale int) (Decimal, error) {
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("computing [%v * %v]: %w", d, e, errScaleRange)
+		return Decimal{}, errorf("computing [%v * %v]: %w", redact(d), redact(e), scaleRangeError(scale))
 	}
 
 	// General case
@@ -1300,7 +2295,7 @@ func (d Decimal) MulExact(e Decimal, scale int) (Decimal, error) {
 	if err != nil {
 		f, err = d.mulBint(e, scale)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v * %v]: %w", d, e, err)
+			return Decimal{}, errorf("computing [%v * %v]: %w", redact(d), redact(e), err)
 		}
 	}
 	return f, nil
@@ -1325,26 +2320,6 @@ func (d Decimal) mulFint(e Decimal, minScale int) (Decimal, error) {
 	return newFromFint(dneg, dcoef, dscale, minScale)
 }
 
-// mulBint computes the product of two decimals using *big.Int arithmetic.
-func (d Decimal) mulBint(e Decimal, minScale int) (Decimal, error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
-	dscale := d.Scale()
-	dneg := d.IsNeg()
-
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setFint(e.coef)
-
-	// Compute d = d * e
-	dcoef.mul(dcoef, ecoef)
-	dneg = dneg != e.IsNeg()
-	dscale = dscale + e.Scale()
-
-	return newFromBint(dneg, dcoef, dscale, minScale)
-}
-
 // Deprecated: use [Decimal.PowInt] instead.
 // This method will change its signature in the v1.0 release.
 func (d Decimal) Pow(power int) (Decimal, error) {
@@ -1358,17 +2333,29 @@ func (d Decimal) Pow(power int) (Decimal, error) {
 //   - the integer part of the result has more than [MaxPrec] digits;
 //   - zero is raised to a negative power.
 func (d Decimal) PowInt(power int) (Decimal, error) {
+	return d.powInt(context.Background(), power)
+}
+
+// powInt is like [Decimal.PowInt] but also stops the *big.Int computation
+// and returns an error as soon as ctx is done.
+func (d Decimal) powInt(ctx context.Context, power int) (Decimal, error) {
 	// Special case: zero to a negative power
 	if power < 0 && d.IsZero() {
-		return Decimal{}, fmt.Errorf("computing [%v^%v]: %w", d, power, errInvalidOperation)
+		return Decimal{}, errorf("computing [%v^%v]: %w", redact(d), power, errInvalidOperation)
 	}
 
 	// General case
-	e, err := d.powIntFint(power)
+	var e Decimal
+	var err error
+	if power < 0 {
+		e, err = d.invPowIntFint(power)
+	} else {
+		e, err = d.powIntFint(power)
+	}
 	if err != nil {
-		e, err = d.powIntBint(power)
+		e, err = d.powIntBint(ctx, power)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v^%v]: %w", d, power, err)
+			return Decimal{}, errorf("computing [%v^%v]: %w", redact(d), power, err)
 		}
 	}
 
@@ -1380,126 +2367,179 @@ func (d Decimal) PowInt(power int) (Decimal, error) {
 	return e, nil
 }
 
-// powIntFint computes the integer power of a decimal using uint64 arithmetic.
-// powIntFint does not support negative powers.
-func (d Decimal) powIntFint(power int) (Decimal, error) {
-	dcoef := d.coef
-	dneg := d.IsNeg()
-	dscale := d.Scale()
+// PowCtx is like [Decimal.PowInt] but also returns an error if ctx is done.
+// The context is checked before the computation and after every squaring,
+// so an adversarial power does not delay the cancellation.
+//
+// PowCtx returns an error if:
+//   - ctx is done;
+//   - the integer part of the result has more than [MaxPrec] digits;
+//   - zero is raised to a negative power.
+func (d Decimal) PowCtx(ctx context.Context, power int) (Decimal, error) {
+	if err := ctx.Err(); err != nil {
+		return Decimal{}, errorf("computing [%v^%v]: %w", redact(d), power, err)
+	}
+	return d.powInt(ctx, power)
+}
 
-	ecoef := One.coef
-	eneg := One.IsNeg()
-	escale := One.Scale()
+// PowIntExact is similar to [Decimal.PowInt], but it allows you to specify
+// the number of digits after the decimal point that should be considered
+// significant.
+// If any of the significant digits are lost during rounding, the method will
+// return an error.
+func (d Decimal) PowIntExact(power, scale int) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, errorf("computing [%v^%v]: %w", redact(d), power, scaleRangeError(scale))
+	}
+	e, err := d.PowInt(power)
+	if err != nil {
+		return Decimal{}, err
+	}
+	e, err = e.padExact(scale)
+	if err != nil {
+		return Decimal{}, errorf("computing [%v^%v]: %w", redact(d), power, err)
+	}
+	return e, nil
+}
 
-	if power < 0 {
-		return Decimal{}, errInvalidOperation
+// PowDecimal returns the (possibly rounded) decimal raised to the given
+// decimal power.
+// If the power is an integer, regardless of its scale, such as 2 or 2.00,
+// the result is the same as for [Decimal.PowInt], so a negative decimal
+// can be raised to it.
+// Otherwise, the result is computed as exp(e * log(d)) with at least
+// double precision using [big.Int] arithmetic and rounded only once.
+// If zero is raised to zero power then the result is one.
+// This method will replace [Decimal.Pow] in the v1.0 release.
+//
+// PowDecimal returns an error if:
+//   - the integer part of the result has more than [MaxPrec] digits;
+//   - zero is raised to a negative power;
+//   - a negative decimal is raised to a non-integer power.
+//
+// [big.Int]: https://pkg.go.dev/math/big#Int
+func (d Decimal) PowDecimal(e Decimal) (Decimal, error) {
+	// Integer powers
+	if e.IsInt() {
+		if power, _, ok := e.Int64(0); ok && power == int64(int(power)) {
+			return d.PowInt(int(power))
+		}
 	}
 
-	// Exponentiation by squaring
-	var ok bool
-	for power > 0 {
-		if power%2 == 1 {
-			power = power - 1
-
-			// Compute e = e * d
-			ecoef, ok = ecoef.mul(dcoef)
-			if !ok {
-				return Decimal{}, errDecimalOverflow
-			}
-			eneg = eneg != dneg
-			escale = escale + dscale
+	// Special cases
+	switch {
+	case d.IsZero():
+		if e.IsNeg() {
+			return Decimal{}, errorf("computing [%v^%v]: %w", redact(d), redact(e), errInvalidOperation)
 		}
-		if power > 0 {
-			power = power / 2
-
-			// Compute d = d * d
-			dcoef, ok = dcoef.mul(dcoef)
-			if !ok {
-				return Decimal{}, errDecimalOverflow
-			}
-			dneg = false
-			dscale = dscale * 2
+		return Zero, nil
+	case d.IsNeg() && !e.IsInt():
+		return Decimal{}, errorf("computing [%v^%v]: %w: non-integer power of negative decimal", redact(d), redact(e), errInvalidOperation)
+	case d.Abs().IsOne():
+		if d.IsNeg() && e.coef/pow10[e.Scale()]%2 == 1 {
+			return NegOne, nil
 		}
+		return One, nil
 	}
 
-	return newFromFint(eneg, ecoef, escale, 0)
+	// General case
+	f, err := d.Abs().powBint(e)
+	if err != nil {
+		return Decimal{}, errorf("computing [%v^%v]: %w", redact(d), redact(e), err)
+	}
+
+	// Sign of odd integer powers
+	if d.IsNeg() && e.coef/pow10[e.Scale()]%2 == 1 {
+		f = f.Neg()
+	}
+
+	// Preferred scale
+	f = f.Trim(0)
+
+	return f, nil
 }
 
-// powIntBint computes the integer power of a decimal using *big.Int arithmetic.
-// powIntBint supports negative powers.
-func (d Decimal) powIntBint(power int) (Decimal, error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
+// powIntFint computes the integer power of a decimal using uint64 arithmetic.
+// powIntFint does not support negative powers.
+func (d Decimal) powIntFint(power int) (Decimal, error) {
+	dcoef := d.coef
 	dneg := d.IsNeg()
 	dscale := d.Scale()
 
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setFint(One.coef)
+	ecoef := One.coef
 	eneg := One.IsNeg()
 	escale := One.Scale()
 
-	inv := false
 	if power < 0 {
-		power = -power
-		inv = true
+		return Decimal{}, errInvalidOperation
 	}
 
 	// Exponentiation by squaring
+	var ok bool
 	for power > 0 {
 		if power%2 == 1 {
 			power = power - 1
 
 			// Compute e = e * d
-			ecoef.mul(ecoef, dcoef)
+			ecoef, ok = ecoef.mul(dcoef)
+			if !ok {
+				return Decimal{}, errDecimalOverflow
+			}
 			eneg = eneg != dneg
 			escale = escale + dscale
-
-			// Intermediate truncation
-			if escale > 3*MaxScale {
-				shift := escale - 3*MaxScale
-				ecoef.rshDown(ecoef, shift)
-				escale = 3 * MaxScale
-			}
 		}
 		if power > 0 {
 			power = power / 2
 
 			// Compute d = d * d
-			dcoef.mul(dcoef, dcoef)
+			dcoef, ok = dcoef.mul(dcoef)
+			if !ok {
+				return Decimal{}, errDecimalOverflow
+			}
 			dneg = false
 			dscale = dscale * 2
-
-			// Intermediate truncation
-			if dscale > 3*MaxScale {
-				shift := dscale - 3*MaxScale
-				dcoef.rshDown(dcoef, shift)
-				dscale = 3 * MaxScale
-			}
 		}
 	}
 
-	if inv {
-		if ecoef.sign() == 0 {
-			return Decimal{}, unknownOverflowError(0)
-		}
+	return newFromFint(eneg, ecoef, escale, 0)
+}
+
+// invPowIntFint computes the negative integer power of a decimal using uint64 arithmetic.
+// invPowIntFint succeeds only if the result is exact, for example, for 2^-3 or 0.5^-2.
+func (d Decimal) invPowIntFint(power int) (Decimal, error) {
+	if power >= 0 || power == math.MinInt {
+		return Decimal{}, errInvalidOperation
+	}
+	power = -power
+
+	// Check that d^power does not need rounding
+	if dscale := d.Scale(); dscale > 0 && power > MaxScale/dscale {
+		return Decimal{}, errDecimalOverflow
+	}
 
-		// Compute e = 1 / e
-		ecoef.quo(bpow10[2*MaxScale+escale], ecoef)
-		escale = 2 * MaxScale
+	// Compute e = d^power
+	e, err := d.powIntFint(power)
+	if err != nil {
+		return Decimal{}, err
 	}
 
-	return newFromBint(eneg, ecoef, escale, 0)
+	// Compute e = 1 / e
+	return One.quoFint(e, 0)
 }
 
 // Sqrt computes the square root of a decimal.
 //
 // Sqrt returns an error if the decimal is negative.
 func (d Decimal) Sqrt() (Decimal, error) {
+	return d.sqrt(context.Background())
+}
+
+// sqrt is like [Decimal.Sqrt] but also stops the *big.Int computation
+// and returns an error as soon as ctx is done.
+func (d Decimal) sqrt(ctx context.Context) (Decimal, error) {
 	// Special case: negative
 	if d.IsNeg() {
-		return Decimal{}, fmt.Errorf("computing sqrt(%v): %w", d, errInvalidOperation)
+		return Decimal{}, errorf("computing sqrt(%v): %w", redact(d), errInvalidOperation)
 	}
 
 	// Special case: zero
@@ -1508,9 +2548,12 @@ func (d Decimal) Sqrt() (Decimal, error) {
 	}
 
 	// General case
-	e, err := d.sqrtBint()
+	e, err := d.sqrtFint()
 	if err != nil {
-		return Decimal{}, fmt.Errorf("computing sqrt(%v): %w", d, err)
+		e, err = d.sqrtBint(ctx)
+		if err != nil {
+			return Decimal{}, errorf("computing sqrt(%v): %w", redact(d), err)
+		}
 	}
 
 	// Preferred scale
@@ -1519,55 +2562,84 @@ func (d Decimal) Sqrt() (Decimal, error) {
 	return e, nil
 }
 
-// sqrtBint computes the square root of a decimal using *big.Int arithmetic.
-func (d Decimal) sqrtBint() (Decimal, error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
+// sqrtFint computes the square root of a decimal using uint64 arithmetic.
+// sqrtFint succeeds only if the square root is exact, for example, for 2.25 or 0.0004.
+func (d Decimal) sqrtFint() (Decimal, error) {
+	dcoef := d.coef
+	dscale := d.Scale()
 
-	ecoef := getBint()
-	defer putBint(ecoef)
-	escale := 2 * MaxScale
+	// Alignment
+	if dscale%2 != 0 {
+		var ok bool
+		dcoef, ok = dcoef.lsh(1)
+		if !ok {
+			return Decimal{}, errDecimalOverflow
+		}
+		dscale = dscale + 1
+	}
 
-	fcoef := getBint()
-	defer putBint(fcoef)
-	fcoef.setFint(0)
+	// Compute e = √d
+	ecoef, ok := dcoef.sqrt()
+	if !ok {
+		return Decimal{}, errInexactDivision
+	}
 
-	// Alignment
-	dcoef.lsh(dcoef, 4*MaxScale-d.Scale())
+	return newFromFint(false, ecoef, dscale/2, 0)
+}
 
-	// Initial guess is calculated as 10^(n/2), where n is the position of
-	// the most significant digit (n is negative if -1 < d < 1).
-	n := dcoef.prec() - 4*MaxScale
-	ecoef.setBint(bpow10[n/2+escale])
+// SqrtCtx is like [Decimal.Sqrt] but also returns an error if ctx is done.
+// The context is checked before the computation and after every iteration
+// of Newton's method.
+//
+// SqrtCtx returns an error if:
+//   - ctx is done;
+//   - the decimal is negative.
+func (d Decimal) SqrtCtx(ctx context.Context) (Decimal, error) {
+	if err := ctx.Err(); err != nil {
+		return Decimal{}, errorf("computing sqrt(%v): %w", redact(d), err)
+	}
+	return d.sqrt(ctx)
+}
 
-	// Newton's method
-	for range 50 {
-		if ecoef.cmp(fcoef) == 0 {
-			break
-		}
-		fcoef.setBint(ecoef)
-		ecoef.quo(dcoef, ecoef)
-		ecoef.add(ecoef, fcoef)
-		ecoef.hlf(ecoef)
+// SqrtExact is similar to [Decimal.Sqrt], but it allows you to specify
+// the number of digits after the decimal point that should be considered
+// significant.
+// If any of the significant digits are lost during rounding, the method will
+// return an error.
+func (d Decimal) SqrtExact(scale int) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, errorf("computing sqrt(%v): %w", redact(d), scaleRangeError(scale))
 	}
-
-	return newFromBint(false, ecoef, escale, 0)
+	e, err := d.Sqrt()
+	if err != nil {
+		return Decimal{}, err
+	}
+	e, err = e.padExact(scale)
+	if err != nil {
+		return Decimal{}, errorf("computing sqrt(%v): %w", redact(d), err)
+	}
+	return e, nil
 }
 
 // Exp returns the (possibly rounded) exponential of a decimal.
 //
 // Exp returns an error if the integer part of the result has more than [MaxPrec] digits.
 func (d Decimal) Exp() (Decimal, error) {
+	return d.exp(context.Background())
+}
+
+// exp is like [Decimal.Exp] but also stops the *big.Int computation
+// and returns an error as soon as ctx is done.
+func (d Decimal) exp(ctx context.Context) (Decimal, error) {
 	// Special case: zero
 	if d.IsZero() {
 		return newSafe(false, 1, 0)
 	}
 
 	// General case
-	e, err := d.expBint()
+	e, err := d.expBint(ctx)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("computing exp(%v): %w", d, err)
+		return Decimal{}, errorf("computing exp(%v): %w", redact(d), err)
 	}
 
 	// Preferred scale
@@ -1576,110 +2648,53 @@ func (d Decimal) Exp() (Decimal, error) {
 	return e, nil
 }
 
-// expBint computes exponential of a decimal using *big.Int arithmetic.
-func (d Decimal) expBint() (Decimal, error) {
-	dcoef := d.coef
-	dscale := d.Scale()
-
-	// Split |d| into integer part q and fractional part r
-	q, r, ok := dcoef.quoRem(pow10[dscale])
-	if !ok {
-		return Decimal{}, errDecimalOverflow // Should never happen
+// ExpCtx is like [Decimal.Exp] but also returns an error if ctx is done.
+// The context is checked before the computation and after every term
+// of the Taylor series.
+//
+// ExpCtx returns an error if:
+//   - ctx is done;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) ExpCtx(ctx context.Context) (Decimal, error) {
+	if err := ctx.Err(); err != nil {
+		return Decimal{}, errorf("computing exp(%v): %w", redact(d), err)
 	}
+	return d.exp(ctx)
+}
 
-	// Check underflow and overflow
-	if q >= fint(len(bexp)) {
-		if d.IsNeg() {
-			return newSafe(false, 0, 0)
-		}
-		return Decimal{}, unknownOverflowError(0)
+// ExpExact is similar to [Decimal.Exp], but it allows you to specify
+// the number of digits after the decimal point that should be considered
+// significant.
+// If any of the significant digits are lost during rounding, the method will
+// return an error.
+func (d Decimal) ExpExact(scale int) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, errorf("computing exp(%v): %w", redact(d), scaleRangeError(scale))
 	}
-
-	// Retrieve e = exp(q) from precomputed cache
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setBint(bexp[q])
-	escale := 2 * MaxScale
-
-	if r != 0 {
-		// Compute f = exp(r) using Taylor series expansion
-		fcoef := getBint()
-		defer putBint(fcoef)
-		fcoef.setFint(0)
-		fscale := 2 * MaxScale
-
-		rcoef := getBint()
-		defer putBint(rcoef)
-		rcoef.setFint(r)
-		rscale := dscale
-
-		gcoef := getBint()
-		defer putBint(gcoef)
-		gcoef.setBint(bpow10[2*MaxScale])
-		gscale := 2 * MaxScale
-
-		hcoef := getBint()
-		defer putBint(hcoef)
-
-		// Alignment
-		if rscale < 2*MaxScale {
-			rcoef.lsh(rcoef, 2*MaxScale-rscale)
-			rscale = 2 * MaxScale
-		}
-
-		// Compute f = exp(r) = r^0 / 0! + r^1 / 1! + ... + r^n / n!
-		for i := range len(bfact) {
-			// Accumulate f = f + r^i / i!
-			hcoef.quo(gcoef, bfact[i])
-			if hcoef.sign() == 0 {
-				break
-			}
-			fcoef.add(fcoef, hcoef)
-
-			// Compute g = r^(i+1)
-			gcoef.mul(gcoef, rcoef)
-			gscale = gscale + rscale
-
-			// Intermediate truncation
-			if gscale > 2*MaxScale {
-				shift := gscale - 2*MaxScale
-				gcoef.rshDown(gcoef, shift)
-				gscale = 2 * MaxScale
-			}
-		}
-
-		// Compute exp(|d|) = exp(q) * exp(r)
-		ecoef.mul(ecoef, fcoef)
-		escale = escale + fscale
-
-		// Intermediate truncation
-		if escale > 2*MaxScale {
-			shift := escale - 2*MaxScale
-			ecoef.rshDown(ecoef, shift)
-			escale = 2 * MaxScale
-		}
+	e, err := d.Exp()
+	if err != nil {
+		return Decimal{}, err
 	}
-
-	if d.IsNeg() {
-		if ecoef.sign() == 0 {
-			return Decimal{}, unknownOverflowError(0)
-		}
-
-		// Compute exp(d) = 1 / exp(|d|)
-		ecoef.quo(bpow10[2*MaxScale+escale], ecoef)
-		escale = 2 * MaxScale
+	e, err = e.padExact(scale)
+	if err != nil {
+		return Decimal{}, errorf("computing exp(%v): %w", redact(d), err)
 	}
-
-	return newFromBint(false, ecoef, escale, 0)
+	return e, nil
 }
 
 // Log returns the (possibly rounded) natural logarithm of a decimal.
 //
 // Log returns an error if the decimal is zero or negative.
 func (d Decimal) Log() (Decimal, error) {
+	return d.log(context.Background())
+}
+
+// log is like [Decimal.Log] but also stops the *big.Int computation
+// and returns an error as soon as ctx is done.
+func (d Decimal) log(ctx context.Context) (Decimal, error) {
 	// Special case: zero or negative
 	if !d.IsPos() {
-		return Decimal{}, fmt.Errorf("computing log(%v): %w", d, errInvalidOperation)
+		return Decimal{}, errorf("computing log(%v): %w", redact(d), errInvalidOperation)
 	}
 
 	// Special case: one
@@ -1688,9 +2703,9 @@ func (d Decimal) Log() (Decimal, error) {
 	}
 
 	// General case
-	e, err := d.logBint()
+	e, err := d.logBint(ctx)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("computing log(%v): %w", d, err)
+		return Decimal{}, errorf("computing log(%v): %w", redact(d), err)
 	}
 
 	// Preferred scale
@@ -1699,146 +2714,230 @@ func (d Decimal) Log() (Decimal, error) {
 	return e, nil
 }
 
-// logBint computes the natural logarithm of a decimal using *big.Int arithmetic.
-func (d Decimal) logBint() (Decimal, error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
-
-	ecoef := getBint()
-	defer putBint(ecoef)
-	escale := 2 * MaxScale
-
-	fcoef := getBint()
-	defer putBint(fcoef)
-	fcoef.setFint(0)
+// LogCtx is like [Decimal.Log] but also returns an error if ctx is done.
+// The context is checked before the computation and after every iteration
+// of Halley's method.
+//
+// LogCtx returns an error if:
+//   - ctx is done;
+//   - the decimal is zero or negative.
+func (d Decimal) LogCtx(ctx context.Context) (Decimal, error) {
+	if err := ctx.Err(); err != nil {
+		return Decimal{}, errorf("computing log(%v): %w", redact(d), err)
+	}
+	return d.log(ctx)
+}
 
-	// Alignment and sign
-	eneg := true
-	if d.WithinOne() {
-		dcoef.quo(bpow10[2*MaxScale+d.Scale()], dcoef)
-	} else {
-		dcoef.lsh(dcoef, 2*MaxScale-d.Scale())
-		eneg = false
+// LogExact is similar to [Decimal.Log], but it allows you to specify
+// the number of digits after the decimal point that should be considered
+// significant.
+// If any of the significant digits are lost during rounding, the method will
+// return an error.
+func (d Decimal) LogExact(scale int) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, errorf("computing log(%v): %w", redact(d), scaleRangeError(scale))
 	}
+	e, err := d.Log()
+	if err != nil {
+		return Decimal{}, err
+	}
+	e, err = e.padExact(scale)
+	if err != nil {
+		return Decimal{}, errorf("computing log(%v): %w", redact(d), err)
+	}
+	return e, nil
+}
 
-	// The initial guess is calculated as n * ln(10),
-	// where n is the position of the most significant digit.
-	n := dcoef.prec() - 2*MaxScale
-	ecoef.setBint(bnlog10[n])
-
-	Ecoef := getBint()
-	defer putBint(Ecoef)
-
-	ncoef := getBint()
-	defer putBint(ncoef)
-
-	mcoef := getBint()
-	defer putBint(mcoef)
-
-	// Halley's method
-	for range 50 {
-		Ecoef.e(ecoef)
-
-		ncoef.sub(Ecoef, dcoef)
-		ncoef.dbl(ncoef)
-
-		mcoef.add(Ecoef, dcoef)
-
-		ncoef.lsh(ncoef, 2*MaxScale)
-		ncoef.quo(ncoef, mcoef)
-
-		fcoef.sub(ecoef, ncoef)
+// ExpSlice returns the (possibly rounded) exponentials of decimals.
+// The result for each decimal is the same as the one returned by [Decimal.Exp],
+// but ExpSlice reuses intermediate values across decimals and is therefore
+// faster than calling [Decimal.Exp] in a loop, for example,
+// in Monte Carlo simulations.
+//
+// ExpSlice returns an error if the integer part of any result has
+// more than [MaxPrec] digits.
+func ExpSlice(d []Decimal) ([]Decimal, error) {
+	e := make([]Decimal, len(d))
+	if i, err := expSliceBint(d, e); err != nil {
+		return nil, errorf("computing exp(%v): %w", redact(d[i]), err)
+	}
+	return e, nil
+}
 
-		if ecoef.cmp(fcoef) == 0 {
-			break
+// LogSlice returns the (possibly rounded) natural logarithms of decimals.
+// The result for each decimal is the same as the one returned by [Decimal.Log],
+// but LogSlice reuses intermediate values across decimals and is therefore
+// faster than calling [Decimal.Log] in a loop.
+//
+// LogSlice returns an error if any decimal is zero or negative.
+func LogSlice(d []Decimal) ([]Decimal, error) {
+	// Special case: zero or negative
+	for _, f := range d {
+		if !f.IsPos() {
+			return nil, errorf("computing log(%v): %w", redact(f), errInvalidOperation)
 		}
-
-		ecoef.setBint(fcoef)
 	}
 
-	return newFromBint(eneg, ecoef, escale, 0)
+	// General case
+	e := make([]Decimal, len(d))
+	if i, err := logSliceBint(d, e); err != nil {
+		return nil, errorf("computing log(%v): %w", redact(d[i]), err)
+	}
+	return e, nil
 }
 
-// e computes the exponential of a decimal using *big.Int arithmetic.
-// TODO: refactor to improve performance even more.
-func (z *bint) e(x *bint) {
-	qcoef := getBint()
-	defer putBint(qcoef)
-
-	rcoef := getBint()
-	defer putBint(rcoef)
-	rscale := 2 * MaxScale
+// Sum returns the (possibly rounded) sum of decimals without any
+// intermediate rounding.
+//
+// Sum returns an error if:
+//   - no arguments are provided;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func Sum(d ...Decimal) (Decimal, error) {
+	// Special cases
+	switch len(d) {
+	case 0:
+		return Decimal{}, errorf("computing [sum([])]: %w: no arguments", errInvalidOperation)
+	case 1:
+		return d[0], nil
+	}
 
-	qcoef.quoRem(x, bpow10[rscale], rcoef)
+	// General case
+	e, err := sumFint(d...)
+	if err != nil {
+		e, err = sumBint(d...)
+		if err != nil {
+			return Decimal{}, errorf("computing [sum(%v)]: %w", redact(d), err)
+		}
+	}
 
-	zcoef := getBint()
-	defer putBint(zcoef)
-	zcoef.setFint(0)
+	return e, nil
+}
 
-	gcoef := getBint()
-	defer putBint(gcoef)
-	gcoef.setBint(bpow10[2*MaxScale])
-	gscale := 2 * MaxScale
+// SumParallel is like [Sum], but it splits decimals into chunks and sums
+// them concurrently using the given number of goroutines.
+// Partial sums are computed without any rounding, so the result is
+// identical to the result of [Sum] regardless of the number of goroutines.
+// If workers is not positive, [runtime.GOMAXPROCS] goroutines are used.
+// SumParallel is useful for aggregating millions of decimals on multi-core
+// machines.
+//
+// SumParallel returns an error if:
+//   - no arguments are provided;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func SumParallel(d []Decimal, workers int) (Decimal, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	// Small slices are summed faster by a single goroutine
+	workers = min(workers, len(d)/minParallelChunk)
+	if workers <= 1 {
+		return Sum(d...)
+	}
 
-	hcoef := getBint()
-	defer putBint(hcoef)
+	e, err := sumParallelBint(d, workers)
+	if err != nil {
+		return Decimal{}, errorf("computing [sum(...)] of %v decimals: %w", len(d), err)
+	}
 
-	// Compute f = exp(r) = r^0 / 0! + r^1 / 1! + ... + r^n / n!
-	for i := range len(bfact) {
-		// Accumulate f = f + r^i / i!
-		hcoef.quo(gcoef, bfact[i])
-		if hcoef.sign() == 0 {
-			break
-		}
-		zcoef.add(zcoef, hcoef)
+	return e, nil
+}
 
-		// Compute g = r^(i+1)
-		gcoef.mul(gcoef, rcoef)
-		gscale = gscale + rscale
+// SumChecked is like [Sum], but if the sum overflows, it also returns
+// the index of the first decimal at which the running sum overflows and
+// the (possibly rounded) sum of the decimals preceding it.
+// It is useful for batch jobs that need to skip or split offending records
+// instead of failing the entire batch.
+// If the sum does not overflow, the total is equal to the result of [Sum],
+// and failedAt is -1.
+//
+// To locate the overflow, decimals are added one by one, so the returned
+// total may differ from [Sum] of the same decimals by the rounding of
+// intermediate results.
+//
+// SumChecked returns an error if:
+//   - no arguments are provided, in which case failedAt is -1;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func SumChecked(d []Decimal) (total Decimal, failedAt int, err error) {
+	total, err = Sum(d...)
+	if err == nil || len(d) == 0 {
+		return total, -1, err
+	}
 
-		// Intermediate truncation
-		if gscale > 2*MaxScale {
-			shift := gscale - 2*MaxScale
-			gcoef.rshDown(gcoef, shift)
-			gscale = 2 * MaxScale
+	// Slow path
+	total = d[0]
+	for i := 1; i < len(d); i++ {
+		e, err := total.Add(d[i])
+		if err != nil {
+			return total, i, errorf("computing [sum(...)] at index %v: %w", i, err)
 		}
+		total = e
 	}
+	// The running sum was rounded just below the limit
+	i := len(d) - 1
+	total, _ = Sum(d[:i]...)
+	return total, i, errorf("computing [sum(...)] at index %v: %w", i, errDecimalOverflow)
+}
 
-	// nolint:gosec
-	zcoef.mul(zcoef, bexp[int(qcoef.fint())])
-	zcoef.quo(zcoef, bpow10[2*MaxScale])
+// minParallelChunk is a minimum number of decimals summed by a single
+// goroutine in SumParallel.
+const minParallelChunk = 1024
 
-	z.setBint(zcoef)
+// SumOrZero is like [Sum], but it returns [Zero] if no arguments are provided.
+// It is useful for aggregating possibly empty query results.
+//
+// SumOrZero returns an error if the integer part of the result has more than
+// [MaxPrec] digits.
+func SumOrZero(d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Zero, nil
+	}
+	return Sum(d...)
 }
 
-// Sum returns the (possibly rounded) sum of decimals without any
-// intermediate rounding.
+// Mean returns the (possibly rounded) arithmetic mean of decimals.
+// The sum of decimals is computed without any intermediate rounding,
+// so Mean does not fail if the sum overflows but the mean does not.
+// Trailing zeros are removed from the result unless they are required
+// to preserve the largest scale of the decimals.
 //
-// Sum returns an error if:
-//   - no argements are provided;
+// Mean returns an error if:
+//   - no arguments are provided;
 //   - the integer part of the result has more than [MaxPrec] digits.
-func Sum(d ...Decimal) (Decimal, error) {
+func Mean(d ...Decimal) (Decimal, error) {
 	// Special cases
 	switch len(d) {
 	case 0:
-		return Decimal{}, fmt.Errorf("computing [sum([])]: %w: no arguments", errInvalidOperation)
+		return Decimal{}, errorf("computing [mean([])]: %w: no arguments", errInvalidOperation)
 	case 1:
 		return d[0], nil
 	}
 
 	// General case
 	e, err := sumFint(d...)
+	if err == nil {
+		e, err = e.QuoInt64(int64(len(d)))
+	} else {
+		e, err = meanBint(d...)
+	}
 	if err != nil {
-		e, err = sumBint(d...)
-		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [sum(%v)]: %w", d, err)
-		}
+		return Decimal{}, errorf("computing [mean(%v)]: %w", redact(d), err)
 	}
 
 	return e, nil
 }
 
+// MeanOrZero is like [Mean], but it returns [Zero] if no arguments are provided.
+// It is useful for aggregating possibly empty query results.
+//
+// MeanOrZero returns an error if the integer part of the result has more than
+// [MaxPrec] digits.
+func MeanOrZero(d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Zero, nil
+	}
+	return Mean(d...)
+}
+
 // sumFint computes the sum of decimals using uint64 arithmetic.
 func sumFint(d ...Decimal) (Decimal, error) {
 	ecoef := Zero.coef
@@ -1881,54 +2980,40 @@ func sumFint(d ...Decimal) (Decimal, error) {
 	return newFromFint(eneg, ecoef, escale, 0)
 }
 
-// sumBint computes the sum of decimals using *big.Int arithmetic.
-func sumBint(d ...Decimal) (Decimal, error) {
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setFint(Zero.coef)
-	escale := Zero.Scale()
-	eneg := Zero.IsNeg()
-
-	fcoef := getBint()
-	defer putBint(fcoef)
-
-	for _, f := range d {
-		fcoef.setFint(f.coef)
-
-		// Alignment
-		switch {
-		case escale > f.Scale():
-			fcoef.lsh(fcoef, escale-f.Scale())
-		case escale < f.Scale():
-			ecoef.lsh(ecoef, f.Scale()-escale)
-			escale = f.Scale()
-		}
-
-		// Compute e = e + f
-		if eneg == f.IsNeg() {
-			ecoef.add(ecoef, fcoef)
-		} else {
-			if fcoef.cmp(ecoef) > 0 {
-				eneg = f.IsNeg()
-			}
-			ecoef.subAbs(ecoef, fcoef)
-		}
-	}
-
-	return newFromBint(eneg, ecoef, escale, 0)
-}
-
 // SubAbs returns the (possibly rounded) absolute difference between decimals d and e.
 //
 // SubAbs returns an error if the integer part of the result has more than [MaxPrec] digits.
 func (d Decimal) SubAbs(e Decimal) (Decimal, error) {
 	f, err := d.Sub(e)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("computing [abs(%v - %v)]: %w", d, e, err)
+		return Decimal{}, errorf("computing [abs(%v - %v)]: %w", redact(d), redact(e), err)
 	}
 	return f.Abs(), nil
 }
 
+// Delta decomposes the difference d - e into its magnitude and sign,
+// where the magnitude is the (possibly rounded) absolute difference
+// and the sign is:
+//
+//	-1 if d < e
+//	 0 if d = e
+//	+1 if d > e
+//
+// Delta is useful when the direction and the size of a change are handled
+// separately, for example, when a balance adjustment is booked as a debit
+// or a credit of a positive amount.
+// See also methods [Decimal.SubAbs], [Decimal.Cmp].
+//
+// Delta returns an error if the integer part of the magnitude has more than
+// [MaxPrec] digits.
+func (d Decimal) Delta(e Decimal) (magnitude Decimal, sign int, err error) {
+	f, err := d.Sub(e)
+	if err != nil {
+		return Decimal{}, 0, errorf("computing [delta(%v, %v)]: %w", redact(d), redact(e), err)
+	}
+	return f.Abs(), d.Cmp(e), nil
+}
+
 // Sub returns the (possibly rounded) difference between decimals d and e.
 //
 // Sub returns an error if the integer part of the result has more than [MaxPrec] digits.
@@ -1936,6 +3021,15 @@ func (d Decimal) Sub(e Decimal) (Decimal, error) {
 	return d.AddExact(e.Neg(), 0)
 }
 
+// SubInt64 returns the (possibly rounded) difference between decimal d and integer v.
+// It is equivalent to [Decimal.Sub] with the integer converted to a decimal,
+// but does not require constructing the decimal and checking the error.
+//
+// SubInt64 returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) SubInt64(v int64) (Decimal, error) {
+	return d.Sub(newFromInt64(v))
+}
+
 // SubExact is similar to [Decimal.Sub], but it allows you to specify the number of digits
 // after the decimal point that should be considered significant.
 // If any of the significant digits are lost during rounding, the method will return an error.
@@ -1952,6 +3046,15 @@ func (d Decimal) Add(e Decimal) (Decimal, error) {
 	return d.AddExact(e, 0)
 }
 
+// AddInt64 returns the (possibly rounded) sum of decimal d and integer v.
+// It is equivalent to [Decimal.Add] with the integer converted to a decimal,
+// but does not require constructing the decimal and checking the error.
+//
+// AddInt64 returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) AddInt64(v int64) (Decimal, error) {
+	return d.Add(newFromInt64(v))
+}
+
 // AddExact is similar to [Decimal.Add], but it allows you to specify the number of digits
 // after the decimal point that should be considered significant.
 // If any of the significant digits are lost during rounding, the method will return an error.
@@ -1959,7 +3062,7 @@ func (d Decimal) Add(e Decimal) (Decimal, error) {
 // equal to or greater than the currency's scale.
 func (d Decimal) AddExact(e Decimal, scale int) (Decimal, error) {
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("computing [%v + %v]: %w", d, e, errScaleRange)
+		return Decimal{}, errorf("computing [%v + %v]: %w", redact(d), redact(e), scaleRangeError(scale))
 	}
 
 	// General case
@@ -1967,7 +3070,7 @@ func (d Decimal) AddExact(e Decimal, scale int) (Decimal, error) {
 	if err != nil {
 		f, err = d.addBint(e, scale)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v + %v]: %w", d, e, err)
+			return Decimal{}, errorf("computing [%v + %v]: %w", redact(d), redact(e), err)
 		}
 	}
 
@@ -2014,40 +3117,6 @@ func (d Decimal) addFint(e Decimal, minScale int) (Decimal, error) {
 	return newFromFint(dneg, dcoef, dscale, minScale)
 }
 
-// addBint computes the sum of two decimals using *big.Int arithmetic.
-func (d Decimal) addBint(e Decimal, minScale int) (Decimal, error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
-	dscale := d.Scale()
-	dneg := d.IsNeg()
-
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setFint(e.coef)
-
-	// Alignment
-	switch {
-	case dscale > e.Scale():
-		ecoef.lsh(ecoef, dscale-e.Scale())
-	case dscale < e.Scale():
-		dcoef.lsh(dcoef, e.Scale()-dscale)
-		dscale = e.Scale()
-	}
-
-	// Compute d = d + e
-	if dneg == e.IsNeg() {
-		dcoef.add(dcoef, ecoef)
-	} else {
-		if ecoef.cmp(dcoef) > 0 {
-			dneg = e.IsNeg()
-		}
-		dcoef.subAbs(dcoef, ecoef)
-	}
-
-	return newFromBint(dneg, dcoef, dscale, minScale)
-}
-
 // Deprecated: use [Decimal.AddMul] instead.
 // Pay attention to the order of arguments, [Decimal.FMA] computes d * e + f,
 // whereas [Decimal.AddMul] computes d + e * f.
@@ -2104,7 +3173,7 @@ func (d Decimal) AddMul(e, f Decimal) (Decimal, error) {
 // equal to or greater than the currency's scale.
 func (d Decimal) AddMulExact(e, f Decimal, scale int) (Decimal, error) {
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("computing [%v + %v * %v]: %w", d, e, f, errScaleRange)
+		return Decimal{}, errorf("computing [%v + %v * %v]: %w", redact(d), redact(e), redact(f), scaleRangeError(scale))
 	}
 
 	// General case
@@ -2112,7 +3181,7 @@ func (d Decimal) AddMulExact(e, f Decimal, scale int) (Decimal, error) {
 	if err != nil {
 		g, err = d.addMulBint(e, f, scale)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v + %v * %v]: %w", d, e, f, err)
+			return Decimal{}, errorf("computing [%v + %v * %v]: %w", redact(d), redact(e), redact(f), err)
 		}
 	}
 
@@ -2171,51 +3240,6 @@ func (d Decimal) addMulFint(e, f Decimal, minScale int) (Decimal, error) {
 	return newFromFint(dneg, dcoef, dscale, minScale)
 }
 
-// addMulBint computes the fused multiply-addition of three decimals using *big.Int arithmetic.
-func (d Decimal) addMulBint(e, f Decimal, minScale int) (Decimal, error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
-	dscale := d.Scale()
-	dneg := d.IsNeg()
-
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setFint(e.coef)
-	escale := e.Scale()
-	eneg := e.IsNeg()
-
-	fcoef := getBint()
-	defer putBint(fcoef)
-	fcoef.setFint(f.coef)
-
-	// Compute e = e * f
-	ecoef.mul(ecoef, fcoef)
-	escale = escale + f.Scale()
-	eneg = eneg != f.IsNeg()
-
-	// Alignment
-	switch {
-	case dscale > escale:
-		ecoef.lsh(ecoef, dscale-escale)
-	case dscale < escale:
-		dcoef.lsh(dcoef, escale-d.Scale())
-		dscale = escale
-	}
-
-	// Compute d = d + e
-	if dneg == eneg {
-		dcoef.add(dcoef, ecoef)
-	} else {
-		if ecoef.cmp(dcoef) > 0 {
-			dneg = eneg
-		}
-		dcoef.subAbs(dcoef, ecoef)
-	}
-
-	return newFromBint(dneg, dcoef, dscale, minScale)
-}
-
 // SubQuo returns the (possibly rounded) fused quotient-subtraction of decimals d, e, and f.
 // It computes d - e / f with double precision during intermediate rounding.
 // This method is useful for improving the accuracy and performance of algorithms
@@ -2256,12 +3280,12 @@ func (d Decimal) AddQuo(e, f Decimal) (Decimal, error) {
 // equal to or greater than the currency's scale.
 func (d Decimal) AddQuoExact(e, f Decimal, scale int) (Decimal, error) {
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("computing [%v + %v / %v]: %w", d, e, f, errScaleRange)
+		return Decimal{}, errorf("computing [%v + %v / %v]: %w", redact(d), redact(e), redact(f), scaleRangeError(scale))
 	}
 
 	// Special case: zero divisor
 	if f.IsZero() {
-		return Decimal{}, fmt.Errorf("computing [%v + %v / %v]: %w", d, e, f, errDivisionByZero)
+		return Decimal{}, errorf("computing [%v + %v / %v]: %w", redact(d), redact(e), redact(f), errDivisionByZero)
 	}
 
 	// Special case: zero dividend
@@ -2275,7 +3299,7 @@ func (d Decimal) AddQuoExact(e, f Decimal, scale int) (Decimal, error) {
 	if err != nil {
 		g, err = d.addQuoBint(e, f, scale)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v + %v / %v]: %w", d, e, f, err)
+			return Decimal{}, errorf("computing [%v + %v / %v]: %w", redact(d), redact(e), redact(f), err)
 		}
 	}
 
@@ -2355,45 +3379,6 @@ func (d Decimal) addQuoFint(e, f Decimal, minScale int) (Decimal, error) {
 	return newFromFint(dneg, dcoef, dscale, minScale)
 }
 
-// addQuoBint computes the fused quotient-addition of three decimals using *big.Int arithmetic.
-func (d Decimal) addQuoBint(e, f Decimal, minScale int) (Decimal, error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
-	dneg := d.IsNeg()
-
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setFint(e.coef)
-	eneg := e.IsNeg()
-
-	fcoef := getBint()
-	defer putBint(fcoef)
-	fcoef.setFint(f.coef)
-
-	// Alignment
-	ecoef.lsh(ecoef, 2*MaxScale-e.Scale()+f.Scale())
-
-	// Compute e = ⌊e / f⌋
-	ecoef.quo(ecoef, fcoef)
-	eneg = eneg != f.IsNeg()
-
-	// Alignment
-	dcoef.lsh(dcoef, 2*MaxScale-d.Scale())
-
-	// Compute d = d + e
-	if dneg == eneg {
-		dcoef.add(dcoef, ecoef)
-	} else {
-		if ecoef.cmp(dcoef) > 0 {
-			dneg = eneg
-		}
-		dcoef.subAbs(dcoef, ecoef)
-	}
-
-	return newFromBint(dneg, dcoef, 2*MaxScale, minScale)
-}
-
 // Inv returns the (possibly rounded) inverse of the decimal.
 //
 // Inv returns an error if:
@@ -2402,7 +3387,7 @@ func (d Decimal) addQuoBint(e, f Decimal, minScale int) (Decimal, error) {
 func (d Decimal) Inv() (Decimal, error) {
 	f, err := One.Quo(d)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("inverting %v: %w", d, err)
+		return Decimal{}, errorf("inverting %v: %w", redact(d), err)
 	}
 	return f, nil
 }
@@ -2416,6 +3401,17 @@ func (d Decimal) Quo(e Decimal) (Decimal, error) {
 	return d.QuoExact(e, 0)
 }
 
+// QuoInt64 returns the (possibly rounded) quotient of decimal d and integer v.
+// It is equivalent to [Decimal.Quo] with the integer converted to a decimal,
+// but does not require constructing the decimal and checking the error.
+//
+// QuoInt64 returns an error if:
+//   - the divisor is 0;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) QuoInt64(v int64) (Decimal, error) {
+	return d.Quo(newFromInt64(v))
+}
+
 // QuoExact is similar to [Decimal.Quo], but it allows you to specify the number of digits
 // after the decimal point that should be considered significant.
 // If any of the significant digits are lost during rounding, the method will return an error.
@@ -2423,12 +3419,12 @@ func (d Decimal) Quo(e Decimal) (Decimal, error) {
 // equal to or greater than the currency's scale.
 func (d Decimal) QuoExact(e Decimal, scale int) (Decimal, error) {
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("computing [%v / %v]: %w", d, e, errScaleRange)
+		return Decimal{}, errorf("computing [%v / %v]: %w", redact(d), redact(e), scaleRangeError(scale))
 	}
 
 	// Special case: zero divisor
 	if e.IsZero() {
-		return Decimal{}, fmt.Errorf("computing [%v / %v]: %w", d, e, errDivisionByZero)
+		return Decimal{}, errorf("computing [%v / %v]: %w", redact(d), redact(e), errDivisionByZero)
 	}
 
 	// Special case: zero dividend
@@ -2442,7 +3438,7 @@ func (d Decimal) QuoExact(e Decimal, scale int) (Decimal, error) {
 	if err != nil {
 		f, err = d.quoBint(e, scale)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v / %v]: %w", d, e, err)
+			return Decimal{}, errorf("computing [%v / %v]: %w", redact(d), redact(e), err)
 		}
 	}
 
@@ -2486,27 +3482,6 @@ func (d Decimal) quoFint(e Decimal, minScale int) (Decimal, error) {
 	return newFromFint(dneg, dcoef, dscale, minScale)
 }
 
-// quoBint computes the quotient of two decimals using *big.Int arithmetic.
-func (d Decimal) quoBint(e Decimal, minScale int) (Decimal, error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
-	dneg := d.IsNeg()
-
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setFint(e.coef)
-
-	// Alignment
-	dcoef.lsh(dcoef, 2*MaxScale+e.Scale()-d.Scale())
-
-	// Compute d = ⌊d / e⌋
-	dcoef.quo(dcoef, ecoef)
-	dneg = dneg != e.IsNeg()
-
-	return newFromBint(dneg, dcoef, 2*MaxScale, minScale)
-}
-
 // QuoRem returns the quotient q and remainder r of decimals d and e
 // such that d = e * q + r, where q is an integer and the sign of the
 // reminder r is the same as the sign of the dividend d.
@@ -2517,7 +3492,7 @@ func (d Decimal) quoBint(e Decimal, minScale int) (Decimal, error) {
 func (d Decimal) QuoRem(e Decimal) (q, r Decimal, err error) {
 	// Special case: zero divisor
 	if e.IsZero() {
-		return Decimal{}, Decimal{}, fmt.Errorf("computing [%v div %v] and [%v mod %v]: %w", d, e, d, e, errDivisionByZero)
+		return Decimal{}, Decimal{}, errorf("computing [%v div %v] and [%v mod %v]: %w", redact(d), redact(e), redact(d), redact(e), errDivisionByZero)
 	}
 
 	// General case
@@ -2525,15 +3500,108 @@ func (d Decimal) QuoRem(e Decimal) (q, r Decimal, err error) {
 	if err != nil {
 		q, r, err = d.quoRemBint(e)
 		if err != nil {
-			return Decimal{}, Decimal{}, fmt.Errorf("computing [%v div %v] and [%v mod %v]: %w", d, e, d, e, err)
+			return Decimal{}, Decimal{}, errorf("computing [%v div %v] and [%v mod %v]: %w", redact(d), redact(e), redact(d), redact(e), err)
 		}
 	}
 
 	return q, r, nil
 }
 
-// quoRemFint computes the quotient and remainder of two decimals using uint64 arithmetic.
-func (d Decimal) quoRemFint(e Decimal) (q, r Decimal, err error) {
+// modKind specifies how the implicit quotient is rounded when computing
+// a remainder.
+type modKind int
+
+const (
+	modTrunc   modKind = iota // quotient is rounded towards zero, see [Decimal.Mod]
+	modEuclid                 // remainder is non-negative, see [Decimal.ModEuclid]
+	modNearest                // quotient is rounded to nearest, see [Decimal.Rem]
+)
+
+// Mod returns the remainder r of decimals d and e such that d = e * q + r,
+// where q is an integer rounded towards zero and the sign of the remainder r
+// is the same as the sign of the dividend d, similar to [math.Mod] and
+// the % operator.
+// The remainder is the same as the one returned by [Decimal.QuoRem], but
+// Mod does not fail if the quotient has more than [MaxPrec] digits.
+// The scale of the remainder is the larger of the scales of d and e,
+// so the remainder is always exact.
+// See also methods [Decimal.ModEuclid] and [Decimal.Rem].
+//
+// Mod returns an error if the divisor is 0.
+func (d Decimal) Mod(e Decimal) (Decimal, error) {
+	// Special case: zero divisor
+	if e.IsZero() {
+		return Decimal{}, errorf("computing [%v mod %v]: %w", redact(d), redact(e), errDivisionByZero)
+	}
+
+	// General case
+	f, err := d.modFint(e, modTrunc)
+	if err != nil {
+		f, err = d.modBint(e, modTrunc)
+		if err != nil {
+			return Decimal{}, errorf("computing [%v mod %v]: %w", redact(d), redact(e), err)
+		}
+	}
+
+	return f, nil
+}
+
+// ModEuclid returns the Euclidean remainder r of decimals d and e such that
+// d = e * q + r, where q is an integer and 0 <= r < |e|.
+// Unlike [Decimal.Mod], the remainder is never negative, which is useful for
+// bucketing, for example, -1 mod 7 is 6 instead of -1.
+// The scale of the remainder is the larger of the scales of d and e.
+//
+// ModEuclid returns an error if:
+//   - the divisor is 0;
+//   - the remainder has more than [MaxPrec] digits, which is only possible
+//     for a negative dividend, for example, -0.0000000000000000001 mod 9999999999999999999.
+func (d Decimal) ModEuclid(e Decimal) (Decimal, error) {
+	// Special case: zero divisor
+	if e.IsZero() {
+		return Decimal{}, errorf("computing [modeuclid(%v, %v)]: %w", redact(d), redact(e), errDivisionByZero)
+	}
+
+	// General case
+	f, err := d.modFint(e, modEuclid)
+	if err != nil {
+		f, err = d.modBint(e, modEuclid)
+		if err != nil {
+			return Decimal{}, errorf("computing [modeuclid(%v, %v)]: %w", redact(d), redact(e), err)
+		}
+	}
+
+	return f, nil
+}
+
+// Rem returns the IEEE 754 remainder r of decimals d and e such that
+// d = e * q + r, where q is the integer nearest to d / e, with ties
+// rounded to even, similar to [math.Remainder].
+// The remainder satisfies |r| <= |e| / 2, for example, 7 rem 4 is -1.
+// The scale of the remainder is the larger of the scales of d and e,
+// so the remainder is always exact.
+//
+// Rem returns an error if the divisor is 0.
+func (d Decimal) Rem(e Decimal) (Decimal, error) {
+	// Special case: zero divisor
+	if e.IsZero() {
+		return Decimal{}, errorf("computing [rem(%v, %v)]: %w", redact(d), redact(e), errDivisionByZero)
+	}
+
+	// General case
+	f, err := d.modFint(e, modNearest)
+	if err != nil {
+		f, err = d.modBint(e, modNearest)
+		if err != nil {
+			return Decimal{}, errorf("computing [rem(%v, %v)]: %w", redact(d), redact(e), err)
+		}
+	}
+
+	return f, nil
+}
+
+// modFint computes the remainder of two decimals using uint64 arithmetic.
+func (d Decimal) modFint(e Decimal, kind modKind) (Decimal, error) {
 	dcoef := d.coef
 	ecoef := e.coef
 	rscale := d.Scale()
@@ -2544,71 +3612,96 @@ func (d Decimal) quoRemFint(e Decimal) (q, r Decimal, err error) {
 	case d.Scale() > e.Scale():
 		ecoef, ok = ecoef.lsh(d.Scale() - e.Scale())
 		if !ok {
-			return Decimal{}, Decimal{}, errDecimalOverflow
+			return Decimal{}, errDecimalOverflow
 		}
 	case d.Scale() < e.Scale():
 		dcoef, ok = dcoef.lsh(e.Scale() - d.Scale())
 		if !ok {
-			return Decimal{}, Decimal{}, errDecimalOverflow
+			return Decimal{}, errDecimalOverflow
 		}
 		rscale = e.Scale()
 	}
 
-	// Compute q = ⌊d / e⌋, r = d - e * q
-	qcoef, rcoef, ok := dcoef.quoRem(ecoef)
-	if !ok {
-		return Decimal{}, Decimal{}, errDivisionByZero // Should never happen
+	// Compute r = |d| - |e| * ⌊|d| / |e|⌋
+	var odd bool
+	var rcoef fint
+	if kind == modNearest {
+		// The parity of the quotient is needed to break ties,
+		// so |d| is divided by 2 * |e| first.
+		fcoef, ok := ecoef.mul(2)
+		if !ok {
+			return Decimal{}, errDecimalOverflow
+		}
+		_, rcoef, ok = dcoef.quoRem(fcoef)
+		if !ok {
+			return Decimal{}, errDivisionByZero // Should never happen
+		}
+		if rcoef >= ecoef {
+			rcoef = rcoef - ecoef
+			odd = true
+		}
+	} else {
+		_, rcoef, ok = dcoef.quoRem(ecoef)
+		if !ok {
+			return Decimal{}, errDivisionByZero // Should never happen
+		}
 	}
-	qsign := d.IsNeg() != e.IsNeg()
 	rsign := d.IsNeg()
 
-	q, err = newFromFint(qsign, qcoef, 0, 0)
-	if err != nil {
-		return Decimal{}, Decimal{}, err
-	}
-	r, err = newFromFint(rsign, rcoef, rscale, rscale)
-	if err != nil {
-		return Decimal{}, Decimal{}, err
-	}
-	return q, r, nil
-}
-
-// quoRemBint computes the quotient and remainder of two decimals using *big.Int arithmetic.
-func (d Decimal) quoRemBint(e Decimal) (q, r Decimal, err error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
-
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setFint(e.coef)
+	// Adjustment
+	switch kind {
+	case modEuclid:
+		if rsign && rcoef != 0 {
+			rcoef = ecoef - rcoef
+		}
+		rsign = false
+	case modNearest:
+		// Compare 2 * r with |e| without overflow
+		switch c := ecoef - rcoef; {
+		case rcoef > c, rcoef == c && odd:
+			rcoef = c
+			rsign = !rsign
+		}
+	}
 
-	qcoef := getBint()
-	defer putBint(qcoef)
+	return newFromFint(rsign, rcoef, rscale, rscale)
+}
 
-	rcoef := getBint()
-	defer putBint(rcoef)
+// quoRemFint computes the quotient and remainder of two decimals using uint64 arithmetic.
+func (d Decimal) quoRemFint(e Decimal) (q, r Decimal, err error) {
+	dcoef := d.coef
+	ecoef := e.coef
 	rscale := d.Scale()
 
 	// Alignment
+	var ok bool
 	switch {
 	case d.Scale() > e.Scale():
-		ecoef.lsh(ecoef, d.Scale()-e.Scale())
+		ecoef, ok = ecoef.lsh(d.Scale() - e.Scale())
+		if !ok {
+			return Decimal{}, Decimal{}, errDecimalOverflow
+		}
 	case d.Scale() < e.Scale():
-		dcoef.lsh(dcoef, e.Scale()-d.Scale())
+		dcoef, ok = dcoef.lsh(e.Scale() - d.Scale())
+		if !ok {
+			return Decimal{}, Decimal{}, errDecimalOverflow
+		}
 		rscale = e.Scale()
 	}
 
 	// Compute q = ⌊d / e⌋, r = d - e * q
-	qcoef.quoRem(dcoef, ecoef, rcoef)
+	qcoef, rcoef, ok := dcoef.quoRem(ecoef)
+	if !ok {
+		return Decimal{}, Decimal{}, errDivisionByZero // Should never happen
+	}
 	qsign := d.IsNeg() != e.IsNeg()
 	rsign := d.IsNeg()
 
-	q, err = newFromBint(qsign, qcoef, 0, 0)
+	q, err = newFromFint(qsign, qcoef, 0, 0)
 	if err != nil {
 		return Decimal{}, Decimal{}, err
 	}
-	r, err = newFromBint(rsign, rcoef, rscale, rscale)
+	r, err = newFromFint(rsign, rcoef, rscale, rscale)
 	if err != nil {
 		return Decimal{}, Decimal{}, err
 	}
@@ -2646,7 +3739,7 @@ func (d Decimal) Min(e Decimal) Decimal {
 //nolint:revive
 func (d Decimal) Clamp(min, max Decimal) (Decimal, error) {
 	if min.Cmp(max) > 0 {
-		return Decimal{}, fmt.Errorf("clamping %v: invalid range", d)
+		return Decimal{}, errorf("clamping %v: invalid range", redact(d))
 	}
 	if min.CmpTotal(max) > 0 {
 		// min and max are equal numerically but have different scales.
@@ -2662,6 +3755,79 @@ func (d Decimal) Clamp(min, max Decimal) (Decimal, error) {
 	return d, nil
 }
 
+// ClampToRange is like [Decimal.Clamp], but it never returns an error.
+// If min is greater than max numerically, they are swapped, so the range
+// is always the one between the two bounds.
+// ClampToRange is useful for defensive normalization of untrusted input,
+// where an error would abort a whole batch.
+//
+//nolint:revive
+func (d Decimal) ClampToRange(min, max Decimal) Decimal {
+	if min.Cmp(max) > 0 {
+		min, max = max, min
+	}
+	e, err := d.Clamp(min, max)
+	if err != nil {
+		return d // Should never happen
+	}
+	return e
+}
+
+// Clamp01 compares the decimal with 0 and 1 numerically and returns:
+//
+//	0 if d < 0
+//	1 if d > 1
+//	d otherwise
+//
+// Unlike [Decimal.ClampToRange], Clamp01 returns decimals numerically equal
+// to the bounds, such as 0.00 and 1.00, unchanged.
+// It is useful for normalizing ratios, such as utilization or haircuts,
+// that must not go below 0 or above 1.
+func (d Decimal) Clamp01() Decimal {
+	switch {
+	case d.IsNeg():
+		return Zero
+	case d.Cmp(One) > 0:
+		return One
+	}
+	return d
+}
+
+// SaturateAt returns a decimal with the same sign as d and an absolute value
+// not greater than the absolute value of the limit:
+//
+//	-|limit| if d < -|limit|
+//	 |limit| if d > |limit|
+//	       d otherwise
+//
+// The sign of the limit is ignored.
+// Decimals are compared numerically, so a decimal equal to the limit,
+// such as 100.00 for the limit of 100, is returned unchanged.
+// See also method [Decimal.ClampToRange].
+func (d Decimal) SaturateAt(limit Decimal) Decimal {
+	if d.CmpAbs(limit) > 0 {
+		return limit.CopySign(d)
+	}
+	return d
+}
+
+// FirstExceeding returns the index of the first threshold that the decimal
+// exceeds, or -1 if the decimal does not exceed any of them.
+// Decimals are compared numerically, so a decimal equal to a threshold,
+// such as 100.00 and 100, does not exceed it.
+// Thresholds are checked in the given order, so with thresholds sorted
+// in descending order, such as hard, soft, and warning limits,
+// FirstExceeding returns the most severe limit breached.
+// See also method [Decimal.Cmp].
+func (d Decimal) FirstExceeding(thresholds []Decimal) int {
+	for i, t := range thresholds {
+		if d.Cmp(t) > 0 {
+			return i
+		}
+	}
+	return -1
+}
+
 // CmpTotal compares decimal representations and returns:
 //
 //	-1 if d < e
@@ -2773,32 +3939,199 @@ func (d Decimal) cmpFint(e Decimal) (int, error) {
 	return 0, nil
 }
 
-// cmpBint compares decimals using *big.Int arithmetic.
-func (d Decimal) cmpBint(e Decimal) int {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
+// CmpInt64 compares a decimal with an integer and returns:
+//
+//	-1 if d < v
+//	 0 if d = v
+//	+1 if d > v
+//
+// Unlike [Decimal.Cmp], CmpInt64 does not require converting the integer
+// to a decimal and never allocates memory.
+// See also methods [Decimal.CmpUint64], [Decimal.CmpFloat64].
+func (d Decimal) CmpInt64(v int64) int {
+	if v >= 0 {
+		return d.CmpUint64(uint64(v))
+	}
+	if !d.IsNeg() {
+		return 1
+	}
+	return -d.cmpAbsUint64(uint64(-(v + 1)) + 1)
+}
 
-	ecoef := getBint()
-	defer putBint(ecoef)
-	ecoef.setFint(e.coef)
+// CmpUint64 compares a decimal with an unsigned integer and returns:
+//
+//	-1 if d < v
+//	 0 if d = v
+//	+1 if d > v
+//
+// Unlike [Decimal.Cmp], CmpUint64 does not require converting the integer
+// to a decimal and never allocates memory.
+// See also methods [Decimal.CmpInt64], [Decimal.CmpFloat64].
+func (d Decimal) CmpUint64(v uint64) int {
+	if d.IsNeg() {
+		return -1
+	}
+	return d.cmpAbsUint64(v)
+}
 
-	// Alignment
+// cmpAbsUint64 compares the absolute value of a decimal with an unsigned integer.
+func (d Decimal) cmpAbsUint64(v uint64) int {
+	y := pow10[d.Scale()]
+	q, r := d.coef/y, d.coef%y
 	switch {
-	case d.Scale() > e.Scale():
-		ecoef.lsh(ecoef, d.Scale()-e.Scale())
-	case d.Scale() < e.Scale():
-		dcoef.lsh(dcoef, e.Scale()-d.Scale())
+	case uint64(q) > v:
+		return 1
+	case uint64(q) < v:
+		return -1
+	case r != 0:
+		return 1
 	}
+	return 0
+}
 
-	// Comparison
-	switch dcoef.cmp(ecoef) {
-	case 1:
-		return d.Sign()
-	case -1:
-		return -e.Sign()
+// CmpFloat64 compares a decimal with a float and returns:
+//
+//	-1 if d < f
+//	 0 if d = f
+//	+1 if d > f
+//
+// The float is compared as if it were converted to a decimal using
+// [NewFromFloat64], so d = 0.1 is equal to f = 0.1.
+// Infinities and floats outside the range of decimals are compared
+// by their sign.
+// See also methods [Decimal.CmpInt64], [Decimal.CmpUint64].
+//
+// If the float is NaN or cannot be converted to a decimal, then false is returned.
+func (d Decimal) CmpFloat64(f float64) (int, bool) {
+	switch {
+	case math.IsNaN(f):
+		return 0, false
+	case f >= 1e19:
+		return -1, true
+	case f <= -1e19:
+		return 1, true
 	}
-	return 0
+	e, err := NewFromFloat64(f)
+	if err != nil {
+		return 0, false
+	}
+	return d.Cmp(e), true
+}
+
+// CmpStrings compares strings representing decimals numerically and returns:
+//
+//	-1 if a < b
+//	 0 if a = b
+//	+1 if a > b
+//
+// The strings are compared as if they were converted to decimals using [Parse],
+// so "1.50" is equal to "1.5" and "-0" is equal to "0".
+// Strings without an exponent that have at most 19 significant digits are
+// compared digit by digit without constructing decimals.
+// See also method [Decimal.Cmp].
+//
+// CmpStrings returns an error if any of the strings is not a valid decimal,
+// as described in [Parse].
+func CmpStrings(a, b string) (int, error) {
+	// Fast path: plain digits
+	if r, ok := cmpDigits(a, b); ok {
+		return r, nil
+	}
+
+	// General case
+	d, err := Parse(a)
+	if err != nil {
+		return 0, err
+	}
+	e, err := Parse(b)
+	if err != nil {
+		return 0, err
+	}
+	return d.Cmp(e), nil
+}
+
+// cmpDigits compares strings of the form [+-]ddd[.ddd] without parsing them.
+// If any of the strings has a different form or cannot be represented
+// by a decimal exactly, then false is returned.
+func cmpDigits(a, b string) (int, bool) {
+	aneg, aint, afrac, ok := splitDigits(a)
+	if !ok {
+		return 0, false
+	}
+	bneg, bint, bfrac, ok := splitDigits(b)
+	if !ok {
+		return 0, false
+	}
+
+	// Signs
+	asign, bsign := signDigits(aneg, aint, afrac), signDigits(bneg, bint, bfrac)
+	switch {
+	case asign > bsign:
+		return 1, true
+	case asign < bsign:
+		return -1, true
+	case asign == 0:
+		return 0, true
+	}
+
+	// Absolute values
+	r := len(aint) - len(bint)
+	if r == 0 {
+		r = strings.Compare(aint, bint)
+	}
+	if r == 0 {
+		r = strings.Compare(afrac, bfrac)
+	}
+	switch {
+	case r > 0:
+		return asign, true
+	case r < 0:
+		return -asign, true
+	}
+	return 0, true
+}
+
+// signDigits returns the sign of a decimal split by [splitDigits].
+func signDigits(neg bool, intg, frac string) int {
+	switch {
+	case intg == "" && frac == "":
+		return 0
+	case neg:
+		return -1
+	}
+	return 1
+}
+
+// splitDigits splits a string of the form [+-]ddd[.ddd] into the sign,
+// the integer part without leading zeros, and the fractional part
+// without trailing zeros.
+// If the string has a different form or has more than [MaxPrec]
+// significant digits, then false is returned.
+func splitDigits(s string) (neg bool, intg, frac string, ok bool) {
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	intg, frac, _ = strings.Cut(s, ".")
+	if intg == "" && frac == "" {
+		return false, "", "", false
+	}
+	for i := 0; i < len(intg); i++ {
+		if intg[i] < '0' || intg[i] > '9' {
+			return false, "", "", false
+		}
+	}
+	for i := 0; i < len(frac); i++ {
+		if frac[i] < '0' || frac[i] > '9' {
+			return false, "", "", false
+		}
+	}
+	intg = strings.TrimLeft(intg, "0")
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) > MaxScale || len(intg)+len(frac) > MaxPrec {
+		return false, "", "", false
+	}
+	return neg, intg, frac, true
 }
 
 // NullDecimal represents a decimal that can be null.
@@ -2810,16 +4143,22 @@ type NullDecimal struct {
 }
 
 // Scan implements the [sql.Scanner] interface.
+// It accepts the same types as [Decimal.Scan].
 // See also constructor [Parse].
 //
 // [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
 func (n *NullDecimal) Scan(value any) error {
+	return n.scan(value, ParseLimits{})
+}
+
+// scan is like [NullDecimal.Scan], but it parses strings with the given limits.
+func (n *NullDecimal) scan(value any, l ParseLimits) error {
 	if value == nil {
 		n.Decimal = Decimal{}
 		n.Valid = false
 		return nil
 	}
-	err := n.Decimal.Scan(value)
+	err := n.Decimal.scan(value, l)
 	if err != nil {
 		n.Decimal = Decimal{}
 		n.Valid = false
@@ -2839,3 +4178,281 @@ func (n NullDecimal) Value() (driver.Value, error) {
 	}
 	return n.Decimal.Value()
 }
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// The first byte is the null flag: 0 means null and 1 means valid.
+// A valid decimal is followed by its binary representation,
+// as described in [Decimal.UnmarshalBinary].
+//
+// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (n *NullDecimal) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errorf("%w: no null flag", errInvalidDecimal)
+	}
+	switch data[0] {
+	case 0:
+		if len(data) > 1 {
+			return errorf("%w: null with payload", errInvalidDecimal)
+		}
+		n.Decimal = Decimal{}
+		n.Valid = false
+		return nil
+	case 1:
+		d, err := parseBCD(data[1:])
+		if err != nil {
+			return err
+		}
+		n.Decimal = d
+		n.Valid = true
+		return nil
+	default:
+		return errorf("%w: invalid null flag \"%x\"", errInvalidDecimal, data[0])
+	}
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is a null flag followed by the binary representation
+// of the decimal, see [NullDecimal.UnmarshalBinary] for details.
+//
+// [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
+func (n NullDecimal) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return []byte{0}, nil
+	}
+	return append([]byte{1}, n.Decimal.bcd()...), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// JSON null is converted to null, and JSON strings are parsed
+// as described in [Parse].
+// Unquoted JSON numbers are rejected, as in [Decimal.UnmarshalText].
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (n *NullDecimal) UnmarshalJSON(data []byte) error {
+	return n.unmarshalJSON(data, ParseLimits{})
+}
+
+// unmarshalJSON is like [NullDecimal.UnmarshalJSON], but it parses strings
+// with the given limits.
+func (n *NullDecimal) unmarshalJSON(data []byte, l ParseLimits) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		n.Decimal = Decimal{}
+		n.Valid = false
+		return nil
+	}
+	d, err := UnmarshalJSONWith(data, WithLimits(l))
+	if err != nil {
+		return err
+	}
+	n.Decimal = d
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// Null is encoded as JSON null, and valid decimals are encoded as JSON
+// strings, such as "1.23".
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (n NullDecimal) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return MarshalJSONWith(n.Decimal)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// Empty text is converted to null, and any other text is parsed
+// as described in [Parse].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (n *NullDecimal) UnmarshalText(text []byte) error {
+	return n.unmarshalText(text, ParseLimits{})
+}
+
+// unmarshalText is like [NullDecimal.UnmarshalText], but it parses text
+// with the given limits.
+func (n *NullDecimal) unmarshalText(text []byte, l ParseLimits) error {
+	if len(text) == 0 {
+		n.Decimal = Decimal{}
+		n.Valid = false
+		return nil
+	}
+	d, err := l.Parse(string(text))
+	if err != nil {
+		n.Decimal = Decimal{}
+		n.Valid = false
+		return err
+	}
+	n.Decimal = d
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// Null is encoded as empty text.
+// See also method [Decimal.MarshalText].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (n NullDecimal) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Decimal.MarshalText()
+}
+
+// UnmarshalBSONValue implements the [v2/bson.ValueUnmarshaler] interface.
+// BSON Null is converted to null, and other types are converted
+// as described in [Decimal.UnmarshalBSONValue].
+//
+// [v2/bson.ValueUnmarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueUnmarshaler
+func (n *NullDecimal) UnmarshalBSONValue(typ byte, data []byte) error {
+	if typ == bsonNull {
+		n.Decimal = Decimal{}
+		n.Valid = false
+		return nil
+	}
+	var d Decimal
+	if err := d.UnmarshalBSONValue(typ, data); err != nil {
+		n.Decimal = Decimal{}
+		n.Valid = false
+		return err
+	}
+	n.Decimal = d
+	n.Valid = true
+	return nil
+}
+
+// MarshalBSONValue implements the [v2/bson.ValueMarshaler] interface.
+// Null is encoded as BSON Null, and valid decimals are encoded
+// as described in [Decimal.MarshalBSONValue].
+//
+// [v2/bson.ValueMarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueMarshaler
+func (n NullDecimal) MarshalBSONValue() (typ byte, data []byte, err error) {
+	if !n.Valid {
+		return bsonNull, nil, nil
+	}
+	return n.Decimal.MarshalBSONValue()
+}
+
+// NewNullFromPtr converts a pointer to a decimal into a null decimal.
+// A nil pointer is converted into null.
+// This is useful with ORMs, such as GORM or ent, that represent
+// nullable columns as pointer fields.
+// See also method [NullDecimal.Ptr].
+func NewNullFromPtr(d *Decimal) NullDecimal {
+	if d == nil {
+		return NullDecimal{}
+	}
+	return NullDecimal{Decimal: *d, Valid: true}
+}
+
+// Ptr returns a pointer to a copy of the decimal, or nil if n is null.
+// See also constructor [NewNullFromPtr].
+func (n NullDecimal) Ptr() *Decimal {
+	if !n.Valid {
+		return nil
+	}
+	d := n.Decimal
+	return &d
+}
+
+// IsZero returns true if n is null.
+// Reflection-based mappers use this method to detect unset fields.
+// Note that a valid decimal equal to 0 is not considered a zero value.
+func (n NullDecimal) IsZero() bool {
+	return !n.Valid
+}
+
+// Add returns the (possibly rounded) sum of decimals n and m.
+// If either n or m is null, the result is null, as in SQL.
+// See also method [Decimal.Add].
+//
+// Add returns an error if the integer part of the result has more than
+// [MaxPrec] digits.
+func (n NullDecimal) Add(m NullDecimal) (NullDecimal, error) {
+	return n.apply(m, Decimal.Add)
+}
+
+// Sub returns the (possibly rounded) difference between decimals n and m.
+// If either n or m is null, the result is null, as in SQL.
+// See also method [Decimal.Sub].
+//
+// Sub returns an error if the integer part of the result has more than
+// [MaxPrec] digits.
+func (n NullDecimal) Sub(m NullDecimal) (NullDecimal, error) {
+	return n.apply(m, Decimal.Sub)
+}
+
+// Mul returns the (possibly rounded) product of decimals n and m.
+// If either n or m is null, the result is null, as in SQL.
+// See also method [Decimal.Mul].
+//
+// Mul returns an error if the integer part of the result has more than
+// [MaxPrec] digits.
+func (n NullDecimal) Mul(m NullDecimal) (NullDecimal, error) {
+	return n.apply(m, Decimal.Mul)
+}
+
+// Quo returns the (possibly rounded) quotient of decimals n and m.
+// If either n or m is null, the result is null, as in SQL.
+// Null propagation takes precedence over division by zero,
+// so dividing null by zero returns null without an error.
+// See also method [Decimal.Quo].
+//
+// Quo returns an error if:
+//   - the divisor is 0;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (n NullDecimal) Quo(m NullDecimal) (NullDecimal, error) {
+	return n.apply(m, Decimal.Quo)
+}
+
+// Coalesce returns the first non-null decimal, or [Zero] if all decimals
+// are null, that is, the same value as COALESCE(n1, n2, ..., 0) in SQL.
+// See also function [SumNull].
+func Coalesce(ns ...NullDecimal) Decimal {
+	for _, n := range ns {
+		if n.Valid {
+			return n.Decimal
+		}
+	}
+	return Zero
+}
+
+// SumNull returns the (possibly rounded) sum of non-null decimals without any
+// intermediate rounding, like the SUM aggregate function in SQL.
+// Null decimals are skipped.
+// If no arguments are provided or all of them are null, the result is null.
+// See also functions [Sum], [Coalesce].
+//
+// SumNull returns an error if the integer part of the result has more than
+// [MaxPrec] digits.
+func SumNull(ns ...NullDecimal) (NullDecimal, error) {
+	d := make([]Decimal, 0, len(ns))
+	for _, n := range ns {
+		if n.Valid {
+			d = append(d, n.Decimal)
+		}
+	}
+	if len(d) == 0 {
+		return NullDecimal{}, nil
+	}
+	e, err := Sum(d...)
+	if err != nil {
+		return NullDecimal{}, err
+	}
+	return NullDecimal{Decimal: e, Valid: true}, nil
+}
+
+// apply applies the binary operation f to the decimals n and m,
+// propagating nulls.
+func (n NullDecimal) apply(m NullDecimal, f func(d, e Decimal) (Decimal, error)) (NullDecimal, error) {
+	if !n.Valid || !m.Valid {
+		return NullDecimal{}, nil
+	}
+	d, err := f(n.Decimal, m.Decimal)
+	if err != nil {
+		return NullDecimal{}, err
+	}
+	return NullDecimal{Decimal: d, Valid: true}, nil
+}
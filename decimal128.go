@@ -0,0 +1,991 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxPrec128  = 34 // MaxPrec128 is a maximum length of the coefficient of a Decimal128 in decimal digits.
+	MaxScale128 = 34 // MaxScale128 is a maximum number of digits after the decimal point of a Decimal128.
+)
+
+// Decimal128 is a wide variant of [Decimal] with a 128-bit coefficient,
+// which holds up to 34 digits, as in the IEEE 754 decimal128 format.
+// It is intended for values that overflow [Decimal], such as crypto market
+// caps, order book totals, or values exchanged with databases that store
+// NUMERIC(38) columns.
+// The scale of Decimal128 ranges from 0 to [MaxScale128].
+//
+// Decimal128 has the same semantics as [Decimal]: arithmetic methods
+// return exact results when possible and round them half to even to
+// [MaxPrec128] digits otherwise, and fail only if the integer part of
+// the result has more than [MaxPrec128] digits.
+// Arithmetic is performed using [big.Int], so Decimal128 is several times
+// slower than [Decimal] and is not available when the package is built
+// with the decimalnobig tag.
+// Use [Decimal.Decimal128] and [Decimal128.Decimal] to convert between
+// the two types.
+//
+// The zero value is 0.
+// Decimal128 is designed to be safe for concurrent use by multiple goroutines.
+//
+// [big.Int]: https://pkg.go.dev/math/big#Int
+type Decimal128 struct {
+	neg   bool   // indicates whether the decimal is negative
+	scale int8   // position of the floating decimal point
+	hi    uint64 // high 64 bits of the coefficient
+	lo    uint64 // low 64 bits of the coefficient
+}
+
+// newDecimal128FromBint creates a new decimal from a non-negative *big.Int
+// coefficient, rounding it to [MaxPrec128] digits if necessary.
+// The coefficient is modified.
+func newDecimal128FromBint(neg bool, coef *bint, scale, minScale int) (Decimal128, error) {
+	// Overflow validation
+	prec := coef.prec()
+	if prec-scale > MaxPrec128-minScale {
+		return Decimal128{}, overflowError128(prec, scale, minScale)
+	}
+	// Scale normalization
+	switch {
+	case scale < minScale:
+		coef.lsh(coef, minScale-scale)
+		scale = minScale
+	case scale >= prec && scale > MaxScale128: // no integer part
+		coef.rshHalfEven(coef, scale-MaxScale128)
+		scale = MaxScale128
+	case prec > scale && prec > MaxPrec128: // there is an integer part
+		coef.rshHalfEven(coef, prec-MaxPrec128)
+		scale = MaxPrec128 - prec + scale
+	}
+	// Handling the rare case when rshHalfEven rounded
+	// a 34-digit coefficient to a 35-digit coefficient.
+	if coef.hasPrec(MaxPrec128 + 1) {
+		return newDecimal128FromBint(neg, coef, scale, minScale)
+	}
+	var buf [16]byte
+	(*big.Int)(coef).FillBytes(buf[:])
+	d := Decimal128{
+		neg:   neg,
+		scale: int8(scale), //nolint:gosec
+		hi:    binary.BigEndian.Uint64(buf[:8]),
+		lo:    binary.BigEndian.Uint64(buf[8:]),
+	}
+	if d.IsZero() {
+		d.neg = false
+	}
+	return d, nil
+}
+
+func overflowError128(gotPrec, gotScale, wantScale int) error {
+	maxDigits := MaxPrec128 - wantScale
+	gotDigits := gotPrec - gotScale
+	return errorf("%w: the integer part of a %T can have at most %v digits, but it has %v digits", errDecimalOverflow, Decimal128{}, maxDigits, gotDigits)
+}
+
+// setDecimal128 sets z to the coefficient of the decimal.
+func (z *bint) setDecimal128(d Decimal128) {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], d.hi)
+	binary.BigEndian.PutUint64(buf[8:], d.lo)
+	(*big.Int)(z).SetBytes(buf[:])
+}
+
+// newDecimal128FromUint64 converts an unsigned integer to a decimal.
+// The conversion is always exact, since the coefficient of a [Decimal128]
+// is wider than uint64.
+func newDecimal128FromUint64(v uint64) Decimal128 {
+	return Decimal128{lo: v}
+}
+
+// Decimal128 converts the decimal to a [Decimal128].
+// The conversion is always exact.
+func (d Decimal) Decimal128() Decimal128 {
+	return Decimal128{neg: d.IsNeg(), scale: int8(d.Scale()), lo: uint64(d.coef)} //nolint:gosec
+}
+
+// Decimal converts the decimal to a [Decimal].
+// If the coefficient has more than [MaxPrec] digits, the decimal is rounded
+// half to even.
+//
+// Decimal returns an error if the integer part of the decimal has more than
+// [MaxPrec] digits.
+func (d Decimal128) Decimal() (Decimal, error) {
+	coef := getBint()
+	defer putBint(coef)
+	coef.setDecimal128(d)
+	e, err := newFromBint(d.IsNeg(), coef, d.Scale(), 0)
+	if err != nil {
+		return Decimal{}, errorf("converting %v to %T: %w", redact(d), Decimal{}, err)
+	}
+	return e, nil
+}
+
+// Parse128 converts a string to a [Decimal128].
+// It accepts the same formats as [Parse], but the coefficient may have up
+// to [MaxPrec128] significant digits.
+// If the string has more than [MaxScale128] digits after the decimal point,
+// or more than [MaxPrec128] significant digits, it is rounded half to even.
+//
+// Parse128 returns an error if:
+//   - the string contains any whitespaces;
+//   - the string is longer than 330 bytes;
+//   - the exponent is less than -330 or greater than 330;
+//   - the string does not represent a valid decimal number;
+//   - the integer part of the result has more than [MaxPrec128] digits.
+//
+// If the string is too long or the exponent is too large,
+// the returned error wraps a [*LimitError].
+// To enforce stricter limits, use [ParseLimits.Parse128].
+func Parse128(s string) (Decimal128, error) {
+	return ParseLimits{}.Parse128(s)
+}
+
+// Parse128 is similar to [Parse128], but it checks the input against the limits.
+//
+// Parse128 returns an error wrapping a [*LimitError] if the string is longer than
+// l.MaxLength or the absolute value of the exponent is greater than l.MaxExponent.
+func (l ParseLimits) Parse128(s string) (Decimal128, error) {
+	d, err := parseDecimal128(s, l.maxLength(), l.maxExponent())
+	if err != nil {
+		return Decimal128{}, errorf("parsing decimal128: %w", err)
+	}
+	return d, nil
+}
+
+// Decimal128 returns a wrapper that decodes into d, checking the input
+// against the limits.
+// See also type [LimitedDecimal128].
+func (l ParseLimits) Decimal128(d *Decimal128) *LimitedDecimal128 {
+	return &LimitedDecimal128{limits: l, d: d}
+}
+
+// LimitedDecimal128 decodes a decimal like [Decimal128.UnmarshalText] and
+// [Decimal128.Scan], but it checks the input against [ParseLimits].
+// It is created by [ParseLimits.Decimal128].
+type LimitedDecimal128 struct {
+	limits ParseLimits
+	d      *Decimal128
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// See also method [Decimal128.UnmarshalText].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (w *LimitedDecimal128) UnmarshalText(text []byte) error {
+	var err error
+	*w.d, err = w.limits.Parse128(string(text))
+	return err
+}
+
+// Scan implements the [sql.Scanner] interface.
+// See also method [Decimal128.Scan].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (w *LimitedDecimal128) Scan(value any) error {
+	return w.d.scan(value, w.limits)
+}
+
+// MustParse128 is like [Parse128] but panics if the string cannot be parsed.
+// It simplifies safe initialization of global variables holding decimals.
+func MustParse128(s string) Decimal128 {
+	d, err := Parse128(s)
+	if err != nil {
+		panic(fmt.Sprintf("Parse128(%q) failed: %v", s, err))
+	}
+	return d
+}
+
+// parseDecimal128 parses the string as described in [Parse128],
+// accepting strings up to maxLen bytes and exponents up to maxExp.
+func parseDecimal128(s string, maxLen, maxExp int) (Decimal128, error) {
+	if len(s) > maxLen {
+		return Decimal128{}, &LimitError{Limit: "length", Max: maxLen}
+	}
+
+	pos := 0
+	width := len(s)
+
+	// Sign
+	var neg bool
+	switch {
+	case pos == width:
+		// skip
+	case s[pos] == '-':
+		neg = true
+		pos++
+	case s[pos] == '+':
+		pos++
+	}
+
+	// Coefficient
+	coef := getBint()
+	defer putBint(coef)
+	coef.setFint(0)
+	var scale int
+	var hasCoef, hasPoint bool
+	for pos < width {
+		switch {
+		case s[pos] >= '0' && s[pos] <= '9':
+			coef.fsa(coef, 1, fint(s[pos]-'0'))
+			if hasPoint {
+				scale++
+			}
+			hasCoef = true
+			pos++
+			continue
+		case s[pos] == '.' && !hasPoint:
+			hasPoint = true
+			pos++
+			continue
+		}
+		break
+	}
+	if !hasCoef {
+		return Decimal128{}, errorf("%w: no coefficient", errInvalidDecimal)
+	}
+
+	// Exponent
+	var exp int
+	if pos < width && (s[pos] == 'e' || s[pos] == 'E') {
+		pos++
+		var eneg, hasExp bool
+		switch {
+		case pos == width:
+			// skip
+		case s[pos] == '-':
+			eneg = true
+			pos++
+		case s[pos] == '+':
+			pos++
+		}
+		for pos < width && s[pos] >= '0' && s[pos] <= '9' {
+			exp = exp*10 + int(s[pos]-'0')
+			if exp > maxExp {
+				return Decimal128{}, &LimitError{Limit: "exponent", Max: maxExp}
+			}
+			hasExp = true
+			pos++
+		}
+		if !hasExp {
+			return Decimal128{}, errorf("%w: no exponent", errInvalidDecimal)
+		}
+		if eneg {
+			exp = -exp
+		}
+	}
+	if pos != width {
+		return Decimal128{}, errorf("%w: unexpected character %q", errInvalidDecimal, s[pos])
+	}
+
+	scale -= exp
+	if scale < 0 {
+		coef.lsh(coef, -scale)
+		scale = 0
+	}
+	return newDecimal128FromBint(neg, coef, scale, 0)
+}
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the decimal, such as "-1.23".
+// The returned string does not use scientific notation,
+// and trailing zeros are preserved.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (d Decimal128) String() string {
+	digits := d.digits()
+	scale := d.Scale()
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale+1-len(digits)) + digits
+	}
+	buf := make([]byte, 0, len(digits)+2)
+	if d.IsNeg() {
+		buf = append(buf, '-')
+	}
+	intdigs := len(digits) - scale
+	buf = append(buf, digits[:intdigs]...)
+	if scale > 0 {
+		buf = append(buf, '.')
+		buf = append(buf, digits[intdigs:]...)
+	}
+	return string(buf)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// When used with [encoding/json], only quoted strings are accepted.
+// See also constructor [Parse128].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (d *Decimal128) UnmarshalText(text []byte) error {
+	var err error
+	*d, err = Parse128(string(text))
+	return err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// See also method [Decimal128.String].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (d Decimal128) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// Scan implements the [sql.Scanner] interface.
+// It accepts the same types as [Decimal.Scan], so NUMERIC columns
+// with up to [MaxPrec128] digits can be read without loss of precision.
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (d *Decimal128) Scan(value any) error {
+	return d.scan(value, ParseLimits{})
+}
+
+// scan is like [Decimal128.Scan], but it parses strings with the given limits.
+func (d *Decimal128) scan(value any, l ParseLimits) error {
+	var err error
+	switch value := value.(type) {
+	case string:
+		*d, err = l.Parse128(value)
+	case []byte:
+		*d, err = l.Parse128(string(value))
+	case int64:
+		*d = newFromInt64(value).Decimal128()
+	case int:
+		*d = newFromInt64(int64(value)).Decimal128()
+	case int8:
+		*d = newFromInt64(int64(value)).Decimal128()
+	case int16:
+		*d = newFromInt64(int64(value)).Decimal128()
+	case int32:
+		*d = newFromInt64(int64(value)).Decimal128()
+	case uint:
+		*d = newDecimal128FromUint64(uint64(value))
+	case uint8:
+		*d = newDecimal128FromUint64(uint64(value))
+	case uint16:
+		*d = newDecimal128FromUint64(uint64(value))
+	case uint32:
+		*d = newDecimal128FromUint64(uint64(value))
+	case uint64:
+		*d = newDecimal128FromUint64(value)
+	case float64:
+		var e Decimal
+		e, err = NewFromFloat64(value)
+		*d = e.Decimal128()
+	case nil:
+		err = errorf("converting to %T: nil is not supported", d)
+	default:
+		err = errorf("converting from %T to %T: type %T is not supported", value, d, value)
+	}
+	return err
+}
+
+// Value implements the [driver.Valuer] interface.
+// See also method [Decimal128.String].
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (d Decimal128) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// It accepts the [packed BCD] representation produced by
+// [Decimal128.MarshalBinary].
+//
+// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
+// [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
+func (d *Decimal128) UnmarshalBinary(data []byte) error {
+	var err error
+	*d, err = parseBCD128(data)
+	return err
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The decimal is encoded in the same [packed BCD] representation as
+// [Decimal.MarshalBinary], but the coefficient may have up to [MaxPrec128]
+// digits.
+//
+// [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
+// [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
+func (d Decimal128) MarshalBinary() ([]byte, error) {
+	return d.bcd(), nil
+}
+
+// parseBCD128 converts a [packed BCD] representation to a decimal.
+//
+// [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
+func parseBCD128(b []byte) (Decimal128, error) {
+	var pos int
+	width := len(b)
+
+	// Coefficient and sign
+	var neg bool
+	digits := make([]byte, 0, 2*width)
+	for pos < width {
+		hi := b[pos] >> 4
+		lo := b[pos] & 0x0f
+
+		if hi > 9 {
+			return Decimal128{}, errorf("%w: invalid high nibble \"%x\"", errInvalidDecimal, b[pos])
+		}
+		digits = append(digits, '0'+hi)
+
+		if lo > 9 {
+			if lo == 0x0d {
+				neg = true
+			} else if lo != 0x0c {
+				return Decimal128{}, errorf("%w: invalid low nibble \"%x\"", errInvalidDecimal, b[pos])
+			}
+			pos++
+			break
+		}
+		digits = append(digits, '0'+lo)
+		pos++
+	}
+
+	// Scale
+	var scale int
+	var hasScale bool
+	if pos < width {
+		hi := b[pos] >> 4
+		lo := b[pos] & 0x0f
+		hasScale = true
+
+		if hi > 9 || lo > 9 {
+			return Decimal128{}, errorf("%w: invalid scale \"%x\"", errInvalidDecimal, b[pos])
+		}
+		scale = int(hi)*10 + int(lo)
+		pos++
+	}
+
+	if pos != width {
+		return Decimal128{}, errorf("%w: unexpected byte \"%x\"", errInvalidDecimal, b[pos])
+	}
+	if !hasScale {
+		return Decimal128{}, errorf("%w: no scale", errInvalidDecimal)
+	}
+	if scale > MaxScale128 {
+		return Decimal128{}, errorf("%w: scale %v is greater than %v", errInvalidDecimal, scale, MaxScale128)
+	}
+
+	coef := getBint()
+	defer putBint(coef)
+	if _, ok := (*big.Int)(coef).SetString(string(digits), 10); !ok {
+		return Decimal128{}, errorf("%w: no coefficient", errInvalidDecimal)
+	}
+	if coef.hasPrec(MaxPrec128 + 1) {
+		return Decimal128{}, errDecimalOverflow
+	}
+	return newDecimal128FromBint(neg, coef, scale, 0)
+}
+
+// bcd returns a [packed BCD] representation of a decimal.
+//
+// [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
+func (d Decimal128) bcd() []byte {
+	digits := d.digits()
+	scale := d.Scale()
+	buf := make([]byte, len(digits)/2+2)
+	pos := len(buf) - 1
+
+	// Scale
+	buf[pos] = byte(scale/10)<<4 | byte(scale%10)
+	pos--
+
+	// Sign and last digit
+	i := len(digits) - 1
+	if d.IsNeg() {
+		buf[pos] = (digits[i]-'0')<<4 | 0x0d
+	} else {
+		buf[pos] = (digits[i]-'0')<<4 | 0x0c
+	}
+	pos--
+	i--
+
+	// Coefficient
+	for ; i >= 0; i -= 2 {
+		buf[pos] = digits[i] - '0'
+		if i > 0 {
+			buf[pos] |= (digits[i-1] - '0') << 4
+		}
+		pos--
+	}
+
+	return buf[pos+1:]
+}
+
+// UnmarshalBSONValue implements the [v2/bson.ValueUnmarshaler] interface.
+// It supports the same BSON types as [Decimal.UnmarshalBSONValue], but
+// Decimal128 values are rounded to [MaxPrec128] digits if necessary, and
+// strings are parsed as described in [Parse128].
+//
+// [v2/bson.ValueUnmarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueUnmarshaler
+func (d *Decimal128) UnmarshalBSONValue(typ byte, data []byte) error {
+	var err error
+	switch typ {
+	case bsonNull:
+		return nil
+	case bsonString:
+		//nolint:gosec
+		if len(data) < 5 || int(int32(binary.LittleEndian.Uint32(data))) != len(data)-4 || data[len(data)-1] != 0 {
+			return errorf("%w: invalid BSON string", errInvalidDecimal)
+		}
+		*d, err = Parse128(string(data[4 : len(data)-1]))
+	case bsonDecimal128:
+		if len(data) != 16 {
+			return errorf("%w: invalid BSON decimal128 length %v", errInvalidDecimal, len(data))
+		}
+		*d, err = parseIEEEDecimal128To128(binary.LittleEndian.Uint64(data[8:]), binary.LittleEndian.Uint64(data))
+	default:
+		var e Decimal
+		if err = e.UnmarshalBSONValue(typ, data); err == nil {
+			*d = e.Decimal128()
+		}
+	}
+	return err
+}
+
+// MarshalBSONValue implements the [v2/bson.ValueMarshaler] interface.
+// The decimal is encoded as BSON Decimal128, preserving its scale.
+//
+// [v2/bson.ValueMarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueMarshaler
+func (d Decimal128) MarshalBSONValue() (typ byte, data []byte, err error) {
+	//nolint:gosec
+	hi := uint64(ieeeBias-d.Scale())<<49 | d.hi
+	if d.IsNeg() {
+		hi |= 1 << 63
+	}
+	data = make([]byte, 16)
+	binary.LittleEndian.PutUint64(data, d.lo)
+	binary.LittleEndian.PutUint64(data[8:], hi)
+	return bsonDecimal128, data, nil
+}
+
+// parseIEEEDecimal128To128 converts the IEEE 754 decimal128 representation,
+// using the binary integer decimal encoding, to a decimal.
+func parseIEEEDecimal128To128(hi, lo uint64) (Decimal128, error) {
+	neg, exp, hi, lo, err := ieeeDecimal128Parts(hi, lo)
+	if err != nil {
+		return Decimal128{}, err
+	}
+	if hi == 0 && lo == 0 {
+		//nolint:gosec
+		return Decimal128{scale: int8(min(max(-exp, 0), MaxScale128))}, nil
+	}
+	b := make([]byte, 0, 48)
+	if neg {
+		b = append(b, '-')
+	}
+	b = appendUint128(b, hi, lo)
+	b = append(b, 'e')
+	b = strconv.AppendInt(b, int64(exp), 10)
+	return Parse128(string(b))
+}
+
+// Format implements the [fmt.Formatter] interface.
+// It supports the same verbs, flags, width and precision as [Decimal.Format],
+// except for the %e and %g verbs:
+//
+//	| Format | Example      | Result     |
+//	|--------|--------------|------------|
+//	| %f     | -123.456     | -123.456   |
+//	| %.2f   | -123.456     | -123.46    |
+//	| %+v    | 123.456      | +123.456   |
+//	| %#f    | -1234.5      | (1,234.5)  |
+//	| %k     | 0.123        | 12.3%      |
+//	| %q     | 123.456      | "123.456"  |
+//
+// [fmt.Formatter]: https://pkg.go.dev/fmt#Formatter
+func (d Decimal128) Format(state fmt.State, verb rune) {
+	opts := FormatOptions{
+		Plus:        state.Flag('+'),
+		Space:       state.Flag(' '),
+		ZeroPad:     state.Flag('0'),
+		LeftAlign:   state.Flag('-'),
+		Grouping:    state.Flag('#'),
+		Parentheses: state.Flag('#'),
+	}
+	opts.Width, _ = state.Width()
+	opts.Scale, opts.FixedScale = state.Precision()
+
+	buf := d.appendFormat(nil, verb, opts)
+
+	// Writing result
+	//nolint:errcheck
+	switch verb {
+	case 'q', 'Q', 's', 'S', 'v', 'V', 'f', 'F', 'k', 'K':
+		state.Write(buf)
+	default:
+		state.Write([]byte("%!"))
+		state.Write([]byte{byte(verb)})
+		state.Write([]byte("(decimal.Decimal128="))
+		state.Write(buf)
+		state.Write([]byte(")"))
+	}
+}
+
+// appendFormat appends a string representation of the decimal formatted
+// according to the verb and options to the byte slice.
+func (d Decimal128) appendFormat(b []byte, verb rune, opts FormatOptions) []byte {
+	percent := verb == 'k' || verb == 'K'
+	fixed := verb == 'f' || verb == 'F' || percent
+
+	// Rounding
+	scale := max(opts.Scale, 0)
+	shift := 0
+	if percent {
+		shift = 2
+	}
+	if fixed && opts.FixedScale && scale+shift < d.Scale() {
+		d = d.Round(scale + shift)
+	}
+
+	// Integer and fractional digits
+	digits := d.digits()
+	fracdigs := d.Scale()
+	if len(digits) <= fracdigs {
+		digits = strings.Repeat("0", fracdigs+1-len(digits)) + digits
+	}
+	intpart, fracpart := digits[:len(digits)-fracdigs], digits[len(digits)-fracdigs:]
+
+	// Percentage multiplier
+	if percent {
+		if len(fracpart) >= shift {
+			intpart, fracpart = intpart+fracpart[:shift], fracpart[shift:]
+		} else {
+			intpart = intpart + fracpart + strings.Repeat("0", shift-len(fracpart))
+			fracpart = ""
+		}
+		intpart = strings.TrimLeft(intpart, "0")
+		if intpart == "" {
+			intpart = "0"
+		}
+	}
+
+	// Trailing zeros
+	if fixed && opts.FixedScale && scale > len(fracpart) {
+		fracpart += strings.Repeat("0", scale-len(fracpart))
+	}
+
+	var body []byte
+
+	// Integer digits and group separators
+	gsep, dsep := opts.groupSeparator(), opts.decimalSeparator()
+	for i := range len(intpart) {
+		if opts.Grouping && i > 0 && (len(intpart)-i)%3 == 0 {
+			body = utf8.AppendRune(body, gsep)
+		}
+		body = append(body, intpart[i])
+	}
+
+	// Fractional digits
+	if fracpart != "" {
+		body = utf8.AppendRune(body, dsep)
+		body = append(body, fracpart...)
+	}
+
+	// Percentage sign
+	if percent {
+		body = append(body, '%')
+	}
+
+	// Arithmetic sign or parentheses
+	var prefix, suffix []byte
+	switch {
+	case d.IsNeg() && opts.Parentheses:
+		prefix, suffix = []byte{'('}, []byte{')'}
+	case d.IsNeg():
+		prefix = []byte{'-'}
+	case opts.Plus:
+		prefix = []byte{'+'}
+	case opts.Space:
+		prefix = []byte{' '}
+	}
+
+	// Opening and closing quotes
+	var quote []byte
+	if verb == 'q' || verb == 'Q' {
+		quote = []byte{'"'}
+	}
+
+	// Padding
+	width := utf8.RuneCount(body) + len(prefix) + len(suffix) + 2*len(quote)
+	var lspaces, tspaces, lzeros int
+	if opts.Width > width {
+		switch {
+		case opts.LeftAlign:
+			tspaces = opts.Width - width
+		case opts.ZeroPad:
+			lzeros = opts.Width - width
+		default:
+			lspaces = opts.Width - width
+		}
+	}
+
+	b = append(b, strings.Repeat(" ", lspaces)...)
+	b = append(b, quote...)
+	b = append(b, prefix...)
+	b = append(b, strings.Repeat("0", lzeros)...)
+	b = append(b, body...)
+	b = append(b, suffix...)
+	b = append(b, quote...)
+	b = append(b, strings.Repeat(" ", tspaces)...)
+	return b
+}
+
+// digits returns the decimal digits of the coefficient.
+func (d Decimal128) digits() string {
+	coef := getBint()
+	defer putBint(coef)
+	coef.setDecimal128(d)
+	return coef.string()
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal128) Scale() int {
+	return int(d.scale)
+}
+
+// Prec returns the number of digits in the coefficient.
+func (d Decimal128) Prec() int {
+	coef := getBint()
+	defer putBint(coef)
+	coef.setDecimal128(d)
+	return coef.prec()
+}
+
+// IsZero returns true if the decimal is equal to 0.
+func (d Decimal128) IsZero() bool {
+	return d.hi == 0 && d.lo == 0
+}
+
+// IsNeg returns true if the decimal is less than 0.
+func (d Decimal128) IsNeg() bool {
+	return d.neg
+}
+
+// IsPos returns true if the decimal is greater than 0.
+func (d Decimal128) IsPos() bool {
+	return !d.neg && !d.IsZero()
+}
+
+// Sign returns:
+//
+//	-1 if d < 0
+//	 0 if d = 0
+//	+1 if d > 0
+func (d Decimal128) Sign() int {
+	switch {
+	case d.neg:
+		return -1
+	case d.IsZero():
+		return 0
+	}
+	return 1
+}
+
+// Neg returns a decimal with the opposite sign.
+func (d Decimal128) Neg() Decimal128 {
+	if !d.IsZero() {
+		d.neg = !d.neg
+	}
+	return d
+}
+
+// Abs returns the absolute value of the decimal.
+func (d Decimal128) Abs() Decimal128 {
+	d.neg = false
+	return d
+}
+
+// Cmp compares decimals and returns:
+//
+//	-1 if d < e
+//	 0 if d = e
+//	+1 if d > e
+//
+// See also method [Decimal128.Equal].
+func (d Decimal128) Cmp(e Decimal128) int {
+	// Special case: different signs
+	switch {
+	case d.Sign() > e.Sign():
+		return 1
+	case d.Sign() < e.Sign():
+		return -1
+	}
+
+	// General case
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setDecimal128(d)
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setDecimal128(e)
+	switch {
+	case d.Scale() < e.Scale():
+		dcoef.lsh(dcoef, e.Scale()-d.Scale())
+	case d.Scale() > e.Scale():
+		ecoef.lsh(ecoef, d.Scale()-e.Scale())
+	}
+	if d.IsNeg() {
+		return ecoef.cmp(dcoef)
+	}
+	return dcoef.cmp(ecoef)
+}
+
+// Equal compares decimals and returns true if they are numerically equal,
+// regardless of their scales, so 1.0 equals 1.00.
+func (d Decimal128) Equal(e Decimal128) bool {
+	return d.Cmp(e) == 0
+}
+
+// Add returns the (possibly rounded) sum of decimals d and e.
+//
+// Add returns an error if the integer part of the result has more than
+// [MaxPrec128] digits.
+func (d Decimal128) Add(e Decimal128) (Decimal128, error) {
+	f, err := d.add(e)
+	if err != nil {
+		return Decimal128{}, errorf("computing [%v + %v]: %w", redact(d), redact(e), err)
+	}
+	return f, nil
+}
+
+// Sub returns the (possibly rounded) difference between decimals d and e.
+//
+// Sub returns an error if the integer part of the result has more than
+// [MaxPrec128] digits.
+func (d Decimal128) Sub(e Decimal128) (Decimal128, error) {
+	f, err := d.add(e.Neg())
+	if err != nil {
+		return Decimal128{}, errorf("computing [%v - %v]: %w", redact(d), redact(e), err)
+	}
+	return f, nil
+}
+
+// add computes the sum of decimals using *big.Int arithmetic.
+func (d Decimal128) add(e Decimal128) (Decimal128, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setDecimal128(d)
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setDecimal128(e)
+
+	neg, scale := accumulateBint(d.IsNeg(), dcoef, d.Scale(), e.IsNeg(), ecoef, e.Scale())
+	return newDecimal128FromBint(neg, dcoef, scale, 0)
+}
+
+// Mul returns the (possibly rounded) product of decimals d and e.
+//
+// Mul returns an error if the integer part of the result has more than
+// [MaxPrec128] digits.
+func (d Decimal128) Mul(e Decimal128) (Decimal128, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setDecimal128(d)
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setDecimal128(e)
+
+	dcoef.mul(dcoef, ecoef)
+	f, err := newDecimal128FromBint(d.IsNeg() != e.IsNeg(), dcoef, d.Scale()+e.Scale(), 0)
+	if err != nil {
+		return Decimal128{}, errorf("computing [%v * %v]: %w", redact(d), redact(e), err)
+	}
+	return f, nil
+}
+
+// Quo returns the (possibly rounded) quotient of decimals d and e.
+// Trailing zeros are removed from the result unless they are required
+// to preserve the scale of d minus the scale of e.
+//
+// Quo returns an error if:
+//   - the divisor is 0;
+//   - the integer part of the result has more than [MaxPrec128] digits.
+func (d Decimal128) Quo(e Decimal128) (Decimal128, error) {
+	// Special case: zero divisor
+	if e.IsZero() {
+		return Decimal128{}, errorf("computing [%v / %v]: %w", redact(d), redact(e), errDivisionByZero)
+	}
+
+	// General case
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setDecimal128(d)
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setDecimal128(e)
+
+	// Alignment
+	dcoef.lsh(dcoef, 2*MaxScale128+e.Scale()-d.Scale())
+
+	// Compute d = ⌊d / e⌋
+	dcoef.quo(dcoef, ecoef)
+
+	f, err := newDecimal128FromBint(d.IsNeg() != e.IsNeg(), dcoef, 2*MaxScale128, 0)
+	if err != nil {
+		return Decimal128{}, errorf("computing [%v / %v]: %w", redact(d), redact(e), err)
+	}
+
+	// Preferred scale
+	return f.Trim(max(d.Scale()-e.Scale(), 0)), nil
+}
+
+// Round returns a decimal rounded to the specified number of digits after
+// the decimal point using rounding half to even.
+// If the given scale is negative, it is redefined to zero.
+// For financial calculations, the scale should be equal to or greater than
+// the scale of the currency.
+func (d Decimal128) Round(scale int) Decimal128 {
+	scale = max(scale, 0)
+	if scale >= d.Scale() {
+		return d
+	}
+	coef := getBint()
+	defer putBint(coef)
+	coef.setDecimal128(d)
+	coef.rshHalfEven(coef, d.Scale()-scale)
+	f, err := newDecimal128FromBint(d.IsNeg(), coef, scale, 0)
+	if err != nil {
+		panic(fmt.Sprintf("%v.Round(%v) failed: %v", d, scale, err)) // Should never happen
+	}
+	return f
+}
+
+// Trim returns a decimal with trailing zeros removed up to the given scale.
+// If the given scale is negative, it is redefined to zero.
+func (d Decimal128) Trim(scale int) Decimal128 {
+	scale = max(scale, 0)
+	if d.Scale() <= scale {
+		return d
+	}
+	coef := getBint()
+	defer putBint(coef)
+	coef.setDecimal128(d)
+	q := getBint()
+	defer putBint(q)
+	r := getBint()
+	defer putBint(r)
+	dscale := d.Scale()
+	for dscale > scale {
+		q.quoRem(coef, bpow10[1], r)
+		if r.sign() != 0 {
+			break
+		}
+		coef.setBint(q)
+		dscale--
+	}
+	f, err := newDecimal128FromBint(d.IsNeg(), coef, dscale, 0)
+	if err != nil {
+		panic(fmt.Sprintf("%v.Trim(%v) failed: %v", d, scale, err)) // Should never happen
+	}
+	return f
+}
@@ -0,0 +1,602 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParse128(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want string
+		}{
+			{"0", "0"},
+			{"-0", "0"},
+			{"+1.23", "1.23"},
+			{"-1.230", "-1.230"},
+			{".5", "0.5"},
+			{"5.", "5"},
+			{"1e3", "1000"},
+			{"1.5E-2", "0.015"},
+			{"1234567890123456789012345678901234", "1234567890123456789012345678901234"},
+			{"-9999999999999999999999999999999999", "-9999999999999999999999999999999999"},
+			{"0.0000000000000000000000000000000001", "0.0000000000000000000000000000000001"},
+			{"0.00000000000000000000000000000000005", "0.0000000000000000000000000000000000"},
+			{"0.00000000000000000000000000000000015", "0.0000000000000000000000000000000002"},
+			{"1.00000000000000000000000000000000005", "1.000000000000000000000000000000000"},
+			{"123456789012345678901234567890123.45", "123456789012345678901234567890123.4"},
+		}
+		for _, tt := range tests {
+			got, err := Parse128(tt.s)
+			if err != nil {
+				t.Errorf("Parse128(%q) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("Parse128(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			s       string
+			wantErr error
+		}{
+			{"", errInvalidDecimal},
+			{"-", errInvalidDecimal},
+			{".", errInvalidDecimal},
+			{"1.2.3", errInvalidDecimal},
+			{"1e", errInvalidDecimal},
+			{"1e+", errInvalidDecimal},
+			{" 1", errInvalidDecimal},
+			{"1 ", errInvalidDecimal},
+			{"0x10", errInvalidDecimal},
+			{"1e331", errInvalidDecimal},
+			{"12345678901234567890123456789012345", errDecimalOverflow},
+			{"9999999999999999999999999999999999.5", errDecimalOverflow},
+			{"1e34", errDecimalOverflow},
+		}
+		for _, tt := range tests {
+			_, err := Parse128(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse128(%q) error = %v, want %v", tt.s, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestDecimal128_Arithmetic(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e             string
+			wantAdd, wantSub string
+			wantMul, wantQuo string
+		}{
+			{"1", "3", "4", "-2", "3", "0.3333333333333333333333333333333333"},
+			{"2", "3", "5", "-1", "6", "0.6666666666666666666666666666666667"},
+			{"1.00", "4", "5.00", "-3.00", "4.00", "0.25"},
+			{"-1.5", "0.5", "-1.0", "-2.0", "-0.75", "-3"},
+			{"9999999999999999999", "1", "10000000000000000000", "9999999999999999998", "9999999999999999999", "9999999999999999999"},
+			{"1234567890123456789012345678901234", "0.5", "1234567890123456789012345678901234", "1234567890123456789012345678901234", "617283945061728394506172839450617.0", "2469135780246913578024691357802468"},
+			{"123456789012345678.9", "1000000000000000.01", "124456789012345678.91", "122456789012345678.89", "123456789012345680134567890123456.8", "123.4567890123456776654321098765432"},
+			{"0.0000000000000000001", "0.0000000000000000001", "0.0000000000000000002", "0.0000000000000000000", "0.0000000000000000000000000000000000", "1"},
+		}
+		for _, tt := range tests {
+			d, e := MustParse128(tt.d), MustParse128(tt.e)
+			ops := []struct {
+				name string
+				f    func(Decimal128) (Decimal128, error)
+				want string
+			}{
+				{"Add", d.Add, tt.wantAdd},
+				{"Sub", d.Sub, tt.wantSub},
+				{"Mul", d.Mul, tt.wantMul},
+				{"Quo", d.Quo, tt.wantQuo},
+			}
+			for _, op := range ops {
+				got, err := op.f(e)
+				if err != nil {
+					t.Errorf("%q.%v(%q) failed: %v", d, op.name, e, err)
+					continue
+				}
+				if got.String() != op.want {
+					t.Errorf("%q.%v(%q) = %q, want %q", d, op.name, e, got, op.want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		max := MustParse128("9999999999999999999999999999999999")
+		one := MustParse128("1")
+		if _, err := max.Add(one); !errors.Is(err, errDecimalOverflow) {
+			t.Errorf("%q.Add(%q) error = %v, want %v", max, one, err, errDecimalOverflow)
+		}
+		if _, err := max.Neg().Sub(one); !errors.Is(err, errDecimalOverflow) {
+			t.Errorf("%q.Sub(%q) error = %v, want %v", max.Neg(), one, err, errDecimalOverflow)
+		}
+		if _, err := max.Mul(max); !errors.Is(err, errDecimalOverflow) {
+			t.Errorf("%q.Mul(%q) error = %v, want %v", max, max, err, errDecimalOverflow)
+		}
+		half := MustParse128("0.5")
+		if _, err := max.Quo(half); !errors.Is(err, errDecimalOverflow) {
+			t.Errorf("%q.Quo(%q) error = %v, want %v", max, half, err, errDecimalOverflow)
+		}
+		if _, err := one.Quo(Decimal128{}); !errors.Is(err, errDivisionByZero) {
+			t.Errorf("%q.Quo(0) error = %v, want %v", one, err, errDivisionByZero)
+		}
+	})
+}
+
+func TestDecimal128_Cmp(t *testing.T) {
+	tests := []struct {
+		d, e string
+		want int
+	}{
+		{"0", "0", 0},
+		{"0", "0.00", 0},
+		{"1.0", "1", 0},
+		{"1", "2", -1},
+		{"-1", "-2", 1},
+		{"-1", "1", -1},
+		{"0", "-1", 1},
+		{"1234567890123456789012345678901234", "123456789012345678901234567890123.9", 1},
+		{"-0.0000000000000000000000000000000001", "0", -1},
+	}
+	for _, tt := range tests {
+		d, e := MustParse128(tt.d), MustParse128(tt.e)
+		if got := d.Cmp(e); got != tt.want {
+			t.Errorf("%q.Cmp(%q) = %v, want %v", d, e, got, tt.want)
+		}
+		if got := d.Equal(e); got != (tt.want == 0) {
+			t.Errorf("%q.Equal(%q) = %v, want %v", d, e, got, tt.want == 0)
+		}
+	}
+}
+
+func TestDecimal128_Round(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		want  string
+	}{
+		{"1.2345", 2, "1.23"},
+		{"1.235", 2, "1.24"},
+		{"1.245", 2, "1.24"},
+		{"-1.245", 2, "-1.24"},
+		{"1.5", 5, "1.5"},
+		{"9.99", 1, "10.0"},
+		{"0.5", -1, "0"},
+		{"-0.4", 0, "0"},
+		{"9999999999999999999999999999999.999", 2, "10000000000000000000000000000000.00"},
+	}
+	for _, tt := range tests {
+		d := MustParse128(tt.d)
+		if got := d.Round(tt.scale); got.String() != tt.want {
+			t.Errorf("%q.Round(%v) = %q, want %q", d, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal128_Trim(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		want  string
+	}{
+		{"1.2300", 0, "1.23"},
+		{"1.2300", 3, "1.230"},
+		{"1.2300", 5, "1.2300"},
+		{"100.000", -1, "100"},
+		{"0.000", 0, "0"},
+	}
+	for _, tt := range tests {
+		d := MustParse128(tt.d)
+		if got := d.Trim(tt.scale); got.String() != tt.want {
+			t.Errorf("%q.Trim(%v) = %q, want %q", d, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal128_Decimal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0"},
+			{"-1.23", "-1.23"},
+			{"9999999999999999999", "9999999999999999999"},
+			{"0.12345678901234567890123456789", "0.1234567890123456789"},
+			{"1.5555555555555555555555", "1.555555555555555556"},
+		}
+		for _, tt := range tests {
+			d := MustParse128(tt.d)
+			got, err := d.Decimal()
+			if err != nil {
+				t.Errorf("%q.Decimal() failed: %v", d, err)
+				continue
+			}
+			if want := MustParse(tt.want); got != want {
+				t.Errorf("%q.Decimal() = %q, want %q", d, got, want)
+			}
+			if back := got.Decimal128(); back.String() != got.String() {
+				t.Errorf("%q.Decimal128() = %q, want %q", got, back, got)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		d := MustParse128("10000000000000000000")
+		_, err := d.Decimal()
+		if !errors.Is(err, errDecimalOverflow) {
+			t.Errorf("%q.Decimal() error = %v, want %v", d, err, errDecimalOverflow)
+		}
+	})
+}
+
+func TestDecimal128_Sign(t *testing.T) {
+	tests := []struct {
+		d     string
+		want  int
+		prec  int
+		scale int
+	}{
+		{"0", 0, 0, 0},
+		{"0.00", 0, 0, 2},
+		{"1.5", 1, 2, 1},
+		{"-12345678901234567890.5", -1, 21, 1},
+	}
+	for _, tt := range tests {
+		d := MustParse128(tt.d)
+		if got := d.Sign(); got != tt.want {
+			t.Errorf("%q.Sign() = %v, want %v", d, got, tt.want)
+		}
+		if got := d.IsZero(); got != (tt.want == 0) {
+			t.Errorf("%q.IsZero() = %v, want %v", d, got, tt.want == 0)
+		}
+		if got := d.IsPos(); got != (tt.want > 0) {
+			t.Errorf("%q.IsPos() = %v, want %v", d, got, tt.want > 0)
+		}
+		if got := d.IsNeg(); got != (tt.want < 0) {
+			t.Errorf("%q.IsNeg() = %v, want %v", d, got, tt.want < 0)
+		}
+		if got := d.Prec(); got != tt.prec {
+			t.Errorf("%q.Prec() = %v, want %v", d, got, tt.prec)
+		}
+		if got := d.Scale(); got != tt.scale {
+			t.Errorf("%q.Scale() = %v, want %v", d, got, tt.scale)
+		}
+		if got := d.Neg().Neg(); got != d {
+			t.Errorf("%q.Neg().Neg() = %q, want %q", d, got, d)
+		}
+		if got := d.Abs(); got.IsNeg() {
+			t.Errorf("%q.Abs() = %q, want non-negative", d, got)
+		}
+	}
+}
+
+func TestDecimal128_JSON(t *testing.T) {
+	type order struct {
+		Total Decimal128 `json:"total"`
+	}
+	data := []byte(`{"total":"-1234567890123456789012345.678901234"}`)
+	var o order
+	if err := json.Unmarshal(data, &o); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed: %v", data, err)
+	}
+	got, err := json.Marshal(o)
+	if err != nil {
+		t.Fatalf("json.Marshal(%v) failed: %v", o, err)
+	}
+	if string(got) != string(data) {
+		t.Errorf("json.Marshal(json.Unmarshal(%s)) = %s", data, got)
+	}
+	if err := json.Unmarshal([]byte(`{"total":1.5}`), &o); err == nil {
+		t.Errorf("json.Unmarshal() did not fail for an unquoted number")
+	}
+}
+
+func TestParseLimits_Parse128(t *testing.T) {
+	limits := ParseLimits{MaxLength: 8, MaxExponent: 3}
+
+	t.Run("success", func(t *testing.T) {
+		got, err := limits.Parse128("1.5e3")
+		if err != nil {
+			t.Errorf("Parse128() failed: %v", err)
+		} else if want := MustParse128("1500"); got != want {
+			t.Errorf("Parse128() = %q, want %q", got, want)
+		}
+		var d Decimal128
+		if err := json.Unmarshal([]byte(`"-2.5"`), limits.Decimal128(&d)); err != nil {
+			t.Errorf("json.Unmarshal() failed: %v", err)
+		} else if want := MustParse128("-2.5"); d != want {
+			t.Errorf("json.Unmarshal() = %q, want %q", d, want)
+		}
+		if err := limits.Decimal128(&d).Scan(uint64(math.MaxUint64)); err != nil {
+			t.Errorf("Scan() failed: %v", err)
+		} else if want := MustParse128("18446744073709551615"); d != want {
+			t.Errorf("Scan() = %q, want %q", d, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var d Decimal128
+		tests := []struct {
+			name string
+			f    func() error
+			want LimitError
+		}{
+			{"Parse128", func() error { _, err := limits.Parse128("1e4"); return err }, LimitError{"exponent", 3}},
+			{"Parse128", func() error { _, err := ParseLimits{}.Parse128("1e331"); return err }, LimitError{"exponent", 330}},
+			{"json.Unmarshal", func() error { return json.Unmarshal([]byte(`"123456789"`), limits.Decimal128(&d)) }, LimitError{"length", 8}},
+			{"Scan", func() error { return limits.Decimal128(&d).Scan([]byte("1e-4")) }, LimitError{"exponent", 3}},
+		}
+		for _, tt := range tests {
+			err := tt.f()
+			var got *LimitError
+			if !errors.As(err, &got) {
+				t.Errorf("%v failed with %v, want *LimitError", tt.name, err)
+				continue
+			}
+			if *got != tt.want {
+				t.Errorf("%v failed with %+v, want %+v", tt.name, *got, tt.want)
+			}
+		}
+	})
+}
+
+func TestDecimal128_Scan(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			value any
+			want  string
+		}{
+			{"12345678901234567890123456789.01", "12345678901234567890123456789.01"},
+			{[]byte("-1.5"), "-1.5"},
+			{int64(-42), "-42"},
+			{int(-42), "-42"},
+			{int8(-128), "-128"},
+			{int16(-32768), "-32768"},
+			{int32(-2147483648), "-2147483648"},
+			{int64(math.MinInt64), "-9223372036854775808"},
+			{uint(42), "42"},
+			{uint8(255), "255"},
+			{uint16(65535), "65535"},
+			{uint32(4294967295), "4294967295"},
+			{uint64(math.MaxUint64), "18446744073709551615"},
+			{float64(0.25), "0.25"},
+		}
+		for _, tt := range tests {
+			var got Decimal128
+			if err := got.Scan(tt.value); err != nil {
+				t.Errorf("Scan(%v) failed: %v", tt.value, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("Scan(%v) = %q, want %q", tt.value, got, tt.want)
+			}
+			v, err := got.Value()
+			if err != nil {
+				t.Errorf("%q.Value() failed: %v", got, err)
+				continue
+			}
+			if v != tt.want {
+				t.Errorf("%q.Value() = %v, want %v", got, v, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []any{nil, float32(1), "abc", true}
+		for _, tt := range tests {
+			var d Decimal128
+			if err := d.Scan(tt); err == nil {
+				t.Errorf("Scan(%v) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestDecimal128_Format(t *testing.T) {
+	t.Run("same as Decimal", func(t *testing.T) {
+		values := []string{
+			"0", "0.00", "-0.001", "1", "-1", "0.005", "12.34", "-12.34",
+			"0.1234", "1234567.891", "-1234567.891", "9999999999999999999",
+			"0.9999999999999999999", "-0.0000000000000000001",
+		}
+		formats := []string{
+			"%v", "%s", "%q", "%f", "%F", "%k", "%K",
+			"%+v", "% v", "%#v", "%+q", "%010q", "%-10s", "%10v", "%010v",
+			"%.0f", "%.1f", "%.2f", "%.5f", "%.20f", "%+.2f", "% .3f", "%#.2f",
+			"%#12.2f", "%-12.3f", "%012.2f", "%+012.2f", "%.0k", "%.1k", "%.3k",
+			"%08.1k", "%#k", "%x", "%d",
+		}
+		for _, v := range values {
+			d := MustParse(v)
+			e := d.Decimal128()
+			for _, format := range formats {
+				want := strings.Replace(fmt.Sprintf(format, d), "decimal.Decimal=", "decimal.Decimal128=", 1)
+				if got := fmt.Sprintf(format, e); got != want {
+					t.Errorf("fmt.Sprintf(%q, %v) = %q, want %q", format, e, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("wide", func(t *testing.T) {
+		tests := []struct {
+			d, format, want string
+		}{
+			{"12345678901234567890.123456789", "%v", "12345678901234567890.123456789"},
+			{"12345678901234567890.123456789", "%.2f", "12345678901234567890.12"},
+			{"-12345678901234567890.125", "%#.2f", "(12,345,678,901,234,567,890.12)"},
+			{"0.0000000000000000000000000000000001", "%.33f", "0.000000000000000000000000000000000"},
+			{"0.0000000000000000000000000000000001", "%k", "0.00000000000000000000000000000001%"},
+			{"1234567890123456789012345678901234", "%+40f", "     +1234567890123456789012345678901234"},
+		}
+		for _, tt := range tests {
+			d := MustParse128(tt.d)
+			if got := fmt.Sprintf(tt.format, d); got != tt.want {
+				t.Errorf("fmt.Sprintf(%q, %v) = %q, want %q", tt.format, d, got, tt.want)
+			}
+		}
+	})
+}
+
+func TestDecimal128_Binary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []string{
+			"0", "0.00", "1", "-1", "12.345", "-0.0000000000000000000000000000000001",
+			"1234567890123456789012345678901234", "-1.234567890123456789012345678901234",
+		}
+		for _, tt := range tests {
+			d := MustParse128(tt)
+			b, err := d.MarshalBinary()
+			if err != nil {
+				t.Errorf("%q.MarshalBinary() failed: %v", d, err)
+				continue
+			}
+			var got Decimal128
+			if err := got.UnmarshalBinary(b); err != nil {
+				t.Errorf("UnmarshalBinary(%x) failed: %v", b, err)
+				continue
+			}
+			if got != d {
+				t.Errorf("UnmarshalBinary(MarshalBinary(%q)) = %q", d, got)
+			}
+			if e, err := d.Decimal(); err == nil && e.Scale() == d.Scale() && e.Prec() == d.Prec() {
+				want, _ := e.MarshalBinary()
+				if !bytes.Equal(b, want) {
+					t.Errorf("%q.MarshalBinary() = %x, want %x", d, b, want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]byte{
+			"empty":         {},
+			"no scale":      {0x1c},
+			"high nibble":   {0xa1, 0x0c, 0x00},
+			"low nibble":    {0x1e, 0x00},
+			"scale range":   {0x1c, 0x35},
+			"trailing byte": {0x1c, 0x00, 0x00},
+			"overflow":      append(bytes.Repeat([]byte{0x11}, 17), 0x1c, 0x00),
+		}
+		for name, b := range tests {
+			var d Decimal128
+			if err := d.UnmarshalBinary(b); err == nil {
+				t.Errorf("UnmarshalBinary(%v) did not fail", name)
+			}
+		}
+	})
+}
+
+func TestDecimal128_BSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []string{
+			"0", "0.00", "1", "-1", "12.345", "-0.0000000000000000000000000000000001",
+			"1234567890123456789012345678901234", "-1.234567890123456789012345678901234",
+		}
+		for _, tt := range tests {
+			d := MustParse128(tt)
+			typ, data, err := d.MarshalBSONValue()
+			if err != nil {
+				t.Errorf("%q.MarshalBSONValue() failed: %v", d, err)
+				continue
+			}
+			var got Decimal128
+			if err := got.UnmarshalBSONValue(typ, data); err != nil {
+				t.Errorf("UnmarshalBSONValue(%x) failed: %v", data, err)
+				continue
+			}
+			if got != d {
+				t.Errorf("UnmarshalBSONValue(MarshalBSONValue(%q)) = %q", d, got)
+			}
+			if e, err := d.Decimal(); err == nil && e.Scale() == d.Scale() && e.Prec() == d.Prec() {
+				_, want, _ := e.MarshalBSONValue()
+				if !bytes.Equal(data, want) {
+					t.Errorf("%q.MarshalBSONValue() = %x, want %x", d, data, want)
+				}
+			}
+		}
+	})
+
+	t.Run("other types", func(t *testing.T) {
+		tests := []struct {
+			typ  byte
+			data []byte
+			want string
+		}{
+			{bsonString, []byte{31, 0, 0, 0, '1', '2', '3', '4', '5', '6', '7', '8', '9', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '0', '.', '1', '2', '3', '4', '5', '6', '7', '8', '9', 0}, "12345678901234567890.123456789"},
+			{bsonInt32, []byte{0xff, 0xff, 0xff, 0xff}, "-1"},
+			{bsonInt64, []byte{0x2a, 0, 0, 0, 0, 0, 0, 0}, "42"},
+			{bsonDouble, []byte{0, 0, 0, 0, 0, 0, 0xf8, 0x3f}, "1.5"},
+		}
+		for _, tt := range tests {
+			var got Decimal128
+			if err := got.UnmarshalBSONValue(tt.typ, tt.data); err != nil {
+				t.Errorf("UnmarshalBSONValue(%#02x, %x) failed: %v", tt.typ, tt.data, err)
+				continue
+			}
+			if want := MustParse128(tt.want); got != want {
+				t.Errorf("UnmarshalBSONValue(%#02x, %x) = %q, want %q", tt.typ, tt.data, got, want)
+			}
+		}
+
+		// Null leaves the decimal unchanged
+		got := MustParse128("1.23")
+		if err := got.UnmarshalBSONValue(bsonNull, nil); err != nil {
+			t.Errorf("UnmarshalBSONValue(null) failed: %v", err)
+		} else if want := MustParse128("1.23"); got != want {
+			t.Errorf("UnmarshalBSONValue(null) = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			typ  byte
+			data []byte
+		}{
+			{bsonDecimal128, []byte{1, 2, 3}},
+			{bsonDecimal128, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x78}}, // infinity
+			{bsonString, []byte{1, 0, 0, 0}},
+			{bsonInt32, []byte{1}},
+			{0x08, []byte{1}},
+		}
+		for _, tt := range tests {
+			var d Decimal128
+			if err := d.UnmarshalBSONValue(tt.typ, tt.data); err == nil {
+				t.Errorf("UnmarshalBSONValue(%#02x, %x) did not fail", tt.typ, tt.data)
+			}
+		}
+	})
+}
+
+func TestDecimal128_redaction(t *testing.T) {
+	d := MustParse128("-1234.56")
+	_, err := d.Quo(Decimal128{})
+	err = RedactError(err)
+	if got, want := err.Error(), "computing [-xxxx.xx / x]: division by zero"; got != want {
+		t.Errorf("%q.Quo(0) error = %q, want %q", d, got, want)
+	}
+
+	d = MustParse128("12345678901234567890")
+	_, err = d.Decimal()
+	if err == nil {
+		t.Fatalf("%q.Decimal() did not fail", d)
+	}
+	err = RedactError(err)
+	if got, want := err.Error(), "converting xxxxxxxxxxxxxxxxxxxx to decimal.Decimal: "; !strings.HasPrefix(got, want) {
+		t.Errorf("%q.Decimal() error = %q, want prefix %q", d, got, want)
+	}
+}
@@ -0,0 +1,2056 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+)
+
+// hasBint reports whether *big.Int arithmetic is available.
+const hasBint = true
+
+// newFromBint creates a new decimal from *big.Int coefficient.
+// This method uses overflowError to return descriptive errors.
+func newFromBint(neg bool, coef *bint, scale, minScale int) (Decimal, error) {
+	// Overflow validation
+	prec := coef.prec()
+	if prec-scale > MaxPrec-minScale {
+		return Decimal{}, overflowError(prec, scale, minScale)
+	}
+	// Scale normalization
+	switch {
+	case scale < minScale:
+		coef.lsh(coef, minScale-scale)
+		scale = minScale
+	case scale >= prec && scale > MaxScale: // no integer part
+		coef.rshHalfEven(coef, scale-MaxScale)
+		scale = MaxScale
+	case prec > scale && prec > MaxPrec: // there is an integer part
+		coef.rshHalfEven(coef, prec-MaxPrec)
+		scale = MaxPrec - prec + scale
+	}
+	// Handling the rare case when rshHalfEven rounded
+	// a 19-digit coefficient to a 20-digit coefficient.
+	if coef.hasPrec(MaxPrec + 1) {
+		return newFromBint(neg, coef, scale, minScale)
+	}
+	return newSafe(neg, coef.fint(), scale)
+}
+
+// parseBint parses a decimal string using *big.Int arithmetic.
+// parseBint supports exponential notation with the absolute value of
+// the exponent up to maxExp.
+func parseBint(s string, minScale, maxExp int) (Decimal, error) {
+	bcoef := getBint()
+	defer putBint(bcoef)
+
+	neg, scale, err := parseBintTo(bcoef, s, maxExp)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return newFromBint(neg, bcoef, scale, minScale)
+}
+
+// parseModeBint parses a decimal string using *big.Int arithmetic and
+// rounds it as described in roundOp.
+func parseModeBint(s string, scale, maxExp int, mode RoundingMode, rnd *rand.Rand) (Decimal, error) {
+	num := getBint()
+	defer putBint(num)
+
+	den := getBint()
+	defer putBint(den)
+	den.setFint(1)
+
+	neg, xscale, err := parseBintTo(num, s, maxExp)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return roundQuoBint(neg, num, den, xscale, scale, MaxPrec, mode, rnd)
+}
+
+// parseBintTo sets bcoef to the exact coefficient of a decimal string
+// and returns the sign and the scale of the decimal.
+// The scale is negative if the exponent is greater than the number of
+// digits after the decimal point.
+//
+//nolint:gocyclo
+func parseBintTo(bcoef *bint, s string, maxExp int) (neg bool, scale int, err error) {
+	var pos int
+	width := len(s)
+
+	// Sign
+	switch {
+	case pos == width:
+		// skip
+	case s[pos] == '-':
+		neg = true
+		pos++
+	case s[pos] == '+':
+		pos++
+	}
+
+	// Coefficient
+	bcoef.setFint(0)
+	var fcoef fint
+	var shift int
+	var hasCoef, ok bool
+
+	// Algorithm:
+	// 	1. Add as many digits as possible to the uint64 coefficient (fast).
+	// 	2. Once the uint64 coefficient has reached its maximum value,
+	//     add it to the *big.Int coefficient (slow).
+	// 	3. Repeat until all digits are processed.
+
+	// Integer
+	for pos < width && s[pos] >= '0' && s[pos] <= '9' {
+		fcoef, ok = fcoef.fsa(1, s[pos]-'0')
+		if !ok {
+			return false, 0, errDecimalOverflow // Should never happen
+		}
+		pos++
+		shift++
+		hasCoef = true
+		if fcoef.hasPrec(MaxPrec) {
+			bcoef.fsa(bcoef, shift, fcoef)
+			fcoef, shift = 0, 0
+		}
+	}
+
+	// Fraction
+	if pos < width && s[pos] == '.' {
+		pos++
+		for pos < width && s[pos] >= '0' && s[pos] <= '9' {
+			fcoef, ok = fcoef.fsa(1, s[pos]-'0')
+			if !ok {
+				return false, 0, errDecimalOverflow // Should never happen
+			}
+			pos++
+			scale++
+			shift++
+			hasCoef = true
+			if fcoef.hasPrec(MaxPrec) {
+				bcoef.fsa(bcoef, shift, fcoef)
+				fcoef, shift = 0, 0
+			}
+		}
+	}
+	if shift > 0 {
+		bcoef.fsa(bcoef, shift, fcoef)
+	}
+
+	// Exponent
+	var exp int
+	var eneg, hasExp, hasE bool
+	if pos < width && (s[pos] == 'e' || s[pos] == 'E') {
+		pos++
+		hasE = true
+		// Sign
+		switch {
+		case pos == width:
+			// skip
+		case s[pos] == '-':
+			eneg = true
+			pos++
+		case s[pos] == '+':
+			pos++
+		}
+		// Integer
+		for pos < width && s[pos] >= '0' && s[pos] <= '9' {
+			exp = exp*10 + int(s[pos]-'0')
+			if exp > maxExp {
+				return false, 0, &LimitError{Limit: "exponent", Max: maxExp}
+			}
+			pos++
+			hasExp = true
+		}
+	}
+
+	if pos != width {
+		return false, 0, errorf("%w: unexpected character %q", errInvalidDecimal, s[pos])
+	}
+	if !hasCoef {
+		return false, 0, errorf("%w: no coefficient", errInvalidDecimal)
+	}
+	if hasE && !hasExp {
+		return false, 0, errorf("%w: no exponent", errInvalidDecimal)
+	}
+
+	if eneg {
+		scale = scale + exp
+	} else {
+		scale = scale - exp
+	}
+
+	return neg, scale, nil
+}
+
+// prodBint computes the product of decimals using *big.Int arithmetic.
+func prodBint(d ...Decimal) (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(One.coef)
+	escale := One.Scale()
+	eneg := One.IsNeg()
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	for _, f := range d {
+		fcoef.setFint(f.coef)
+
+		// Compute e = e * f
+		ecoef.mul(ecoef, fcoef)
+		eneg = eneg != f.IsNeg()
+		escale = escale + f.Scale()
+
+		// Intermediate truncation
+		if escale > 2*MaxScale {
+			shift := escale - 2*MaxScale
+			ecoef.rshDown(ecoef, shift)
+			escale = 2 * MaxScale
+		}
+	}
+
+	return newFromBint(eneg, ecoef, escale, 0)
+}
+
+// mulBint computes the product of two decimals using *big.Int arithmetic.
+func (d Decimal) mulBint(e Decimal, minScale int) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+	dscale := d.Scale()
+	dneg := d.IsNeg()
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+
+	// Compute d = d * e
+	dcoef.mul(dcoef, ecoef)
+	dneg = dneg != e.IsNeg()
+	dscale = dscale + e.Scale()
+
+	return newFromBint(dneg, dcoef, dscale, minScale)
+}
+
+// powIntBint computes the integer power of a decimal using *big.Int arithmetic.
+// powIntBint supports negative powers.
+func (d Decimal) powIntBint(ctx context.Context, power int) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+	dneg := d.IsNeg()
+	dscale := d.Scale()
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(One.coef)
+	eneg := One.IsNeg()
+	escale := One.Scale()
+
+	inv := false
+	if power < 0 {
+		power = -power
+		inv = true
+	}
+
+	// Exponentiation by squaring
+	for power > 0 {
+		if err := ctx.Err(); err != nil {
+			return Decimal{}, err
+		}
+		if power%2 == 1 {
+			power = power - 1
+
+			// Compute e = e * d
+			ecoef.mul(ecoef, dcoef)
+			eneg = eneg != dneg
+			escale = escale + dscale
+
+			// Intermediate truncation
+			if escale > 3*MaxScale {
+				shift := escale - 3*MaxScale
+				ecoef.rshDown(ecoef, shift)
+				escale = 3 * MaxScale
+			}
+		}
+		if power > 0 {
+			power = power / 2
+
+			// Compute d = d * d
+			dcoef.mul(dcoef, dcoef)
+			dneg = false
+			dscale = dscale * 2
+
+			// Intermediate truncation
+			if dscale > 3*MaxScale {
+				shift := dscale - 3*MaxScale
+				dcoef.rshDown(dcoef, shift)
+				dscale = 3 * MaxScale
+			}
+		}
+	}
+
+	if inv {
+		if ecoef.sign() == 0 {
+			return Decimal{}, unknownOverflowError(0)
+		}
+
+		// Compute e = 1 / e
+		ecoef.quo(bpow10[2*MaxScale+escale], ecoef)
+		escale = 2 * MaxScale
+	}
+
+	return newFromBint(eneg, ecoef, escale, 0)
+}
+
+// sqrtBint computes the square root of a decimal using *big.Int arithmetic.
+func (d Decimal) sqrtBint(ctx context.Context) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	escale := 2 * MaxScale
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.setFint(0)
+
+	// Alignment
+	dcoef.lsh(dcoef, 4*MaxScale-d.Scale())
+
+	// Initial guess is calculated as 10^(n/2), where n is the position of
+	// the most significant digit (n is negative if -1 < d < 1).
+	n := dcoef.prec() - 4*MaxScale
+	ecoef.setBint(bpow10[n/2+escale])
+
+	// Newton's method
+	for range 50 {
+		if ecoef.cmp(fcoef) == 0 {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return Decimal{}, err
+		}
+		fcoef.setBint(ecoef)
+		ecoef.quo(dcoef, ecoef)
+		ecoef.add(ecoef, fcoef)
+		ecoef.hlf(ecoef)
+	}
+
+	return newFromBint(false, ecoef, escale, 0)
+}
+
+// expScratch holds temporary values used by expBintWith, so that they can be
+// reused when computing exponentials of many decimals.
+type expScratch struct {
+	e, f, r, g, h, t *bint
+	ctx              context.Context // optional, checked after every term
+}
+
+func (s *expScratch) get() {
+	s.e, s.f, s.r, s.g, s.h, s.t = getBint(), getBint(), getBint(), getBint(), getBint(), getBint()
+}
+
+func (s *expScratch) put() {
+	putBint(s.e)
+	putBint(s.f)
+	putBint(s.r)
+	putBint(s.g)
+	putBint(s.h)
+	putBint(s.t)
+}
+
+// err returns the error of the optional context.
+func (s *expScratch) err() error {
+	if s.ctx == nil {
+		return nil
+	}
+	return s.ctx.Err()
+}
+
+// expBint computes exponential of a decimal using *big.Int arithmetic.
+func (d Decimal) expBint(ctx context.Context) (Decimal, error) {
+	s := expScratch{ctx: ctx}
+	s.get()
+	defer s.put()
+	return d.expBintWith(&s)
+}
+
+// expSliceBint computes exponentials of decimals using *big.Int arithmetic,
+// reusing temporary values across decimals.
+// It returns the index of the decimal that caused an error.
+func expSliceBint(d, e []Decimal) (int, error) {
+	var s expScratch
+	s.get()
+	defer s.put()
+	for i, f := range d {
+		if f.IsZero() {
+			e[i] = One
+			continue
+		}
+		g, err := f.expBintWith(&s)
+		if err != nil {
+			return i, err
+		}
+		e[i] = g.Trim(0)
+	}
+	return 0, nil
+}
+
+// expBintWith computes exponential of a decimal using *big.Int arithmetic
+// and the given temporary values.
+func (d Decimal) expBintWith(s *expScratch) (Decimal, error) {
+	dcoef := d.coef
+	dscale := d.Scale()
+
+	// Split |d| into integer part q and fractional part r
+	q, r, ok := dcoef.quoRem(pow10[dscale])
+	if !ok {
+		return Decimal{}, errDecimalOverflow // Should never happen
+	}
+
+	// Check underflow and overflow
+	if q >= fint(len(bexp)) {
+		if d.IsNeg() {
+			return newSafe(false, 0, 0)
+		}
+		return Decimal{}, unknownOverflowError(0)
+	}
+
+	// Retrieve e = exp(q) from precomputed cache
+	ecoef := s.e
+	ecoef.setBint(bexp[q])
+	escale := 2 * MaxScale
+
+	if r != 0 {
+		// Compute f = exp(r) using Taylor series expansion
+		fcoef := s.f
+		fcoef.setFint(0)
+		fscale := 2 * MaxScale
+
+		rcoef := s.r
+		rcoef.setFint(r)
+		rscale := dscale
+
+		gcoef := s.g
+		gcoef.setBint(bpow10[2*MaxScale])
+		gscale := 2 * MaxScale
+
+		hcoef := s.h
+
+		// Alignment
+		if rscale < 2*MaxScale {
+			rcoef.lsh(rcoef, 2*MaxScale-rscale)
+			rscale = 2 * MaxScale
+		}
+
+		// Compute f = exp(r) = r^0 / 0! + r^1 / 1! + ... + r^n / n!
+		for i := range len(bfact) {
+			// Accumulate f = f + r^i / i!
+			hcoef.quoRem(gcoef, bfact[i], s.t)
+			if hcoef.sign() == 0 {
+				break
+			}
+			if err := s.err(); err != nil {
+				return Decimal{}, err
+			}
+			fcoef.add(fcoef, hcoef)
+
+			// Compute g = r^(i+1)
+			gcoef.mul(gcoef, rcoef)
+			gscale = gscale + rscale
+
+			// Intermediate truncation
+			if gscale > 2*MaxScale {
+				shift := gscale - 2*MaxScale
+				gcoef.rshDown(gcoef, shift)
+				gscale = 2 * MaxScale
+			}
+		}
+
+		// Compute exp(|d|) = exp(q) * exp(r)
+		ecoef.mul(ecoef, fcoef)
+		escale = escale + fscale
+
+		// Intermediate truncation
+		if escale > 2*MaxScale {
+			shift := escale - 2*MaxScale
+			ecoef.rshDown(ecoef, shift)
+			escale = 2 * MaxScale
+		}
+	}
+
+	if d.IsNeg() {
+		if ecoef.sign() == 0 {
+			return Decimal{}, unknownOverflowError(0)
+		}
+
+		// Compute exp(d) = 1 / exp(|d|)
+		ecoef.quoRem(bpow10[2*MaxScale+escale], ecoef, s.t)
+		escale = 2 * MaxScale
+	}
+
+	return newFromBint(false, ecoef, escale, 0)
+}
+
+// logScratch holds temporary values used by logBintToWith, so that they can be
+// reused when computing logarithms of many decimals.
+// It also remembers the last computed logarithm g and the position gn of
+// the most significant digit of its argument, so that the next logarithm
+// of a decimal of the same magnitude can start from a better initial guess.
+type logScratch struct {
+	d, f, E, n, m, t, g *bint
+	gn                  int
+	e                   eScratch
+	ctx                 context.Context // optional, checked after every iteration
+}
+
+func (s *logScratch) get() {
+	s.d, s.f, s.E, s.n, s.m, s.t, s.g = getBint(), getBint(), getBint(), getBint(), getBint(), getBint(), getBint()
+	s.gn = 0
+	s.e.get()
+}
+
+func (s *logScratch) put() {
+	putBint(s.d)
+	putBint(s.f)
+	putBint(s.E)
+	putBint(s.n)
+	putBint(s.m)
+	putBint(s.t)
+	putBint(s.g)
+	s.e.put()
+}
+
+// err returns the error of the optional context.
+func (s *logScratch) err() error {
+	if s.ctx == nil {
+		return nil
+	}
+	return s.ctx.Err()
+}
+
+// logBint computes the natural logarithm of a decimal using *big.Int arithmetic.
+func (d Decimal) logBint(ctx context.Context) (Decimal, error) {
+	s := logScratch{ctx: ctx}
+	s.get()
+	defer s.put()
+	ecoef := getBint()
+	defer putBint(ecoef)
+	eneg := d.logBintToWith(ecoef, &s)
+	if err := s.err(); err != nil {
+		return Decimal{}, err
+	}
+	return newFromBint(eneg, ecoef, 2*MaxScale, 0)
+}
+
+// logSliceBint computes natural logarithms of positive decimals using
+// *big.Int arithmetic, reusing temporary values across decimals.
+// It returns the index of the decimal that caused an error.
+func logSliceBint(d, e []Decimal) (int, error) {
+	var s logScratch
+	s.get()
+	defer s.put()
+	ecoef := getBint()
+	defer putBint(ecoef)
+	for i, f := range d {
+		if f.IsOne() {
+			e[i] = Zero
+			continue
+		}
+		eneg := f.logBintToWith(ecoef, &s)
+		g, err := newFromBint(eneg, ecoef, 2*MaxScale, 0)
+		if err != nil {
+			return i, err
+		}
+		e[i] = g.Trim(0)
+	}
+	return 0, nil
+}
+
+// logBintTo sets ecoef to the coefficient of the natural logarithm of
+// a positive decimal with a scale of 2 * MaxScale and returns its sign.
+func (d Decimal) logBintTo(ecoef *bint) (eneg bool) {
+	var s logScratch
+	s.get()
+	defer s.put()
+	return d.logBintToWith(ecoef, &s)
+}
+
+// logBintToWith is similar to logBintTo, but it uses the given temporary values.
+func (d Decimal) logBintToWith(ecoef *bint, s *logScratch) (eneg bool) {
+	dcoef := s.d
+	dcoef.setFint(d.coef)
+
+	// Alignment and sign
+	eneg = true
+	if d.WithinOne() {
+		dcoef.quoRem(bpow10[2*MaxScale+d.Scale()], dcoef, s.t)
+	} else {
+		dcoef.lsh(dcoef, 2*MaxScale-d.Scale())
+		eneg = false
+	}
+
+	ecoef.logWith(dcoef, s)
+	return eneg
+}
+
+// log calculates z = ln(x), where x and z are coefficients with a scale
+// of 2 * MaxScale and x >= 10^(2 * MaxScale).
+func (z *bint) log(x *bint) {
+	var s logScratch
+	s.get()
+	defer s.put()
+	z.logWith(x, &s)
+}
+
+// logWith is similar to log, but it uses the given temporary values.
+// The argument x must not be one of the temporary values, except for s.d.
+func (z *bint) logWith(x *bint, s *logScratch) {
+	dcoef := x
+	ecoef := z
+
+	fcoef := s.f
+	fcoef.setFint(0)
+
+	// The initial guess is calculated as n * ln(10),
+	// where n is the position of the most significant digit.
+	// If the previous logarithm was computed for a decimal of the same
+	// magnitude, it is used as the initial guess instead.
+	n := dcoef.prec() - 2*MaxScale
+
+	// Special case: power of ten
+	if dcoef.cmp(bpow10[n-1+2*MaxScale]) == 0 {
+		ecoef.setBint(bnlog10[n-1])
+		return
+	}
+
+	if s.gn == n {
+		ecoef.setBint(s.g)
+	} else {
+		ecoef.setBint(bnlog10[n])
+	}
+
+	Ecoef := s.E
+	ncoef := s.n
+	mcoef := s.m
+
+	// Halley's method
+	for range 50 {
+		Ecoef.eWith(ecoef, &s.e)
+
+		ncoef.sub(Ecoef, dcoef)
+		ncoef.dbl(ncoef)
+
+		mcoef.add(Ecoef, dcoef)
+
+		ncoef.lsh(ncoef, 2*MaxScale)
+		ncoef.quoRem(ncoef, mcoef, s.t)
+
+		fcoef.sub(ecoef, ncoef)
+
+		if ecoef.cmp(fcoef) == 0 {
+			break
+		}
+		if s.err() != nil {
+			// The caller checks the context again and discards the result
+			return
+		}
+
+		ecoef.setBint(fcoef)
+	}
+
+	s.g.setBint(ecoef)
+	s.gn = n
+}
+
+// geoMeanBint computes the geometric mean of positive decimals as
+// exp((log(d[0]) + log(d[1]) + ... + log(d[n-1])) / n) using *big.Int arithmetic.
+func geoMeanBint(d ...Decimal) (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(0)
+	escale := 2 * MaxScale
+	eneg := false
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	// Compute e = log(d[0]) + log(d[1]) + ... + log(d[n-1])
+	for _, f := range d {
+		if f.IsOne() {
+			continue
+		}
+		fneg := f.logBintTo(fcoef)
+		eneg, escale = accumulateBint(eneg, ecoef, escale, fneg, fcoef, 2*MaxScale)
+	}
+
+	// Compute e = e / n
+	fcoef.setInt64(int64(len(d)))
+	ecoef.quo(ecoef, fcoef)
+
+	// Compute g = exp(e)
+	gcoef := getBint()
+	defer putBint(gcoef)
+	gcoef.e(ecoef)
+	if eneg {
+		gcoef.quo(bpow10[4*MaxScale], gcoef)
+	}
+
+	return newFromBint(false, gcoef, 2*MaxScale, 0)
+}
+
+// cagrBint computes the compound annual growth rate of positive decimals as
+// exp((log(end) - log(begin)) / periods) - 1 using *big.Int arithmetic.
+func cagrBint(begin, end Decimal, periods int) (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(0)
+	eneg := false
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	// Compute e = log(end) - log(begin)
+	if !end.IsOne() {
+		eneg = end.logBintTo(ecoef)
+	}
+	if !begin.IsOne() {
+		fneg := begin.logBintTo(fcoef)
+		eneg, _ = accumulateBint(eneg, ecoef, 2*MaxScale, !fneg, fcoef, 2*MaxScale)
+	}
+
+	// Compute e = e / periods
+	fcoef.setInt64(int64(periods))
+	ecoef.quo(ecoef, fcoef)
+
+	// Check underflow and overflow
+	fcoef.setInt64(int64(len(bexp)))
+	fcoef.lsh(fcoef, 2*MaxScale)
+	if ecoef.cmp(fcoef) >= 0 {
+		if eneg {
+			return NegOne, nil
+		}
+		return Decimal{}, unknownOverflowError(0)
+	}
+
+	// Compute g = exp(e)
+	gcoef := getBint()
+	defer putBint(gcoef)
+	gcoef.e(ecoef)
+	if eneg {
+		gcoef.quo(bpow10[4*MaxScale], gcoef)
+	}
+
+	// Compute g = g - 1
+	gneg := gcoef.cmp(bpow10[2*MaxScale]) < 0
+	gcoef.subAbs(gcoef, bpow10[2*MaxScale])
+
+	return newFromBint(gneg, gcoef, 2*MaxScale, 0)
+}
+
+// powBint computes the power of a positive decimal as exp(e * log(d))
+// using *big.Int arithmetic.
+func (d Decimal) powBint(e Decimal) (Decimal, error) {
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.setFint(0)
+	fneg := false
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+
+	// Compute f = e * log(d)
+	if !d.IsOne() {
+		fneg = d.logBintTo(fcoef)
+	}
+	gcoef.setFint(e.coef)
+	fcoef.mul(fcoef, gcoef)
+	fcoef.rshDown(fcoef, e.Scale())
+	fneg = fneg != e.IsNeg()
+
+	// Check underflow and overflow
+	gcoef.setInt64(int64(len(bexp)))
+	gcoef.lsh(gcoef, 2*MaxScale)
+	if fcoef.cmp(gcoef) >= 0 {
+		if fneg {
+			return newSafe(false, 0, MaxScale)
+		}
+		return Decimal{}, unknownOverflowError(0)
+	}
+
+	// Compute g = exp(f)
+	gcoef.e(fcoef)
+	if fneg {
+		gcoef.quo(bpow10[4*MaxScale], gcoef)
+	}
+
+	return newFromBint(false, gcoef, 2*MaxScale, 0)
+}
+
+// harmonicMeanBint computes the harmonic mean of positive decimals as
+// n / (1 / d[0] + 1 / d[1] + ... + 1 / d[n-1]) using *big.Int arithmetic.
+// The reciprocals and the result are rounded to 2 * MaxScale digits after
+// the decimal point using half-to-even rounding.
+func harmonicMeanBint(d ...Decimal) (Decimal, error) {
+	scoef := getBint()
+	defer putBint(scoef)
+	scoef.setFint(0)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+	qcoef := getBint()
+	defer putBint(qcoef)
+
+	// Compute s = 1 / d[0] + 1 / d[1] + ... + 1 / d[n-1]
+	for _, f := range d {
+		fcoef.setFint(f.coef)
+		qcoef.lsh(bpow10[1], 2*MaxScale+f.Scale())
+		qcoef.quo(qcoef, fcoef)
+		qcoef.rshHalfEven(qcoef, 1)
+		scoef.add(scoef, qcoef)
+	}
+
+	// Compute e = n / s
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setInt64(int64(len(d)))
+	ecoef.lsh(ecoef, 4*MaxScale+1)
+	ecoef.quo(ecoef, scoef)
+	ecoef.rshHalfEven(ecoef, 1)
+
+	return newFromBint(false, ecoef, 2*MaxScale, 0)
+}
+
+// vwapBint computes the volume-weighted average price using *big.Int arithmetic.
+func vwapBint(prices, quantities []Decimal) (Decimal, error) {
+	// Compute n = p[0] * q[0] + ... + p[n-1] * q[n-1]
+	ncoef := getBint()
+	defer putBint(ncoef)
+	ncoef.setFint(Zero.coef)
+	nneg, nscale := Zero.IsNeg(), Zero.Scale()
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+	qcoef := getBint()
+	defer putBint(qcoef)
+
+	for i, p := range prices {
+		q := quantities[i]
+		fcoef.setFint(p.coef)
+		qcoef.setFint(q.coef)
+		fcoef.mul(fcoef, qcoef)
+		nneg, nscale = accumulateBint(nneg, ncoef, nscale, p.IsNeg(), fcoef, p.Scale()+q.Scale())
+	}
+
+	// Compute d = q[0] + ... + q[n-1]
+	dcoef := getBint()
+	defer putBint(dcoef)
+	_, dscale := sumBintTo(dcoef, quantities)
+
+	// Alignment
+	ncoef.lsh(ncoef, 2*MaxScale+dscale-nscale)
+
+	// Compute n = ⌊n / d⌋
+	ncoef.quo(ncoef, dcoef)
+
+	return newFromBint(nneg, ncoef, 2*MaxScale, 0)
+}
+
+// eScratch holds temporary values used by eWith, so that they can be
+// reused across iterations of Halley's method.
+type eScratch struct {
+	q, r, z, g, h, t *bint
+}
+
+func (s *eScratch) get() {
+	s.q, s.r, s.z, s.g, s.h, s.t = getBint(), getBint(), getBint(), getBint(), getBint(), getBint()
+}
+
+func (s *eScratch) put() {
+	putBint(s.q)
+	putBint(s.r)
+	putBint(s.z)
+	putBint(s.g)
+	putBint(s.h)
+	putBint(s.t)
+}
+
+// e computes the exponential of a decimal using *big.Int arithmetic.
+func (z *bint) e(x *bint) {
+	var s eScratch
+	s.get()
+	defer s.put()
+	z.eWith(x, &s)
+}
+
+// eWith is similar to e, but it uses the given temporary values.
+func (z *bint) eWith(x *bint, s *eScratch) {
+	qcoef := s.q
+	rcoef := s.r
+	rscale := 2 * MaxScale
+
+	qcoef.quoRem(x, bpow10[rscale], rcoef)
+
+	zcoef := s.z
+	zcoef.setFint(0)
+
+	gcoef := s.g
+	gcoef.setBint(bpow10[2*MaxScale])
+	gscale := 2 * MaxScale
+
+	hcoef := s.h
+
+	// Compute f = exp(r) = r^0 / 0! + r^1 / 1! + ... + r^n / n!
+	for i := range len(bfact) {
+		// Accumulate f = f + r^i / i!
+		hcoef.quoRem(gcoef, bfact[i], s.t)
+		if hcoef.sign() == 0 {
+			break
+		}
+		zcoef.add(zcoef, hcoef)
+
+		// Compute g = r^(i+1)
+		gcoef.mul(gcoef, rcoef)
+		gscale = gscale + rscale
+
+		// Intermediate truncation
+		if gscale > 2*MaxScale {
+			shift := gscale - 2*MaxScale
+			gcoef.rshDown(gcoef, shift)
+			gscale = 2 * MaxScale
+		}
+	}
+
+	// nolint:gosec
+	zcoef.mul(zcoef, bexp[int(qcoef.fint())])
+	zcoef.quoRem(zcoef, bpow10[2*MaxScale], s.t)
+
+	z.setBint(zcoef)
+}
+
+// sumBint computes the sum of decimals using *big.Int arithmetic.
+func sumBint(d ...Decimal) (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	eneg, escale := sumBintTo(ecoef, d)
+	return newFromBint(eneg, ecoef, escale, 0)
+}
+
+// meanBint computes the arithmetic mean of decimals using *big.Int arithmetic.
+func meanBint(d ...Decimal) (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	eneg, escale := sumBintTo(ecoef, d)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.setInt64(int64(len(d)))
+
+	// Alignment
+	ecoef.lsh(ecoef, 2*MaxScale)
+
+	// Compute e = ⌊e / n⌋
+	ecoef.quo(ecoef, fcoef)
+
+	e, err := newFromBint(eneg, ecoef, escale+2*MaxScale, 0)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	// Preferred scale
+	return e.Trim(escale), nil
+}
+
+// pctChangeBint computes the percentage change using *big.Int arithmetic.
+func pctChangeBint(from, to Decimal, scale int) (Decimal, error) {
+	// Compute n = to - from
+	ncoef := getBint()
+	defer putBint(ncoef)
+	nneg, nscale := sumBintTo(ncoef, []Decimal{to, from.Neg()})
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.setFint(from.coef)
+
+	// Alignment
+	shift := scale + 2 + from.Scale() - nscale
+	switch {
+	case shift > 0:
+		ncoef.lsh(ncoef, shift)
+	case shift < 0:
+		fcoef.lsh(fcoef, -shift)
+	}
+
+	// Compute q = round(n * 10^(scale + 2) / |from|)
+	qcoef := getBint()
+	defer putBint(qcoef)
+	rcoef := getBint()
+	defer putBint(rcoef)
+	qcoef.quoRem(ncoef, fcoef, rcoef)
+
+	// Reducing the scale to fit the result into MaxPrec digits,
+	// so that the result is still rounded only once
+	if prec := qcoef.prec(); prec > MaxPrec {
+		if prec-scale > MaxPrec {
+			return Decimal{}, overflowError(prec, scale, 0)
+		}
+		return pctChangeBint(from, to, scale-(prec-MaxPrec))
+	}
+
+	rcoef.dbl(rcoef)
+	if c := rcoef.cmp(fcoef); c > 0 || (c == 0 && qcoef.isOdd()) { // half-to-even
+		qcoef.inc(qcoef)
+	}
+
+	return newFromBint(nneg, qcoef, scale, 0)
+}
+
+// convertRateBint computes rate * num / den using *big.Int arithmetic.
+func convertRateBint(rate Decimal, num, den fint) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(rate.coef)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+	ncoef.setFint(num)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(den)
+
+	// Compute d = rate * num
+	dcoef.mul(dcoef, ncoef)
+
+	// Alignment
+	dcoef.lsh(dcoef, 2*MaxScale-rate.Scale())
+
+	// Compute d = ⌊d / den⌋
+	dcoef.quo(dcoef, ecoef)
+
+	return newFromBint(rate.IsNeg(), dcoef, 2*MaxScale, 0)
+}
+
+// sumBintTo sets ecoef to the coefficient of the exact sum of decimals
+// and returns the sign and the scale of the sum.
+func sumBintTo(ecoef *bint, d []Decimal) (eneg bool, escale int) {
+	ecoef.setFint(Zero.coef)
+	escale = Zero.Scale()
+	eneg = Zero.IsNeg()
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	for _, f := range d {
+		fcoef.setFint(f.coef)
+		eneg, escale = accumulateBint(eneg, ecoef, escale, f.IsNeg(), fcoef, f.Scale())
+	}
+
+	return eneg, escale
+}
+
+// accumulateBint computes e = e + f, where decimals e and f are represented
+// by their signs, coefficients, and scales.
+// It modifies both coefficients and returns the sign and the scale of e.
+func accumulateBint(eneg bool, ecoef *bint, escale int, fneg bool, fcoef *bint, fscale int) (bool, int) {
+	// Alignment
+	switch {
+	case escale > fscale:
+		fcoef.lsh(fcoef, escale-fscale)
+	case escale < fscale:
+		ecoef.lsh(ecoef, fscale-escale)
+		escale = fscale
+	}
+
+	// Compute e = e + f
+	if eneg == fneg {
+		ecoef.add(ecoef, fcoef)
+	} else {
+		if fcoef.cmp(ecoef) > 0 {
+			eneg = fneg
+		}
+		ecoef.subAbs(ecoef, fcoef)
+	}
+
+	return eneg, escale
+}
+
+// sumParallelBint computes the sum of decimals by splitting them into
+// the given number of chunks and summing each chunk in a separate goroutine.
+// Partial sums are exact, so the result does not depend on the number of chunks.
+func sumParallelBint(d []Decimal, workers int) (Decimal, error) {
+	size := (len(d) + workers - 1) / workers
+	workers = (len(d) + size - 1) / size
+
+	negs := make([]bool, workers)
+	coefs := make([]*bint, workers)
+	scales := make([]int, workers)
+
+	var wg sync.WaitGroup
+	for i := range workers {
+		coefs[i] = getBint()
+		defer putBint(coefs[i])
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			chunk := d[i*size : min((i+1)*size, len(d))]
+			// Fast path: most chunks do not overflow uint64 arithmetic
+			if e, err := sumFint(chunk...); err == nil {
+				negs[i], scales[i] = e.IsNeg(), e.Scale()
+				coefs[i].setFint(e.coef)
+				return
+			}
+			negs[i], scales[i] = sumBintTo(coefs[i], chunk)
+		}()
+	}
+	wg.Wait()
+
+	// Merging partial sums
+	eneg, ecoef, escale := negs[0], coefs[0], scales[0]
+	for i := 1; i < workers; i++ {
+		eneg, escale = accumulateBint(eneg, ecoef, escale, negs[i], coefs[i], scales[i])
+	}
+
+	return newFromBint(eneg, ecoef, escale, 0)
+}
+
+// addBint computes the sum of two decimals using *big.Int arithmetic.
+func (d Decimal) addBint(e Decimal, minScale int) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+	dscale := d.Scale()
+	dneg := d.IsNeg()
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+
+	// Alignment
+	switch {
+	case dscale > e.Scale():
+		ecoef.lsh(ecoef, dscale-e.Scale())
+	case dscale < e.Scale():
+		dcoef.lsh(dcoef, e.Scale()-dscale)
+		dscale = e.Scale()
+	}
+
+	// Compute d = d + e
+	if dneg == e.IsNeg() {
+		dcoef.add(dcoef, ecoef)
+	} else {
+		if ecoef.cmp(dcoef) > 0 {
+			dneg = e.IsNeg()
+		}
+		dcoef.subAbs(dcoef, ecoef)
+	}
+
+	return newFromBint(dneg, dcoef, dscale, minScale)
+}
+
+// addMulBint computes the fused multiply-addition of three decimals using *big.Int arithmetic.
+func (d Decimal) addMulBint(e, f Decimal, minScale int) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+	dscale := d.Scale()
+	dneg := d.IsNeg()
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+	escale := e.Scale()
+	eneg := e.IsNeg()
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.setFint(f.coef)
+
+	// Compute e = e * f
+	ecoef.mul(ecoef, fcoef)
+	escale = escale + f.Scale()
+	eneg = eneg != f.IsNeg()
+
+	// Alignment
+	switch {
+	case dscale > escale:
+		ecoef.lsh(ecoef, dscale-escale)
+	case dscale < escale:
+		dcoef.lsh(dcoef, escale-d.Scale())
+		dscale = escale
+	}
+
+	// Compute d = d + e
+	if dneg == eneg {
+		dcoef.add(dcoef, ecoef)
+	} else {
+		if ecoef.cmp(dcoef) > 0 {
+			dneg = eneg
+		}
+		dcoef.subAbs(dcoef, ecoef)
+	}
+
+	return newFromBint(dneg, dcoef, dscale, minScale)
+}
+
+// addQuoBint computes the fused quotient-addition of three decimals using *big.Int arithmetic.
+func (d Decimal) addQuoBint(e, f Decimal, minScale int) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+	dneg := d.IsNeg()
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+	eneg := e.IsNeg()
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.setFint(f.coef)
+
+	// Alignment
+	ecoef.lsh(ecoef, 2*MaxScale-e.Scale()+f.Scale())
+
+	// Compute e = ⌊e / f⌋
+	ecoef.quo(ecoef, fcoef)
+	eneg = eneg != f.IsNeg()
+
+	// Alignment
+	dcoef.lsh(dcoef, 2*MaxScale-d.Scale())
+
+	// Compute d = d + e
+	if dneg == eneg {
+		dcoef.add(dcoef, ecoef)
+	} else {
+		if ecoef.cmp(dcoef) > 0 {
+			dneg = eneg
+		}
+		dcoef.subAbs(dcoef, ecoef)
+	}
+
+	return newFromBint(dneg, dcoef, 2*MaxScale, minScale)
+}
+
+// quoBint computes the quotient of two decimals using *big.Int arithmetic.
+func (d Decimal) quoBint(e Decimal, minScale int) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+	dneg := d.IsNeg()
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+
+	// Alignment
+	dcoef.lsh(dcoef, 2*MaxScale+e.Scale()-d.Scale())
+
+	// Compute d = ⌊d / e⌋
+	dcoef.quo(dcoef, ecoef)
+	dneg = dneg != e.IsNeg()
+
+	return newFromBint(dneg, dcoef, 2*MaxScale, minScale)
+}
+
+// remainderBint computes the remainder of a rounded operation using *big.Int arithmetic.
+func remainderBint(op string, d, e, f Decimal) (Decimal, bool, error) {
+	xcoef := getBint()
+	defer putBint(xcoef)
+	var xneg bool
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+	ncoef.setFint(f.coef)
+	var nneg bool
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+
+	// Compute n = x - f, where x is the exact result of the operation
+	// for addition and multiplication, or n = d - f * e for division
+	var scale int
+	switch op {
+	case "+":
+		scale = max(d.Scale(), e.Scale(), f.Scale())
+		xcoef.setFint(d.coef)
+		xcoef.lsh(xcoef, scale-d.Scale())
+		ecoef.lsh(ecoef, scale-e.Scale())
+		xneg = d.IsNeg()
+		if xneg == e.IsNeg() {
+			xcoef.add(xcoef, ecoef)
+		} else {
+			if ecoef.cmp(xcoef) > 0 {
+				xneg = e.IsNeg()
+			}
+			xcoef.subAbs(xcoef, ecoef)
+		}
+		ncoef.lsh(ncoef, scale-f.Scale())
+		nneg = !f.IsNeg()
+	case "*":
+		scale = max(d.Scale()+e.Scale(), f.Scale())
+		xcoef.setFint(d.coef)
+		xcoef.mul(xcoef, ecoef)
+		xcoef.lsh(xcoef, scale-d.Scale()-e.Scale())
+		xneg = d.IsNeg() != e.IsNeg()
+		ncoef.lsh(ncoef, scale-f.Scale())
+		nneg = !f.IsNeg()
+	case "/":
+		scale = max(d.Scale(), f.Scale()+e.Scale())
+		xcoef.setFint(d.coef)
+		xcoef.lsh(xcoef, scale-d.Scale())
+		xneg = d.IsNeg()
+		ncoef.mul(ncoef, ecoef)
+		ncoef.lsh(ncoef, scale-f.Scale()-e.Scale())
+		nneg = f.IsNeg() == e.IsNeg()
+	default:
+		return Decimal{}, false, errInvalidOperation
+	}
+	if xneg == nneg {
+		ncoef.add(xcoef, ncoef)
+	} else {
+		if xcoef.cmp(ncoef) > 0 {
+			nneg = xneg
+		}
+		ncoef.subAbs(xcoef, ncoef)
+	}
+	if ncoef.sign() == 0 {
+		return Zero, false, nil
+	}
+
+	// Compute r = n or r = ⌊n / e⌋
+	if op != "/" {
+		r, err := newFromBint(nneg, ncoef, scale, 0)
+		return r, true, err
+	}
+	ncoef.lsh(ncoef, 2*MaxScale+e.Scale()-scale)
+	ncoef.quo(ncoef, ecoef)
+	r, err := newFromBint(nneg != e.IsNeg(), ncoef, 2*MaxScale, 0)
+	if err != nil {
+		return Decimal{}, true, err
+	}
+	return r.Trim(scale - e.Scale()), true, nil
+}
+
+// quoRemBint computes the quotient and remainder of two decimals using *big.Int arithmetic.
+func (d Decimal) quoRemBint(e Decimal) (q, r Decimal, err error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+
+	qcoef := getBint()
+	defer putBint(qcoef)
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+	rscale := d.Scale()
+
+	// Alignment
+	switch {
+	case d.Scale() > e.Scale():
+		ecoef.lsh(ecoef, d.Scale()-e.Scale())
+	case d.Scale() < e.Scale():
+		dcoef.lsh(dcoef, e.Scale()-d.Scale())
+		rscale = e.Scale()
+	}
+
+	// Compute q = ⌊d / e⌋, r = d - e * q
+	qcoef.quoRem(dcoef, ecoef, rcoef)
+	qsign := d.IsNeg() != e.IsNeg()
+	rsign := d.IsNeg()
+
+	q, err = newFromBint(qsign, qcoef, 0, 0)
+	if err != nil {
+		return Decimal{}, Decimal{}, err
+	}
+	r, err = newFromBint(rsign, rcoef, rscale, rscale)
+	if err != nil {
+		return Decimal{}, Decimal{}, err
+	}
+	return q, r, nil
+}
+
+// modBint computes the remainder of two decimals using *big.Int arithmetic.
+func (d Decimal) modBint(e Decimal, kind modKind) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+
+	qcoef := getBint()
+	defer putBint(qcoef)
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+	rscale := d.Scale()
+
+	// Alignment
+	switch {
+	case d.Scale() > e.Scale():
+		ecoef.lsh(ecoef, d.Scale()-e.Scale())
+	case d.Scale() < e.Scale():
+		dcoef.lsh(dcoef, e.Scale()-d.Scale())
+		rscale = e.Scale()
+	}
+
+	// Compute r = |d| - |e| * ⌊|d| / |e|⌋
+	var odd bool
+	if kind == modNearest {
+		// The parity of the quotient is needed to break ties,
+		// so |d| is divided by 2 * |e| first.
+		qcoef.dbl(ecoef)
+		qcoef.quoRem(dcoef, qcoef, rcoef)
+		if rcoef.cmp(ecoef) >= 0 {
+			rcoef.sub(rcoef, ecoef)
+			odd = true
+		}
+	} else {
+		qcoef.quoRem(dcoef, ecoef, rcoef)
+	}
+	rsign := d.IsNeg()
+
+	// Adjustment
+	switch kind {
+	case modEuclid:
+		if rsign && rcoef.sign() != 0 {
+			rcoef.sub(ecoef, rcoef)
+		}
+		rsign = false
+	case modNearest:
+		// Compare 2 * r with |e|
+		qcoef.sub(ecoef, rcoef)
+		switch c := rcoef.cmp(qcoef); {
+		case c > 0, c == 0 && odd:
+			rcoef.setBint(qcoef)
+			rsign = !rsign
+		}
+	}
+
+	return newFromBint(rsign, rcoef, rscale, rscale)
+}
+
+// cmpBint compares decimals using *big.Int arithmetic.
+func (d Decimal) cmpBint(e Decimal) int {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+
+	// Alignment
+	switch {
+	case d.Scale() > e.Scale():
+		ecoef.lsh(ecoef, d.Scale()-e.Scale())
+	case d.Scale() < e.Scale():
+		dcoef.lsh(dcoef, e.Scale()-d.Scale())
+	}
+
+	// Comparison
+	switch dcoef.cmp(ecoef) {
+	case 1:
+		return d.Sign()
+	case -1:
+		return -e.Sign()
+	}
+	return 0
+}
+
+// newFromSignedBint is similar to newFromBint, but it takes the sign of
+// the decimal from the *big.Int coefficient, which may be negative.
+func newFromSignedBint(coef *bint, scale, minScale int) (Decimal, error) {
+	neg := coef.sign() < 0
+	if neg {
+		coef.neg(coef)
+	}
+	return newFromBint(neg, coef, scale, minScale)
+}
+
+// halfPi sets z to the coefficient of π / 2 with a scale of 2 * MaxScale.
+func (z *bint) halfPi() {
+	z.rshHalfEven(bhalfpi, 2*MaxScale)
+}
+
+// sinCosBintTo sets scoef and ccoef to the coefficients of the sine and
+// cosine of a decimal with a scale of 2 * MaxScale.
+// Unlike other coefficients, scoef and ccoef can be negative.
+func (d Decimal) sinCosBintTo(scoef, ccoef *bint) {
+	xcoef := getBint()
+	defer putBint(xcoef)
+	xcoef.setFint(d.coef)
+
+	kcoef := getBint()
+	defer putBint(kcoef)
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+	gcoef.setBint(bpow10[2*MaxScale])
+
+	hcoef := getBint()
+	defer putBint(hcoef)
+
+	tcoef := getBint()
+	defer putBint(tcoef)
+
+	// Reduce |d| to r = |d| - k * π / 2, where 0 <= r < π / 2.
+	// The reduction uses π / 2 with a scale of 4 * MaxScale, so that
+	// r has no less than 2 * MaxScale correct digits even if k has
+	// MaxPrec digits.
+	xcoef.lsh(xcoef, 4*MaxScale-d.Scale())
+	kcoef.quoRem(xcoef, bhalfpi, rcoef)
+	rcoef.rshHalfEven(rcoef, 2*MaxScale)
+	k := kcoef.fint() % 4 // k < 2^64, since |d| < 10^MaxPrec
+
+	// Compute sin(r) = r^1 / 1! - r^3 / 3! + ... and
+	// cos(r) = r^0 / 0! - r^2 / 2! + ... using Taylor series expansion
+	scoef.setFint(0)
+	ccoef.setFint(0)
+	for i := range len(bfact) {
+		// Accumulate r^i / i!
+		hcoef.quoRem(gcoef, bfact[i], tcoef)
+		if hcoef.sign() == 0 {
+			break
+		}
+		switch i % 4 {
+		case 0:
+			ccoef.add(ccoef, hcoef)
+		case 1:
+			scoef.add(scoef, hcoef)
+		case 2:
+			ccoef.sub(ccoef, hcoef)
+		case 3:
+			scoef.sub(scoef, hcoef)
+		}
+
+		// Compute g = r^(i+1) with intermediate truncation
+		gcoef.mul(gcoef, rcoef)
+		gcoef.rshDown(gcoef, 2*MaxScale)
+	}
+
+	// Quadrant
+	switch k {
+	case 1: // sin(|d|) = cos(r), cos(|d|) = -sin(r)
+		tcoef.setBint(scoef)
+		scoef.setBint(ccoef)
+		ccoef.neg(tcoef)
+	case 2: // sin(|d|) = -sin(r), cos(|d|) = -cos(r)
+		scoef.neg(scoef)
+		ccoef.neg(ccoef)
+	case 3: // sin(|d|) = -cos(r), cos(|d|) = sin(r)
+		tcoef.setBint(scoef)
+		scoef.neg(ccoef)
+		ccoef.setBint(tcoef)
+	}
+
+	// Sign
+	if d.IsNeg() {
+		scoef.neg(scoef)
+	}
+}
+
+// sinBint computes the sine of a decimal using *big.Int arithmetic.
+func (d Decimal) sinBint() (Decimal, error) {
+	scoef := getBint()
+	defer putBint(scoef)
+
+	ccoef := getBint()
+	defer putBint(ccoef)
+
+	d.sinCosBintTo(scoef, ccoef)
+	return newFromSignedBint(scoef, 2*MaxScale, 0)
+}
+
+// cosBint computes the cosine of a decimal using *big.Int arithmetic.
+func (d Decimal) cosBint() (Decimal, error) {
+	scoef := getBint()
+	defer putBint(scoef)
+
+	ccoef := getBint()
+	defer putBint(ccoef)
+
+	d.sinCosBintTo(scoef, ccoef)
+	return newFromSignedBint(ccoef, 2*MaxScale, 0)
+}
+
+// tanBint computes the tangent of a decimal as sin(d) / cos(d)
+// using *big.Int arithmetic.
+func (d Decimal) tanBint() (Decimal, error) {
+	scoef := getBint()
+	defer putBint(scoef)
+
+	ccoef := getBint()
+	defer putBint(ccoef)
+
+	d.sinCosBintTo(scoef, ccoef)
+	if ccoef.sign() == 0 {
+		return Decimal{}, unknownOverflowError(0)
+	}
+
+	// Compute tan(d) = sin(d) / cos(d)
+	scoef.lsh(scoef, 2*MaxScale)
+	scoef.quo(scoef, ccoef)
+
+	return newFromSignedBint(scoef, 2*MaxScale, 0)
+}
+
+// atan calculates z = atan(x), where x and z are coefficients with a scale
+// of 2 * MaxScale and 0 <= x <= 10^(2 * MaxScale).
+func (z *bint) atan(x *bint) {
+	xcoef := getBint()
+	defer putBint(xcoef)
+	xcoef.setBint(x)
+
+	ycoef := getBint()
+	defer putBint(ycoef)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+
+	hcoef := getBint()
+	defer putBint(hcoef)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+
+	// Reduce x three times using atan(x) = 2 * atan(x / (1 + √(1 + x^2))),
+	// so that x <= tan(π / 32) and the series below converges quickly.
+	for range 3 {
+		ycoef.mul(xcoef, xcoef)
+		ycoef.add(ycoef, bpow10[4*MaxScale])
+		ycoef.sqrt(ycoef)
+		ycoef.add(ycoef, bpow10[2*MaxScale])
+		xcoef.lsh(xcoef, 2*MaxScale)
+		xcoef.quo(xcoef, ycoef)
+	}
+
+	// Compute y = x^2
+	ycoef.mul(xcoef, xcoef)
+	ycoef.rshDown(ycoef, 2*MaxScale)
+
+	// Compute z = x^1 / 1 - x^3 / 3 + x^5 / 5 - ... using Taylor series expansion
+	z.setFint(0)
+	gcoef.setBint(xcoef)
+	for i := range 50 {
+		// Accumulate x^(2i+1) / (2i+1)
+		ncoef.setInt64(int64(2*i + 1))
+		hcoef.quo(gcoef, ncoef)
+		if hcoef.sign() == 0 {
+			break
+		}
+		if i%2 == 0 {
+			z.add(z, hcoef)
+		} else {
+			z.sub(z, hcoef)
+		}
+
+		// Compute g = x^(2i+3) with intermediate truncation
+		gcoef.mul(gcoef, ycoef)
+		gcoef.rshDown(gcoef, 2*MaxScale)
+	}
+
+	// Undo the reduction
+	ncoef.setInt64(8)
+	z.mul(z, ncoef)
+}
+
+// atanBint computes the arctangent of a decimal using *big.Int arithmetic.
+func (d Decimal) atanBint() (Decimal, error) {
+	xcoef := getBint()
+	defer putBint(xcoef)
+	xcoef.setFint(d.coef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	if d.CmpAbs(One) <= 0 {
+		// Compute atan(|d|) directly
+		xcoef.lsh(xcoef, 2*MaxScale-d.Scale())
+		ecoef.atan(xcoef)
+	} else {
+		// Compute atan(|d|) = π / 2 - atan(1 / |d|)
+		xcoef.quo(bpow10[2*MaxScale+d.Scale()], xcoef)
+		ecoef.atan(xcoef)
+		xcoef.halfPi()
+		ecoef.sub(xcoef, ecoef)
+	}
+
+	return newFromBint(d.IsNeg(), ecoef, 2*MaxScale, 0)
+}
+
+// atan2Bint computes the arctangent of d / e using the signs of both
+// decimals to determine the quadrant and *big.Int arithmetic.
+func (d Decimal) atan2Bint(e Decimal) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	if d.CmpAbs(e) <= 0 {
+		// Compute atan(|d| / |e|) directly
+		dcoef.lsh(dcoef, 2*MaxScale+e.Scale()-d.Scale())
+		dcoef.quo(dcoef, ecoef)
+		fcoef.atan(dcoef)
+	} else {
+		// Compute atan(|d| / |e|) = π / 2 - atan(|e| / |d|)
+		ecoef.lsh(ecoef, 2*MaxScale+d.Scale()-e.Scale())
+		ecoef.quo(ecoef, dcoef)
+		fcoef.atan(ecoef)
+		ecoef.halfPi()
+		fcoef.sub(ecoef, fcoef)
+	}
+
+	// Compute π - atan(|d| / |e|) for the left half-plane
+	if e.IsNeg() {
+		ecoef.dbl(bhalfpi)
+		ecoef.rshHalfEven(ecoef, 2*MaxScale)
+		fcoef.sub(ecoef, fcoef)
+	}
+
+	return newFromBint(d.IsNeg(), fcoef, 2*MaxScale, 0)
+}
+
+// asinBintTo sets ecoef to the coefficient of the arcsine of |d|
+// with a scale of 2 * MaxScale.
+// If |d| > 1, the result is unpredictable.
+func (d Decimal) asinBintTo(ecoef *bint) {
+	xcoef := getBint()
+	defer putBint(xcoef)
+	xcoef.setFint(d.coef)
+	xcoef.lsh(xcoef, 2*MaxScale-d.Scale())
+
+	ycoef := getBint()
+	defer putBint(ycoef)
+
+	// Compute y = 1 + √(1 - x^2), where x^2 is exact
+	ycoef.mul(xcoef, xcoef)
+	ycoef.sub(bpow10[4*MaxScale], ycoef)
+	ycoef.sqrt(ycoef)
+	ycoef.add(ycoef, bpow10[2*MaxScale])
+
+	// Compute asin(x) = 2 * atan(x / y)
+	xcoef.lsh(xcoef, 2*MaxScale)
+	xcoef.quo(xcoef, ycoef)
+	ecoef.atan(xcoef)
+	ecoef.dbl(ecoef)
+}
+
+// asinBint computes the arcsine of a decimal using *big.Int arithmetic.
+func (d Decimal) asinBint() (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	d.asinBintTo(ecoef)
+	return newFromBint(d.IsNeg(), ecoef, 2*MaxScale, 0)
+}
+
+// acosBint computes the arccosine of a decimal as π / 2 - asin(d)
+// using *big.Int arithmetic.
+func (d Decimal) acosBint() (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	d.asinBintTo(ecoef)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.halfPi()
+
+	if d.IsNeg() {
+		ecoef.add(fcoef, ecoef)
+	} else {
+		ecoef.sub(fcoef, ecoef)
+	}
+
+	return newFromSignedBint(ecoef, 2*MaxScale, 0)
+}
+
+// expPairBintTo sets ecoef and fcoef to the coefficients of exp(|d|) and
+// exp(-|d|) with a scale of 2 * MaxScale.
+// It returns false if the integer part of |d| is too large for the cache
+// of exponentials.
+func (d Decimal) expPairBintTo(ecoef, fcoef *bint) bool {
+	dscale := d.Scale()
+	q, _, ok := d.coef.quoRem(pow10[dscale])
+	if !ok || q >= fint(len(bexp)) {
+		return false
+	}
+
+	// Compute e = exp(|d|)
+	fcoef.setFint(d.coef)
+	fcoef.lsh(fcoef, 2*MaxScale-dscale)
+	ecoef.e(fcoef)
+
+	// Compute f = exp(-|d|) = 1 / e
+	fcoef.quo(bpow10[4*MaxScale], ecoef)
+	return true
+}
+
+// sinhBint computes the hyperbolic sine of a decimal as
+// (exp(d) - exp(-d)) / 2 using *big.Int arithmetic.
+func (d Decimal) sinhBint() (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	if !d.expPairBintTo(ecoef, fcoef) {
+		return Decimal{}, unknownOverflowError(0)
+	}
+
+	ecoef.sub(ecoef, fcoef)
+	ecoef.hlf(ecoef)
+
+	return newFromBint(d.IsNeg(), ecoef, 2*MaxScale, 0)
+}
+
+// coshBint computes the hyperbolic cosine of a decimal as
+// (exp(d) + exp(-d)) / 2 using *big.Int arithmetic.
+func (d Decimal) coshBint() (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	if !d.expPairBintTo(ecoef, fcoef) {
+		return Decimal{}, unknownOverflowError(0)
+	}
+
+	ecoef.add(ecoef, fcoef)
+	ecoef.hlf(ecoef)
+
+	return newFromBint(false, ecoef, 2*MaxScale, 0)
+}
+
+// tanhBint computes the hyperbolic tangent of a decimal as
+// (exp(d) - exp(-d)) / (exp(d) + exp(-d)) using *big.Int arithmetic.
+func (d Decimal) tanhBint() (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	// Special case: |tanh(d)| differs from 1 by less than 10^(-2 * MaxScale)
+	if !d.expPairBintTo(ecoef, fcoef) {
+		return newSafe(d.IsNeg(), 1, 0)
+	}
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+	gcoef.add(ecoef, fcoef)
+
+	ecoef.sub(ecoef, fcoef)
+	ecoef.lsh(ecoef, 2*MaxScale)
+	ecoef.quo(ecoef, gcoef)
+
+	return newFromBint(d.IsNeg(), ecoef, 2*MaxScale, 0)
+}
+
+// asinhBint computes the inverse hyperbolic sine of a decimal as
+// ln(|d| + √(d^2 + 1)) using *big.Int arithmetic.
+func (d Decimal) asinhBint() (Decimal, error) {
+	xcoef := getBint()
+	defer putBint(xcoef)
+	xcoef.setFint(d.coef)
+	xcoef.lsh(xcoef, 2*MaxScale-d.Scale())
+
+	ycoef := getBint()
+	defer putBint(ycoef)
+
+	// Compute y = |d| + √(d^2 + 1), where d^2 is exact
+	ycoef.mul(xcoef, xcoef)
+	ycoef.add(ycoef, bpow10[4*MaxScale])
+	ycoef.sqrt(ycoef)
+	ycoef.add(ycoef, xcoef)
+
+	xcoef.log(ycoef)
+
+	return newFromBint(d.IsNeg(), xcoef, 2*MaxScale, 0)
+}
+
+// acoshBint computes the inverse hyperbolic cosine of a decimal as
+// ln(d + √(d^2 - 1)) using *big.Int arithmetic.
+// If d < 1, the result is unpredictable.
+func (d Decimal) acoshBint() (Decimal, error) {
+	xcoef := getBint()
+	defer putBint(xcoef)
+	xcoef.setFint(d.coef)
+	xcoef.lsh(xcoef, 2*MaxScale-d.Scale())
+
+	ycoef := getBint()
+	defer putBint(ycoef)
+
+	// Compute y = d + √(d^2 - 1), where d^2 is exact
+	ycoef.mul(xcoef, xcoef)
+	ycoef.sub(ycoef, bpow10[4*MaxScale])
+	ycoef.sqrt(ycoef)
+	ycoef.add(ycoef, xcoef)
+
+	xcoef.log(ycoef)
+
+	return newFromBint(false, xcoef, 2*MaxScale, 0)
+}
+
+// atanhBint computes the inverse hyperbolic tangent of a decimal as
+// ln((1 + |d|) / (1 - |d|)) / 2 using *big.Int arithmetic.
+// If |d| >= 1, the result is unpredictable.
+func (d Decimal) atanhBint() (Decimal, error) {
+	xcoef := getBint()
+	defer putBint(xcoef)
+	xcoef.setFint(d.coef)
+	xcoef.lsh(xcoef, 2*MaxScale-d.Scale())
+
+	ycoef := getBint()
+	defer putBint(ycoef)
+
+	// Compute y = (1 + |d|) / (1 - |d|)
+	ycoef.add(bpow10[2*MaxScale], xcoef)
+	ycoef.lsh(ycoef, 2*MaxScale)
+	xcoef.sub(bpow10[2*MaxScale], xcoef)
+	ycoef.quo(ycoef, xcoef)
+
+	xcoef.log(ycoef)
+	xcoef.hlf(xcoef)
+
+	return newFromBint(d.IsNeg(), xcoef, 2*MaxScale, 0)
+}
+
+// roundOpBint computes the rounded result of the operation described
+// in roundOp using *big.Int arithmetic.
+func roundOpBint(op string, d, e Decimal, scale, prec int, mode RoundingMode, rnd *rand.Rand) (Decimal, error) {
+	num := getBint()
+	defer putBint(num)
+	num.setFint(d.coef)
+
+	den := getBint()
+	defer putBint(den)
+	den.setFint(1)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+
+	// Compute x = num / den / 10^xscale, where x is the exact result
+	var neg bool
+	var xscale int
+	switch op {
+	case "+":
+		neg, xscale = accumulateBint(d.IsNeg(), num, d.Scale(), e.IsNeg(), ecoef, e.Scale())
+	case "*":
+		num.mul(num, ecoef)
+		neg, xscale = d.IsNeg() != e.IsNeg(), d.Scale()+e.Scale()
+	case "/":
+		den.setBint(ecoef)
+		neg, xscale = d.IsNeg() != e.IsNeg(), d.Scale()-e.Scale()
+	default:
+		return Decimal{}, errInvalidOperation
+	}
+	return roundQuoBint(neg, num, den, xscale, scale, prec, mode, rnd)
+}
+
+// roundSumBint computes the rounded sum of decimals described in roundSum
+// using *big.Int arithmetic.
+func roundSumBint(d []Decimal, scale, prec int, mode RoundingMode, rnd *rand.Rand) (Decimal, error) {
+	num := getBint()
+	defer putBint(num)
+
+	den := getBint()
+	defer putBint(den)
+	den.setFint(1)
+
+	neg, xscale := sumBintTo(num, d)
+	return roundQuoBint(neg, num, den, xscale, scale, prec, mode, rnd)
+}
+
+// roundQuoBint computes num / den / 10^xscale rounded as described
+// in roundOp using *big.Int arithmetic.
+func roundQuoBint(neg bool, num, den *bint, xscale, scale, prec int, mode RoundingMode, rnd *rand.Rand) (Decimal, error) {
+	q := getBint()
+	defer putBint(q)
+
+	r := getBint()
+	defer putBint(r)
+
+	y := getBint()
+	defer putBint(y)
+
+	for {
+		// Compute q = ⌊n / y⌋, r = n - y * q
+		if shift := scale - xscale; shift >= 0 {
+			q.lsh(num, shift)
+			y.setBint(den)
+		} else {
+			q.setBint(num)
+			y.lsh(den, -shift)
+		}
+		q.quoRem(q, y, r)
+
+		// Rounding
+		switch {
+		case r.sign() == 0:
+			// skip
+		case mode == Stochastic:
+			if roundUpRandBint(r, y, rnd) {
+				q.inc(q) // q = q + 1
+			}
+		default:
+			r.dbl(r) // r = r * 2
+			if roundUp(neg, mode, r.cmp(y), q.isOdd()) {
+				q.inc(q) // q = q + 1
+			}
+		}
+
+		// Precision
+		p := q.prec()
+		if p <= prec {
+			return newSafe(neg, q.fint(), scale)
+		}
+		if scale-(p-prec) < MinScale {
+			return Decimal{}, precOverflowError(prec, p-scale)
+		}
+		scale -= p - prec
+	}
+}
+
+// roundUpRandBint is like roundUpRand, but the fraction r / y is given
+// using *big.Int.
+// Leading digits are sufficient to determine the probability, so r and y
+// are shifted to the precision of uint64 first.
+func roundUpRandBint(r, y *bint, rnd *rand.Rand) bool {
+	shift := y.prec() - MaxPrec
+	if shift <= 0 {
+		return roundUpRand(uint64(r.fint()), uint64(y.fint()), rnd)
+	}
+	rcoef := getBint()
+	defer putBint(rcoef)
+	ycoef := getBint()
+	defer putBint(ycoef)
+	rcoef.rshDown(r, shift)
+	ycoef.rshDown(y, shift)
+	return roundUpRand(uint64(rcoef.fint()), uint64(ycoef.fint()), rnd)
+}
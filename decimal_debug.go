@@ -0,0 +1,25 @@
+//go:build decimaldebug
+
+package decimal
+
+import "fmt"
+
+// This file enables internal invariant assertions.
+// Every decimal created by the package is checked to be valid,
+// and *big.Int values are poisoned when they are obtained from
+// or returned to the pool.
+
+// debug reports whether internal invariant assertions are enabled.
+const debug = true
+
+// assertDecimal panics if the arguments do not describe a valid decimal.
+func assertDecimal(neg bool, coef fint, scale int) {
+	switch {
+	case scale < MinScale || scale > MaxScale:
+		panic(fmt.Sprintf("decimal invariant violated: scale %v is not within the range [%v, %v]", scale, MinScale, MaxScale))
+	case coef > maxCoef:
+		panic(fmt.Sprintf("decimal invariant violated: coefficient %v has more than %v digits", coef, MaxPrec))
+	case neg && coef == 0:
+		panic("decimal invariant violated: negative zero")
+	}
+}
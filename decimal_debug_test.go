@@ -0,0 +1,32 @@
+//go:build decimaldebug
+
+package decimal
+
+import "testing"
+
+func TestNewUnsafe_Debug(t *testing.T) {
+	tests := map[string]struct {
+		neg   bool
+		coef  fint
+		scale int
+	}{
+		"scale range 1": {false, 1, MinScale - 1},
+		"scale range 2": {false, 1, MaxScale + 1},
+		"coefficient":   {false, maxCoef + 1, 0},
+		"negative coef": {true, maxCoef + 1, MaxScale},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("newUnsafe(%v, %v, %v) did not panic", tt.neg, tt.coef, tt.scale)
+				}
+			}()
+			newUnsafe(tt.neg, tt.coef, tt.scale)
+		})
+	}
+
+	if got := newUnsafe(true, 0, 2); got.IsNeg() {
+		t.Errorf("newUnsafe(true, 0, 2).IsNeg() = true, want false")
+	}
+}
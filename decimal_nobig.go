@@ -0,0 +1,243 @@
+//go:build decimalnobig
+
+package decimal
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+)
+
+// This file replaces the *big.Int arithmetic with stubs.
+// All operations that cannot be computed using uint64 arithmetic
+// return an overflow error instead.
+
+// hasBint reports whether *big.Int arithmetic is available.
+const hasBint = false
+
+func parseBint(s string, _, maxExp int) (Decimal, error) {
+	if err := checkExponent(s, maxExp); err != nil {
+		return Decimal{}, err
+	}
+	return Decimal{}, errDecimalOverflow
+}
+
+func parseModeBint(s string, _, maxExp int, _ RoundingMode, _ *rand.Rand) (Decimal, error) {
+	if err := checkExponent(s, maxExp); err != nil {
+		return Decimal{}, err
+	}
+	return Decimal{}, errDecimalOverflow
+}
+
+// checkExponent returns a [*LimitError] if the absolute value of the exponent
+// of a decimal string is greater than maxExp, so that [ParseLimits] are
+// enforced even though the string cannot be parsed.
+func checkExponent(s string, maxExp int) error {
+	pos := strings.IndexAny(s, "eE") + 1
+	if pos == 0 {
+		return nil
+	}
+	if pos < len(s) && (s[pos] == '-' || s[pos] == '+') {
+		pos++
+	}
+	var exp int
+	for ; pos < len(s) && s[pos] >= '0' && s[pos] <= '9'; pos++ {
+		exp = exp*10 + int(s[pos]-'0')
+		if exp > maxExp {
+			return &LimitError{Limit: "exponent", Max: maxExp}
+		}
+	}
+	return nil
+}
+
+func prodBint(...Decimal) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) mulBint(Decimal, int) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) powIntBint(context.Context, int) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) sqrtBint(context.Context) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) expBint(context.Context) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) logBint(context.Context) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func expSliceBint(d, e []Decimal) (int, error) {
+	for i, f := range d {
+		if !f.IsZero() {
+			return i, errDecimalOverflow
+		}
+		e[i] = One
+	}
+	return 0, nil
+}
+
+func logSliceBint(d, e []Decimal) (int, error) {
+	for i, f := range d {
+		if !f.IsOne() {
+			return i, errDecimalOverflow
+		}
+		e[i] = Zero
+	}
+	return 0, nil
+}
+
+func geoMeanBint(...Decimal) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func cagrBint(Decimal, Decimal, int) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) powBint(Decimal) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func harmonicMeanBint(...Decimal) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func vwapBint([]Decimal, []Decimal) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func pctChangeBint(Decimal, Decimal, int) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func convertRateBint(Decimal, fint, fint) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func sumBint(...Decimal) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func meanBint(...Decimal) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func sumParallelBint(d []Decimal, _ int) (Decimal, error) {
+	return sumFint(d...)
+}
+
+func (d Decimal) addBint(Decimal, int) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) addMulBint(Decimal, Decimal, int) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) addQuoBint(Decimal, Decimal, int) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) quoBint(Decimal, int) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func remainderBint(string, Decimal, Decimal, Decimal) (Decimal, bool, error) {
+	return Decimal{}, false, errDecimalOverflow
+}
+
+func (d Decimal) quoRemBint(Decimal) (q, r Decimal, err error) {
+	return Decimal{}, Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) modBint(Decimal, modKind) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+// cmpBint compares decimals using uint64 arithmetic by comparing
+// integer and fractional parts separately.
+func (d Decimal) cmpBint(e Decimal) int {
+	dint, dfrac, _ := d.coef.quoRem(pow10[d.Scale()])
+	eint, efrac, _ := e.coef.quoRem(pow10[e.Scale()])
+
+	// Alignment
+	dfrac, _ = dfrac.lsh(MaxScale - d.Scale())
+	efrac, _ = efrac.lsh(MaxScale - e.Scale())
+
+	// Comparison
+	switch {
+	case dint > eint, dint == eint && dfrac > efrac:
+		return d.Sign()
+	case eint > dint, eint == dint && efrac > dfrac:
+		return -e.Sign()
+	}
+	return 0
+}
+
+func (d Decimal) sinBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) cosBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) tanBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) asinBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) acosBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) atanBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) atan2Bint(Decimal) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) sinhBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) coshBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) tanhBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) asinhBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) acoshBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func (d Decimal) atanhBint() (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func roundOpBint(string, Decimal, Decimal, int, int, RoundingMode, *rand.Rand) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
+
+func roundSumBint([]Decimal, int, int, RoundingMode, *rand.Rand) (Decimal, error) {
+	return Decimal{}, errDecimalOverflow
+}
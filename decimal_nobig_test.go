@@ -0,0 +1,112 @@
+//go:build decimalnobig
+
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecimal_NoBig(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			{"1.23", "4.56", "5.79"},
+			{"9999999999999999998", "1", "9999999999999999999"},
+			{"0.1", "0.0000000000000000001", "0.1000000000000000001"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.Add(e)
+			if err != nil {
+				t.Errorf("%q.Add(%q) failed: %v", d, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Add(%q) = %q, want %q", d, e, got, want)
+			}
+		}
+
+		d := MustParse("2.25")
+		got, err := d.Sqrt()
+		if err != nil {
+			t.Errorf("%q.Sqrt() failed: %v", d, err)
+		} else if want := MustParse("1.5"); got != want {
+			t.Errorf("%q.Sqrt() = %q, want %q", d, got, want)
+		}
+
+		d = MustParse("2")
+		got, err = d.PowInt(-3)
+		if err != nil {
+			t.Errorf("%q.PowInt(-3) failed: %v", d, err)
+		} else if want := MustParse("0.125"); got != want {
+			t.Errorf("%q.PowInt(-3) = %q, want %q", d, got, want)
+		}
+
+		e := MustParse("-3.00")
+		got, err = d.PowDecimal(e)
+		if err != nil {
+			t.Errorf("%q.PowDecimal(%q) failed: %v", d, e, err)
+		} else if want := MustParse("0.125"); got != want {
+			t.Errorf("%q.PowDecimal(%q) = %q, want %q", d, e, got, want)
+		}
+
+		got, err = NewFromPartsString("12340", 3, true)
+		if err != nil {
+			t.Errorf("NewFromPartsString(%q, 3, true) failed: %v", "12340", err)
+		} else if want := MustParse("-12.340"); got != want {
+			t.Errorf("NewFromPartsString(%q, 3, true) = %q, want %q", "12340", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		d := MustParse("1")
+		e := MustParse("3")
+		if _, err := d.Quo(e); err == nil {
+			t.Errorf("%q.Quo(%q) did not fail", d, e)
+		}
+		if _, err := e.Sqrt(); err == nil {
+			t.Errorf("%q.Sqrt() did not fail", e)
+		}
+		if _, err := e.PowDecimal(MustParse("0.5")); err == nil {
+			t.Errorf("%q.PowDecimal(0.5) did not fail", e)
+		}
+		if _, err := Parse("1e5"); err == nil {
+			t.Errorf("Parse(%q) did not fail", "1e5")
+		}
+		if _, err := NewFromPartsString("123", -2, false); err == nil {
+			t.Errorf("NewFromPartsString(%q, -2, false) did not fail", "123")
+		}
+		var lerr *LimitError
+		if _, err := (ParseLimits{MaxExponent: 5}).Parse("1e-6"); !errors.As(err, &lerr) {
+			t.Errorf("Parse(%q) failed with %v, want *LimitError", "1e-6", err)
+		}
+	})
+}
+
+func TestDecimal_NoBig_Cmp(t *testing.T) {
+	tests := []struct {
+		d, e string
+		want int
+	}{
+		{"9999999999999999999", "0.9999999999999999999", 1},
+		{"0.9999999999999999999", "9999999999999999999", -1},
+		{"-9999999999999999999", "-0.9999999999999999999", -1},
+		{"-0.9999999999999999999", "-9999999999999999999", 1},
+		{"1000000000000000000", "999999999999999999.9", 1},
+		{"999999999999999999.9", "1000000000000000000", -1},
+		{"0.1000000000000000000", "0.1", 0},
+		{"1.000000000000000001", "1", 1},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		e := MustParse(tt.e)
+		got := d.Cmp(e)
+		if got != tt.want {
+			t.Errorf("%q.Cmp(%q) = %v, want %v", d, e, got, tt.want)
+		}
+	}
+}
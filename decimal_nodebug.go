@@ -0,0 +1,8 @@
+//go:build !decimaldebug
+
+package decimal
+
+// debug reports whether internal invariant assertions are enabled.
+const debug = false
+
+func assertDecimal(bool, fint, int) {}
@@ -1,15 +1,24 @@
+//go:build !decimalnobig
+
 package decimal
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
+	"strings"
 	"testing"
+	"time"
 	"unsafe"
 )
 
@@ -131,6 +140,178 @@ func TestNew(t *testing.T) {
 	})
 }
 
+func TestNewFromParts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			neg   bool
+			coef  uint64
+			scale int
+			want  string
+		}{
+			{false, 0, 0, "0"},
+			{true, 0, 2, "0.00"},
+			{false, 123, 2, "1.23"},
+			{true, 123, 2, "-1.23"},
+			{false, 9_999_999_999_999_999_999, 0, "9999999999999999999"},
+			{true, 9_999_999_999_999_999_999, 19, "-0.9999999999999999999"},
+			{false, 1, 19, "0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			got, err := NewFromParts(tt.neg, tt.coef, tt.scale)
+			if err != nil {
+				t.Errorf("NewFromParts(%v, %v, %v) failed: %v", tt.neg, tt.coef, tt.scale, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("NewFromParts(%v, %v, %v) = %q, want %q", tt.neg, tt.coef, tt.scale, got, want)
+			}
+			if got.IsNeg() != want.IsNeg() || got.Coef() != tt.coef || got.Scale() != tt.scale {
+				t.Errorf("NewFromParts(%v, %v, %v) parts = (%v, %v, %v)", tt.neg, tt.coef, tt.scale, got.IsNeg(), got.Coef(), got.Scale())
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			neg   bool
+			coef  uint64
+			scale int
+		}{
+			{false, 10_000_000_000_000_000_000, 0},
+			{true, math.MaxUint64, 0},
+			{false, 1, -1},
+			{false, 1, 20},
+		}
+		for _, tt := range tests {
+			_, err := NewFromParts(tt.neg, tt.coef, tt.scale)
+			if err == nil {
+				t.Errorf("NewFromParts(%v, %v, %v) did not fail", tt.neg, tt.coef, tt.scale)
+			}
+		}
+	})
+}
+
+func TestNewFromPartsString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			coef  string
+			scale int
+			neg   bool
+			want  string
+		}{
+			{"0", 0, false, "0"},
+			{"0", 2, true, "0.00"},
+			{"000123", 2, false, "1.23"},
+			{"12340", 3, true, "-12.340"},
+			{"9999999999999999999", 0, false, "9999999999999999999"},
+			{"9999999999999999999", 19, true, "-0.9999999999999999999"},
+			{"1", 19, false, "0.0000000000000000001"},
+			{"123", -2, false, "12300"},
+			{"0", -5, false, "0"},
+			{"1", 20, false, "0.0000000000000000000"},
+			{"5", 20, false, "0.0000000000000000000"},
+			{"15", 20, false, "0.0000000000000000002"},
+			{"123456789012345678901234567890", 25, false, "12345.67890123456789"},
+			{"12345678901234567890", 2, true, "-123456789012345678.9"},
+		}
+		for _, tt := range tests {
+			got, err := NewFromPartsString(tt.coef, tt.scale, tt.neg)
+			if err != nil {
+				t.Errorf("NewFromPartsString(%q, %v, %v) failed: %v", tt.coef, tt.scale, tt.neg, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("NewFromPartsString(%q, %v, %v) = %q, want %q", tt.coef, tt.scale, tt.neg, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			coef    string
+			scale   int
+			wantErr error
+		}{
+			{"", 0, errInvalidDecimal},
+			{"-123", 0, errInvalidDecimal},
+			{"+123", 0, errInvalidDecimal},
+			{"1.23", 0, errInvalidDecimal},
+			{"1e3", 0, errInvalidDecimal},
+			{" 1", 0, errInvalidDecimal},
+			{"10000000000000000000", 0, errDecimalOverflow},
+			{"1", -19, errDecimalOverflow},
+		}
+		for _, tt := range tests {
+			_, err := NewFromPartsString(tt.coef, tt.scale, false)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewFromPartsString(%q, %v, false) error = %v, want %v", tt.coef, tt.scale, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestDecimal_Parts(t *testing.T) {
+	tests := []struct {
+		d         string
+		wantCoef  string
+		wantScale int
+		wantNeg   bool
+	}{
+		{"0", "0", 0, false},
+		{"0.00", "0", 2, false},
+		{"-12.340", "12340", 3, true},
+		{"9999999999999999999", "9999999999999999999", 0, false},
+		{"-0.0000000000000000001", "1", 19, true},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		gotCoef, gotScale, gotNeg := d.Parts()
+		if gotCoef != tt.wantCoef || gotScale != tt.wantScale || gotNeg != tt.wantNeg {
+			t.Errorf("%q.Parts() = (%q, %v, %v), want (%q, %v, %v)", d, gotCoef, gotScale, gotNeg, tt.wantCoef, tt.wantScale, tt.wantNeg)
+		}
+		got, err := NewFromPartsString(d.Parts())
+		if err != nil {
+			t.Errorf("NewFromPartsString(%q.Parts()) failed: %v", d, err)
+			continue
+		}
+		if got != d {
+			t.Errorf("NewFromPartsString(%q.Parts()) = %q, want %q", d, got, d)
+		}
+	}
+}
+
+func TestNewCents(t *testing.T) {
+	tests := []struct {
+		f    func(int64) Decimal
+		name string
+		v    int64
+		want string
+	}{
+		{NewCents, "NewCents", 0, "0.00"},
+		{NewCents, "NewCents", 12345, "123.45"},
+		{NewCents, "NewCents", -5, "-0.05"},
+		{NewCents, "NewCents", math.MaxInt64, "92233720368547758.07"},
+		{NewCents, "NewCents", math.MinInt64, "-92233720368547758.08"},
+		{NewMicros, "NewMicros", 0, "0.000000"},
+		{NewMicros, "NewMicros", 1, "0.000001"},
+		{NewMicros, "NewMicros", -1234567, "-1.234567"},
+		{NewMicros, "NewMicros", math.MinInt64, "-9223372036854.775808"},
+		{NewNanos, "NewNanos", 0, "0.000000000"},
+		{NewNanos, "NewNanos", 1500000000, "1.500000000"},
+		{NewNanos, "NewNanos", -1, "-0.000000001"},
+		{NewNanos, "NewNanos", math.MaxInt64, "9223372036.854775807"},
+	}
+	for _, tt := range tests {
+		got := tt.f(tt.v)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%v(%v) = %q, want %q", tt.name, tt.v, got, want)
+		}
+	}
+}
+
 func TestMustNew(t *testing.T) {
 	t.Run("error", func(t *testing.T) {
 		defer func() {
@@ -302,6 +483,62 @@ func TestNewFromFloat64(t *testing.T) {
 	})
 }
 
+func TestNewFromQ(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			value    int64
+			fracBits int
+			want     string
+		}{
+			{0, 0, "0"},
+			{0, 16, "0"},
+			{1, 0, "1"},
+			{-1, 0, "-1"},
+			{1, 1, "0.5"},
+			{-1, 1, "-0.5"},
+			{1 << 16, 16, "1"},
+			{3 << 15, 16, "1.5"},
+			{1, 16, "0.0000152587890625"},
+			{-1, 16, "-0.0000152587890625"},
+			{0x0001_8000, 16, "1.5"},
+			{1 << 32, 32, "1"},
+			{1, 32, "0.0000000002328306437"},
+			{math.MaxInt64, 0, "9223372036854775807"},
+			{math.MinInt64, 0, "-9223372036854775808"},
+			{math.MaxInt64, 63, "0.9999999999999999999"},
+			{math.MinInt64, 63, "-1"},
+			{math.MaxInt64, 32, "2147483648"},
+		}
+		for _, tt := range tests {
+			got, err := NewFromQ(tt.value, tt.fracBits)
+			if err != nil {
+				t.Errorf("NewFromQ(%v, %v) failed: %v", tt.value, tt.fracBits, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("NewFromQ(%v, %v) = %q, want %q", tt.value, tt.fracBits, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			value    int64
+			fracBits int
+		}{
+			{1, -1},
+			{1, 64},
+		}
+		for _, tt := range tests {
+			_, err := NewFromQ(tt.value, tt.fracBits)
+			if err == nil {
+				t.Errorf("NewFromQ(%v, %v) did not fail", tt.value, tt.fracBits)
+			}
+		}
+	})
+}
+
 func TestParse(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -493,6 +730,290 @@ func TestParse(t *testing.T) {
 	})
 }
 
+func TestValidate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s     string
+			scale int
+		}{
+			{"0", 0},
+			{"-123.456", 0},
+			{"1e10", 0},
+			{"0.00000000000000000001", 0},
+			{"12345678901234567890123e-10", 0},
+			{"0.5", 19},
+			{"123456789012345678.9", 1},
+		}
+		for _, tt := range tests {
+			if err := ValidateExact(tt.s, tt.scale); err != nil {
+				t.Errorf("ValidateExact(%q, %v) failed: %v", tt.s, tt.scale, err)
+			}
+			if tt.scale == 0 {
+				if err := Validate(tt.s); err != nil {
+					t.Errorf("Validate(%q) failed: %v", tt.s, err)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			s     string
+			scale int
+		}{
+			{"", 0},
+			{" 1", 0},
+			{"1.2.3", 0},
+			{"1e400", 0},
+			{"99999999999999999999", 0},
+			{"123456789012345678.9", 2},
+			{"1", -1},
+			{"1", MaxScale + 1},
+		}
+		for _, tt := range tests {
+			want := ""
+			if _, err := ParseExact(tt.s, tt.scale); err != nil {
+				want = err.Error()
+			}
+			err := ValidateExact(tt.s, tt.scale)
+			if err == nil {
+				t.Errorf("ValidateExact(%q, %v) did not fail", tt.s, tt.scale)
+				continue
+			}
+			if err.Error() != want {
+				t.Errorf("ValidateExact(%q, %v) = %q, want %q", tt.s, tt.scale, err, want)
+			}
+		}
+	})
+
+	t.Run("allocs", func(t *testing.T) {
+		for _, s := range []string{"0", "123.456", "-0.0000000000000000001", "9999999999999999999"} {
+			allocs := testing.AllocsPerRun(100, func() {
+				_ = Validate(s)
+			})
+			if allocs != 0 {
+				t.Errorf("Validate(%q) allocations = %v, want 0", s, allocs)
+			}
+		}
+	})
+}
+
+func TestParseLimits_Parse(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			limits ParseLimits
+			s      string
+			want   string
+		}{
+			{ParseLimits{}, "1e-330", "0.0000000000000000000"},
+			{ParseLimits{MaxLength: 4}, "1.23", "1.23"},
+			{ParseLimits{MaxExponent: 5}, "1.23e5", "123000"},
+			{ParseLimits{MaxExponent: 5}, "1.23e-5", "0.0000123"},
+			{ParseLimits{MaxLength: 1000, MaxExponent: 1000}, "1e-330", "0.0000000000000000000"},
+		}
+		for _, tt := range tests {
+			got, err := tt.limits.Parse(tt.s)
+			if err != nil {
+				t.Errorf("%+v.Parse(%q) failed: %v", tt.limits, tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%+v.Parse(%q) = %q, want %q", tt.limits, tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			limits ParseLimits
+			s      string
+			want   LimitError
+		}{
+			{ParseLimits{}, "1e331", LimitError{"exponent", 330}},
+			{ParseLimits{}, "1e-999999999", LimitError{"exponent", 330}},
+			{ParseLimits{}, "0." + strings.Repeat("0", 329), LimitError{"length", 330}},
+			{ParseLimits{MaxLength: 4}, "1.234", LimitError{"length", 4}},
+			{ParseLimits{MaxExponent: 5}, "1.23e6", LimitError{"exponent", 5}},
+			{ParseLimits{MaxExponent: 5}, "1.23e-6", LimitError{"exponent", 5}},
+			{ParseLimits{MaxLength: 1000}, strings.Repeat("0", 331), LimitError{"length", 330}},
+		}
+		for _, tt := range tests {
+			_, err := tt.limits.Parse(tt.s)
+			if err == nil {
+				t.Errorf("%+v.Parse(%q) did not fail", tt.limits, tt.s)
+				continue
+			}
+			var got *LimitError
+			if !errors.As(err, &got) {
+				t.Errorf("%+v.Parse(%q) failed with %v, want *LimitError", tt.limits, tt.s, err)
+				continue
+			}
+			if *got != tt.want {
+				t.Errorf("%+v.Parse(%q) failed with %+v, want %+v", tt.limits, tt.s, *got, tt.want)
+			}
+			if !errors.Is(err, errInvalidDecimal) {
+				t.Errorf("%+v.Parse(%q) failed with %v, want %v", tt.limits, tt.s, err, errInvalidDecimal)
+			}
+		}
+	})
+}
+
+func TestParseLimits_Decimal(t *testing.T) {
+	limits := ParseLimits{MaxLength: 8, MaxExponent: 3}
+
+	t.Run("success", func(t *testing.T) {
+		var d Decimal
+		if err := json.Unmarshal([]byte(`"1.23e3"`), limits.Decimal(&d)); err != nil {
+			t.Errorf("json.Unmarshal() failed: %v", err)
+		} else if want := MustParse("1230"); d != want {
+			t.Errorf("json.Unmarshal() = %q, want %q", d, want)
+		}
+		if err := limits.Decimal(&d).Scan([]byte("-4.5")); err != nil {
+			t.Errorf("Scan() failed: %v", err)
+		} else if want := MustParse("-4.5"); d != want {
+			t.Errorf("Scan() = %q, want %q", d, want)
+		}
+		if err := limits.Decimal(&d).Scan(int64(7)); err != nil {
+			t.Errorf("Scan() failed: %v", err)
+		} else if want := MustParse("7"); d != want {
+			t.Errorf("Scan() = %q, want %q", d, want)
+		}
+
+		var n NullDecimal
+		if err := json.Unmarshal([]byte(`"5.67"`), limits.NullDecimal(&n)); err != nil {
+			t.Errorf("json.Unmarshal() failed: %v", err)
+		} else if want := (NullDecimal{Decimal: MustParse("5.67"), Valid: true}); n != want {
+			t.Errorf("json.Unmarshal() = %v, want %v", n, want)
+		}
+		if err := json.Unmarshal([]byte(`null`), limits.NullDecimal(&n)); err != nil {
+			t.Errorf("json.Unmarshal() failed: %v", err)
+		} else if n.Valid {
+			t.Errorf("json.Unmarshal() = %v, want null", n)
+		}
+		if err := limits.NullDecimal(&n).UnmarshalText([]byte("1e-3")); err != nil {
+			t.Errorf("UnmarshalText() failed: %v", err)
+		} else if want := (NullDecimal{Decimal: MustParse("0.001"), Valid: true}); n != want {
+			t.Errorf("UnmarshalText() = %v, want %v", n, want)
+		}
+		if err := limits.NullDecimal(&n).Scan(nil); err != nil {
+			t.Errorf("Scan(nil) failed: %v", err)
+		} else if n.Valid {
+			t.Errorf("Scan(nil) = %v, want null", n)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var d Decimal
+		var n NullDecimal
+		tests := []struct {
+			name string
+			f    func() error
+			want LimitError
+		}{
+			{"json.Unmarshal(Decimal)", func() error { return json.Unmarshal([]byte(`"1e4"`), limits.Decimal(&d)) }, LimitError{"exponent", 3}},
+			{"Decimal.Scan", func() error { return limits.Decimal(&d).Scan("123456789") }, LimitError{"length", 8}},
+			{"json.Unmarshal(NullDecimal)", func() error { return json.Unmarshal([]byte(`"1e-4"`), limits.NullDecimal(&n)) }, LimitError{"exponent", 3}},
+			{"NullDecimal.UnmarshalText", func() error { return limits.NullDecimal(&n).UnmarshalText([]byte("0.0000001")) }, LimitError{"length", 8}},
+			{"NullDecimal.Scan", func() error { return limits.NullDecimal(&n).Scan([]byte("1e9")) }, LimitError{"exponent", 3}},
+		}
+		for _, tt := range tests {
+			err := tt.f()
+			var got *LimitError
+			if !errors.As(err, &got) {
+				t.Errorf("%v failed with %v, want *LimitError", tt.name, err)
+				continue
+			}
+			if *got != tt.want {
+				t.Errorf("%v failed with %+v, want %+v", tt.name, *got, tt.want)
+			}
+		}
+		if n.Valid {
+			t.Errorf("NullDecimal = %v, want null after errors", n)
+		}
+	})
+}
+
+func TestParseInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			base int
+			want string
+		}{
+			{"0", 0, "0"},
+			{"-0", 0, "0"},
+			{"42", 0, "42"},
+			{"+42", 0, "42"},
+			{"-42", 0, "-42"},
+			{"0x2a", 0, "42"},
+			{"0X2A", 0, "42"},
+			{"-0x2a", 0, "-42"},
+			{"0b101010", 0, "42"},
+			{"0o52", 0, "42"},
+			{"052", 0, "42"},
+			{"1_000", 0, "1000"},
+			{"0x_2a", 0, "42"},
+			{"2a", 16, "42"},
+			{"0x2a", 16, "42"},
+			{"-0x2A", 16, "-42"},
+			{"101010", 2, "42"},
+			{"0b101010", 2, "42"},
+			{"52", 8, "42"},
+			{"0o52", 8, "42"},
+			{"42", 10, "42"},
+			{"0x0", 16, "0"},
+			{"0x8ac7230489e7ffff", 0, "9999999999999999999"},
+			{"-0x8ac7230489e7ffff", 0, "-9999999999999999999"},
+			{"0xde0b6b3a7640000", 0, "1000000000000000000"},
+		}
+		for _, tt := range tests {
+			got, err := ParseInt(tt.s, tt.base)
+			if err != nil {
+				t.Errorf("ParseInt(%q, %v) failed: %v", tt.s, tt.base, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("ParseInt(%q, %v) = %q, want %q", tt.s, tt.base, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			base int
+		}{
+			{"", 0},
+			{"-", 0},
+			{"0x", 0},
+			{"0x", 16},
+			{"--1", 0},
+			{"-+1", 0},
+			{"1.5", 0},
+			{"1e3", 0},
+			{"0x2g", 0},
+			{"2", 2},
+			{"0b2", 0},
+			{"0x2a", 10},
+			{"1_000", 10},
+			{" 1", 0},
+			{"42", 1},
+			{"42", 36},
+			{"0x8ac7230489e80000", 0},
+			{"0xffffffffffffffff", 0},
+			{"0x10000000000000000", 0},
+		}
+		for _, tt := range tests {
+			_, err := ParseInt(tt.s, tt.base)
+			if err == nil {
+				t.Errorf("ParseInt(%q, %v) did not fail", tt.s, tt.base)
+			}
+		}
+	})
+}
+
 func TestMustParse(t *testing.T) {
 	t.Run("error", func(t *testing.T) {
 		defer func() {
@@ -560,6 +1081,60 @@ func TestDecimal_String(t *testing.T) {
 	})
 }
 
+func TestDecimal_IntPartString(t *testing.T) {
+	tests := []struct {
+		d    string
+		want string
+	}{
+		{"0", "0"},
+		{"0.00", "0"},
+		{"-0.99", "0"},
+		{"1", "1"},
+		{"-1.5", "1"},
+		{"1234.56", "1234"},
+		{"-1234.56", "1234"},
+		{"9.996", "9"},
+		{"9999999999999999999", "9999999999999999999"},
+		{"0.9999999999999999999", "0"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.IntPartString()
+		if got != tt.want {
+			t.Errorf("%q.IntPartString() = %q, want %q", d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_FracPartString(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		want  string
+	}{
+		{"0", 2, "00"},
+		{"1234.56", 2, "56"},
+		{"-1234.56", 2, "56"},
+		{"1234.56", 4, "5600"},
+		{"1234.56", 1, "6"},
+		{"1234.05", 2, "05"},
+		{"1234.005", 2, "00"},
+		{"1234.015", 2, "02"},
+		{"9.996", 2, "00"},
+		{"1.5", 0, ""},
+		{"1.5", -1, ""},
+		{"0.0000000000000000001", 19, "0000000000000000001"},
+		{"0.1", 21, "100000000000000000000"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.FracPartString(tt.scale)
+		if got != tt.want {
+			t.Errorf("%q.FracPartString(%v) = %q, want %q", d, tt.scale, got, tt.want)
+		}
+	}
+}
+
 func TestParseBCD(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -717,14 +1292,68 @@ func TestDecimal_Float64(t *testing.T) {
 	}
 }
 
-func TestDecimal_Int64(t *testing.T) {
+func TestDecimal_Q(t *testing.T) {
 	tests := []struct {
-		d                   string
-		scale               int
-		wantWhole, wantFrac int64
-		wantOk              bool
+		d        string
+		fracBits int
+		want     int64
+		wantOk   bool
 	}{
-		// Zeros
+		{"0", 0, 0, true},
+		{"0.00", 16, 0, true},
+		{"1", 0, 1, true},
+		{"1", 16, 1 << 16, true},
+		{"-1", 16, -1 << 16, true},
+		{"1.5", 16, 3 << 15, true},
+		{"0.0000152587890625", 16, 1, true},
+		{"-0.0000152587890625", 16, -1, true},
+		{"0.1", 16, 6554, true},
+		{"-0.1", 16, -6554, true},
+		{"0.1", 32, 429496730, true},
+
+		// Rounding half to even
+		{"0.5", 0, 0, true},
+		{"1.5", 0, 2, true},
+		{"2.5", 0, 2, true},
+		{"-2.5", 0, -2, true},
+		{"0.25", 1, 0, true},
+		{"0.75", 1, 2, true},
+		{"0.00000762939453125", 16, 0, true},
+		{"0.00000762939453126", 16, 1, true},
+
+		// Boundaries
+		{"9223372036854775807", 0, math.MaxInt64, true},
+		{"-9223372036854775808", 0, math.MinInt64, true},
+		{"9223372036854775808", 0, 0, false},
+		{"-9223372036854775809", 0, 0, false},
+		{"0.9999999999999999999", 63, math.MaxInt64, true},
+		{"-1", 63, math.MinInt64, true},
+		{"1", 63, 0, false},
+		{"2147483648", 32, 0, false},
+		{"9999999999999999999", 63, 0, false},
+		{"999999999999999999.9", 63, 0, false},
+
+		// Invalid fractional bits
+		{"1", -1, 0, false},
+		{"1", 64, 0, false},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, ok := d.Q(tt.fracBits)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("%q.Q(%v) = %v, %v, want %v, %v", d, tt.fracBits, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestDecimal_Int64(t *testing.T) {
+	tests := []struct {
+		d                   string
+		scale               int
+		wantWhole, wantFrac int64
+		wantOk              bool
+	}{
+		// Zeros
 		{"0.00", 2, 0, 0, true},
 		{"0.0", 1, 0, 0, true},
 		{"0", 0, 0, 0, true},
@@ -835,6 +1464,404 @@ func TestDecimal_Int64(t *testing.T) {
 	}
 }
 
+func TestDecimal_UnmarshalText_json(t *testing.T) {
+	type Object struct {
+		Number Decimal `json:"number"`
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want string
+		}{
+			{`{"number":"5.67"}`, "5.67"},
+			{`{"number":"-5.670"}`, "-5.670"},
+			{`{"number":"5.67e2"}`, "567"},
+			{`{"number":null}`, "0"},
+			{`{}`, "0"},
+		}
+		for _, tt := range tests {
+			var got Object
+			err := json.Unmarshal([]byte(tt.s), &got)
+			if err != nil {
+				t.Errorf("json.Unmarshal(%q) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got.Number != want {
+				t.Errorf("json.Unmarshal(%q) = %q, want %q", tt.s, got.Number, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			`{"number":5.67}`,
+			`{"number":-5}`,
+			`{"number":5.67e2}`,
+			`{"number":true}`,
+			`{"number":""}`,
+			`{"number":"5.67.8"}`,
+		}
+		for _, tt := range tests {
+			var got Object
+			err := json.Unmarshal([]byte(tt), &got)
+			if err == nil {
+				t.Errorf("json.Unmarshal(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestDecimal_MarshalText_json(t *testing.T) {
+	type Object struct {
+		Number   Decimal  `json:"number,omitzero"`
+		Optional *Decimal `json:"optional,omitempty"`
+	}
+
+	tests := []struct {
+		d    string
+		want string
+	}{
+		{"0", `{}`},
+		{"0.00", `{}`},
+		{"-0.00", `{}`},
+		{"5.67", `{"number":"5.67","optional":"5.67"}`},
+		{"-5.670", `{"number":"-5.670","optional":"-5.670"}`},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		v := Object{Number: d, Optional: d.NilIfZero()}
+		got, err := json.Marshal(v)
+		if err != nil {
+			t.Errorf("json.Marshal(%v) failed: %v", v, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("json.Marshal(%v) = %s, want %s", v, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_UnmarshalText_xml(t *testing.T) {
+	type Object struct {
+		Price  Decimal `xml:"price,attr"`
+		Number Decimal `xml:"Number"`
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s                 string
+			wantPrice, wantNr string
+		}{
+			{`<Object price="5.67"><Number>-5.670</Number></Object>`, "5.67", "-5.670"},
+			{`<Object price="5.67e2"><Number>5.67E-2</Number></Object>`, "567", "0.0567"},
+			{`<Object price="+.5"><Number>5.</Number></Object>`, "0.5", "5"},
+			{`<Object></Object>`, "0", "0"},
+		}
+		for _, tt := range tests {
+			var got Object
+			err := xml.Unmarshal([]byte(tt.s), &got)
+			if err != nil {
+				t.Errorf("xml.Unmarshal(%q) failed: %v", tt.s, err)
+				continue
+			}
+			wantPrice, wantNr := MustParse(tt.wantPrice), MustParse(tt.wantNr)
+			if got.Price != wantPrice || got.Number != wantNr {
+				t.Errorf("xml.Unmarshal(%q) = [%q %q], want [%q %q]", tt.s, got.Price, got.Number, wantPrice, wantNr)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			`<Object price=""></Object>`,
+			`<Object price="5,67"></Object>`,
+			`<Object><Number>5.67.8</Number></Object>`,
+			`<Object><Number>NaN</Number></Object>`,
+		}
+		for _, tt := range tests {
+			var got Object
+			err := xml.Unmarshal([]byte(tt), &got)
+			if err == nil {
+				t.Errorf("xml.Unmarshal(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestDecimal_MarshalText_xml(t *testing.T) {
+	type Object struct {
+		Price  Decimal `xml:"price,attr"`
+		Number Decimal `xml:"Number"`
+	}
+
+	tests := []struct {
+		d    string
+		want string
+	}{
+		{"0", `<Object price="0"><Number>0</Number></Object>`},
+		{"-5.670", `<Object price="-5.670"><Number>-5.670</Number></Object>`},
+		{"5.67e-5", `<Object price="0.0000567"><Number>0.0000567</Number></Object>`},
+		{"5.67E5", `<Object price="567000"><Number>567000</Number></Object>`},
+		{"-0.0000000000000000001", `<Object price="-0.0000000000000000001"><Number>-0.0000000000000000001</Number></Object>`},
+		{"9999999999999999999", `<Object price="9999999999999999999"><Number>9999999999999999999</Number></Object>`},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		v := Object{Price: d, Number: d}
+		got, err := xml.Marshal(v)
+		if err != nil {
+			t.Errorf("xml.Marshal(%v) failed: %v", v, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("xml.Marshal(%v) = %s, want %s", v, got, tt.want)
+		}
+
+		// Round trip preserves the scale
+		var w Object
+		if err := xml.Unmarshal(got, &w); err != nil {
+			t.Errorf("xml.Unmarshal(%s) failed: %v", got, err)
+			continue
+		}
+		if w != v {
+			t.Errorf("xml.Unmarshal(%s) = %v, want %v", got, w, v)
+		}
+	}
+}
+
+func TestDecimal_CompliesWithXSD(t *testing.T) {
+	t.Run("decimal", func(t *testing.T) {
+		for _, c := range corpus {
+			d, err := newSafe(c.neg, fint(c.coef), c.scale)
+			if err != nil {
+				continue
+			}
+			if !d.CompliesWithXSD() {
+				t.Errorf("%q.CompliesWithXSD() = false, want true", d)
+			}
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want bool
+		}{
+			{"0", true},
+			{"-5.67", true},
+			{"+5.67", true},
+			{"5.", true},
+			{".5", true},
+			{"0005.6700", true},
+			{"", false},
+			{".", false},
+			{"-", false},
+			{"+.", false},
+			{"5.67e2", false},
+			{"5.67E2", false},
+			{"5..67", false},
+			{"5.6.7", false},
+			{" 5", false},
+			{"Inf", false},
+			{"NaN", false},
+			{"--5", false},
+		}
+		for _, tt := range tests {
+			if got := isXSDDecimal([]byte(tt.s)); got != tt.want {
+				t.Errorf("isXSDDecimal(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		}
+	})
+}
+
+func TestParseISO6093_NR3(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want string
+		}{
+			{"1.234E+1", "12.34"},
+			{"-1,5e-3", "-0.0015"},
+			{" 5.E0", "5"},
+			{".5E2", "50"},
+			{"1.000E+3", "1000"},
+			{"0.E-2", "0.00"},
+			{"9.999999999999999999E+18", "9999999999999999999"},
+			{"1.E-19", "0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			got, err := ParseISO6093(tt.s, NR3)
+			if err != nil {
+				t.Errorf("ParseISO6093(%q, NR3) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("ParseISO6093(%q, NR3) = %q, want %q", tt.s, got, want)
+			}
+
+			// Round trip
+			s, err := got.FormatISO6093(NR3)
+			if err != nil {
+				t.Errorf("%q.FormatISO6093(NR3) failed: %v", got, err)
+				continue
+			}
+			r, err := ParseISO6093(s, NR3)
+			if err != nil {
+				t.Errorf("ParseISO6093(%q, NR3) failed: %v", s, err)
+				continue
+			}
+			if r != got {
+				t.Errorf("ParseISO6093(%q, NR3) = %q, want %q", s, r, got)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"1.0E400",
+			"1.0E-400",
+			"1.0E+19",
+		}
+		for _, tt := range tests {
+			_, err := ParseISO6093(tt, NR3)
+			if err == nil {
+				t.Errorf("ParseISO6093(%q, NR3) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestDecimal_NilIfZero(t *testing.T) {
+	tests := []string{"0", "0.00", "1", "-5.67"}
+	for _, tt := range tests {
+		d := MustParse(tt)
+		got := d.NilIfZero()
+		switch {
+		case d.IsZero() && got != nil:
+			t.Errorf("%q.NilIfZero() = %v, want nil", d, *got)
+		case !d.IsZero() && (got == nil || *got != d):
+			t.Errorf("%q.NilIfZero() = %v, want %q", d, got, d)
+		}
+	}
+}
+
+func TestDecimal_MarshalBSONValue(t *testing.T) {
+	tests := []struct {
+		d    string
+		want []byte
+	}{
+		{"0", []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x40, 0x30}},
+		{"1", []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x40, 0x30}},
+		{"-1", []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x40, 0xb0}},
+		{"1.23", []byte{0x7b, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x3c, 0x30}},
+		{"0.00", []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x3c, 0x30}},
+		{"9999999999999999999", []byte{0xff, 0xff, 0xe7, 0x89, 0x04, 0x23, 0xc7, 0x8a, 0, 0, 0, 0, 0, 0, 0x40, 0x30}},
+		{"0.0000000000000000001", []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1a, 0x30}},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		gotType, got, err := d.MarshalBSONValue()
+		if err != nil {
+			t.Errorf("%q.MarshalBSONValue() failed: %v", d, err)
+			continue
+		}
+		if gotType != 0x13 || !bytes.Equal(got, tt.want) {
+			t.Errorf("%q.MarshalBSONValue() = %#02x, % x, want 0x13, % x", d, gotType, got, tt.want)
+		}
+		var e Decimal
+		err = e.UnmarshalBSONValue(gotType, got)
+		if err != nil {
+			t.Errorf("UnmarshalBSONValue(0x13, % x) failed: %v", got, err)
+			continue
+		}
+		if e != d {
+			t.Errorf("UnmarshalBSONValue(0x13, % x) = %q, want %q", got, e, d)
+		}
+	}
+}
+
+func TestDecimal_UnmarshalBSONValue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			typ  byte
+			data []byte
+			want string
+		}{
+			// Double
+			{0x01, []byte{0, 0, 0, 0, 0, 0, 0xf8, 0x3f}, "1.5"},
+			{0x01, []byte{0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xb9, 0x3f}, "0.1"},
+
+			// String
+			{0x02, []byte{0x05, 0, 0, 0, '1', '.', '2', '0', 0}, "1.20"},
+			{0x02, []byte{0x06, 0, 0, 0, '-', '1', 'e', '-', '3', 0}, "-0.001"},
+
+			// Int32 and Int64
+			{0x10, []byte{0xff, 0xff, 0xff, 0xff}, "-1"},
+			{0x12, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}, "9223372036854775807"},
+
+			// Decimal128
+			{0x13, []byte{0x7b, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x44, 0x30}, "12300"},
+			{0x13, []byte{0x7b, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x3c, 0xb0}, "-1.23"},
+			{0x13, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x30}, "0.0000000000000000000"},
+			{0x13, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80, 0x30}, "0"},
+			{0x13, []byte{0, 0, 0x64, 0xa7, 0xb3, 0xb6, 0xe0, 0x0d, 0, 0, 0, 0, 0, 0, 0x1c, 0x30}, "1.000000000000000000"},
+			{0x13, []byte{0xff, 0xff, 0xff, 0xff, 0x63, 0x8e, 0x8d, 0x37, 0xc0, 0x87, 0xad, 0xbe, 0x09, 0xed, 0xff, 0x2f}, "10.00000000000000000"},
+			{0x13, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x60}, "0.0000000000000000000"},
+		}
+		for _, tt := range tests {
+			var got Decimal
+			err := got.UnmarshalBSONValue(tt.typ, tt.data)
+			if err != nil {
+				t.Errorf("UnmarshalBSONValue(%#02x, % x) failed: %v", tt.typ, tt.data, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("UnmarshalBSONValue(%#02x, % x) = %q, want %q", tt.typ, tt.data, got, want)
+			}
+		}
+	})
+
+	t.Run("null", func(t *testing.T) {
+		got := MustParse("1.23")
+		err := got.UnmarshalBSONValue(0x0a, nil)
+		if err != nil {
+			t.Errorf("UnmarshalBSONValue(0x0a, nil) failed: %v", err)
+		}
+		if want := MustParse("1.23"); got != want {
+			t.Errorf("UnmarshalBSONValue(0x0a, nil) = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			typ  byte
+			data []byte
+		}{
+			{0x01, []byte{0, 0, 0, 0, 0, 0, 0xf0, 0x7f}},
+			{0x01, []byte{0, 0, 0, 0}},
+			{0x02, []byte{0x05, 0, 0, 0, '1', '.', '2', '0'}},
+			{0x02, []byte{0x04, 0, 0, 0, '1', '.', '2', '0', 0}},
+			{0x02, []byte{0x02, 0, 0, 0, '.', 0}},
+			{0x08, []byte{0x01}},
+			{0x10, []byte{0x01}},
+			{0x12, []byte{0x01}},
+			{0x13, []byte{0x01}},
+			{0x13, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x78}},
+			{0x13, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7c}},
+			{0x13, []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80, 0x31}},
+		}
+		for _, tt := range tests {
+			var d Decimal
+			err := d.UnmarshalBSONValue(tt.typ, tt.data)
+			if err == nil {
+				t.Errorf("UnmarshalBSONValue(%#02x, % x) did not fail", tt.typ, tt.data)
+			}
+		}
+	})
+}
+
 func TestDecimal_Scan(t *testing.T) {
 	t.Run("float64", func(t *testing.T) {
 		tests := []struct {
@@ -916,17 +1943,71 @@ func TestDecimal_Scan(t *testing.T) {
 		}
 	})
 
+	t.Run("integers", func(t *testing.T) {
+		tests := []struct {
+			v    any
+			want string
+		}{
+			{int(math.MinInt32), "-2147483648"},
+			{int(0), "0"},
+			{int(math.MaxInt32), "2147483647"},
+			{int8(math.MinInt8), "-128"},
+			{int8(math.MaxInt8), "127"},
+			{int16(math.MinInt16), "-32768"},
+			{int16(math.MaxInt16), "32767"},
+			{int32(math.MinInt32), "-2147483648"},
+			{int32(math.MaxInt32), "2147483647"},
+			{uint(0), "0"},
+			{uint(123), "123"},
+			{uint8(math.MaxUint8), "255"},
+			{uint16(math.MaxUint16), "65535"},
+			{uint32(math.MaxUint32), "4294967295"},
+			{uint64(0), "0"},
+			{uint64(9999999999999999999), "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			got := Decimal{}
+			err := got.Scan(tt.v)
+			if err != nil {
+				t.Errorf("Scan(%T(%v)) failed: %v", tt.v, tt.v, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("Scan(%T(%v)) = %v, want %v", tt.v, tt.v, got, want)
+			}
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want string
+		}{
+			{"-9223372036854775808", "-9223372036854775808"},
+			{"0", "0"},
+			{"1.230", "1.230"},
+		}
+		for _, tt := range tests {
+			got := Decimal{}
+			err := got.Scan(tt.s)
+			if err != nil {
+				t.Errorf("Scan(%q) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("Scan(%q) = %v, want %v", tt.s, got, want)
+			}
+		}
+	})
+
 	t.Run("error", func(t *testing.T) {
 		tests := []any{
-			int8(123),
-			int16(123),
-			int32(123),
-			int(123),
-			uint8(123),
-			uint16(123),
-			uint32(123),
-			uint(123),
-			uint64(123),
+			uint64(10000000000000000000),
+			uint64(math.MaxUint64),
+			"",
+			".",
 			float32(123),
 			nil,
 		}
@@ -1042,17 +2123,96 @@ func TestDecimal_Format(t *testing.T) {
 		{"9999999999999999999", "%.2f", "9999999999999999999.00"},
 		{"9999999999999999999", "%.3f", "9999999999999999999.000"},
 
+		// Accounting style
+		{"1234.5", "%#f", "1,234.5"},
+		{"-1234.5", "%#f", "(1,234.5)"},
+		{"-1234.5", "%#.2f", "(1,234.50)"},
+		{"-1234.5", "%#12.2f", "  (1,234.50)"},
+		{"-1234.5", "%#-12.2f", "(1,234.50)  "},
+		{"1234.5", "%#+.2f", "+1,234.50"},
+		{"-0.5", "%#f", "(0.5)"},
+		{"0", "%#.2f", "0.00"},
+		{"123", "%#f", "123"},
+		{"-123456", "%#f", "(123,456)"},
+		{"1234567", "%#v", "1,234,567"},
+		{"-1234567", "%#s", "(1,234,567)"},
+		{"-1234567", "%#q", "\"(1,234,567)\""},
+		{"-12.345", "%#k", "(1,234.5%)"},
+		{"9999999999999999999", "%#f", "9,999,999,999,999,999,999"},
+		{"-0.0000000000000000001", "%#f", "(0.0000000000000000001)"},
+
+		// %e verb
+		{"12.34", "%e", "1.234e+01"},
+		{"12.34", "%E", "1.234E+01"},
+		{"-12.340", "%e", "-1.2340e+01"},
+		{"12.34", "%+e", "+1.234e+01"},
+		{"12.34", "% e", " 1.234e+01"},
+		{"12.34", "%.0e", "1e+01"},
+		{"12.34", "%.2e", "1.23e+01"},
+		{"12.35", "%.2e", "1.24e+01"},
+		{"12.34", "%.5e", "1.23400e+01"},
+		{"12.34", "%12e", "   1.234e+01"},
+		{"12.34", "%-12e", "1.234e+01   "},
+		{"12.34", "%012e", "0001.234e+01"},
+		{"-12.34", "%012e", "-001.234e+01"},
+		{"-12.34", "%#e", "(1.234e+01)"},
+		{"-12.34", "%#13e", "  (1.234e+01)"},
+		{"1234567.8", "%#e", "1.2345678e+06"},
+		{"0", "%e", "0e+00"},
+		{"0.00", "%e", "0e+00"},
+		{"0", "%.2e", "0.00e+00"},
+		{"9.99", "%.1e", "1.0e+01"},
+		{"0.00001234", "%e", "1.234e-05"},
+		{"9999999999999999999", "%e", "9.999999999999999999e+18"},
+		{"9999999999999999999", "%.2e", "1.00e+19"},
+		{"0.0000000000000000001", "%e", "1e-19"},
+		{"1", "%.20e", "1.00000000000000000000e+00"},
+
+		// %g verb
+		{"12.34", "%g", "12.34"},
+		{"12.34", "%G", "12.34"},
+		{"-12.340", "%g", "-12.340"},
+		{"12.34", "%+g", "+12.34"},
+		{"12.34", "%.3g", "12.3"},
+		{"12.34", "%.10g", "12.34"},
+		{"12.34", "%.0g", "1e+01"},
+		{"12.34", "%8g", "   12.34"},
+		{"12.34", "%-8g", "12.34   "},
+		{"12.34", "%08g", "00012.34"},
+		{"0", "%g", "0"},
+		{"0.00", "%g", "0.00"},
+		{"123456", "%g", "123456"},
+		{"123456.7", "%g", "123456.7"},
+		{"1234567", "%g", "1.234567e+06"},
+		{"1234567", "%G", "1.234567E+06"},
+		{"1234567", "%.10g", "1234567"},
+		{"1234567", "%.3g", "1.23e+06"},
+		{"1234567", "%14g", "  1.234567e+06"},
+		{"-1234567", "%#g", "(1.234567e+06)"},
+		{"-1234.5", "%#g", "(1234.5)"},
+		{"0.0001234", "%g", "0.0001234"},
+		{"0.00001234", "%g", "1.234e-05"},
+		{"0.0001234", "%.2g", "0.00012"},
+		{"9.99", "%.2g", "10"},
+		{"9.99", "%.1g", "1e+01"},
+		{"1.999", "%.3g", "2"},
+		{"9999999999999999999", "%g", "9.999999999999999999e+18"},
+		{"9999999999999999999", "%.10g", "1e+19"},
+		{"-0.0000000000000000001", "%g", "-1e-19"},
+
 		// Wrong verbs
 		{"12.34", "%b", "%!b(decimal.Decimal=12.34)"},
-		{"12.34", "%e", "%!e(decimal.Decimal=12.34)"},
-		{"12.34", "%E", "%!E(decimal.Decimal=12.34)"},
-		{"12.34", "%g", "%!g(decimal.Decimal=12.34)"},
-		{"12.34", "%G", "%!G(decimal.Decimal=12.34)"},
 		{"12.34", "%x", "%!x(decimal.Decimal=12.34)"},
 		{"12.34", "%X", "%!X(decimal.Decimal=12.34)"},
 
-		// Errors
-		{"9999999999999999999", "%k", "%!k(PANIC=Format method: formatting percent: computing [9999999999999999999 * 100]: decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has 21 digits)"},
+		// Large percentages
+		{"9999999999999999999", "%k", "999999999999999999900%"},
+		{"-9999999999999999999", "%#k", "(999,999,999,999,999,999,900%)"},
+		{"999999999999999999.9", "%k", "99999999999999999990%"},
+		{"999999999999999999.9", "%.2k", "99999999999999999990.00%"},
+		{"0", "%k", "0%"},
+		{"0.0", "%k", "0%"},
+		{"0", "%.1k", "0.0%"},
 	}
 	for _, tt := range tests {
 		d := MustParse(tt.d)
@@ -1063,6 +2223,55 @@ func TestDecimal_Format(t *testing.T) {
 	}
 }
 
+func TestFormatOptions_Format(t *testing.T) {
+	tests := []struct {
+		d    string
+		opts FormatOptions
+		want string
+	}{
+		{"1234.5", FormatOptions{}, "1234.5"},
+		{"-1234.5", FormatOptions{}, "-1234.5"},
+		{"1234.5", FormatOptions{Plus: true}, "+1234.5"},
+		{"1234.5", FormatOptions{Space: true}, " 1234.5"},
+		{"1234.5", FormatOptions{Scale: 2, FixedScale: true}, "1234.50"},
+		{"1234.5", FormatOptions{Scale: 0, FixedScale: true}, "1234"},
+		{"1234.5", FormatOptions{Scale: 0}, "1234.5"},
+		{"1234.5", FormatOptions{Width: 10}, "    1234.5"},
+		{"1234.5", FormatOptions{Width: 10, LeftAlign: true}, "1234.5    "},
+		{"1234.5", FormatOptions{Width: 10, ZeroPad: true}, "00001234.5"},
+		{"1234.5", FormatOptions{Width: 13, Scale: 2, FixedScale: true, Plus: true, ZeroPad: true}, "+000001234.50"},
+		{"-1234.5", FormatOptions{Width: 13, Scale: 2, FixedScale: true, Plus: true, ZeroPad: true}, "-000001234.50"},
+		{"1234.5", FormatOptions{Width: 3}, "1234.5"},
+		{"0.005", FormatOptions{Scale: 2, FixedScale: true}, "0.00"},
+		{"0.015", FormatOptions{Scale: 2, FixedScale: true}, "0.02"},
+		{"-1234.5", FormatOptions{Scale: 2, FixedScale: true, Grouping: true}, "-1,234.50"},
+		{"-1234.5", FormatOptions{Scale: 2, FixedScale: true, Parentheses: true}, "(1234.50)"},
+		{"1234.5", FormatOptions{Scale: 2, FixedScale: true, Parentheses: true}, "1234.50"},
+		{"-1234.5", FormatOptions{Width: 12, Scale: 2, FixedScale: true, Grouping: true, Parentheses: true}, "  (1,234.50)"},
+		{"1234.5", FormatOptions{Scale: 2, FixedScale: true, Grouping: true, GroupSeparator: '\''}, "1'234.50"},
+		{"1234567.5", FormatOptions{Scale: 2, FixedScale: true, Grouping: true, GroupSeparator: ' ', DecimalSeparator: ','}, "1 234 567,50"},
+		{"1234567.5", FormatOptions{Grouping: true, GroupSeparator: '\u202f', DecimalSeparator: ','}, "1\u202f234\u202f567,5"},
+		{"1234567.5", FormatOptions{Width: 12, Grouping: true, GroupSeparator: '\u202f'}, " 1\u202f234\u202f567.5"},
+		{"-1234.5", FormatOptions{Width: 12, ZeroPad: true, DecimalSeparator: '٫'}, "-000001234٫5"},
+		{"1234.5", FormatOptions{GroupSeparator: '\''}, "1234.5"},
+		{"1234", FormatOptions{DecimalSeparator: ','}, "1234"},
+		{"0.5", FormatOptions{DecimalSeparator: -1}, "0.5"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := tt.opts.Format(d)
+		if got != tt.want {
+			t.Errorf("%+v.Format(%q) = %q, want %q", tt.opts, d, got, tt.want)
+		}
+		// Append
+		prefix := []byte("amount=")
+		got = string(tt.opts.Append(prefix, d))
+		if got != string(prefix)+tt.want {
+			t.Errorf("%+v.Append(%q, %q) = %q, want %q", tt.opts, prefix, d, got, string(prefix)+tt.want)
+		}
+	}
+}
+
 func TestDecimal_Prec(t *testing.T) {
 	tests := []struct {
 		d    string
@@ -1144,21 +2353,48 @@ func TestDecimal_Prec(t *testing.T) {
 	}
 }
 
-func TestDecimal_Rescale(t *testing.T) {
+func TestDecimal_LeadingDigit(t *testing.T) {
 	tests := []struct {
-		d     string
-		scale int
-		want  string
+		d    string
+		want int
 	}{
-		// Zeros
-		{"0", 0, "0"},
-		{"0", 1, "0.0"},
-		{"0", 2, "0.00"},
-		{"0", 19, "0.0000000000000000000"},
-		{"0.0", 1, "0.0"},
-		{"0.00", 2, "0.00"},
-		{"0.000000000", 19, "0.0000000000000000000"},
-		{"0.000000000", 0, "0"},
+		{"0", 0},
+		{"0.00", 0},
+		{"0.0000000000000000001", 1},
+		{"0.0000000000000000009", 9},
+		{"0.05", 5},
+		{"-0.05", 5},
+		{"1", 1},
+		{"-7.89", 7},
+		{"123.45", 1},
+		{"9999999999999999999", 9},
+		{"5000000000000000000", 5},
+		{"0.2000000000000000000", 2},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.LeadingDigit()
+		if got != tt.want {
+			t.Errorf("%q.LeadingDigit() = %v, want %v", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_Rescale(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		want  string
+	}{
+		// Zeros
+		{"0", 0, "0"},
+		{"0", 1, "0.0"},
+		{"0", 2, "0.00"},
+		{"0", 19, "0.0000000000000000000"},
+		{"0.0", 1, "0.0"},
+		{"0.00", 2, "0.00"},
+		{"0.000000000", 19, "0.0000000000000000000"},
+		{"0.000000000", 0, "0"},
 		{"0.000000000", 1, "0.0"},
 		{"0.000000000", 2, "0.00"},
 
@@ -1231,6 +2467,8 @@ func TestDecimal_Rescale(t *testing.T) {
 		{"1000000000000", 7, "1000000000000.000000"},
 		{"1", 19, "1.000000000000000000"},
 		{"0", 20, "0.0000000000000000000"},
+		{"1.5", 100, "1.500000000000000000"},
+		{"1.5", -100, "2"},
 	}
 	for _, tt := range tests {
 		d := MustParse(tt.d)
@@ -1759,6 +2997,102 @@ func TestDecimal_Trim(t *testing.T) {
 	}
 }
 
+func TestDecimal_MulPow10(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			n    int
+			want string
+		}{
+			{"0", 0, "0"},
+			{"0", 5, "0"},
+			{"0.00", 1, "0.0"},
+			{"0", 100, "0"},
+			{"1.23", 0, "1.23"},
+			{"1.23", 1, "12.3"},
+			{"1.23", 2, "123"},
+			{"1.23", 9, "1230000000"},
+			{"-1.23", 3, "-1230"},
+			{"1.230", 2, "123.0"},
+			{"1", 18, "1000000000000000000"},
+			{"0.0000000000000000001", 37, "1000000000000000000"},
+			{"1.23", -2, "0.0123"},
+			{"-1.23", -17, "-0.0000000000000000123"},
+			{"1000", -19, "0.0000000000000001000"},
+			{"0", -100, "0.0000000000000000000"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.MulPow10(tt.n)
+			if err != nil {
+				t.Errorf("%q.MulPow10(%v) failed: %v", d, tt.n, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.MulPow10(%v) = %q, want %q", d, tt.n, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d string
+			n int
+		}{
+			{"1", 19},
+			{"1.23", 19},
+			{"-9999999999999999999", 1},
+			{"1", math.MaxInt},
+			{"1.23", -18},
+			{"1", -20},
+			{"1", math.MinInt},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := d.MulPow10(tt.n)
+			if err == nil {
+				t.Errorf("%q.MulPow10(%v) did not fail", d, tt.n)
+			}
+		}
+	})
+}
+
+func TestDecimal_DivPow10(t *testing.T) {
+	tests := []struct {
+		d         string
+		n         int
+		want      string
+		wantExact bool
+	}{
+		{"0", 0, "0", true},
+		{"0", 100, "0.0000000000000000000", true},
+		{"1.23", 0, "1.23", true},
+		{"1.23", 2, "0.0123", true},
+		{"-123", 5, "-0.00123", true},
+		{"1.23", 17, "0.0000000000000000123", true},
+		{"1.23", 18, "0.0000000000000000012", false},
+		{"1.25", 18, "0.0000000000000000012", false},
+		{"1.35", 18, "0.0000000000000000014", false},
+		{"1000", 19, "0.0000000000000001000", true},
+		{"1000", 22, "0.0000000000000000001", true},
+		{"1000", 23, "0.0000000000000000000", false},
+		{"1", math.MaxInt, "0.0000000000000000000", false},
+		{"1.23", -2, "123", true},
+		{"1.23", -3, "1230", true},
+		{"1", -19, "0", false},
+		{"1", math.MinInt, "0", false},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, exact := d.DivPow10(tt.n)
+		want := MustParse(tt.want)
+		if got != want || exact != tt.wantExact {
+			t.Errorf("%q.DivPow10(%v) = (%q, %v), want (%q, %v)", d, tt.n, got, exact, want, tt.wantExact)
+		}
+	}
+}
+
 func TestSum(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -1856,6 +3190,217 @@ func TestSum(t *testing.T) {
 	})
 }
 
+func TestSumParallel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			name string
+			gen  func(i int) Decimal
+		}{
+			{"small", func(i int) Decimal { return MustNew(int64(i%1000)-500, i%4) }},
+			{"large", func(i int) Decimal {
+				d := MustNew(9_000_000_000_000_000_000-int64(i), i/2%3)
+				if i%2 == 1 {
+					return d.Neg()
+				}
+				return d
+			}},
+			{"cancel", func(i int) Decimal {
+				if i%2 == 0 {
+					return MustParse("9999999999999999999")
+				}
+				return MustParse("-9999999999999999999")
+			}},
+			{"rounding", func(i int) Decimal {
+				if i == 0 {
+					return MustParse("1000000000000000000")
+				}
+				return MustParse("0.0000000000000000001")
+			}},
+		}
+		for _, tt := range tests {
+			for _, n := range []int{1, 2, 1023, 1024, 2048, 10_000, 50_001} {
+				d := make([]Decimal, n)
+				for i := range d {
+					d[i] = tt.gen(i)
+				}
+				want, err := Sum(d...)
+				if err != nil {
+					t.Errorf("Sum(%v, %v) failed: %v", tt.name, n, err)
+					continue
+				}
+				for _, workers := range []int{-1, 0, 1, 2, 3, 7, 16, 1000} {
+					got, err := SumParallel(d, workers)
+					if err != nil {
+						t.Errorf("SumParallel(%v, %v, %v) failed: %v", tt.name, n, workers, err)
+						continue
+					}
+					if got != want {
+						t.Errorf("SumParallel(%v, %v, %v) = %q, want %q", tt.name, n, workers, got, want)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := SumParallel(nil, 4)
+		if err == nil {
+			t.Errorf("SumParallel(nil, 4) did not fail")
+		}
+		d := make([]Decimal, 10_000)
+		for i := range d {
+			d[i] = MustParse("9999999999999999999")
+		}
+		_, err = SumParallel(d, 4)
+		if err == nil {
+			t.Errorf("SumParallel(d, 4) did not fail")
+		}
+	})
+}
+
+func TestSumOrZero(t *testing.T) {
+	got, err := SumOrZero()
+	if err != nil {
+		t.Errorf("SumOrZero() failed: %v", err)
+	}
+	if got != Zero {
+		t.Errorf("SumOrZero() = %q, want %q", got, Zero)
+	}
+	got, err = SumOrZero(MustParse("1.5"), MustParse("2"))
+	if err != nil {
+		t.Errorf("SumOrZero(1.5, 2) failed: %v", err)
+	}
+	if want := MustParse("3.5"); got != want {
+		t.Errorf("SumOrZero(1.5, 2) = %q, want %q", got, want)
+	}
+	_, err = SumOrZero(MustParse("9999999999999999999"), One)
+	if err == nil {
+		t.Errorf("SumOrZero(9999999999999999999, 1) did not fail")
+	}
+}
+
+func TestSumChecked(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"1"}, "1"},
+			{[]string{"1.5", "2"}, "3.5"},
+			{[]string{"9999999999999999999", "9999999999999999999", "-9999999999999999999"}, "9999999999999999999"},
+			{[]string{"0.1", "0.0000000000000000001"}, "0.1000000000000000001"},
+		}
+		for _, tt := range tests {
+			d := make([]Decimal, len(tt.d))
+			for i, s := range tt.d {
+				d[i] = MustParse(s)
+			}
+			got, failedAt, err := SumChecked(d)
+			if err != nil {
+				t.Errorf("SumChecked(%v) failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want || failedAt != -1 {
+				t.Errorf("SumChecked(%v) = (%q, %v), want (%q, %v)", d, got, failedAt, want, -1)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d        []string
+			want     string
+			failedAt int
+		}{
+			{[]string{"1", "9999999999999999999", "5"}, "1", 1},
+			{[]string{"1", "2", "9999999999999999997", "-10", "20"}, "3", 2},
+			{[]string{"-9999999999999999999", "-1"}, "-9999999999999999999", 1},
+			{[]string{"9999999999999999999", "0.25", "0.25"}, "9999999999999999999", 2},
+		}
+		for _, tt := range tests {
+			d := make([]Decimal, len(tt.d))
+			for i, s := range tt.d {
+				d[i] = MustParse(s)
+			}
+			got, failedAt, err := SumChecked(d)
+			if err == nil {
+				t.Errorf("SumChecked(%v) did not fail", d)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want || failedAt != tt.failedAt {
+				t.Errorf("SumChecked(%v) = (%q, %v), want (%q, %v)", d, got, failedAt, want, tt.failedAt)
+			}
+		}
+
+		_, failedAt, err := SumChecked(nil)
+		if err == nil || failedAt != -1 {
+			t.Errorf("SumChecked(nil) = (%v, %v), want (-1, error)", failedAt, err)
+		}
+	})
+}
+
+func TestMean(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"0"}, "0"},
+			{[]string{"5.67"}, "5.67"},
+			{[]string{"1", "2"}, "1.5"},
+			{[]string{"1", "3"}, "2"},
+			{[]string{"1.00", "3"}, "2.00"},
+			{[]string{"1", "2", "3", "4"}, "2.5"},
+			{[]string{"-1", "1"}, "0"},
+			{[]string{"-1.5", "-2.5"}, "-2.0"},
+			{[]string{"1", "1", "2"}, "1.333333333333333333"},
+			{[]string{"9999999999999999999", "9999999999999999999"}, "9999999999999999999"},
+			{[]string{"9999999999999999999", "9999999999999999998"}, "9999999999999999998"},
+			{[]string{"9999999999999999999", "9999999999999999997"}, "9999999999999999998"},
+			{[]string{"-9999999999999999999", "-9999999999999999999", "-9999999999999999999"}, "-9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := make([]Decimal, len(tt.d))
+			for i, s := range tt.d {
+				d[i] = MustParse(s)
+			}
+			got, err := Mean(d...)
+			if err != nil {
+				t.Errorf("Mean(%v) failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("Mean(%v) = %q, want %q", d, got, want)
+			}
+			got, err = MeanOrZero(d...)
+			if err != nil {
+				t.Errorf("MeanOrZero(%v) failed: %v", d, err)
+				continue
+			}
+			if got != want {
+				t.Errorf("MeanOrZero(%v) = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Mean()
+		if err == nil {
+			t.Errorf("Mean() did not fail")
+		}
+		got, err := MeanOrZero()
+		if err != nil {
+			t.Errorf("MeanOrZero() failed: %v", err)
+		}
+		if got != Zero {
+			t.Errorf("MeanOrZero() = %q, want %q", got, Zero)
+		}
+	})
+}
+
 func TestDecimal_Add(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -2104,6 +3649,65 @@ func TestProd(t *testing.T) {
 	})
 }
 
+func TestProdChecked(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"2"}, "2"},
+			{[]string{"1.5", "2"}, "3.0"},
+			{[]string{"10000000000", "10000000000", "0"}, "0"},
+		}
+		for _, tt := range tests {
+			d := make([]Decimal, len(tt.d))
+			for i, s := range tt.d {
+				d[i] = MustParse(s)
+			}
+			got, failedAt, err := ProdChecked(d)
+			if err != nil {
+				t.Errorf("ProdChecked(%v) failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want || failedAt != -1 {
+				t.Errorf("ProdChecked(%v) = (%q, %v), want (%q, %v)", d, got, failedAt, want, -1)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d        []string
+			want     string
+			failedAt int
+		}{
+			{[]string{"2", "5000000000000000000", "3"}, "2", 1},
+			{[]string{"10000000000", "10000000000", "2"}, "10000000000", 1},
+		}
+		for _, tt := range tests {
+			d := make([]Decimal, len(tt.d))
+			for i, s := range tt.d {
+				d[i] = MustParse(s)
+			}
+			got, failedAt, err := ProdChecked(d)
+			if err == nil {
+				t.Errorf("ProdChecked(%v) did not fail", d)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want || failedAt != tt.failedAt {
+				t.Errorf("ProdChecked(%v) = (%q, %v), want (%q, %v)", d, got, failedAt, want, tt.failedAt)
+			}
+		}
+
+		_, failedAt, err := ProdChecked(nil)
+		if err == nil || failedAt != -1 {
+			t.Errorf("ProdChecked(nil) = (%v, %v), want (-1, error)", failedAt, err)
+		}
+	})
+}
+
 func TestDecimal_Mul(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -2718,40 +4322,187 @@ func TestDecimal_PowInt(t *testing.T) {
 	})
 }
 
-func TestDecimal_Sqrt(t *testing.T) {
+func TestDecimal_MathExact(t *testing.T) {
+	ops := map[string]func(d Decimal, scale int) (Decimal, error){
+		"PowIntExact(2)":  func(d Decimal, scale int) (Decimal, error) { return d.PowIntExact(2, scale) },
+		"PowIntExact(-1)": func(d Decimal, scale int) (Decimal, error) { return d.PowIntExact(-1, scale) },
+		"SqrtExact":       Decimal.SqrtExact,
+		"ExpExact":        Decimal.ExpExact,
+		"LogExact":        Decimal.LogExact,
+	}
+
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
-			d, want string
+			op    string
+			d     string
+			scale int
+			want  string
 		}{
-			// Zeros
-			{"0.00000000", "0.0000"},
-			{"0.0000000", "0.000"},
-			{"0.000000", "0.000"},
-			{"0.00000", "0.00"},
-			{"0.0000", "0.00"},
-			{"0.000", "0.0"},
-			{"0.00", "0.0"},
-			{"0.0", "0"},
-			{"0", "0"},
-
-			// Trailing zeros
-			{"0.010000000", "0.1000"},
-			{"0.01000000", "0.1000"},
-			{"0.0100000", "0.100"},
-			{"0.010000", "0.100"},
-			{"0.01000", "0.10"},
-			{"0.0100", "0.10"},
-			{"0.010", "0.1"},
-			{"0.01", "0.1"},
+			{"PowIntExact(2)", "1.5", 0, "2.25"},
+			{"PowIntExact(2)", "32", 2, "1024.00"},
+			{"PowIntExact(2)", "1000000000", 0, "1000000000000000000"},
+			{"PowIntExact(-1)", "3", 19, "0.3333333333333333333"},
+			{"SqrtExact", "0", 2, "0.00"},
+			{"SqrtExact", "4", 2, "2.00"},
+			{"SqrtExact", "2", 18, "1.414213562373095049"},
+			{"ExpExact", "0", 2, "1.00"},
+			{"ExpExact", "1", 18, "2.718281828459045235"},
+			{"ExpExact", "0.5", 5, "1.648721270700128147"},
+			{"ExpExact", "40", 1, "235385266837019985.4"},
+			{"LogExact", "1", 3, "0.000"},
+			{"LogExact", "10", 18, "2.302585092994045684"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := ops[tt.op](d, tt.scale)
+			if err != nil {
+				t.Errorf("%q.%v(%v) failed: %v", d, tt.op, tt.scale, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.%v(%v) = %q, want %q", d, tt.op, tt.scale, got, want)
+			}
+		}
+	})
 
-			// Powers of ten
-			{"0.00000001", "0.0001"},
-			{"0.0000001", "0.0003162277660168379"},
-			{"0.000001", "0.001"},
-			{"0.00001", "0.0031622776601683793"},
-			{"0.0001", "0.01"},
-			{"0.001", "0.0316227766016837933"},
-			{"0.01", "0.1"},
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			op    string
+			d     string
+			scale int
+		}{
+			{"PowIntExact(2)", "1000000000", 1},
+			{"PowIntExact(2)", "10000000000", 0},
+			{"PowIntExact(-1)", "0", 0},
+			{"SqrtExact", "2", 19},
+			{"SqrtExact", "-1", 0},
+			{"ExpExact", "1", 19},
+			{"ExpExact", "40", 2},
+			{"ExpExact", "1", -1},
+			{"ExpExact", "1", MaxScale + 1},
+			{"LogExact", "10", 19},
+			{"LogExact", "0", 0},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := ops[tt.op](d, tt.scale)
+			if err == nil {
+				t.Errorf("%q.%v(%v) did not fail", d, tt.op, tt.scale)
+			}
+		}
+	})
+}
+
+func TestDecimal_PowDecimal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			// Integer powers
+			{"0", "0", "1"},
+			{"0", "0.00", "1"},
+			{"-2", "2", "4"},
+			{"-2", "2.00", "4"},
+			{"-2", "3.0", "-8"},
+			{"-2", "-2.0", "0.25"},
+			{"1.5", "2.000", "2.25"},
+
+			// Huge integer powers
+			{"1", "9999999999999999998", "1"},
+			{"-1", "9999999999999999998", "1"},
+			{"-1", "9999999999999999999", "-1"},
+			{"-2", "-9999999999999999999", "0"},
+			{"-0.5", "9999999999999999999", "0"},
+			{"1.000000000000000001", "9999999999999999998", "22026.46579480671636"},
+
+			// Fractional powers
+			{"0", "0.5", "0"},
+			{"1", "0.5", "1"},
+			{"2", "0.5", "1.414213562373095049"},
+			{"4", "0.5", "2"},
+			{"2", "-0.5", "0.7071067811865475244"},
+			{"10", "2.5", "316.2277660168379332"},
+			{"0.5", "1.5", "0.3535533905932737622"},
+			{"1.1", "-0.1", "0.9905142582145217826"},
+			{"1.0001", "10000.5", "2.71828183072405359"},
+			{"27", "0.3333333333333333333", "3"},
+			{"2", "62.5", "6521908912666391106"},
+			{"0.1", "20.5", "0"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.PowDecimal(e)
+			if err != nil {
+				t.Errorf("%q.PowDecimal(%q) failed: %v", d, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.PowDecimal(%q) = %q, want %q", d, e, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d, e string
+		}{
+			{"0", "-1"},
+			{"0", "-0.5"},
+			{"-2", "0.5"},
+			{"-2", "2.01"},
+			{"2", "64"},
+			{"2", "63.5"},
+			{"2", "9999999999999999998"},
+			{"0.5", "-9999999999999999998"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			_, err := d.PowDecimal(e)
+			if err == nil {
+				t.Errorf("%q.PowDecimal(%q) did not fail", d, e)
+			}
+		}
+	})
+}
+
+func TestDecimal_Sqrt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			// Zeros
+			{"0.00000000", "0.0000"},
+			{"0.0000000", "0.000"},
+			{"0.000000", "0.000"},
+			{"0.00000", "0.00"},
+			{"0.0000", "0.00"},
+			{"0.000", "0.0"},
+			{"0.00", "0.0"},
+			{"0.0", "0"},
+			{"0", "0"},
+
+			// Trailing zeros
+			{"0.010000000", "0.1000"},
+			{"0.01000000", "0.1000"},
+			{"0.0100000", "0.100"},
+			{"0.010000", "0.100"},
+			{"0.01000", "0.10"},
+			{"0.0100", "0.10"},
+			{"0.010", "0.1"},
+			{"0.01", "0.1"},
+
+			// Powers of ten
+			{"0.00000001", "0.0001"},
+			{"0.0000001", "0.0003162277660168379"},
+			{"0.000001", "0.001"},
+			{"0.00001", "0.0031622776601683793"},
+			{"0.0001", "0.01"},
+			{"0.001", "0.0316227766016837933"},
+			{"0.01", "0.1"},
 			{"0.1", "0.3162277660168379332"},
 			{"1", "1"},
 			{"10", "3.162277660168379332"},
@@ -2864,6 +4615,82 @@ func TestDecimal_Sqrt(t *testing.T) {
 	})
 }
 
+func TestDecimal_fastPaths(t *testing.T) {
+	t.Run("sqrtFint", func(t *testing.T) {
+		tests := []struct {
+			d      string
+			wantOk bool
+		}{
+			{"0.0000000000000000001", false},
+			{"0.0004", true},
+			{"0.01", true},
+			{"0.04", true},
+			{"0.1", false},
+			{"1", true},
+			{"2", false},
+			{"2.25", true},
+			{"10", false},
+			{"144", true},
+			{"1000", false},
+			{"1024", true},
+			{"9999999998935075600", true},
+			{"9999999999999999999", false},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.sqrtFint()
+			if (err == nil) != tt.wantOk {
+				t.Errorf("%q.sqrtFint() error = %v, want ok %v", d, err, tt.wantOk)
+				continue
+			}
+			if err != nil {
+				continue
+			}
+			want, err := d.sqrtBint(context.Background())
+			if err != nil {
+				t.Errorf("%q.sqrtBint() failed: %v", d, err)
+				continue
+			}
+			if got.Cmp(want) != 0 {
+				t.Errorf("%q.sqrtFint() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("invPowIntFint", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			power int
+		}{
+			{"1", -1},
+			{"2", -1},
+			{"2", -10},
+			{"0.5", -3},
+			{"-0.2", -5},
+			{"4", -2},
+			{"0.001", -6},
+			{"200", -4},
+			{"262144000", -1},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.invPowIntFint(tt.power)
+			if err != nil {
+				t.Errorf("%q.invPowIntFint(%v) failed: %v", d, tt.power, err)
+				continue
+			}
+			want, err := d.powIntBint(context.Background(), tt.power)
+			if err != nil {
+				t.Errorf("%q.powIntBint(%v) failed: %v", d, tt.power, err)
+				continue
+			}
+			if got.Cmp(want) != 0 {
+				t.Errorf("%q.invPowIntFint(%v) = %q, want %q", d, tt.power, got, want)
+			}
+		}
+	})
+}
+
 func TestDecimal_Exp(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -3341,6 +5168,187 @@ func TestDecimal_Log(t *testing.T) {
 	})
 }
 
+func TestDecimal_Ctx(t *testing.T) {
+	ops := []struct {
+		name string
+		f    func(d Decimal, ctx context.Context) (Decimal, error)
+		g    func(d Decimal) (Decimal, error)
+	}{
+		{"PowCtx", func(d Decimal, ctx context.Context) (Decimal, error) { return d.PowCtx(ctx, -3) }, func(d Decimal) (Decimal, error) { return d.PowInt(-3) }},
+		{"SqrtCtx", Decimal.SqrtCtx, Decimal.Sqrt},
+		{"ExpCtx", Decimal.ExpCtx, Decimal.Exp},
+		{"LogCtx", Decimal.LogCtx, Decimal.Log},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		decimals := []string{"0", "1", "-1", "2", "0.5", "5.67", "-0.25", "45"}
+		for _, op := range ops {
+			for _, s := range decimals {
+				d := MustParse(s)
+				got, gotErr := op.f(d, context.Background())
+				want, wantErr := op.g(d)
+				if (gotErr == nil) != (wantErr == nil) {
+					t.Errorf("%q.%v() error = %v, want %v", d, op.name, gotErr, wantErr)
+					continue
+				}
+				if got != want {
+					t.Errorf("%q.%v() = %q, want %q", d, op.name, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		d := MustParse("2")
+		for _, op := range ops {
+			_, err := op.f(d, ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("%q.%v() error = %v, want %v", d, op.name, err, context.Canceled)
+			}
+		}
+	})
+
+	t.Run("interrupted", func(t *testing.T) {
+		if !hasBint {
+			t.Skip("requires *big.Int arithmetic")
+		}
+		tests := []struct {
+			name string
+			f    func(d Decimal, ctx context.Context) (Decimal, error)
+			d    string
+		}{
+			{"PowCtx", func(d Decimal, ctx context.Context) (Decimal, error) { return d.PowCtx(ctx, 1000) }, "1.000000001"},
+			{"SqrtCtx", Decimal.SqrtCtx, "2"},
+			{"ExpCtx", Decimal.ExpCtx, "0.5"},
+			{"LogCtx", Decimal.LogCtx, "2"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			// The first check before the computation passes
+			ctx := &countdownCtx{Context: context.Background(), n: 1}
+			_, err := tt.f(d, ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("%q.%v() error = %v, want %v", d, tt.name, err, context.Canceled)
+			}
+		}
+	})
+}
+
+// countdownCtx is a context that becomes done after n calls to Err.
+type countdownCtx struct {
+	context.Context
+	n int
+}
+
+func (c *countdownCtx) Err() error {
+	if c.n <= 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestExpSlice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := [][]string{
+			{},
+			{"0"},
+			{"0", "1", "-1", "0.5", "-0.5", "2.5", "10", "-10", "43.749", "-45", "-1000"},
+			{"0.0000000000000000001", "0.1", "1.1", "11.1", "-11.1", "-1.1"},
+		}
+		for _, tt := range tests {
+			d := make([]Decimal, len(tt))
+			for i, s := range tt {
+				d[i] = MustParse(s)
+			}
+			got, err := ExpSlice(d)
+			if err != nil {
+				t.Errorf("ExpSlice(%v) failed: %v", d, err)
+				continue
+			}
+			for i := range d {
+				want, err := d[i].Exp()
+				if err != nil {
+					t.Errorf("%q.Exp() failed: %v", d[i], err)
+					continue
+				}
+				if got[i] != want {
+					t.Errorf("ExpSlice(%v)[%v] = %q, want %q", d, i, got[i], want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := [][]string{
+			{"0", "1", "44"},
+			{"50"},
+		}
+		for _, tt := range tests {
+			d := make([]Decimal, len(tt))
+			for i, s := range tt {
+				d[i] = MustParse(s)
+			}
+			_, err := ExpSlice(d)
+			if err == nil {
+				t.Errorf("ExpSlice(%v) did not fail", d)
+			}
+		}
+	})
+}
+
+func TestLogSlice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := [][]string{
+			{},
+			{"1"},
+			{"1", "2", "0.5", "10", "2.718281828459045236", "0.0000000000000000001", "9999999999999999999"},
+			{"1.0000000000000000001", "0.9999999999999999999", "123.456", "1000000"},
+		}
+		for _, tt := range tests {
+			d := make([]Decimal, len(tt))
+			for i, s := range tt {
+				d[i] = MustParse(s)
+			}
+			got, err := LogSlice(d)
+			if err != nil {
+				t.Errorf("LogSlice(%v) failed: %v", d, err)
+				continue
+			}
+			for i := range d {
+				want, err := d[i].Log()
+				if err != nil {
+					t.Errorf("%q.Log() failed: %v", d[i], err)
+					continue
+				}
+				if got[i] != want {
+					t.Errorf("LogSlice(%v)[%v] = %q, want %q", d, i, got[i], want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := [][]string{
+			{"0"},
+			{"1", "2", "-1"},
+			{"1", "0.00"},
+		}
+		for _, tt := range tests {
+			d := make([]Decimal, len(tt))
+			for i, s := range tt {
+				d[i] = MustParse(s)
+			}
+			_, err := LogSlice(d)
+			if err == nil {
+				t.Errorf("LogSlice(%v) did not fail", d)
+			}
+		}
+	})
+}
+
 func TestDecimal_Abs(t *testing.T) {
 	tests := []struct {
 		d, want string
@@ -3410,48 +5418,305 @@ func TestDecimal_Neg(t *testing.T) {
 	}
 }
 
-func TestDecimal_Quo(t *testing.T) {
+func TestDecimal_Delta(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
-			d, e, want string
+			d, e     string
+			wantMag  string
+			wantSign int
 		}{
-			// Zeros
-			{"0", "1.000", "0"},
-			{"0.0", "1.000", "0"},
-			{"0.00", "1.000", "0"},
-			{"0.000", "1.000", "0"},
-			{"0.0000", "1.000", "0.0"},
-			{"0.00000", "1.000", "0.00"},
+			{"1", "1", "0", 0},
+			{"1.00", "1", "0.00", 0},
+			{"5.67", "1.23", "4.44", 1},
+			{"1.23", "5.67", "4.44", -1},
+			{"-1", "1", "2", -1},
+			{"1", "-1", "2", 1},
+			{"9999999999999999999", "-0.4", "9999999999999999999", 1},
+			{"1", "0.0000000000000000001", "0.9999999999999999999", 1},
+		}
+		for _, tt := range tests {
+			d, e := MustParse(tt.d), MustParse(tt.e)
+			gotMag, gotSign, err := d.Delta(e)
+			if err != nil {
+				t.Errorf("%q.Delta(%q) failed: %v", d, e, err)
+				continue
+			}
+			wantMag := MustParse(tt.wantMag)
+			if gotMag != wantMag || gotSign != tt.wantSign {
+				t.Errorf("%q.Delta(%q) = (%q, %v), want (%q, %v)", d, e, gotMag, gotSign, wantMag, tt.wantSign)
+			}
+		}
+	})
 
-			{"0.000", "1", "0.000"},
-			{"0.000", "1.0", "0.00"},
-			{"0.000", "1.00", "0.0"},
-			{"0.000", "1.000", "0"},
-			{"0.000", "1.0000", "0"},
-			{"0.000", "1.00000", "0"},
+	t.Run("error", func(t *testing.T) {
+		d, e := MustParse("9999999999999999999"), MustParse("-1")
+		_, _, err := d.Delta(e)
+		if err == nil {
+			t.Errorf("%q.Delta(%q) did not fail", d, e)
+		}
+	})
+}
 
-			// Ones
-			{"1", "1.000", "1"},
-			{"1.0", "1.000", "1"},
-			{"1.00", "1.000", "1"},
-			{"1.000", "1.000", "1"},
-			{"1.0000", "1.000", "1.0"},
-			{"1.00000", "1.000", "1.00"},
+func TestPctChange_bint(t *testing.T) {
+	tests := []struct {
+		from, to string
+		scale    int
+		want     string
+	}{
+		{"1.5", "1.75", 19, "16.66666666666666667"},
+		{"3", "4", 19, "33.33333333333333333"},
+		{"9999999999999999999", "-9999999999999999999", 19, "-200.0000000000000000"},
+		{"7", "0.0000000000000000001", 19, "-100.0000000000000000"},
+		{"7", "7.000000000000000001", 19, "0.0000000000000000143"},
+	}
+	for _, tt := range tests {
+		from, to := MustParse(tt.from), MustParse(tt.to)
+		got, err := PctChange(from, to, tt.scale)
+		if err != nil {
+			t.Errorf("PctChange(%q, %q, %v) failed: %v", from, to, tt.scale, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("PctChange(%q, %q, %v) = %q, want %q", from, to, tt.scale, got, tt.want)
+		}
+	}
 
-			{"1.000", "1", "1.000"},
-			{"1.000", "1.0", "1.00"},
-			{"1.000", "1.00", "1.0"},
-			{"1.000", "1.000", "1"},
-			{"1.000", "1.0000", "1"},
-			{"1.000", "1.00000", "1"},
+	from, to := MustParse("0.0000000000000000001"), MustParse("9999999999999999999")
+	_, err := PctChange(from, to, 0)
+	if err == nil {
+		t.Errorf("PctChange(%q, %q, 0) did not fail", from, to)
+	}
+}
 
-			// Simple cases
-			{"1", "1", "1"},
-			{"2", "1", "2"},
-			{"1", "2", "0.5"},
-			{"2", "2", "1"},
-			{"0", "1", "0"},
-			{"0", "2", "0"},
+func TestConvertRate_bint(t *testing.T) {
+	tests := []struct {
+		rate     string
+		from, to time.Duration
+		want     string
+	}{
+		{"0.05", Actual365Fixed.Year(), 24 * time.Hour, "0.000136986301369863"},
+		{"0.05", Actual360.Year(), 24 * time.Hour, "0.0001388888888888889"},
+		{"1", 24 * time.Hour, time.Hour, "0.0416666666666666667"},
+		{"9999999999999999999", 7 * time.Hour, 3 * time.Hour, "4285714285714285714"},
+		{"0.9999999999999999999", 3 * time.Second, 2 * time.Second, "0.6666666666666666666"},
+	}
+	for _, tt := range tests {
+		rate := MustParse(tt.rate)
+		got, err := ConvertRate(rate, tt.from, tt.to)
+		if err != nil {
+			t.Errorf("ConvertRate(%q, %v, %v) failed: %v", rate, tt.from, tt.to, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ConvertRate(%q, %v, %v) = %q, want %q", rate, tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	// Single rounding
+	rate := MustParse("0.05")
+	for _, basis := range []DayCount{Actual360, Actual365Fixed, Thirty360} {
+		got, err := PerAnnumToPerDay(rate, basis)
+		if err != nil {
+			t.Errorf("PerAnnumToPerDay(%q, %v) failed: %v", rate, basis, err)
+			continue
+		}
+		want, err := rate.QuoInt64(int64(basis.DaysPerYear()))
+		if err != nil {
+			t.Errorf("%q.QuoInt64(%v) failed: %v", rate, basis.DaysPerYear(), err)
+			continue
+		}
+		if got != want {
+			t.Errorf("PerAnnumToPerDay(%q, %v) = %q, want %q", rate, basis, got, want)
+		}
+	}
+}
+
+func TestExplainDifference_bint(t *testing.T) {
+	tests := []struct {
+		a, b, want string
+	}{
+		{"1000000000000000000", "0.000000000000000001", "b = a * 10^-36; scales differ: 0 and 18; b - a = -1000000000000000000 (rounded)"},
+		{"0.1", "1234567890.123456789", "scales differ: 1 and 9; b - a = 1234567890.023456789 (1234567890023456789 units at scale 9)"},
+	}
+	for _, tt := range tests {
+		a, b := MustParse(tt.a), MustParse(tt.b)
+		got := ExplainDifference(a, b)
+		if got != tt.want {
+			t.Errorf("ExplainDifference(%q, %q) = %q, want %q", a, b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_OnRounded_bint(t *testing.T) {
+	ops := map[string]func(c Context, d, e Decimal) (Decimal, error){
+		"+": Context.Add,
+		"-": Context.Sub,
+		"*": Context.Mul,
+		"/": Context.Quo,
+	}
+	tests := []struct {
+		op, d, e, want string
+	}{
+		{"+", "1234567890123456789", "0.5", "-0.5"},
+		{"-", "1234567890123456789", "-0.5", "-0.5"},
+		{"*", "1234567890.123456789", "1234567890.123456789", "0.019051998750190521"},
+		{"/", "1", "3", "0.0000000000000000000"},
+		{"/", "1000000000000000000", "3", "0.0333333333333333333"},
+		{"/", "-1000000000000000000", "3", "-0.0333333333333333333"},
+	}
+	for _, tt := range tests {
+		var got []RoundingEvent
+		c := Context{OnRounded: func(ev RoundingEvent) { got = append(got, ev) }}
+		d := MustParse(tt.d)
+		e := MustParse(tt.e)
+		f, err := ops[tt.op](c, d, e)
+		if err != nil {
+			t.Errorf("Context(%q %v %q) failed: %v", d, tt.op, e, err)
+			continue
+		}
+		want := RoundingEvent{Op: tt.op, D: d, E: e, Result: f, Remainder: MustParse(tt.want)}
+		if len(got) != 1 || got[0] != want {
+			t.Errorf("Context(%q %v %q) reported %v, want [%v]", d, tt.op, e, got, want)
+		}
+	}
+}
+
+func TestScanner_bint(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			input string
+			scale int
+			want  []string
+		}{
+			{"1e2 1.5E-3", -1, []string{"100", "0.0015"}},
+			{"1e-2 0.5e1", 2, []string{"0.01", "5.00"}},
+			{"0.12345678901234567891", -1, []string{"0.1234567890123456789"}},
+		}
+		for _, tt := range tests {
+			s := NewScanner(strings.NewReader(tt.input))
+			s.EnforceScale(tt.scale)
+			for _, w := range tt.want {
+				got, err := s.Next()
+				if err != nil {
+					t.Errorf("NewScanner(%q).Next() failed: %v", tt.input, err)
+					break
+				}
+				want := MustParse(w)
+				if got != want {
+					t.Errorf("NewScanner(%q).Next() = %q, want %q", tt.input, got, want)
+				}
+			}
+			if _, err := s.Next(); err != io.EOF {
+				t.Errorf("NewScanner(%q).Next() error = %v, want %v", tt.input, err, io.EOF)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			input string
+			scale int
+		}{
+			{"1.5e-3", 2},
+			{"0.12345678901234567891", 19},
+			{"1234567890123456789.5", 0},
+		}
+		for _, tt := range tests {
+			s := NewScanner(strings.NewReader(tt.input))
+			s.EnforceScale(tt.scale)
+			if _, err := s.Next(); err == nil {
+				t.Errorf("NewScanner(%q).Next() did not fail", tt.input)
+			}
+		}
+	})
+}
+
+func TestDecimal_Int64Arithmetic(t *testing.T) {
+	ops := []struct {
+		name string
+		f    func(d Decimal, v int64) (Decimal, error)
+		g    func(d, e Decimal) (Decimal, error)
+	}{
+		{"AddInt64", Decimal.AddInt64, Decimal.Add},
+		{"SubInt64", Decimal.SubInt64, Decimal.Sub},
+		{"MulInt64", Decimal.MulInt64, Decimal.Mul},
+		{"QuoInt64", Decimal.QuoInt64, Decimal.Quo},
+	}
+	decimals := []string{"0", "1", "-1", "5.67", "-0.25", "1000000000000000000", "-9999999999999999999"}
+	integers := []int64{0, 1, -1, 2, -4, 100, math.MaxInt64, math.MinInt64}
+	for _, op := range ops {
+		for _, s := range decimals {
+			d := MustParse(s)
+			for _, v := range integers {
+				got, gotErr := op.f(d, v)
+				want, wantErr := op.g(d, MustNew(v, 0))
+				if (gotErr == nil) != (wantErr == nil) {
+					t.Errorf("%q.%v(%v) error = %v, want %v", d, op.name, v, gotErr, wantErr)
+					continue
+				}
+				if got != want {
+					t.Errorf("%q.%v(%v) = %q, want %q", d, op.name, v, got, want)
+				}
+			}
+		}
+	}
+
+	t.Run("allocs", func(t *testing.T) {
+		d := MustParse("5.67")
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = d.AddInt64(3)
+			_, _ = d.SubInt64(3)
+			_, _ = d.MulInt64(3)
+			_, _ = d.QuoInt64(4)
+		})
+		if allocs != 0 {
+			t.Errorf("AllocsPerRun = %v, want 0", allocs)
+		}
+	})
+}
+
+func TestDecimal_Quo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			// Zeros
+			{"0", "1.000", "0"},
+			{"0.0", "1.000", "0"},
+			{"0.00", "1.000", "0"},
+			{"0.000", "1.000", "0"},
+			{"0.0000", "1.000", "0.0"},
+			{"0.00000", "1.000", "0.00"},
+
+			{"0.000", "1", "0.000"},
+			{"0.000", "1.0", "0.00"},
+			{"0.000", "1.00", "0.0"},
+			{"0.000", "1.000", "0"},
+			{"0.000", "1.0000", "0"},
+			{"0.000", "1.00000", "0"},
+
+			// Ones
+			{"1", "1.000", "1"},
+			{"1.0", "1.000", "1"},
+			{"1.00", "1.000", "1"},
+			{"1.000", "1.000", "1"},
+			{"1.0000", "1.000", "1.0"},
+			{"1.00000", "1.000", "1.00"},
+
+			{"1.000", "1", "1.000"},
+			{"1.000", "1.0", "1.00"},
+			{"1.000", "1.00", "1.0"},
+			{"1.000", "1.000", "1"},
+			{"1.000", "1.0000", "1"},
+			{"1.000", "1.00000", "1"},
+
+			// Simple cases
+			{"1", "1", "1"},
+			{"2", "1", "2"},
+			{"1", "2", "0.5"},
+			{"2", "2", "1"},
+			{"0", "1", "0"},
+			{"0", "2", "0"},
 			{"1.5", "3", "0.5"},
 			{"3", "3", "1"},
 			{"9999999999999999999", "1", "9999999999999999999"},
@@ -3717,6 +5982,318 @@ func TestDecimal_QuoRem(t *testing.T) {
 	})
 }
 
+func TestDecimal_Mod(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			{"7", "3", "1"},
+			{"-7", "3", "-1"},
+			{"7", "-3", "1"},
+			{"-7", "-3", "-1"},
+			{"7", "4", "3"},
+			{"-7", "4", "-3"},
+			{"5", "2", "1"},
+			{"7", "2", "1"},
+			{"-5", "2", "-1"},
+			{"6", "4", "2"},
+			{"10", "4", "2"},
+			{"-10", "4", "-2"},
+			{"0", "3", "0"},
+			{"0.00", "3", "0.00"},
+			{"3", "3", "0"},
+			{"-3", "3", "0"},
+			{"1.5", "0.5", "0.0"},
+			{"7.5", "2", "1.5"},
+			{"7.25", "0.5", "0.25"},
+			{"-7.25", "0.5", "-0.25"},
+			{"2.5", "1", "0.5"},
+			{"3.5", "1", "0.5"},
+			{"-2.5", "1", "-0.5"},
+			{"1", "0.0000000000000000003", "0.0000000000000000001"},
+			{"9999999999999999999", "0.0000000000000000003", "0.0000000000000000000"},
+			{"-9999999999999999999", "0.0000000000000000007", "-0.0000000000000000006"},
+			{"9999999999999999999", "9999999999999999998", "1"},
+			{"9999999999999999999", "5000000000000000000", "4999999999999999999"},
+			{"-9999999999999999999", "2", "-1"},
+			{"0.0000000000000000001", "9999999999999999999", "0.0000000000000000001"},
+			{"1000000000000000000", "0.3", "0.1"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.Mod(e)
+			if err != nil {
+				t.Errorf("%q.Mod(%q) failed: %v", d, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Mod(%q) = %q, want %q", d, e, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e string
+		}{
+			"zero 1": {"1", "0"},
+			"zero 2": {"0", "0.00"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				e := MustParse(tt.e)
+				_, err := d.Mod(e)
+				if err == nil {
+					t.Errorf("%q.Mod(%q) did not fail", d, e)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_ModEuclid(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			{"7", "3", "1"},
+			{"-7", "3", "2"},
+			{"7", "-3", "1"},
+			{"-7", "-3", "2"},
+			{"7", "4", "3"},
+			{"-7", "4", "1"},
+			{"5", "2", "1"},
+			{"7", "2", "1"},
+			{"-5", "2", "1"},
+			{"6", "4", "2"},
+			{"10", "4", "2"},
+			{"-10", "4", "2"},
+			{"0", "3", "0"},
+			{"0.00", "3", "0.00"},
+			{"3", "3", "0"},
+			{"-3", "3", "0"},
+			{"1.5", "0.5", "0.0"},
+			{"7.5", "2", "1.5"},
+			{"7.25", "0.5", "0.25"},
+			{"-7.25", "0.5", "0.25"},
+			{"2.5", "1", "0.5"},
+			{"3.5", "1", "0.5"},
+			{"-2.5", "1", "0.5"},
+			{"1", "0.0000000000000000003", "0.0000000000000000001"},
+			{"9999999999999999999", "0.0000000000000000003", "0.0000000000000000000"},
+			{"-9999999999999999999", "0.0000000000000000007", "0.0000000000000000001"},
+			{"9999999999999999999", "9999999999999999998", "1"},
+			{"9999999999999999999", "5000000000000000000", "4999999999999999999"},
+			{"-9999999999999999999", "2", "1"},
+			{"0.0000000000000000001", "9999999999999999999", "0.0000000000000000001"},
+			{"1000000000000000000", "0.3", "0.1"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.ModEuclid(e)
+			if err != nil {
+				t.Errorf("%q.ModEuclid(%q) failed: %v", d, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.ModEuclid(%q) = %q, want %q", d, e, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e string
+		}{
+			"zero 1":   {"1", "0"},
+			"zero 2":   {"0", "0.00"},
+			"overflow": {"-0.0000000000000000001", "9999999999999999999"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				e := MustParse(tt.e)
+				_, err := d.ModEuclid(e)
+				if err == nil {
+					t.Errorf("%q.ModEuclid(%q) did not fail", d, e)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Rem(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			{"7", "3", "1"},
+			{"-7", "3", "-1"},
+			{"7", "-3", "1"},
+			{"-7", "-3", "-1"},
+			{"7", "4", "-1"},
+			{"-7", "4", "1"},
+			{"5", "2", "1"},
+			{"7", "2", "-1"},
+			{"-5", "2", "-1"},
+			{"6", "4", "-2"},
+			{"10", "4", "2"},
+			{"-10", "4", "-2"},
+			{"0", "3", "0"},
+			{"0.00", "3", "0.00"},
+			{"3", "3", "0"},
+			{"-3", "3", "0"},
+			{"1.5", "0.5", "0.0"},
+			{"7.5", "2", "-0.5"},
+			{"7.25", "0.5", "0.25"},
+			{"-7.25", "0.5", "-0.25"},
+			{"2.5", "1", "0.5"},
+			{"3.5", "1", "-0.5"},
+			{"-2.5", "1", "-0.5"},
+			{"1", "0.0000000000000000003", "0.0000000000000000001"},
+			{"9999999999999999999", "0.0000000000000000003", "0.0000000000000000000"},
+			{"-9999999999999999999", "0.0000000000000000007", "0.0000000000000000001"},
+			{"9999999999999999999", "9999999999999999998", "1"},
+			{"9999999999999999999", "5000000000000000000", "-1"},
+			{"-9999999999999999999", "2", "1"},
+			{"0.0000000000000000001", "9999999999999999999", "0.0000000000000000001"},
+			{"1000000000000000000", "0.3", "0.1"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.Rem(e)
+			if err != nil {
+				t.Errorf("%q.Rem(%q) failed: %v", d, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Rem(%q) = %q, want %q", d, e, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e string
+		}{
+			"zero 1": {"1", "0"},
+			"zero 2": {"0", "0.00"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				e := MustParse(tt.e)
+				_, err := d.Rem(e)
+				if err == nil {
+					t.Errorf("%q.Rem(%q) did not fail", d, e)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_CmpInt64(t *testing.T) {
+	tests := []struct {
+		d    string
+		v    int64
+		want int
+	}{
+		{"0", 0, 0},
+		{"0", 1, -1},
+		{"0", -1, 1},
+		{"0.0000000000000000001", 0, 1},
+		{"-0.0000000000000000001", 0, -1},
+		{"1", 1, 0},
+		{"1.00", 1, 0},
+		{"1.01", 1, 1},
+		{"0.99", 1, -1},
+		{"-1", -1, 0},
+		{"-1.00", -1, 0},
+		{"-1.01", -1, -1},
+		{"-0.99", -1, 1},
+		{"-1", 1, -1},
+		{"1", -1, 1},
+		{"9223372036854775807", math.MaxInt64, 0},
+		{"9223372036854775808", math.MaxInt64, 1},
+		{"9223372036854775806", math.MaxInt64, -1},
+		{"-9223372036854775808", math.MinInt64, 0},
+		{"-9223372036854775809", math.MinInt64, -1},
+		{"-9223372036854775807", math.MinInt64, 1},
+		{"9999999999999999999", math.MaxInt64, 1},
+		{"-9999999999999999999", math.MinInt64, -1},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.CmpInt64(tt.v)
+		if got != tt.want {
+			t.Errorf("%q.CmpInt64(%v) = %v, want %v", d, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_CmpUint64(t *testing.T) {
+	tests := []struct {
+		d    string
+		v    uint64
+		want int
+	}{
+		{"0", 0, 0},
+		{"0", 1, -1},
+		{"-0.0000000000000000001", 0, -1},
+		{"0.0000000000000000001", 0, 1},
+		{"1.5", 1, 1},
+		{"1.5", 2, -1},
+		{"-1", 1, -1},
+		{"9999999999999999999", math.MaxUint64, -1},
+		{"9999999999999999999", 9999999999999999999, 0},
+		{"9999999999999999999", 9999999999999999998, 1},
+		{"999999999999999999.9", 999999999999999999, 1},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.CmpUint64(tt.v)
+		if got != tt.want {
+			t.Errorf("%q.CmpUint64(%v) = %v, want %v", d, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_CmpFloat64(t *testing.T) {
+	tests := []struct {
+		d      string
+		f      float64
+		want   int
+		wantOk bool
+	}{
+		{"0", 0, 0, true},
+		{"0", math.Copysign(0, -1), 0, true},
+		{"0.1", 0.1, 0, true},
+		{"0.10", 0.1, 0, true},
+		{"0.1", 0.2, -1, true},
+		{"-0.1", -0.2, 1, true},
+		{"1.5", 1, 1, true},
+		{"9999999999999999999", 1e19, -1, true},
+		{"-9999999999999999999", -1e19, 1, true},
+		{"9999999999999999999", math.Inf(1), -1, true},
+		{"-9999999999999999999", math.Inf(-1), 1, true},
+		{"0", math.NaN(), 0, false},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, ok := d.CmpFloat64(tt.f)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("%q.CmpFloat64(%v) = [%v %v], want [%v %v]", d, tt.f, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
 func TestDecimal_Cmp(t *testing.T) {
 	tests := []struct {
 		d, e string
@@ -3768,11 +6345,89 @@ func TestDecimal_Cmp(t *testing.T) {
 	}
 }
 
-func TestDecimal_Max(t *testing.T) {
-	tests := []struct {
-		d, e, want string
-	}{
-		{"-2", "-2", "-2"},
+func TestCmpStrings(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			a, b string
+			want int
+		}{
+			{"-2", "-1", -1},
+			{"-1", "0", -1},
+			{"0", "-0", 0},
+			{"+0.000", "-00", 0},
+			{"0", "0.0000000000000000001", -1},
+			{"1", "-1", 1},
+			{"2", "2.000", 0},
+			{"002.50", "2.5", 0},
+			{"2.5", "2.49", 1},
+			{"-2.5", "-2.49", -1},
+			{".5", "0.5", 0},
+			{"5.", "5", 0},
+			{"10", "9.99", 1},
+			{"-10", "-9.99", -1},
+			{"9999999999999999999", "0.9999999999999999999", 1},
+			{"1.0000000000000000001", "1", 0},
+			{"0.00000000000000000001", "0", 0},
+			{"1e3", "999.9", 1},
+			{"-1E-3", "-0.001", 0},
+			{"12345678901234567890123e-10", "1234567890123.456789", 0},
+		}
+		for _, tt := range tests {
+			got, err := CmpStrings(tt.a, tt.b)
+			if err != nil {
+				t.Errorf("CmpStrings(%q, %q) failed: %v", tt.a, tt.b, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("CmpStrings(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			a, b string
+		}{
+			{"", "1"},
+			{"1", "."},
+			{"-", "1"},
+			{"1", "1.2.3"},
+			{"1,5", "1"},
+			{"99999999999999999999", "1"},
+			{"1", "1e400"},
+		}
+		for _, tt := range tests {
+			_, err := CmpStrings(tt.a, tt.b)
+			if err == nil {
+				t.Errorf("CmpStrings(%q, %q) did not fail", tt.a, tt.b)
+			}
+		}
+	})
+
+	t.Run("allocs", func(t *testing.T) {
+		tests := []struct {
+			a, b string
+		}{
+			{"123.45", "123.456"},
+			{"-0.0000000000000000001", "0"},
+			{"9999999999999999999", "-9999999999999999999"},
+		}
+		for _, tt := range tests {
+			allocs := testing.AllocsPerRun(100, func() {
+				_, _ = CmpStrings(tt.a, tt.b)
+			})
+			if allocs != 0 {
+				t.Errorf("CmpStrings(%q, %q) allocations = %v, want 0", tt.a, tt.b, allocs)
+			}
+		}
+	})
+}
+
+func TestDecimal_Max(t *testing.T) {
+	tests := []struct {
+		d, e, want string
+	}{
+		{"-2", "-2", "-2"},
 		{"-2", "-1", "-1"},
 		{"-2", "0", "0"},
 		{"-2", "1", "1"},
@@ -3930,6 +6585,115 @@ func TestDecimal_Clamp(t *testing.T) {
 	})
 }
 
+func TestDecimal_ClampToRange(t *testing.T) {
+	tests := []struct {
+		d, min, max, want string
+	}{
+		{"0", "-2", "-1", "-1"},
+		{"0", "-1", "1", "0"},
+		{"0", "1", "2", "1"},
+		{"0", "-1", "-2", "-1"},
+		{"0", "1", "-1", "0"},
+		{"0", "2", "1", "1"},
+		{"5.67", "10", "-10", "5.67"},
+		{"23", "20", "-20", "20"},
+		{"-23", "20", "-20", "-20"},
+		{"1.23", "1.2300", "2", "1.23"},
+		{"1.2300", "2", "1.23", "1.23"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		min := MustParse(tt.min)
+		max := MustParse(tt.max)
+		got := d.ClampToRange(min, max)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.ClampToRange(%q, %q) = %q, want %q", d, min, max, got, want)
+		}
+	}
+}
+
+func TestDecimal_Clamp01(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"-1", "0"},
+		{"-0.0000000000000000001", "0"},
+		{"0", "0"},
+		{"0.00", "0.00"},
+		{"0.5", "0.5"},
+		{"1", "1"},
+		{"1.00", "1.00"},
+		{"1.000000000000000001", "1"},
+		{"9999999999999999999", "1"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.Clamp01()
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Clamp01() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_SaturateAt(t *testing.T) {
+	tests := []struct {
+		d, limit, want string
+	}{
+		{"0", "100", "0"},
+		{"99.99", "100", "99.99"},
+		{"100.00", "100", "100.00"},
+		{"100.01", "100", "100"},
+		{"-100.01", "100", "-100"},
+		{"-99.99", "100", "-99.99"},
+		{"150", "-100", "100"},
+		{"-150", "-100", "-100"},
+		{"5", "0", "0"},
+		{"-5", "0", "0"},
+		{"-9999999999999999999", "9999999999999999999", "-9999999999999999999"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		limit := MustParse(tt.limit)
+		got := d.SaturateAt(limit)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.SaturateAt(%q) = %q, want %q", d, limit, got, want)
+		}
+	}
+}
+
+func TestDecimal_FirstExceeding(t *testing.T) {
+	tests := []struct {
+		d          string
+		thresholds []string
+		want       int
+	}{
+		{"0", nil, -1},
+		{"0", []string{}, -1},
+		{"1000.00", []string{"10000", "5000", "1000"}, -1},
+		{"1000.01", []string{"10000", "5000", "1000"}, 2},
+		{"5000.01", []string{"10000", "5000", "1000"}, 1},
+		{"10000.01", []string{"10000", "5000", "1000"}, 0},
+		{"999.999", []string{"1000"}, -1},
+		{"0.0000000000000000001", []string{"0.00"}, 0},
+		{"-5", []string{"-1", "-10"}, 1},
+		{"5", []string{"1", "2"}, 0},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		thresholds := make([]Decimal, len(tt.thresholds))
+		for i, s := range tt.thresholds {
+			thresholds[i] = MustParse(s)
+		}
+		got := d.FirstExceeding(thresholds)
+		if got != tt.want {
+			t.Errorf("%q.FirstExceeding(%v) = %v, want %v", d, thresholds, got, tt.want)
+		}
+	}
+}
+
 func TestNullDecimal_Interfaces(t *testing.T) {
 	var n any = NullDecimal{}
 	_, ok := n.(driver.Valuer)
@@ -3937,22 +6701,448 @@ func TestNullDecimal_Interfaces(t *testing.T) {
 		t.Errorf("%T does not implement driver.Valuer", n)
 	}
 
-	n = &NullDecimal{}
-	_, ok = n.(sql.Scanner)
-	if !ok {
-		t.Errorf("%T does not implement sql.Scanner", n)
+	_, ok = n.(encoding.BinaryMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryMarshaler", n)
+	}
+
+	_, ok = n.(encoding.TextMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextMarshaler", n)
+	}
+
+	_, ok = n.(json.Marshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Marshaler", n)
+	}
+
+	n = &NullDecimal{}
+	_, ok = n.(sql.Scanner)
+	if !ok {
+		t.Errorf("%T does not implement sql.Scanner", n)
+	}
+
+	_, ok = n.(encoding.BinaryUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryUnmarshaler", n)
+	}
+
+	_, ok = n.(encoding.TextUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextUnmarshaler", n)
+	}
+
+	_, ok = n.(json.Unmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Unmarshaler", n)
+	}
+}
+
+func TestNullDecimal_Scan(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			v    any
+			want NullDecimal
+		}{
+			{nil, NullDecimal{}},
+			{int32(-5), NullDecimal{Decimal: MustParse("-5"), Valid: true}},
+			{uint16(7), NullDecimal{Decimal: MustParse("7"), Valid: true}},
+			{"1.5", NullDecimal{Decimal: MustParse("1.5"), Valid: true}},
+		}
+		for _, tt := range tests {
+			got := NullDecimal{Decimal: One, Valid: true}
+			err := got.Scan(tt.v)
+			if err != nil {
+				t.Errorf("Scan(%v) failed: %v", tt.v, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("Scan(%v) = %v, want %v", tt.v, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("[]byte", func(t *testing.T) {
+		tests := []string{"."}
+		for _, tt := range tests {
+			got := NullDecimal{}
+			err := got.Scan([]byte(tt))
+			if err == nil {
+				t.Errorf("Scan(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestNullDecimal_MarshalBinary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			n    NullDecimal
+			want []byte
+		}{
+			{NullDecimal{}, []byte{0x00}},
+			{NullDecimal{Decimal: MustParse("0"), Valid: true}, []byte{0x01, 0x0c, 0x00}},
+			{NullDecimal{Decimal: MustParse("-1.23"), Valid: true}, []byte{0x01, 0x12, 0x3d, 0x02}},
+		}
+		for _, tt := range tests {
+			got, err := tt.n.MarshalBinary()
+			if err != nil {
+				t.Errorf("%v.MarshalBinary() failed: %v", tt.n, err)
+				continue
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("%v.MarshalBinary() = % x, want % x", tt.n, got, tt.want)
+			}
+			var n NullDecimal
+			err = n.UnmarshalBinary(got)
+			if err != nil {
+				t.Errorf("UnmarshalBinary(% x) failed: %v", got, err)
+				continue
+			}
+			if n != tt.n {
+				t.Errorf("UnmarshalBinary(% x) = %v, want %v", got, n, tt.n)
+			}
+		}
+	})
+
+	t.Run("gob", func(t *testing.T) {
+		type Entry struct {
+			Price NullDecimal
+			Fee   NullDecimal
+		}
+		want := Entry{Price: NullDecimal{Decimal: MustParse("5.67"), Valid: true}}
+		var buf bytes.Buffer
+		err := gob.NewEncoder(&buf).Encode(want)
+		if err != nil {
+			t.Fatalf("Encode(%v) failed: %v", want, err)
+		}
+		var got Entry
+		err = gob.NewDecoder(&buf).Decode(&got)
+		if err != nil {
+			t.Fatalf("Decode() failed: %v", err)
+		}
+		if got != want {
+			t.Errorf("Decode() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := [][]byte{
+			{},
+			{0x02},
+			{0x00, 0x0c, 0x00},
+			{0x01},
+			{0x01, 0x0e, 0x00},
+		}
+		for _, tt := range tests {
+			var n NullDecimal
+			err := n.UnmarshalBinary(tt)
+			if err == nil {
+				t.Errorf("UnmarshalBinary(% x) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestNullDecimal_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		type Entry struct {
+			Price NullDecimal `json:"price"`
+			Fee   NullDecimal `json:"fee"`
+		}
+		tests := []struct {
+			e    Entry
+			want string
+		}{
+			{Entry{}, `{"price":null,"fee":null}`},
+			{Entry{Price: NullDecimal{Decimal: MustParse("5.670"), Valid: true}}, `{"price":"5.670","fee":null}`},
+			{Entry{Price: NullDecimal{Decimal: MustParse("-1"), Valid: true}, Fee: NullDecimal{Valid: true}}, `{"price":"-1","fee":"0"}`},
+		}
+		for _, tt := range tests {
+			got, err := json.Marshal(tt.e)
+			if err != nil {
+				t.Errorf("json.Marshal(%v) failed: %v", tt.e, err)
+				continue
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal(%v) = %s, want %s", tt.e, got, tt.want)
+			}
+			e := Entry{Price: NullDecimal{Decimal: MustParse("9.99"), Valid: true}}
+			err = json.Unmarshal(got, &e)
+			if err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", got, err)
+				continue
+			}
+			if e != tt.e {
+				t.Errorf("json.Unmarshal(%s) = %v, want %v", got, e, tt.e)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			`1.23`,
+			`true`,
+			`""`,
+			`"1.2.3"`,
+			`{}`,
+		}
+		for _, tt := range tests {
+			n := NullDecimal{Valid: true}
+			err := n.UnmarshalJSON([]byte(tt))
+			if err == nil {
+				t.Errorf("UnmarshalJSON(%s) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestNullDecimal_MarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			n    NullDecimal
+			want string
+		}{
+			{NullDecimal{}, ""},
+			{NullDecimal{Decimal: MustParse("0"), Valid: true}, "0"},
+			{NullDecimal{Decimal: MustParse("-1.230"), Valid: true}, "-1.230"},
+		}
+		for _, tt := range tests {
+			got, err := tt.n.MarshalText()
+			if err != nil {
+				t.Errorf("%v.MarshalText() failed: %v", tt.n, err)
+				continue
+			}
+			if string(got) != tt.want {
+				t.Errorf("%v.MarshalText() = %q, want %q", tt.n, got, tt.want)
+			}
+			n := NullDecimal{Decimal: MustParse("9.99"), Valid: true}
+			err = n.UnmarshalText(got)
+			if err != nil {
+				t.Errorf("UnmarshalText(%q) failed: %v", got, err)
+				continue
+			}
+			if n != tt.n {
+				t.Errorf("UnmarshalText(%q) = %v, want %v", got, n, tt.n)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{".", "1.2.3", "null"}
+		for _, tt := range tests {
+			n := NullDecimal{Valid: true}
+			err := n.UnmarshalText([]byte(tt))
+			if err == nil {
+				t.Errorf("UnmarshalText(%q) did not fail", tt)
+				continue
+			}
+			if n.Valid {
+				t.Errorf("UnmarshalText(%q) left the decimal valid", tt)
+			}
+		}
+	})
+}
+
+func TestNullDecimal_MarshalBSONValue(t *testing.T) {
+	tests := []struct {
+		n        NullDecimal
+		wantType byte
+		wantData []byte
+	}{
+		{NullDecimal{}, 0x0a, nil},
+		{
+			NullDecimal{Decimal: MustParse("1.23"), Valid: true},
+			0x13,
+			[]byte{0x7b, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x3c, 0x30},
+		},
+	}
+	for _, tt := range tests {
+		gotType, gotData, err := tt.n.MarshalBSONValue()
+		if err != nil {
+			t.Errorf("%v.MarshalBSONValue() failed: %v", tt.n, err)
+			continue
+		}
+		if gotType != tt.wantType || !bytes.Equal(gotData, tt.wantData) {
+			t.Errorf("%v.MarshalBSONValue() = %#02x, % x, want %#02x, % x", tt.n, gotType, gotData, tt.wantType, tt.wantData)
+		}
+		n := NullDecimal{Decimal: MustParse("9.99"), Valid: true}
+		err = n.UnmarshalBSONValue(gotType, gotData)
+		if err != nil {
+			t.Errorf("UnmarshalBSONValue(%#02x, % x) failed: %v", gotType, gotData, err)
+			continue
+		}
+		if n != tt.n {
+			t.Errorf("UnmarshalBSONValue(%#02x, % x) = %v, want %v", gotType, gotData, n, tt.n)
+		}
+	}
+}
+
+func TestNullDecimal_Ptr(t *testing.T) {
+	tests := []struct {
+		n    NullDecimal
+		want *Decimal
+	}{
+		{NullDecimal{}, nil},
+		{NullDecimal{Decimal: MustParse("1.23"), Valid: false}, nil},
+		{NullDecimal{Decimal: Zero, Valid: true}, &Zero},
+		{NullDecimal{Decimal: MustParse("1.23"), Valid: true}, ptr(MustParse("1.23"))},
+	}
+	for _, tt := range tests {
+		got := tt.n.Ptr()
+		switch {
+		case got == nil && tt.want == nil:
+		case got == nil || tt.want == nil || *got != *tt.want:
+			t.Errorf("%v.Ptr() = %v, want %v", tt.n, got, tt.want)
+		case got == &tt.n.Decimal:
+			t.Errorf("%v.Ptr() does not return a copy", tt.n)
+		}
+		back := NewNullFromPtr(got)
+		if back.Valid != tt.n.Valid || (back.Valid && back.Decimal != tt.n.Decimal) {
+			t.Errorf("NewNullFromPtr(%v.Ptr()) = %v, want %v", tt.n, back, tt.n)
+		}
+	}
+}
+
+func TestNullDecimal_IsZero(t *testing.T) {
+	tests := []struct {
+		n    NullDecimal
+		want bool
+	}{
+		{NullDecimal{}, true},
+		{NullDecimal{Decimal: MustParse("1.23"), Valid: false}, true},
+		{NullDecimal{Decimal: Zero, Valid: true}, false},
+		{NullDecimal{Decimal: MustParse("1.23"), Valid: true}, false},
+	}
+	for _, tt := range tests {
+		got := tt.n.IsZero()
+		if got != tt.want {
+			t.Errorf("%v.IsZero() = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func ptr(d Decimal) *Decimal {
+	return &d
+}
+
+func TestNullDecimal_Arithmetic(t *testing.T) {
+	null := NullDecimal{}
+	valid := func(s string) NullDecimal {
+		return NullDecimal{Decimal: MustParse(s), Valid: true}
+	}
+	ops := map[string]func(n, m NullDecimal) (NullDecimal, error){
+		"Add": NullDecimal.Add,
+		"Sub": NullDecimal.Sub,
+		"Mul": NullDecimal.Mul,
+		"Quo": NullDecimal.Quo,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			op   string
+			n, m NullDecimal
+			want NullDecimal
+		}{
+			{"Add", valid("1.5"), valid("2"), valid("3.5")},
+			{"Sub", valid("1.5"), valid("2"), valid("-0.5")},
+			{"Mul", valid("1.5"), valid("2"), valid("3.0")},
+			{"Quo", valid("1.5"), valid("2"), valid("0.75")},
+			{"Add", null, valid("2"), null},
+			{"Sub", valid("1.5"), null, null},
+			{"Mul", null, null, null},
+			{"Quo", null, valid("0"), null},
+			{"Quo", valid("1"), null, null},
+		}
+		for _, tt := range tests {
+			got, err := ops[tt.op](tt.n, tt.m)
+			if err != nil {
+				t.Errorf("%v.%v(%v) failed: %v", tt.n, tt.op, tt.m, err)
+				continue
+			}
+			if got.Valid != tt.want.Valid || got.Decimal != tt.want.Decimal {
+				t.Errorf("%v.%v(%v) = %v, want %v", tt.n, tt.op, tt.m, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			op   string
+			n, m NullDecimal
+		}{
+			{"Quo", valid("1"), valid("0")},
+			{"Add", valid("9999999999999999999"), valid("1")},
+			{"Mul", valid("9999999999999999999"), valid("2")},
+		}
+		for _, tt := range tests {
+			_, err := ops[tt.op](tt.n, tt.m)
+			if err == nil {
+				t.Errorf("%v.%v(%v) did not fail", tt.n, tt.op, tt.m)
+			}
+		}
+	})
+}
+
+func TestCoalesce(t *testing.T) {
+	null := NullDecimal{}
+	valid := func(s string) NullDecimal {
+		return NullDecimal{Decimal: MustParse(s), Valid: true}
+	}
+	tests := []struct {
+		ns   []NullDecimal
+		want string
+	}{
+		{nil, "0"},
+		{[]NullDecimal{null}, "0"},
+		{[]NullDecimal{null, null}, "0"},
+		{[]NullDecimal{valid("1.50")}, "1.50"},
+		{[]NullDecimal{null, valid("-2"), valid("3")}, "-2"},
+		{[]NullDecimal{valid("0"), valid("3")}, "0"},
+	}
+	for _, tt := range tests {
+		got := Coalesce(tt.ns...)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("Coalesce(%v) = %q, want %q", tt.ns, got, want)
+		}
+	}
+}
+
+func TestSumNull(t *testing.T) {
+	null := NullDecimal{}
+	valid := func(s string) NullDecimal {
+		return NullDecimal{Decimal: MustParse(s), Valid: true}
 	}
-}
 
-func TestNullDecimal_Scan(t *testing.T) {
-	t.Run("[]byte", func(t *testing.T) {
-		tests := []string{"."}
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			ns   []NullDecimal
+			want NullDecimal
+		}{
+			{nil, null},
+			{[]NullDecimal{null, null}, null},
+			{[]NullDecimal{valid("0")}, valid("0")},
+			{[]NullDecimal{null, valid("1.5"), null, valid("2.25")}, valid("3.75")},
+			{[]NullDecimal{valid("9999999999999999999"), valid("1"), valid("-2")}, valid("9999999999999999998")},
+		}
 		for _, tt := range tests {
-			got := NullDecimal{}
-			err := got.Scan([]byte(tt))
-			if err == nil {
-				t.Errorf("Scan(%q) did not fail", tt)
+			got, err := SumNull(tt.ns...)
+			if err != nil {
+				t.Errorf("SumNull(%v) failed: %v", tt.ns, err)
+				continue
 			}
+			if got != tt.want {
+				t.Errorf("SumNull(%v) = %v, want %v", tt.ns, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ns := []NullDecimal{valid("9999999999999999999"), null, valid("1")}
+		_, err := SumNull(ns...)
+		if err == nil {
+			t.Errorf("SumNull(%v) did not fail", ns)
 		}
 	})
 }
@@ -4005,7 +7195,7 @@ func FuzzParse(f *testing.F) {
 				return
 			}
 
-			want, err := parseBint(num, scale)
+			want, err := parseBint(num, scale, maxParseExponent)
 			if err != nil {
 				t.Errorf("parseBint(%q) failed: %v", num, err)
 				return
@@ -4093,6 +7283,252 @@ func FuzzDecimal_BCD_ParseBCD(f *testing.F) {
 	)
 }
 
+func FuzzPack_Unpack(f *testing.F) {
+	for _, d := range corpus {
+		f.Add(d.neg, d.scale, d.coef)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			want, err := newSafe(neg, fint(coef), scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			p := Pack(want)
+			got, err := p.Unpack()
+			if err != nil {
+				t.Errorf("Unpack(% x) failed: %v", p, err)
+				return
+			}
+
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("Unpack(% x) = %v, want %v", p, got, want)
+				return
+			}
+		},
+	)
+}
+
+func FuzzDecimal_SortKey(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			dkey, ekey := d.SortKey(), e.SortKey()
+			got := strings.Compare(dkey, ekey)
+			want := d.Cmp(e)
+			if got != want {
+				t.Errorf("strings.Compare(%q, %q) = %v, whereas %q.Cmp(%q) = %v", dkey, ekey, got, d, e, want)
+				return
+			}
+
+			f, err := ParseSortKey(dkey)
+			if err != nil {
+				t.Errorf("ParseSortKey(%q) failed: %v", dkey, err)
+				return
+			}
+			if f != d.Trim(0) {
+				t.Errorf("ParseSortKey(%q) = %q, want %q", dkey, f, d.Trim(0))
+				return
+			}
+		},
+	)
+}
+
+func FuzzEqualWithin(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, d.scale, e.coef)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, tscale int, tcoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			tol, err := newSafe(false, fint(tcoef), tscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, _ := EqualWithin(map[string]Decimal{"x": d}, map[string]Decimal{"x": e}, tol)
+
+			drat, _ := new(big.Rat).SetString(d.String())
+			erat, _ := new(big.Rat).SetString(e.String())
+			trat, _ := new(big.Rat).SetString(tol.String())
+			diff := new(big.Rat).Sub(drat, erat)
+			want := diff.Abs(diff).Cmp(trat) <= 0
+
+			if got != want {
+				t.Errorf("EqualWithin(%q, %q, %q) = %v, want %v", d, e, tol, got, want)
+				return
+			}
+		},
+	)
+}
+
+func FuzzNullDecimal_MarshalBinary_UnmarshalBinary(f *testing.F) {
+	for _, d := range corpus {
+		f.Add(true, d.neg, d.scale, d.coef)
+	}
+	f.Add(false, false, 0, uint64(0))
+
+	f.Fuzz(
+		func(t *testing.T, valid, neg bool, scale int, coef uint64) {
+			var want NullDecimal
+			if valid {
+				d, err := newSafe(neg, fint(coef), scale)
+				if err != nil {
+					t.Skip()
+					return
+				}
+				want = NullDecimal{Decimal: d, Valid: true}
+			}
+
+			b, err := want.MarshalBinary()
+			if err != nil {
+				t.Errorf("%v.MarshalBinary() failed: %v", want, err)
+				return
+			}
+			var got NullDecimal
+			err = got.UnmarshalBinary(b)
+			if err != nil {
+				t.Errorf("UnmarshalBinary(% x) failed: %v", b, err)
+				return
+			}
+
+			if got.Valid != want.Valid || got.Decimal.CmpTotal(want.Decimal) != 0 {
+				t.Errorf("UnmarshalBinary(% x) = %v, want %v", b, got, want)
+				return
+			}
+		},
+	)
+}
+
+func FuzzPctChange(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, 2)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil || d.IsZero() {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			if scale < MinScale || scale > MaxScale {
+				t.Skip()
+				return
+			}
+
+			got, err := pctChangeFint(d, e, scale)
+			if err != nil {
+				t.Skip() // Decimal overflow
+				return
+			}
+			want, err := pctChangeBint(d, e, scale)
+			if err != nil {
+				t.Errorf("pctChangeBint(%q, %q, %v) failed: %v", d, e, scale, err)
+				return
+			}
+
+			if got != want {
+				t.Errorf("pctChangeFint(%q, %q, %v) = %q, whereas pctChangeBint(%q, %q, %v) = %q", d, e, scale, got, d, e, scale, want)
+				return
+			}
+		},
+	)
+}
+
+func FuzzRemainder(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, 2)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			if scale < MinScale || scale > MaxScale {
+				t.Skip()
+				return
+			}
+			c := Context{ScalePolicy: ScaleFixed, Scale: scale}
+
+			for op, g := range map[string]func(c Context, d, e Decimal) (Decimal, error){
+				"+": Context.Add,
+				"*": Context.Mul,
+				"/": Context.Quo,
+			} {
+				res, err := g(c, d, e)
+				if err != nil {
+					continue
+				}
+
+				got, gotInexact, err := remainderFint(op, d, e, res)
+				if err != nil {
+					continue // Overflow is an expected error in fast computation
+				}
+				want, wantInexact, err := remainderBint(op, d, e, res)
+				if err != nil {
+					t.Errorf("remainderBint(%q, %q, %q, %q) failed: %v", op, d, e, res, err)
+					continue
+				}
+				if gotInexact != wantInexact {
+					t.Errorf("remainderBint(%q, %q, %q, %q) reported %v, whereas remainderFint reported %v", op, d, e, res, wantInexact, gotInexact)
+				}
+				if got.Cmp(want) != 0 {
+					t.Errorf("remainderBint(%q, %q, %q, %q) = %q, whereas remainderFint = %q", op, d, e, res, want, got)
+				}
+			}
+		},
+	)
+}
+
 func FuzzDecimal_Int64_NewFromInt64(f *testing.F) {
 	for _, d := range corpus {
 		for s := range MaxScale + 1 {
@@ -4762,6 +8198,49 @@ func FuzzDecimal_Cmp(f *testing.F) {
 	)
 }
 
+func FuzzCmpStrings(f *testing.F) {
+	for _, c := range corpus {
+		for _, g := range corpus {
+			d, err := newSafe(c.neg, fint(c.coef), c.scale)
+			if err != nil {
+				continue
+			}
+			e, err := newSafe(g.neg, fint(g.coef), g.scale)
+			if err != nil {
+				continue
+			}
+			f.Add(d.String(), e.String())
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, a, b string) {
+			got, ok := cmpDigits(a, b)
+			if !ok {
+				t.Skip()
+				return
+			}
+
+			d, err := Parse(a)
+			if err != nil {
+				t.Errorf("Parse(%q) failed: %v", a, err)
+				return
+			}
+			e, err := Parse(b)
+			if err != nil {
+				t.Errorf("Parse(%q) failed: %v", b, err)
+				return
+			}
+			want := d.Cmp(e)
+
+			if got != want {
+				t.Errorf("cmpDigits(%q, %q) = %v, whereas %q.Cmp(%q) = %v", a, b, got, d, e, want)
+				return
+			}
+		},
+	)
+}
+
 func FuzzDecimal_Sqrt_PowInt(f *testing.F) {
 	for _, d := range corpus {
 		f.Add(d.neg, d.scale, d.coef)
@@ -4831,6 +8310,44 @@ func FuzzDecimal_Log_Exp(f *testing.F) {
 	)
 }
 
+func FuzzLogSlice(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.scale, d.coef, e.scale, e.coef)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dscale int, dcoef uint64, escale int, ecoef uint64) {
+			d, err := newSafe(false, fint(dcoef), dscale)
+			if err != nil || d.IsZero() {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(false, fint(ecoef), escale)
+			if err != nil || e.IsZero() {
+				t.Skip()
+				return
+			}
+
+			got, err := LogSlice([]Decimal{d, e})
+			if err != nil {
+				t.Errorf("LogSlice(%q, %q) failed: %v", d, e, err)
+				return
+			}
+			want, err := e.Log()
+			if err != nil {
+				t.Errorf("%q.Log() failed: %v", e, err)
+				return
+			}
+
+			if got[1] != want {
+				t.Errorf("LogSlice(%q, %q)[1] = %q, want %q", d, e, got[1], want)
+			}
+		},
+	)
+}
+
 // cmpULP compares decimals and returns 0 if they are within specified number of ULPs.
 func cmpULP(d, e Decimal, ulps int) (int, error) {
 	n, err := New(int64(ulps), 0)
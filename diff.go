@@ -0,0 +1,149 @@
+package decimal
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ExplainDifference returns a human-readable explanation of how decimal b
+// differs from decimal a.
+// It is intended for reconciliation reports that must explain why two
+// systems disagree on an amount.
+//
+// The explanation consists of the following facts separated by semicolons,
+// in this order, omitting those that do not apply:
+//   - "identical", if the decimals have the same value and scale;
+//   - "equal values", if the decimals have the same value but different scales;
+//   - "opposite signs", if b is equal to -a;
+//   - "b = a * 10^k", if b differs from a only by the position of the decimal
+//     point, which is a typical symptom of a unit mismatch, such as dollars
+//     and cents;
+//   - "scales differ: s and t", if the decimals have different scales;
+//   - "b - a = delta (n units at scale s)", if the decimals have different values,
+//     where s is the larger of the scales and n is the absolute difference
+//     expressed in units in the last place at that scale;
+//     if the difference has more than [MaxPrec] digits, it is rounded,
+//     and the number of units is omitted.
+//
+// For example, the explanation for 1.23 and 1.250 is
+// "scales differ: 2 and 3; b - a = 0.020 (20 units at scale 3)".
+func ExplainDifference(a, b Decimal) string {
+	if a == b {
+		return "identical"
+	}
+	var facts []string
+	switch {
+	case a.Cmp(b) == 0:
+		facts = append(facts, "equal values")
+	case !a.IsZero() && a.Neg().Cmp(b) == 0:
+		facts = append(facts, "opposite signs")
+	default:
+		if k, ok := pow10Shift(a, b); ok {
+			facts = append(facts, fmt.Sprintf("b = a * 10^%v", k))
+		}
+	}
+	if a.Scale() != b.Scale() {
+		facts = append(facts, fmt.Sprintf("scales differ: %v and %v", a.Scale(), b.Scale()))
+	}
+	if a.Cmp(b) != 0 {
+		facts = append(facts, explainDelta(a, b))
+	}
+	return strings.Join(facts, "; ")
+}
+
+// pow10Shift returns k such that b = a * 10^k, where k is not zero.
+// If there is no such k, pow10Shift returns false.
+func pow10Shift(a, b Decimal) (int, bool) {
+	if a.IsZero() || b.IsZero() || a.IsNeg() != b.IsNeg() {
+		return 0, false
+	}
+	antz, bntz := a.coef.ntz(), b.coef.ntz()
+	if a.coef/pow10[antz] != b.coef/pow10[bntz] {
+		return 0, false
+	}
+	k := (bntz - b.Scale()) - (antz - a.Scale())
+	return k, k != 0
+}
+
+// explainDelta returns the difference b - a together with the number of
+// units in the last place at the larger of the scales.
+func explainDelta(a, b Decimal) string {
+	delta, err := b.Sub(a)
+	if err != nil {
+		return "b - a overflows"
+	}
+	scale := max(a.Scale(), b.Scale())
+	delta = delta.Pad(scale)
+	if delta.Scale() != scale {
+		return fmt.Sprintf("b - a = %v (rounded)", delta)
+	}
+	units := "units"
+	if delta.Coef() == 1 {
+		units = "unit"
+	}
+	return fmt.Sprintf("b - a = %v (%v %v at scale %v)", delta, delta.Coef(), units, scale)
+}
+
+// EqualWithin compares two maps of decimals, for example, expected and actual
+// amounts of a settlement file keyed by account, and returns true if both
+// maps have the same keys and the absolute difference between the values
+// of each key is less than or equal to the tolerance.
+// Otherwise, it returns false and the mismatched keys in ascending order,
+// including the keys that are present in only one of the maps.
+// Differences are computed exactly, without any rounding.
+// If the tolerance is negative, its absolute value is used.
+// See also function [ExplainDifference].
+func EqualWithin(a, b map[string]Decimal, tol Decimal) (bool, []string) {
+	tol = tol.Abs()
+	var keys []string
+	for k, d := range a {
+		e, ok := b[k]
+		if !ok || !withinTol(d, e, tol) {
+			keys = append(keys, k)
+		}
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return len(keys) == 0, keys
+}
+
+// withinTol returns true if |d - e| <= tol, where tol is non-negative.
+// The difference is computed exactly using fixed-point arithmetic
+// with the integer and fractional parts stored separately.
+func withinTol(d, e, tol Decimal) bool {
+	dint, dfrac := d.fixed()
+	eint, efrac := e.fixed()
+	var zint, zfrac fint
+	if d.IsNeg() == e.IsNeg() {
+		// |d - e| = ||d| - |e||
+		if dint < eint || (dint == eint && dfrac < efrac) {
+			dint, dfrac, eint, efrac = eint, efrac, dint, dfrac
+		}
+		zint = dint - eint
+		if dfrac < efrac {
+			zint--
+			zfrac = dfrac + (pow10[MaxScale] - efrac)
+		} else {
+			zfrac = dfrac - efrac
+		}
+	} else {
+		// |d - e| = |d| + |e|
+		if dfrac >= pow10[MaxScale]-efrac {
+			zfrac = dfrac - (pow10[MaxScale] - efrac)
+			dint++
+		} else {
+			zfrac = dfrac + efrac
+		}
+		if dint > maxCoef-eint {
+			return false // the difference is greater than any decimal
+		}
+		zint = dint + eint
+	}
+	tint, tfrac := tol.fixed()
+	return zint < tint || (zint == tint && zfrac <= tfrac)
+}
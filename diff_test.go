@@ -0,0 +1,74 @@
+package decimal
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExplainDifference(t *testing.T) {
+	tests := []struct {
+		a, b, want string
+	}{
+		{"1.23", "1.23", "identical"},
+		{"0", "0", "identical"},
+		{"1.23", "1.230", "equal values; scales differ: 2 and 3"},
+		{"0", "0.00", "equal values; scales differ: 0 and 2"},
+		{"1.23", "-1.23", "opposite signs; b - a = -2.46 (246 units at scale 2)"},
+		{"-0.5", "0.50", "opposite signs; scales differ: 1 and 2; b - a = 1.00 (100 units at scale 2)"},
+		{"1.23", "123", "b = a * 10^2; scales differ: 2 and 0; b - a = 121.77 (12177 units at scale 2)"},
+		{"1200", "0.12", "b = a * 10^-4; scales differ: 0 and 2; b - a = -1199.88 (119988 units at scale 2)"},
+		{"-12.5", "-125.0", "b = a * 10^1; b - a = -112.5 (1125 units at scale 1)"},
+		{"1.23", "1.24", "b - a = 0.01 (1 unit at scale 2)"},
+		{"1.23", "1.250", "scales differ: 2 and 3; b - a = 0.020 (20 units at scale 3)"},
+		{"1.23", "1.2", "scales differ: 2 and 1; b - a = -0.03 (3 units at scale 2)"},
+		{"0", "1.5", "scales differ: 0 and 1; b - a = 1.5 (15 units at scale 1)"},
+		{"9999999999999999999", "-9999999999999999999", "opposite signs; b - a overflows"},
+	}
+	for _, tt := range tests {
+		a, b := MustParse(tt.a), MustParse(tt.b)
+		got := ExplainDifference(a, b)
+		if got != tt.want {
+			t.Errorf("ExplainDifference(%q, %q) = %q, want %q", a, b, got, tt.want)
+		}
+	}
+}
+
+func TestEqualWithin(t *testing.T) {
+	tests := []struct {
+		a, b     map[string]string
+		tol      string
+		want     bool
+		wantKeys []string
+	}{
+		{nil, nil, "0", true, nil},
+		{map[string]string{"x": "1.00"}, map[string]string{"x": "1"}, "0", true, nil},
+		{map[string]string{"x": "1.00", "y": "2"}, map[string]string{"x": "1.01", "y": "1.99"}, "0.01", true, nil},
+		{map[string]string{"x": "1.00", "y": "2"}, map[string]string{"x": "1.01", "y": "1.98"}, "0.01", false, []string{"y"}},
+		{map[string]string{"x": "1.00", "y": "2"}, map[string]string{"x": "1.01", "y": "1.98"}, "-0.02", true, nil},
+		{map[string]string{"x": "1", "z": "1"}, map[string]string{"x": "1", "y": "1"}, "0", false, []string{"y", "z"}},
+		{map[string]string{"x": "-0.005"}, map[string]string{"x": "0.005"}, "0.01", true, nil},
+		{map[string]string{"x": "-0.005"}, map[string]string{"x": "0.0051"}, "0.01", false, []string{"x"}},
+		{map[string]string{"x": "100000000000000000.0"}, map[string]string{"x": "0.0000000000000000001"}, "99999999999999999.9", false, []string{"x"}},
+		{map[string]string{"x": "1000000000000000000"}, map[string]string{"x": "-0.0000000000000000001"}, "9999999999999999999", true, nil},
+		{map[string]string{"x": "100000000000000000.0"}, map[string]string{"x": "0"}, "100000000000000000", true, nil},
+		{map[string]string{"x": "9999999999999999999"}, map[string]string{"x": "-9999999999999999999"}, "9999999999999999999", false, []string{"x"}},
+		{map[string]string{"x": "9999999999999999999"}, map[string]string{"x": "-0.9999999999999999999"}, "9999999999999999999", false, []string{"x"}},
+		{map[string]string{"x": "0.9999999999999999999"}, map[string]string{"x": "-0.9999999999999999999"}, "1.999999999999999999", false, []string{"x"}},
+		{map[string]string{"x": "0.9999999999999999999"}, map[string]string{"x": "-0.9999999999999999999"}, "2", true, nil},
+	}
+	for _, tt := range tests {
+		a := make(map[string]Decimal)
+		for k, v := range tt.a {
+			a[k] = MustParse(v)
+		}
+		b := make(map[string]Decimal)
+		for k, v := range tt.b {
+			b[k] = MustParse(v)
+		}
+		tol := MustParse(tt.tol)
+		got, gotKeys := EqualWithin(a, b, tol)
+		if got != tt.want || !slices.Equal(gotKeys, tt.wantKeys) {
+			t.Errorf("EqualWithin(%v, %v, %q) = (%v, %q), want (%v, %q)", a, b, tol, got, gotKeys, tt.want, tt.wantKeys)
+		}
+	}
+}
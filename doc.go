@@ -44,6 +44,10 @@ Here are the ranges for frequently used scales:
 	| Bitcoin      | 8     |            -99,999,999,999.99999999  |            99,999,999,999.99999999  |
 	| Ethereum     | 9     |             -9,999,999,999.999999999 |             9,999,999,999.999999999 |
 
+Values that do not fit these ranges, such as crypto market caps or values
+of NUMERIC(38) database columns, can be represented by [Decimal128],
+which holds up to 34 digits at the cost of slower arithmetic.
+
 [Subnormal numbers] are not supported to ensure peak performance.
 Consequently, decimals between -0.00000000000000000005 and 0.00000000000000000005
 inclusive, are rounded to 0.
@@ -91,7 +95,9 @@ If any significant digit is lost, an overflow error is returned.
 
 The following rules determine the significance of digits:
 
-  - [Decimal.Sqrt], [Decimal.Exp], [Decimal.Log]:
+  - [Decimal.Sqrt], [Decimal.Exp], [Decimal.Log], trigonometric functions,
+    such as [Decimal.Sin] and [Decimal.Atan2], and hyperbolic functions,
+    such as [Decimal.Sinh] and [Decimal.Atanh]:
     All digits in the integer part are significant, while digits in the
     fractional part are considered insignificant.
 
@@ -114,6 +120,18 @@ the following settings:
 
 The equality of Etiny and Emin implies that this package does not support
 subnormal numbers.
+The same settings are available at runtime using [Capabilities].
+
+The scale of arithmetic results can be chosen explicitly using [Context],
+which either keeps the scale chosen by the methods of [Decimal],
+rounds or pads results to a fixed scale, or removes trailing zeros.
+[Context] can also enable the underflow trap, so that a non-zero result
+rounded to zero, such as 0.0000000000000000001 / 4, is reported as an error.
+[Context] can also limit the precision and the exponents of results,
+with the limits of the table above used by default, and enable the
+Inexact trap.
+If the Inexact condition is not trapped, [Context] can report it
+through a callback together with the discarded remainder, see [RoundingEvent].
 
 # Rounding Methods
 
@@ -134,6 +152,12 @@ explicit rounding:
     [Decimal.Floor].
   - Rounding towards zero:
     [Decimal.Trunc].
+  - Rounding with an explicit [RoundingMode], such as half away from zero:
+    [Decimal.RoundMode].
+  - Stochastic rounding with a user-supplied random number generator:
+    [Decimal.RoundStochastic].
+  - Rounding to a number of significant digits:
+    [Decimal.RoundSig], [Decimal.CeilSig], [Decimal.FloorSig].
 
 See the documentation for each method for more details.
 
@@ -144,13 +168,16 @@ Errors are returned in the following cases:
 
   - Division by Zero:
     Unlike Go's standard library, [Decimal.Quo], [Decimal.QuoRem], [Decimal.Inv],
-    [Decimal.AddQuo], [Decimal.SubQuo], do not panic when dividing by 0.
+    [Decimal.AddQuo], [Decimal.SubQuo], [Decimal.Mod], [Decimal.ModEuclid],
+    [Decimal.Rem], do not panic when dividing by 0.
     Instead, they return an error.
 
   - Invalid Operation:
     [Decimal.PowInt] returns an error if 0 is raised to a negative power.
     [Decimal.Sqrt] return an error if the square root of a negative decimal is requested.
     [Decimal.Log] returns an error when calculating the natural logarithm of a non-positive decimal.
+    [Decimal.Asin] and [Decimal.Acos] return an error for decimals outside the range [-1, 1].
+    [Decimal.Acosh] and [Decimal.Atanh] return an error for decimals outside their domains.
 
   - Overflow:
     Unlike standard integers, decimals do not "wrap around" when exceeding their maximum value.
@@ -163,6 +190,58 @@ Errors are not returned in the following cases:
     If the result is a decimal between -0.00000000000000000005 and
     0.00000000000000000005 inclusive, it will be rounded to 0.
 
+Error messages include the operands of the failed operation and,
+for methods such as [Decimal.AddQuoExact], the requested scale.
+If the operands are sensitive, use [RedactError], [Context.Redact],
+or [WithRedaction] to hide their digits.
+Redaction is chosen per call, so libraries sharing a process do not
+affect each other's error messages.
+
+# Build Tags
+
+The decimalnobig build tag removes step 2 of arithmetic operations,
+so the package does not use [big.Int] arithmetic at all.
+This reduces binary size, which is useful for [TinyGo] and WebAssembly
+targets that only need small exact arithmetic.
+With this tag, the package behaves as follows:
+
+  - Arithmetic operations return an overflow error if the exact result
+    cannot be computed using uint64 arithmetic during step 1.
+    For example, [Decimal.Quo] returns an error for 1 / 3.
+  - [Parse] and [ParseExact] do not support exponential notation and return
+    an overflow error for strings with more than 19 significant digits.
+  - [NewFromPartsString] returns an overflow error for coefficients with more
+    than 19 digits and for scales outside the range [MinScale, MaxScale].
+  - [Decimal.Sqrt] returns an overflow error unless the square root is exact.
+  - [Decimal.PowDecimal] returns an overflow error for non-integer powers,
+    except for trivial arguments such as 0 and 1.
+  - [Decimal.Exp], [Decimal.Log], [ExpSlice], [LogSlice], [GeoMean], [HarmonicMean],
+    [CAGR] return an overflow error, except for trivial arguments such as 0 and 1.
+  - Trigonometric and hyperbolic functions, such as [Decimal.Sin] and
+    [Decimal.Sinh], return an overflow error, except for trivial arguments such as 0.
+  - [NewFromWei], [NewFromGwei], [Decimal.Wei], and [Decimal.Gwei] are not
+    available, since they use [big.Int] values.
+  - [NewFromSpannerRat] and [Decimal.SpannerRat] are not available,
+    since they use [big.Rat] values.
+  - [Context] may report a [RoundingEvent] with a zero remainder for a result
+    whose remainder cannot be computed using uint64 arithmetic.
+  - [Calc], [Xp], and [EvaluateExact] are not available, since they keep
+    intermediate results in extended precision.
+  - [AccrualSchedule] returns an overflow error unless the powers of the daily
+    growth factor can be computed exactly using uint64 arithmetic.
+  - [Decimal128] is not available, since its arithmetic uses [big.Int] values.
+  - Comparison, rounding, and conversion methods are not affected.
+
+The decimaldebug build tag enables internal invariant assertions.
+The package panics if it creates a decimal with a coefficient of more than
+19 digits, a scale outside the range [MinScale, MaxScale], or a negative zero.
+Intermediate [big.Int] values are also poisoned when they are reused,
+so that reading them before initialization produces obviously wrong results.
+This tag is intended for running test suites against a hardened build
+and should not be used in production:
+
+	go test -tags decimaldebug ./...
+
 # Data Conversion
 
 A. JSON
@@ -186,6 +265,35 @@ Below is an example OpenAPI schema:
 	  format: decimal
 	  pattern: '^(\-|\+)?((\d+(\.\d*)?)|(\.\d+))$'
 
+Unmarshaling is strict: a decimal must be sent as a quoted string.
+Unquoted JSON numbers, such as 5.67, are rejected with an [json.UnmarshalTypeError],
+because they are often produced by clients using binary floating-point numbers.
+A JSON null leaves the decimal unchanged; use [NullDecimal] if the field can be null.
+[NullDecimal] encodes null as JSON null and valid decimals as quoted strings.
+
+To omit zero decimals from payloads, use the "omitzero" option, which relies on
+[Decimal.IsZero], so 0, 0.00, and other representations of zero are all omitted:
+
+	type Object struct {
+	  Number decimal.Decimal `json:"some_number,omitzero"`
+	}
+
+The "omitempty" option has no effect on struct values, such as decimals.
+If "omitempty" is required, use a pointer field together with [Decimal.NilIfZero].
+
+Decimals can also be used as keys of built-in maps, such as map[decimal.Decimal]V,
+which are marshaled as JSON objects.
+Note that the keys of built-in maps are compared by representation,
+so 1 and 1.0 are different keys, and both are emitted.
+To key JSON objects by numeric value, use [Map], which stores and emits
+keys in the canonical form with trailing zeros removed.
+
+Documents exported from MongoDB, for example, by mongoexport or Atlas triggers,
+represent Decimal128 values in the Extended JSON format, such as
+{"$numberDecimal":"1.23"}.
+To read and write such documents, use [NumberDecimal] instead of [Decimal].
+It also accepts quoted strings, which simplifies migration between the formats.
+
 B. XML
 
 The package integrates with standard [encoding/xml] via the implementation of
@@ -194,10 +302,20 @@ Below is an example structure:
 
 	type Entity struct {
 	  Number decimal.Decimal `xml:"SomeNumber"`
+	  Price  decimal.Decimal `xml:"price,attr"`
 	  // Other fields...
 	}
 
+Elements and attributes are handled in the same way.
+When unmarshaling, both lowercase and uppercase exponents, such as "5.67e2" and
+"5.67E2", are accepted.
+When marshaling, decimals are never written in scientific notation and
+trailing zeros are preserved, so that a round trip does not change the scale.
+
 "xs:decimal" type can represent decimals in XML schema.
+Since the type does not allow exponents, the text written by
+[Decimal.MarshalText] always passes schema validation, which can be
+checked using [Decimal.CompliesWithXSD].
 It is possible to impose restrictions on the length of the decimals
 using the following type:
 
@@ -244,6 +362,8 @@ appropriate column types:
 	| PostgreSQL | DECIMAL                       |
 	| SQLite     | TEXT                          |
 	| MySQL      | DECIMAL(19, d) or VARCHAR(22) |
+	| Oracle     | NUMBER(p, s)                  |
+	| SQL Server | DECIMAL(p, s)                 |
 
 Below are the reasons for these preferences:
 
@@ -265,11 +385,66 @@ Below are the reasons for these preferences:
     To prevent automatic rescaling, consider using VARCHAR(22), which accurately
     preserves the scale of decimals.
 
+  - Oracle and SQL Server:
+    Both databases round decimals with more than s digits after the decimal
+    point half away from zero and reject decimals with more than (p - s)
+    digits in the integer part.
+    Use [OracleNumber] or [SQLServerDecimal] to describe the column and
+    [Column.Round] or [Column.Validate] to round or validate decimals before
+    they are sent to the database.
+    Out-of-range decimals are reported with a [*ColumnRangeError].
+
+Nullable columns can be represented either by [NullDecimal] or by a pointer
+to a decimal.
+Both work with reflection-based mappers, such as GORM or ent:
+a nil *Decimal is stored as NULL, since [Decimal.Value] has a value receiver,
+and [NullDecimal.IsZero] reports whether the value is null.
+Use [NewNullFromPtr] and [NullDecimal.Ptr] to convert between the two forms.
+
+E. Compact Storage
+
+A decimal occupies 16 bytes in memory, including padding.
+To store large numbers of decimals, for example, in slices backed by
+memory-mapped files, use [Packed], which occupies 9 bytes and has
+a platform-independent layout.
+Use [Pack] and [Packed.Unpack] to convert between decimals and [Packed] values.
+To read decimals directly from such memory without copying them into
+the heap, use [ReadonlyArray].
+
+F. Google Cloud
+
+Google Cloud Spanner NUMERIC columns have a precision of 38 digits and a scale
+of 9 digits, so the integer part of any decimal fits into them, but the
+fractional part may not.
+Use [Decimal.SpannerNumeric] or [Decimal.SpannerRat] to convert decimals
+to the string or [big.Rat] forms accepted by the Spanner client, and
+[NewFromSpannerRat] to convert values read from NUMERIC columns.
+These methods return an error instead of silently rounding decimals with
+more than 9 digits after the decimal point.
+
+Google Cloud Firestore has no decimal type, and decimals stored as plain strings
+do not sort in numeric order.
+Use [Decimal.SortKey] to obtain a fixed-length string that can be used
+in range queries and ordering, and [ParseSortKey] to convert it back.
+
+G. MongoDB
+
+[Decimal] and [NullDecimal] implement the value marshaler interfaces of
+the MongoDB Go driver v2 without depending on it.
+Decimals are stored as BSON Decimal128 values with their scale preserved,
+so they keep their exact value and can be used in numeric queries.
+Unmarshaling also accepts BSON Double, String, Int32, and Int64 values,
+see [Decimal.UnmarshalBSONValue].
+[NullDecimal] stores null as BSON Null.
+
 [Infinity]: https://en.wikipedia.org/wiki/Infinity#Computing
 [Subnormal numbers]: https://en.wikipedia.org/wiki/Subnormal_number
 [NaN]: https://en.wikipedia.org/wiki/NaN
 [ANSI X3.274-1996]: https://speleotrove.com/decimal/dax3274.html
 [big.Int]: https://pkg.go.dev/math/big#Int
+[big.Rat]: https://pkg.go.dev/math/big#Rat
+[TinyGo]: https://tinygo.org
+[json.UnmarshalTypeError]: https://pkg.go.dev/encoding/json#UnmarshalTypeError
 [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
 [negative zeros]: https://en.wikipedia.org/wiki/Signed_zero
 [context]: https://speleotrove.com/decimal/damodel.html
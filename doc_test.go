@@ -1,11 +1,23 @@
+//go:build !decimalnobig
+
 package decimal_test
 
 import (
+	"context"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
 	"slices"
 	"strings"
+	"testing/quick"
+	"time"
 
 	"github.com/govalues/decimal"
 )
@@ -123,6 +135,174 @@ func ExampleSum() {
 	// Output: 20.67 <nil>
 }
 
+func ExampleSumParallel() {
+	d := make([]decimal.Decimal, 100_000)
+	for i := range d {
+		d[i] = decimal.MustNew(int64(i), 2)
+	}
+	fmt.Println(decimal.SumParallel(d, 4))
+	fmt.Println(decimal.Sum(d...))
+	// Output:
+	// 49999500.00 <nil>
+	// 49999500.00 <nil>
+}
+
+func ExampleSumOrZero() {
+	d := decimal.MustParse("5.67")
+	e := decimal.MustParse("-8")
+	fmt.Println(decimal.SumOrZero(d, e))
+	fmt.Println(decimal.SumOrZero())
+	// Output:
+	// -2.33 <nil>
+	// 0 <nil>
+}
+
+func ExampleSumChecked() {
+	d := []decimal.Decimal{
+		decimal.MustParse("1000.00"),
+		decimal.MustParse("9999999999999999999"),
+		decimal.MustParse("5.67"),
+	}
+	total, failedAt, err := decimal.SumChecked(d)
+	fmt.Println(total, failedAt, err != nil)
+	// Output: 1000.00 1 true
+}
+
+func ExampleMean() {
+	d := decimal.MustParse("5.67")
+	e := decimal.MustParse("-8")
+	f := decimal.MustParse("23")
+	fmt.Println(decimal.Mean(d, e, f))
+	fmt.Println(decimal.Mean())
+	// Output:
+	// 6.89 <nil>
+	// 0 computing [mean([])]: invalid operation: no arguments
+}
+
+func ExampleMeanOrZero() {
+	d := decimal.MustParse("5.67")
+	e := decimal.MustParse("-8")
+	f := decimal.MustParse("23")
+	fmt.Println(decimal.MeanOrZero(d, e, f))
+	fmt.Println(decimal.MeanOrZero())
+	// Output:
+	// 6.89 <nil>
+	// 0 <nil>
+}
+
+func ExampleSort() {
+	prices := []decimal.Decimal{
+		decimal.MustParse("101.5"),
+		decimal.MustParse("99.75"),
+		decimal.MustParse("101.50"),
+		decimal.MustParse("100"),
+	}
+	decimal.Sort(prices)
+	fmt.Println(prices)
+	fmt.Println(decimal.BinarySearch(prices, decimal.MustParse("100.00")))
+	fmt.Println(decimal.BinarySearch(prices, decimal.MustParse("100.25")))
+	// Output:
+	// [99.75 100 101.50 101.5]
+	// 1 true
+	// 2 false
+}
+
+func ExampleContains() {
+	prices := []decimal.Decimal{decimal.MustParse("1.50"), decimal.MustParse("2.25")}
+	fmt.Println(decimal.Contains(prices, decimal.MustParse("1.5")))
+	fmt.Println(slices.Contains(prices, decimal.MustParse("1.5")))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleMin() {
+	fmt.Println(decimal.Min(decimal.MustParse("1.5"), decimal.MustParse("-2"), decimal.MustParse("0.75")))
+	fmt.Println(decimal.Max(decimal.MustParse("1.5"), decimal.MustParse("-2"), decimal.MustParse("0.75")))
+	fmt.Println(decimal.Min())
+	// Output:
+	// -2 <nil>
+	// 1.5 <nil>
+	// 0 computing [min([])]: invalid operation: no arguments
+}
+
+func ExampleMedian() {
+	d := decimal.MustParse("5.67")
+	e := decimal.MustParse("-8")
+	f := decimal.MustParse("23")
+	g := decimal.MustParse("10")
+	fmt.Println(decimal.Median(d, e, f))
+	fmt.Println(decimal.Median(d, e, f, g))
+	// Output:
+	// 5.67 <nil>
+	// 7.835 <nil>
+}
+
+func ExampleMode() {
+	d := decimal.MustParse("5.67")
+	e := decimal.MustParse("-8")
+	f := decimal.MustParse("5.670")
+	fmt.Println(decimal.Mode(d, e, f))
+	fmt.Println(decimal.Mode(d, e))
+	// Output:
+	// 5.67 <nil>
+	// -8 <nil>
+}
+
+func ExampleMedianAbsoluteDeviation() {
+	s := []decimal.Decimal{
+		decimal.MustParse("1"),
+		decimal.MustParse("1"),
+		decimal.MustParse("2"),
+		decimal.MustParse("2"),
+		decimal.MustParse("4"),
+		decimal.MustParse("6"),
+		decimal.MustParse("9"),
+	}
+	fmt.Println(decimal.MedianAbsoluteDeviation(s...))
+	// Output: 1 <nil>
+}
+
+func ExampleGeoMean() {
+	d := decimal.MustParse("1.05")
+	e := decimal.MustParse("1.10")
+	f := decimal.MustParse("0.95")
+	fmt.Println(decimal.GeoMean(d, e, f))
+	// Output: 1.031419164168325905 <nil>
+}
+
+func ExampleHarmonicMean() {
+	d := decimal.MustParse("2")
+	e := decimal.MustParse("8")
+	fmt.Println(decimal.HarmonicMean(d, e))
+	// Output: 3.2 <nil>
+}
+
+func ExampleVWAP() {
+	prices := []decimal.Decimal{
+		decimal.MustParse("100.10"),
+		decimal.MustParse("100.20"),
+		decimal.MustParse("100.05"),
+	}
+	quantities := []decimal.Decimal{
+		decimal.MustParse("300"),
+		decimal.MustParse("100"),
+		decimal.MustParse("250"),
+	}
+	fmt.Println(decimal.VWAP(prices, quantities))
+	// Output: 100.0961538461538462 <nil>
+}
+
+func ExampleCAGR() {
+	begin := decimal.MustParse("1000")
+	end := decimal.MustParse("1500")
+	fmt.Println(decimal.CAGR(begin, end, 7))
+	fmt.Println(decimal.CAGR(begin, end, 0))
+	// Output:
+	// 0.0596340226670483814 <nil>
+	// 0 computing [cagr(1000, 1500, 0)]: invalid operation: non-positive number of periods
+}
+
 func ExampleProd() {
 	d := decimal.MustParse("5.67")
 	e := decimal.MustParse("-8")
@@ -131,6 +311,368 @@ func ExampleProd() {
 	// Output: -1043.28 <nil>
 }
 
+func ExampleExplainDifference() {
+	a := decimal.MustParse("12.50")
+	fmt.Println(decimal.ExplainDifference(a, decimal.MustParse("12.5")))
+	fmt.Println(decimal.ExplainDifference(a, decimal.MustParse("1250")))
+	fmt.Println(decimal.ExplainDifference(a, decimal.MustParse("12.53")))
+	// Output:
+	// equal values; scales differ: 2 and 1
+	// b = a * 10^2; scales differ: 2 and 0; b - a = 1237.50 (123750 units at scale 2)
+	// b - a = 0.03 (3 units at scale 2)
+}
+
+func ExampleProdChecked() {
+	d := []decimal.Decimal{
+		decimal.MustParse("2"),
+		decimal.MustParse("3"),
+		decimal.MustParse("9999999999999999999"),
+	}
+	total, failedAt, err := decimal.ProdChecked(d)
+	fmt.Println(total, failedAt, err != nil)
+	// Output: 6 2 true
+}
+
+func ExampleEqualWithin() {
+	want := map[string]decimal.Decimal{
+		"ACC-1": decimal.MustParse("100.00"),
+		"ACC-2": decimal.MustParse("250.00"),
+		"ACC-3": decimal.MustParse("75.50"),
+	}
+	got := map[string]decimal.Decimal{
+		"ACC-1": decimal.MustParse("100.01"),
+		"ACC-2": decimal.MustParse("249.90"),
+		"ACC-4": decimal.MustParse("75.50"),
+	}
+	fmt.Println(decimal.EqualWithin(want, got, decimal.MustParse("0.01")))
+	// Output: false [ACC-2 ACC-3 ACC-4]
+}
+
+func ExampleDomain() {
+	usd := decimal.Domain{Scale: 2, Mode: decimal.HalfUp}
+	price, _ := usd.Parse("19.99")
+	rate, _ := decimal.Parse("0.0825")
+	tax, _ := usd.Mul(price, rate)
+	fmt.Println(price, tax)
+	fmt.Println(usd.Add(price, tax))
+	fmt.Println(usd.Quo(price, decimal.MustParse("3")))
+	// Output:
+	// 19.99 1.65
+	// 21.64 <nil>
+	// 6.66 <nil>
+}
+
+func ExamplePack() {
+	d := decimal.MustParse("-123.45")
+	p := decimal.Pack(d)
+	fmt.Printf("% x\n", p)
+	fmt.Println(p.Unpack())
+	// Output:
+	// 82 39 30 00 00 00 00 00 00
+	// -123.45 <nil>
+}
+
+func ExampleReadonlyArray() {
+	// In practice, the bytes come from a memory-mapped file
+	var b []byte
+	for _, s := range []string{"1.0825", "0.8571", "157.32"} {
+		p := decimal.Pack(decimal.MustParse(s))
+		b = append(b, p[:]...)
+	}
+	rates, err := decimal.NewReadonlyArray(b)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(rates.Len())
+	fmt.Println(rates.At(2))
+	// Output:
+	// 3
+	// 157.32 <nil>
+}
+
+func ExampleRedactError() {
+	d := decimal.MustParse("-1234.56")
+	e := decimal.MustParse("0")
+	_, err := d.Quo(e)
+	fmt.Println(err)
+	fmt.Println(decimal.RedactError(err))
+	// Output:
+	// computing [-1234.56 / 0]: division by zero
+	// computing [-xxxx.xx / x]: division by zero
+}
+
+func ExampleCapabilities() {
+	caps := decimal.Capabilities()
+	fmt.Printf("NUMERIC(%v, %v)\n", caps.Precision, caps.MaxScale)
+	fmt.Println(caps.Emax, caps.Emin, caps.Rounding == decimal.HalfEven)
+	fmt.Println(caps.EnabledTraps)
+	// Output:
+	// NUMERIC(19, 19)
+	// 18 -19 true
+	// [Division by Zero Invalid Operation Overflow]
+}
+
+func ExampleContext() {
+	d := decimal.MustParse("1.10")
+	e := decimal.MustParse("2.5")
+	fixed := decimal.Context{ScalePolicy: decimal.ScaleFixed, Scale: 4}
+	minimal := decimal.Context{ScalePolicy: decimal.ScaleMinimal}
+	fmt.Println(decimal.Context{}.Mul(d, e))
+	fmt.Println(fixed.Mul(d, e))
+	fmt.Println(minimal.Mul(d, e))
+	// Output:
+	// 2.750 <nil>
+	// 2.7500 <nil>
+	// 2.75 <nil>
+}
+
+func ExampleScalePolicy() {
+	d := decimal.MustParse("1")
+	e := decimal.MustParse("1.0325")
+	capped := decimal.Context{ScalePolicy: decimal.ScaleCapped, Scale: 9}
+	f, g := d, d
+	for range 5 {
+		f, _ = decimal.Context{}.Mul(f, e)
+		g, _ = capped.Mul(g, e)
+	}
+	fmt.Println(f)
+	fmt.Println(g)
+	// Output:
+	// 1.173411395829394531
+	// 1.173411396
+}
+
+func ExampleContext_TrapUnderflow() {
+	d := decimal.MustParse("0.0000000000000000001")
+	e := decimal.MustParse("4")
+	risk := decimal.Context{TrapUnderflow: true}
+	fmt.Println(decimal.Context{}.Quo(d, e))
+	fmt.Println(risk.Quo(d, e))
+	// Output:
+	// 0.0000000000000000000 <nil>
+	// 0 computing [0.0000000000000000001 / 4]: decimal underflow
+}
+
+func ExampleContext_Precision() {
+	d := decimal.MustParse("2")
+	e := decimal.MustParse("3")
+	f := decimal.MustParse("123.45")
+	g := decimal.MustParse("10")
+	engine := decimal.Context{Precision: 4, Mode: decimal.HalfUp}
+	strict := engine
+	strict.TrapInexact = true
+	fmt.Println(engine.Quo(d, e))
+	fmt.Println(engine.Mul(f, g))
+	fmt.Println(strict.Quo(d, e))
+	// Output:
+	// 0.6667 <nil>
+	// 1235 <nil>
+	// 0 computing [2 / 3]: inexact result: result is rounded to 0.6667
+}
+
+func ExampleContext_OnRounded() {
+	d := decimal.MustParse("1.15")
+	e := decimal.MustParse("0.5")
+	audit := decimal.Context{
+		ScalePolicy: decimal.ScaleFixed,
+		Scale:       2,
+		Mode:        decimal.HalfUp,
+		OnRounded:   func(ev decimal.RoundingEvent) { fmt.Println("rounded:", ev) },
+	}
+	fmt.Println(audit.Mul(d, e))
+	fmt.Println(audit.Add(d, e))
+	// Output:
+	// rounded: 1.15 * 0.5 = 0.58 (remainder -0.005)
+	// 0.58 <nil>
+	// 1.65 <nil>
+}
+
+func ExampleTrackedDecimal() {
+	usd := decimal.Context{ScalePolicy: decimal.ScaleFixed, Scale: 2, Mode: decimal.HalfUp}
+	price := decimal.MustParse("19.99")
+	qty := decimal.MustParse("3")
+	tax := decimal.MustParse("0.0825")
+	t := decimal.NewTrackedDecimal(price, usd, 10)
+	t, _ = t.Mul(qty)
+	t, _ = t.Mul(tax)
+	fmt.Println(t.Value())
+	for _, s := range t.Steps() {
+		fmt.Println(s)
+	}
+	// Output:
+	// 4.95
+	// 19.99 * 3 = 59.97
+	// 59.97 * 0.0825 = 4.95 (remainder -0.002475)
+}
+
+func ExampleTrackedDecimal_MarshalJSON() {
+	t := decimal.NewTrackedDecimal(decimal.MustParse("1"), decimal.Context{}, 10)
+	t, _ = t.Round(0)
+	b, _ := json.Marshal(t)
+	fmt.Println(string(b))
+	// Output: {"origin":"1","value":"1","dropped":0,"steps":[{"op":"round","d":"1","e":"1","result":"1","remainder":"0","rounded":false}]}
+}
+
+func ExampleCalc() {
+	price := decimal.MustParse("19.99")
+	qty := decimal.MustParse("3")
+	rate := decimal.MustParse("0.0825")
+	fmt.Println(price.Calc().Mul(qty).Mul(rate).Round(2).Result())
+	fmt.Println(decimal.One.Calc().Quo(qty).Mul(qty).Result())
+	fmt.Println(decimal.One.Calc().Quo(decimal.Zero).Add(price).Result())
+	// Output:
+	// 4.95 <nil>
+	// 1.000000000000000000 <nil>
+	// 0 computing [1 / 0]: division by zero
+}
+
+func ExampleCalc_AddQuo() {
+	a := decimal.MustParse("19.99")
+	b := decimal.MustParse("3")
+	c := decimal.MustParse("10")
+	d := decimal.MustParse("4")
+	fmt.Println(a.Calc().Mul(b).AddQuo(c, d).Result())
+	fmt.Println(decimal.Zero.Calc().AddQuo(decimal.One, b).AddQuo(decimal.One, b).AddQuo(decimal.One, b).Result())
+	// Output:
+	// 62.47 <nil>
+	// 1.000000000000000000 <nil>
+}
+
+func ExampleEvaluateExact() {
+	price := decimal.MustParse("19.99")
+	qty := decimal.MustParse("3")
+	discount := decimal.MustParse("0.125")
+	fmt.Println(decimal.EvaluateExact(2, func(x *decimal.Xp) *decimal.Xp {
+		return x.Mul(price.Xp(), qty.Xp()).Sub(x, discount.Xp())
+	}))
+	fmt.Println(decimal.EvaluateExact(2, func(x *decimal.Xp) *decimal.Xp {
+		return x.Quo(price.Xp(), decimal.Zero.Xp())
+	}))
+	// Output:
+	// 59.84 <nil>
+	// 0 evaluating formula: computing [19.99 / 0]: division by zero
+}
+
+func ExampleColumn_Round() {
+	c, _ := decimal.SQLServerDecimal(5, 2)
+	fmt.Println(c.Round(decimal.MustParse("123.455")))
+	fmt.Println(c.Round(decimal.MustParse("999.995")))
+	// Output:
+	// 123.46 <nil>
+	// 0 rounding 999.995: decimal overflow: 1000.00 does not fit into a column with precision 5 and scale 2
+}
+
+func ExampleColumn_Validate() {
+	c, _ := decimal.OracleNumber(10, 2)
+	fmt.Println(c.Validate(decimal.MustParse("123.450")))
+	fmt.Println(c.Validate(decimal.MustParse("123.455")))
+	// Output:
+	// <nil>
+	// validating 123.455: invalid operation: more than 2 digits after the decimal point
+}
+
+func ExampleMap() {
+	var fees decimal.Map[string]
+	fees.Set(decimal.MustParse("1000.00"), "0.5%")
+	fees.Set(decimal.MustParse("0"), "1%")
+	fees.Set(decimal.MustParse("10000"), "0.25%")
+	fmt.Println(fees.Get(decimal.MustParse("1000")))
+	fmt.Println(fees.Keys())
+	// Output:
+	// 0.5% true
+	// [0 1000 10000]
+}
+
+func ExampleMap_MarshalJSON() {
+	var fees decimal.Map[string]
+	fees.Set(decimal.MustParse("1000.00"), "0.5%")
+	fees.Set(decimal.MustParse("0"), "1%")
+	b, _ := json.Marshal(fees)
+	fmt.Println(string(b))
+	// Output: {"0":"1%","1000":"0.5%"}
+}
+
+func ExampleMap_UnmarshalJSON() {
+	var fees decimal.Map[string]
+	_ = json.Unmarshal([]byte(`{"1000.00":"0.5%","0":"1%","1000":"0.4%"}`), &fees)
+	fmt.Println(fees.Keys())
+	fmt.Println(fees.Get(decimal.MustParse("1000")))
+	// Output:
+	// [0 1000]
+	// 0.4% true
+}
+
+func ExampleNumberDecimal_MarshalJSON() {
+	type Document struct {
+		Price decimal.NumberDecimal `json:"price"`
+	}
+	doc := Document{Price: decimal.NumberDecimal(decimal.MustParse("19.99"))}
+	b, _ := json.Marshal(doc)
+	fmt.Println(string(b))
+	// Output: {"price":{"$numberDecimal":"19.99"}}
+}
+
+func ExampleNumberDecimal_UnmarshalJSON() {
+	type Document struct {
+		Price decimal.NumberDecimal `json:"price"`
+	}
+	var doc Document
+	_ = json.Unmarshal([]byte(`{"price":{"$numberDecimal":"19.99"}}`), &doc)
+	fmt.Println(decimal.Decimal(doc.Price))
+	_ = json.Unmarshal([]byte(`{"price":"5.67"}`), &doc)
+	fmt.Println(decimal.Decimal(doc.Price))
+	// Output:
+	// 19.99
+	// 5.67
+}
+
+func ExampleOrderedLevels() {
+	var asks decimal.OrderedLevels[int]
+	asks.Insert(decimal.MustParse("100.25"), 300)
+	asks.Insert(decimal.MustParse("100.50"), 200)
+	asks.Insert(decimal.MustParse("100.5"), 250) // same level as 100.50
+	fmt.Println(asks.Len())
+	fmt.Println(asks.Lowest())
+	fmt.Println(asks.NearestAbove(decimal.MustParse("100.30")))
+	fmt.Println(asks.NearestBelow(decimal.MustParse("100.30")))
+	// Output:
+	// 2
+	// 100.25 300 true
+	// 100.5 250 true
+	// 100.25 300 true
+}
+
+func ExampleSet() {
+	s := decimal.NewSet(
+		decimal.MustParse("1.50"),
+		decimal.MustParse("0.25"),
+		decimal.MustParse("1.5"),
+	)
+	fmt.Println(s.Len(), s.Contains(decimal.MustParse("0.250")))
+	fmt.Println(s.Values())
+	// Output:
+	// 2 true
+	// [0.25 1.5]
+}
+
+func ExampleNewCents() {
+	fmt.Println(decimal.NewCents(12345))
+	fmt.Println(decimal.NewCents(-5))
+	// Output:
+	// 123.45
+	// -0.05
+}
+
+func ExampleNewMicros() {
+	fmt.Println(decimal.NewMicros(1234567))
+	// Output: 1.234567
+}
+
+func ExampleNewNanos() {
+	fmt.Println(decimal.NewNanos(1500000000))
+	// Output: 1.500000000
+}
+
 func ExampleMustNew() {
 	fmt.Println(decimal.MustNew(567, 0))
 	fmt.Println(decimal.MustNew(567, 1))
@@ -187,6 +729,60 @@ func ExampleNewFromFloat64() {
 	// 567 <nil>
 }
 
+func ExampleNewFromPartsString() {
+	fmt.Println(decimal.NewFromPartsString("12340", 3, true))
+	fmt.Println(decimal.NewFromPartsString("123", -2, false))
+	fmt.Println(decimal.NewFromPartsString("123456789012345678901234567890", 25, false))
+	// Output:
+	// -12.340 <nil>
+	// 12300 <nil>
+	// 12345.67890123456789 <nil>
+}
+
+func ExampleDecimal_Parts() {
+	d := decimal.MustParse("-12.340")
+	fmt.Println(d.Parts())
+	// Output: 12340 3 true
+}
+
+func ExampleNewFromParts() {
+	fmt.Println(decimal.NewFromParts(true, 12345, 2))
+	fmt.Println(decimal.NewFromParts(false, 9999999999999999999, 4))
+	// Output:
+	// -123.45 <nil>
+	// 999999999999999.9999 <nil>
+}
+
+func ExampleNewFromQ() {
+	fmt.Println(decimal.NewFromQ(98304, 16)) // Q16.16
+	fmt.Println(decimal.NewFromQ(-6554, 16)) // Q16.16
+	fmt.Println(decimal.NewFromQ(1, 32))     // Q32.32
+	// Output:
+	// 1.5 <nil>
+	// -0.100006103515625 <nil>
+	// 0.0000000002328306437 <nil>
+}
+
+func ExampleNewFromWei() {
+	w, _ := new(big.Int).SetString("1500000000000000000", 10)
+	fmt.Println(decimal.NewFromWei(w))
+	// Output: 1.500000000000000000 <nil>
+}
+
+func ExampleNewFromGwei() {
+	g := big.NewInt(21_000)
+	fmt.Println(decimal.NewFromGwei(g))
+	// Output: 0.000021000 <nil>
+}
+
+func ExampleNewFromSatoshi() {
+	fmt.Println(decimal.NewFromSatoshi(150_000_000))
+	fmt.Println(decimal.NewFromSatoshi(-546))
+	// Output:
+	// 1.50000000
+	// -0.00000546
+}
+
 func ExampleDecimal_Zero() {
 	d := decimal.MustParse("5")
 	e := decimal.MustParse("5.6")
@@ -245,17 +841,279 @@ func ExampleParseExact() {
 	// 5.6700 <nil>
 }
 
+func ExampleValidate() {
+	fmt.Println(decimal.Validate("5.67"))
+	fmt.Println(decimal.Validate("5.6.7"))
+	// Output:
+	// <nil>
+	// parsing decimal: invalid decimal: unexpected character '.'
+}
+
+func ExampleValidateExact() {
+	fmt.Println(decimal.ValidateExact("5.67", 2))
+	fmt.Println(decimal.ValidateExact("5.67", 19))
+	// Output:
+	// <nil>
+	// parsing decimal: decimal overflow: with 19 significant digits after the decimal point, the integer part of a decimal.Decimal can have at most 0 digits, but it has 1 digits
+}
+
+func ExampleParseLimits_Parse() {
+	limits := decimal.ParseLimits{MaxLength: 32, MaxExponent: 20}
+	fmt.Println(limits.Parse("1.23e5"))
+	_, err := limits.Parse("1e999999999")
+	var lerr *decimal.LimitError
+	fmt.Println(errors.As(err, &lerr), lerr.Limit, lerr.Max)
+	// Output:
+	// 123000 <nil>
+	// true exponent 20
+}
+
+func ExampleParseLocale() {
+	fmt.Println(decimal.ParseLocale("1.234,56", "de-DE"))
+	fmt.Println(decimal.ParseLocale("-1 234,56", "fr-FR"))
+	fmt.Println(decimal.ParseLocale("1,234.56", "de-DE"))
+	// Output:
+	// 1234.56 <nil>
+	// -1234.56 <nil>
+	// 0 invalid decimal: unexpected character '.'
+}
+
+func ExampleDecimal_StringLocale() {
+	d := decimal.MustParse("-1234567.89")
+	fmt.Println(d.StringLocale("de-DE"))
+	fmt.Println(d.StringLocale("en-US"))
+	fmt.Println(d.StringLocale("de-CH"))
+	// Output:
+	// -1.234.567,89
+	// -1,234,567.89
+	// -1’234’567.89
+}
+
+func ExampleLocaleOptions() {
+	d := decimal.MustParse("1234.5")
+	opts, _ := decimal.LocaleOptions("it-IT")
+	opts.Scale, opts.FixedScale = 2, true
+	fmt.Println(opts.Format(d))
+	// Output: 1.234,50
+}
+
+func ExampleParseLenient() {
+	fmt.Println(decimal.ParseLenient(" −1.23 "))
+	fmt.Println(decimal.ParseLenient("１２３．４５"))
+	fmt.Println(decimal.ParseLenient("١٢٣٫٤٥"))
+	// Output:
+	// -1.23 <nil>
+	// 123.45 <nil>
+	// 123.45 <nil>
+}
+
+func ExampleParseWith() {
+	fmt.Println(decimal.ParseWith("1.225"))
+	fmt.Println(decimal.ParseWith("1.225", decimal.WithScale(2)))
+	fmt.Println(decimal.ParseWith("1.225", decimal.WithScale(2), decimal.WithRounding(decimal.HalfUp)))
+	fmt.Println(decimal.ParseWith(" −1.225 ", decimal.WithLenient()))
+	// Output:
+	// 1.225 <nil>
+	// 1.22 <nil>
+	// 1.23 <nil>
+	// -1.225 <nil>
+}
+
+func ExampleMarshalJSONWith() {
+	d := decimal.MustParse("1.225")
+	b, _ := decimal.MarshalJSONWith(d)
+	fmt.Println(string(b))
+	b, _ = decimal.MarshalJSONWith(d, decimal.WithUnquoted())
+	fmt.Println(string(b))
+	b, _ = decimal.MarshalJSONWith(d, decimal.WithScale(2), decimal.WithRounding(decimal.HalfUp))
+	fmt.Println(string(b))
+	// Output:
+	// "1.225"
+	// 1.225
+	// "1.23"
+}
+
+func ExampleUnmarshalJSONWith() {
+	fmt.Println(decimal.UnmarshalJSONWith([]byte(`"1.225"`)))
+	fmt.Println(decimal.UnmarshalJSONWith([]byte(`1.225`), decimal.WithUnquoted()))
+	fmt.Println(decimal.UnmarshalJSONWith([]byte(`1.225`)))
+	// Output:
+	// 1.225 <nil>
+	// 1.225 <nil>
+	// 0 unmarshaling decimal: invalid decimal: unexpected JSON value "1.225"
+}
+
+func ExampleRewriteJSON() {
+	src := strings.NewReader(`{"id": 7, "price": 19.990, "lines": [{"price": "+5.0"}]}`)
+	err := decimal.RewriteJSON(os.Stdout, src, "price")
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// {"id":7,"price":"19.990","lines":[{"price":"5.0"}]}
+}
+
+func ExampleScanner() {
+	rates := strings.NewReader("1.0850\n0.8571\n157.3\n")
+	s := decimal.NewScanner(rates)
+	s.EnforceScale(4)
+	for {
+		d, err := s.Next()
+		if err == io.EOF {
+			break
+		}
+		fmt.Println(d, err)
+	}
+	// Output:
+	// 1.0850 <nil>
+	// 0.8571 <nil>
+	// 157.3000 <nil>
+}
+
+func ExampleScanner_Delimiters() {
+	s := decimal.NewScanner(strings.NewReader("1.5;2.25;;3.125;"))
+	s.Delimiters(";")
+	s.EnforceScale(2)
+	for {
+		d, err := s.Next()
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(d)
+	}
+	// Output:
+	// 1.50
+	// 2.25
+	// scanning decimal 3: invalid decimal: 3 significant digits after the decimal point, but at most 2 are allowed
+}
+
+func ExampleFindFirst() {
+	fmt.Println(decimal.FindFirst("Invoice A123: total due 1,234.50 EUR"))
+	fmt.Println(decimal.FindFirst("Rechnung: Betrag (1.234,50) EUR"))
+	fmt.Println(decimal.FindFirst("no amount"))
+	// Output:
+	// 1234.50 24 true
+	// -1234.50 17 true
+	// 0 -1 false
+}
+
+func ExampleExtractAll() {
+	fmt.Println(decimal.ExtractAll("Qty 3 at 12,50 each, total 37,50."))
+	fmt.Println(decimal.ExtractAll("1'234.50 CHF, 1 234,50 EUR, 1,234.50 USD"))
+	// Output:
+	// [3 12.50 37.50]
+	// [1234.50 1234.50 1234.50]
+}
+
+func ExampleParseInt() {
+	fmt.Println(decimal.ParseInt("0x2a", 0))
+	fmt.Println(decimal.ParseInt("-2A", 16))
+	fmt.Println(decimal.ParseInt("0b101010", 0))
+	// Output:
+	// 42 <nil>
+	// -42 <nil>
+	// 42 <nil>
+}
+
+func ExampleSteps() {
+	start := decimal.MustParse("1.20")
+	stop := decimal.MustParse("1.30")
+	step := decimal.MustParse("0.025")
+	seq, err := decimal.Steps(start, stop, step)
+	if err != nil {
+		panic(err)
+	}
+	seq(func(price decimal.Decimal) bool {
+		fmt.Println(price)
+		return true
+	})
+	// Output:
+	// 1.200
+	// 1.225
+	// 1.250
+	// 1.275
+	// 1.300
+}
+
+func ExamplePartitionRange() {
+	lo := decimal.MustParse("0.00")
+	hi := decimal.MustParse("10.00")
+	fmt.Println(decimal.PartitionRange(lo, hi, 3))
+	// Output: [0.00 3.33 6.66 10.00] <nil>
+}
+
+func ExampleParseISO6093() {
+	fmt.Println(decimal.ParseISO6093("  -1234", decimal.NR1))
+	fmt.Println(decimal.ParseISO6093("12,34", decimal.NR2))
+	fmt.Println(decimal.ParseISO6093("1.234E+1", decimal.NR3))
+	// Output:
+	// -1234 <nil>
+	// 12.34 <nil>
+	// 12.34 <nil>
+}
+
+func ExampleDecimal_FormatISO6093() {
+	d := decimal.MustParse("12.340")
+	fmt.Println(d.FormatISO6093(decimal.NR2))
+	fmt.Println(d.FormatISO6093(decimal.NR3))
+	// Output:
+	// 12.340 <nil>
+	// 1.2340E+1 <nil>
+}
+
+func ExampleDecimal128() {
+	supply := decimal.MustParse128("120000000.123456789012345678")
+	price := decimal.MustParse128("3456.78")
+	fmt.Println(supply.Mul(price))
+	fmt.Println(price.Decimal())
+	// Output:
+	// 414813600426.76295912209629279684 <nil>
+	// 3456.78 <nil>
+}
+
 func ExampleMustParse() {
 	fmt.Println(decimal.MustParse("-1.23"))
 	// Output: -1.23
 }
 
+func ExampleConst() {
+	feeRate := decimal.Const("0.0025")
+	fmt.Println(feeRate)
+	// Output: 0.0025
+}
+
+func ExampleConsts() {
+	_ = decimal.Const("0.0025")
+	consts := decimal.Consts()
+	c := consts[len(consts)-1]
+	fmt.Println(c.Value, filepath.Base(c.File))
+	// Output: 0.0025 doc_test.go
+}
+
 func ExampleDecimal_String() {
 	d := decimal.MustParse("1234567890.123456789")
 	fmt.Println(d.String())
 	// Output: 1234567890.123456789
 }
 
+func ExampleDecimal_IntPartString() {
+	d := decimal.MustParse("-1234.567")
+	fmt.Println(d.IntPartString())
+	// Output: 1234
+}
+
+func ExampleDecimal_FracPartString() {
+	d := decimal.MustParse("-1234.567")
+	fmt.Println(d.FracPartString(2))
+	fmt.Println(d.FracPartString(3))
+	fmt.Println(d.FracPartString(5))
+	// Output:
+	// 57
+	// 567
+	// 56700
+}
+
 func unmarshalBytes(b []byte) (decimal.Decimal, error) {
 	var d decimal.Decimal
 	err := d.UnmarshalBinary(b)
@@ -300,6 +1158,86 @@ func ExampleDecimal_Float64() {
 	// 1.2345678901234567e+09 true
 }
 
+func ExampleDecimal_Q() {
+	d := decimal.MustParse("1.5")
+	e := decimal.MustParse("-0.1")
+	fmt.Println(d.Q(16))
+	fmt.Println(e.Q(16))
+	// Output:
+	// 98304 true
+	// -6554 true
+}
+
+func ExampleDecimal_Wei() {
+	d := decimal.MustParse("1.5")
+	fmt.Println(d.Wei())
+	// Output: 1500000000000000000
+}
+
+func ExampleDecimal_Gwei() {
+	d := decimal.MustParse("0.000021")
+	fmt.Println(d.Gwei())
+	// Output: 21000
+}
+
+func ExampleDecimal_Satoshi() {
+	d := decimal.MustParse("1.5")
+	e := decimal.MustParse("0.000000001")
+	fmt.Println(d.Satoshi())
+	fmt.Println(e.Satoshi())
+	// Output:
+	// 150000000 <nil>
+	// 0 converting 0.000000001 to satoshis: invalid operation: fractions of a satoshi are not allowed
+}
+
+func ExampleDecimal_SpannerNumeric() {
+	d := decimal.MustParse("1.2300000000")
+	e := decimal.MustParse("0.0000000001")
+	fmt.Println(d.SpannerNumeric())
+	fmt.Println(e.SpannerNumeric())
+	// Output:
+	// 1.230000000 <nil>
+	//  converting 0.0000000001 to Spanner NUMERIC: invalid operation: more than 9 digits after the decimal point
+}
+
+func ExampleDecimal_SpannerRat() {
+	d := decimal.MustParse("-1.25")
+	fmt.Println(d.SpannerRat())
+	// Output: -5/4 <nil>
+}
+
+func ExampleNewFromSpannerRat() {
+	r := big.NewRat(-5, 4)
+	fmt.Println(decimal.NewFromSpannerRat(r))
+	// Output: -1.25 <nil>
+}
+
+func ExampleDecimal_SortKey() {
+	d := decimal.MustParse("1.5")
+	e := decimal.MustParse("-1.5")
+	fmt.Println(d.SortKey())
+	fmt.Println(e.SortKey())
+	fmt.Println(e.SortKey() < d.SortKey())
+	// Output:
+	// 00000000000000000001.5000000000000000000
+	// -9999999999999999998.4999999999999999999
+	// true
+}
+
+func ExampleParseSortKey() {
+	fmt.Println(decimal.ParseSortKey("00000000000000000001.5000000000000000000"))
+	// Output: 1.5 <nil>
+}
+
+func ExampleDustValidator() {
+	var v decimal.DustValidator // uses DefaultDustThreshold
+	fmt.Println(v.Validate(decimal.MustParse("0.001")))
+	fmt.Println(v.Validate(decimal.MustParse("0.00000500")))
+	// Output:
+	// <nil>
+	// validating 0.00000500: invalid operation: amount is below the dust threshold of 0.00000546
+}
+
 func ExampleDecimal_Int64() {
 	d := decimal.MustParse("5.67")
 	fmt.Println(d.Int64(0))
@@ -412,6 +1350,26 @@ func ExampleDecimal_MarshalText_xml() {
 	// <Entity><Number>567000</Number></Entity> <nil>
 }
 
+func ExampleDecimal_MarshalText_xmlAttr() {
+	type Quote struct {
+		Bid decimal.Decimal `xml:"bid,attr"`
+		Ask decimal.Decimal `xml:"ask,attr"`
+	}
+	q := Quote{
+		Bid: decimal.MustParse("1.2340"),
+		Ask: decimal.MustParse("1.235E0"),
+	}
+	b, err := xml.Marshal(q)
+	fmt.Println(string(b), err)
+	// Output: <Quote bid="1.2340" ask="1.235"></Quote> <nil>
+}
+
+func ExampleDecimal_CompliesWithXSD() {
+	d := decimal.MustParse("5.67e-5")
+	fmt.Println(d, d.CompliesWithXSD())
+	// Output: 0.0000567 true
+}
+
 func ExampleDecimal_Scan() {
 	var d decimal.Decimal
 	_ = d.Scan("5.67")
@@ -434,6 +1392,64 @@ func ExampleDecimal_Format() {
 	// 567%
 }
 
+func ExampleDecimal_Format_scientific() {
+	d := decimal.MustParse("1234567.80")
+	e := decimal.MustParse("0.00001234")
+	fmt.Printf("%e %.2e\n", d, d)
+	fmt.Printf("%g %g %.3g\n", d, e, e)
+	fmt.Printf("%g\n", decimal.MustParse("5.670"))
+	// Output:
+	// 1.23456780e+06 1.23e+06
+	// 1.23456780e+06 1.234e-05 1.23e-05
+	// 5.670
+}
+
+func ExampleDecimal_Format_accounting() {
+	d := decimal.MustParse("-1234.5")
+	e := decimal.MustParse("98765.4321")
+	fmt.Printf("%#12.2f\n", d)
+	fmt.Printf("%#12.2f\n", e)
+	// Output:
+	//   (1,234.50)
+	//    98,765.43
+}
+
+func ExampleFormatOptions_Format() {
+	d := decimal.MustParse("1234.5")
+	opts := decimal.FormatOptions{
+		Width:      13,
+		Scale:      2,
+		FixedScale: true,
+		Plus:       true,
+		ZeroPad:    true,
+	}
+	fmt.Println(opts.Format(d))
+	fmt.Println(opts.Format(d.Neg()))
+	// Output:
+	// +000001234.50
+	// -000001234.50
+}
+
+func ExampleFormatOptions_Format_separators() {
+	d := decimal.MustParse("1234567.5")
+	swiss := decimal.FormatOptions{Scale: 2, FixedScale: true, Grouping: true, GroupSeparator: '\''}
+	si := decimal.FormatOptions{Scale: 2, FixedScale: true, Grouping: true, GroupSeparator: ' ', DecimalSeparator: ','}
+	fmt.Println(swiss.Format(d))
+	fmt.Println(si.Format(d))
+	// Output:
+	// 1'234'567.50
+	// 1 234 567,50
+}
+
+func ExampleFormatOptions_Append() {
+	d := decimal.MustParse("5.67")
+	opts := decimal.FormatOptions{Scale: 3, FixedScale: true}
+	b := []byte("amount=")
+	b = opts.Append(b, d)
+	fmt.Println(string(b))
+	// Output: amount=5.670
+}
+
 func ExampleDecimal_Coef() {
 	d := decimal.MustParse("-123")
 	e := decimal.MustParse("5.7")
@@ -447,6 +1463,38 @@ func ExampleDecimal_Coef() {
 	// 4
 }
 
+func ExampleDecimal_LeadingDigit() {
+	d := decimal.MustParse("-123")
+	e := decimal.MustParse("5.7")
+	f := decimal.MustParse("0.04")
+	fmt.Println(d.LeadingDigit())
+	fmt.Println(e.LeadingDigit())
+	fmt.Println(f.LeadingDigit())
+	// Output:
+	// 1
+	// 5
+	// 4
+}
+
+func ExampleBenfordCounter() {
+	var c decimal.BenfordCounter
+	c.Add(
+		decimal.MustParse("1250.00"),
+		decimal.MustParse("1780.50"),
+		decimal.MustParse("199.99"),
+		decimal.MustParse("2400.00"),
+		decimal.MustParse("310.25"),
+		decimal.MustParse("9999.99"),
+	)
+	fmt.Println(c.Count(1), c.Total())
+	fmt.Println(c.Freq(1))
+	fmt.Println(c.MAD())
+	// Output:
+	// 3 6
+	// 0.5 <nil>
+	// 0.0803571356667504577 <nil>
+}
+
 func ExampleDecimal_Prec() {
 	d := decimal.MustParse("-123")
 	e := decimal.MustParse("5.7")
@@ -467,6 +1515,105 @@ func ExampleDecimal_Mul() {
 	// Output: 17.1 <nil>
 }
 
+func ExampleDecimal_MulInt64() {
+	d := decimal.MustParse("5.7")
+	fmt.Println(d.MulInt64(3))
+	// Output: 17.1 <nil>
+}
+
+func ExampleDecimal_Percent() {
+	d := decimal.MustParse("0.12345")
+	e := decimal.MustParse("2.5")
+	f := decimal.MustParse("9999999999999999999")
+	fmt.Println(d.Percent(2))
+	fmt.Println(d.Percent(1))
+	fmt.Println(e.Percent(2))
+	fmt.Println(f.Percent(2))
+	// Output:
+	// 12.34 <nil>
+	// 12.3 <nil>
+	// 250 <nil>
+	// 0 computing [9999999999999999999 * 100]: decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has 21 digits
+}
+
+func ExampleDecimal_PerMille() {
+	d := decimal.MustParse("0.0125")
+	fmt.Println(d.PerMille(1))
+	fmt.Println(d.PerMille(0))
+	// Output:
+	// 12.5 <nil>
+	// 12 <nil>
+}
+
+func ExampleDecimal_PPM() {
+	d := decimal.MustParse("0.00125")
+	fmt.Println(d.PPM(0))
+	// Output: 1250 <nil>
+}
+
+func ExamplePerAnnumToPerDay() {
+	rate := decimal.MustParse("0.0365")
+	fmt.Println(decimal.PerAnnumToPerDay(rate, decimal.Actual365Fixed))
+	fmt.Println(decimal.PerAnnumToPerDay(rate, decimal.Actual360))
+	// Output:
+	// 0.0001 <nil>
+	// 0.0001013888888888889 <nil>
+}
+
+func ExamplePerDayToPerAnnum() {
+	rate := decimal.MustParse("0.0001")
+	fmt.Println(decimal.PerDayToPerAnnum(rate, decimal.Actual365Fixed))
+	fmt.Println(decimal.PerDayToPerAnnum(rate, decimal.Actual360))
+	// Output:
+	// 0.0365 <nil>
+	// 0.0360 <nil>
+}
+
+func ExampleAccrualSchedule() {
+	principal := decimal.MustParse("1000000.00")
+	rate := decimal.MustParse("0.05")
+	fmt.Println(decimal.AccrualSchedule(principal, rate, 5, decimal.Actual360, 2))
+	// Output: [138.89 138.91 138.92 138.95 138.97] <nil>
+}
+
+func ExampleConvertRate() {
+	rate := decimal.MustParse("0.05")
+	fmt.Println(decimal.ConvertRate(rate, decimal.Actual365Fixed.Year(), time.Hour))
+	fmt.Println(decimal.ConvertRate(rate, time.Hour, time.Minute))
+	// Output:
+	// 0.0000057077625570776 <nil>
+	// 0.0008333333333333333 <nil>
+}
+
+func ExampleDayCount_String() {
+	fmt.Println(decimal.Actual360, decimal.Actual365Fixed, decimal.Thirty360)
+	// Output: ACT/360 ACT/365F 30/360
+}
+
+func ExamplePctChange() {
+	from := decimal.MustParse("3")
+	to := decimal.MustParse("4")
+	fmt.Println(decimal.PctChange(from, to, 2))
+	fmt.Println(decimal.PctChange(to, from, 2))
+	fmt.Println(decimal.PctChange(decimal.Zero, to, 2))
+	// Output:
+	// 33.33 <nil>
+	// -25.00 <nil>
+	// 0 computing [pctchange(0, 4)]: division by zero
+}
+
+func ExampleParseRate() {
+	fmt.Println(decimal.ParseRate("12.5%"))
+	fmt.Println(decimal.ParseRate("125‰"))
+	fmt.Println(decimal.ParseRate("1250ppm"))
+	fmt.Println(decimal.ParseRate("0.125"))
+	// Output:
+	// 0.125 <nil>
+	// 0.125 <nil>
+	// 0.001250 <nil>
+	// 0.125 <nil>
+}
+
 func ExampleDecimal_MulExact() {
 	d := decimal.MustParse("5.7")
 	e := decimal.MustParse("3")
@@ -583,61 +1730,321 @@ func ExampleDecimal_AddQuoExact() {
 	// 2.7500 <nil>
 }
 
-func ExampleDecimal_PowInt() {
-	d := decimal.MustParse("2")
-	fmt.Println(d.PowInt(-2))
-	fmt.Println(d.PowInt(-1))
-	fmt.Println(d.PowInt(0))
-	fmt.Println(d.PowInt(1))
-	fmt.Println(d.PowInt(2))
+func ExampleDecimal_PowDecimal() {
+	d := decimal.MustParse("-2")
+	e := decimal.MustParse("4")
+	fmt.Println(d.PowDecimal(decimal.MustParse("2.00")))
+	fmt.Println(e.PowDecimal(decimal.MustParse("0.5")))
+	fmt.Println(d.PowDecimal(decimal.MustParse("0.5")))
+	// Output:
+	// 4 <nil>
+	// 2 <nil>
+	// 0 computing [-2^0.5]: invalid operation: non-integer power of negative decimal
+}
+
+func ExampleDecimal_PowInt() {
+	d := decimal.MustParse("2")
+	fmt.Println(d.PowInt(-2))
+	fmt.Println(d.PowInt(-1))
+	fmt.Println(d.PowInt(0))
+	fmt.Println(d.PowInt(1))
+	fmt.Println(d.PowInt(2))
+	// Output:
+	// 0.25 <nil>
+	// 0.5 <nil>
+	// 1 <nil>
+	// 2 <nil>
+	// 4 <nil>
+}
+
+func ExampleDecimal_PowIntExact() {
+	d := decimal.MustParse("1.5")
+	fmt.Println(d.PowIntExact(2, 4))
+	fmt.Println(d.PowIntExact(100, 4))
+	// Output:
+	// 2.2500 <nil>
+	// 0 computing [1.5^100]: decimal overflow: with 4 significant digits after the decimal point, the integer part of a decimal.Decimal can have at most 15 digits, but it has 18 digits
+}
+
+func ExampleDecimal_PowCtx() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	d := decimal.MustParse("2")
+	fmt.Println(d.PowCtx(ctx, 10))
+	cancel()
+	fmt.Println(d.PowCtx(ctx, 10))
+	// Output:
+	// 1024 <nil>
+	// 0 computing [2^10]: context canceled
+}
+
+func ExampleEstimateCost() {
+	d := decimal.MustParse("1.000000000000000001")
+	fmt.Println(decimal.EstimateCost(decimal.OpAdd, d, decimal.One))
+	fmt.Println(decimal.EstimateCost(decimal.OpPowInt, d, decimal.MustParse("10")))
+	fmt.Println(decimal.EstimateCost(decimal.OpPowInt, d, decimal.MustParse("1000000")))
+	fmt.Println(decimal.EstimateCost(decimal.OpLog, d, decimal.Zero))
+	// Output:
+	// 1
+	// 240
+	// 1200
+	// 15000
+}
+
+func ExampleDecimal_Sqrt() {
+	d := decimal.MustParse("1")
+	e := decimal.MustParse("2")
+	f := decimal.MustParse("3")
+	g := decimal.MustParse("4")
+	fmt.Println(d.Sqrt())
+	fmt.Println(e.Sqrt())
+	fmt.Println(f.Sqrt())
+	fmt.Println(g.Sqrt())
+	// Output:
+	// 1 <nil>
+	// 1.414213562373095049 <nil>
+	// 1.732050807568877294 <nil>
+	// 2 <nil>
+}
+
+func ExampleDecimal_SqrtExact() {
+	d := decimal.MustParse("2")
+	fmt.Println(d.SqrtExact(4))
+	fmt.Println(d.SqrtExact(19))
+	// Output:
+	// 1.414213562373095049 <nil>
+	// 0 computing sqrt(2): decimal overflow: with 19 significant digits after the decimal point, the integer part of a decimal.Decimal can have at most 0 digits, but it has 1 digits
+}
+
+func ExampleDecimal_Exp() {
+	d := decimal.MustParse("-2.302585092994045684")
+	e := decimal.MustParse("0")
+	f := decimal.MustParse("2.302585092994045684")
+	fmt.Println(d.Exp())
+	fmt.Println(e.Exp())
+	fmt.Println(f.Exp())
+	// Output:
+	// 0.1 <nil>
+	// 1 <nil>
+	// 10 <nil>
+}
+
+func ExampleDecimal_ExpExact() {
+	d := decimal.MustParse("0")
+	e := decimal.MustParse("40")
+	fmt.Println(d.ExpExact(2))
+	fmt.Println(e.ExpExact(2))
+	// Output:
+	// 1.00 <nil>
+	// 0 computing exp(40): decimal overflow: with 2 significant digits after the decimal point, the integer part of a decimal.Decimal can have at most 17 digits, but it has 18 digits
+}
+
+func ExampleDecimal_Log() {
+	d := decimal.MustParse("1")
+	e := decimal.MustParse("2.718281828459045236")
+	f := decimal.MustParse("10")
+	fmt.Println(d.Log())
+	fmt.Println(e.Log())
+	fmt.Println(f.Log())
+	// Output:
+	// 0 <nil>
+	// 1 <nil>
+	// 2.302585092994045684 <nil>
+}
+
+func ExampleDecimal_Sin() {
+	d := decimal.MustParse("0")
+	e := decimal.MustParse("0.5235987755982988731")
+	f := decimal.MustParse("1.570796326794896619")
+	fmt.Println(d.Sin())
+	fmt.Println(e.Sin())
+	fmt.Println(f.Sin())
+	// Output:
+	// 0 <nil>
+	// 0.5 <nil>
+	// 1 <nil>
+}
+
+func ExampleDecimal_Cos() {
+	d := decimal.MustParse("0")
+	e := decimal.MustParse("1")
+	f := decimal.MustParse("3.141592653589793238")
+	fmt.Println(d.Cos())
+	fmt.Println(e.Cos())
+	fmt.Println(f.Cos())
+	// Output:
+	// 1 <nil>
+	// 0.5403023058681397174 <nil>
+	// -1 <nil>
+}
+
+func ExampleDecimal_Tan() {
+	d := decimal.MustParse("-0.7853981633974483096")
+	e := decimal.MustParse("0")
+	f := decimal.MustParse("1")
+	fmt.Println(d.Tan())
+	fmt.Println(e.Tan())
+	fmt.Println(f.Tan())
+	// Output:
+	// -1 <nil>
+	// 0 <nil>
+	// 1.557407724654902231 <nil>
+}
+
+func ExampleDecimal_Asin() {
+	d := decimal.MustParse("-1")
+	e := decimal.MustParse("0.5")
+	f := decimal.MustParse("2")
+	fmt.Println(d.Asin())
+	fmt.Println(e.Asin())
+	fmt.Println(f.Asin())
+	// Output:
+	// -1.570796326794896619 <nil>
+	// 0.5235987755982988731 <nil>
+	// 0 computing asin(2): invalid operation
+}
+
+func ExampleDecimal_Acos() {
+	d := decimal.MustParse("-1")
+	e := decimal.MustParse("0.5")
+	f := decimal.MustParse("1")
+	fmt.Println(d.Acos())
+	fmt.Println(e.Acos())
+	fmt.Println(f.Acos())
+	// Output:
+	// 3.141592653589793238 <nil>
+	// 1.047197551196597746 <nil>
+	// 0 <nil>
+}
+
+func ExampleDecimal_Atan() {
+	d := decimal.MustParse("-1")
+	e := decimal.MustParse("0")
+	f := decimal.MustParse("1000")
+	fmt.Println(d.Atan())
+	fmt.Println(e.Atan())
+	fmt.Println(f.Atan())
+	// Output:
+	// -0.7853981633974483096 <nil>
+	// 0 <nil>
+	// 1.569796327128229753 <nil>
+}
+
+func ExampleDecimal_Atan2() {
+	y := decimal.MustParse("1")
+	x := decimal.MustParse("-1")
+	fmt.Println(y.Atan2(x))
+	fmt.Println(y.Atan2(decimal.Zero))
+	fmt.Println(decimal.Zero.Atan2(x))
+	// Output:
+	// 2.356194490192344929 <nil>
+	// 1.570796326794896619 <nil>
+	// 3.141592653589793238 <nil>
+}
+
+func ExampleDecimal_Sinh() {
+	d := decimal.MustParse("-1")
+	e := decimal.MustParse("0")
+	f := decimal.MustParse("1")
+	fmt.Println(d.Sinh())
+	fmt.Println(e.Sinh())
+	fmt.Println(f.Sinh())
+	// Output:
+	// -1.175201193643801457 <nil>
+	// 0 <nil>
+	// 1.175201193643801457 <nil>
+}
+
+func ExampleDecimal_Cosh() {
+	d := decimal.MustParse("-1")
+	e := decimal.MustParse("0")
+	f := decimal.MustParse("1")
+	fmt.Println(d.Cosh())
+	fmt.Println(e.Cosh())
+	fmt.Println(f.Cosh())
+	// Output:
+	// 1.543080634815243778 <nil>
+	// 1 <nil>
+	// 1.543080634815243778 <nil>
+}
+
+func ExampleDecimal_Tanh() {
+	d := decimal.MustParse("-1")
+	e := decimal.MustParse("0")
+	f := decimal.MustParse("100")
+	fmt.Println(d.Tanh())
+	fmt.Println(e.Tanh())
+	fmt.Println(f.Tanh())
 	// Output:
-	// 0.25 <nil>
-	// 0.5 <nil>
+	// -0.7615941559557648881 <nil>
+	// 0 <nil>
 	// 1 <nil>
-	// 2 <nil>
-	// 4 <nil>
 }
 
-func ExampleDecimal_Sqrt() {
-	d := decimal.MustParse("1")
-	e := decimal.MustParse("2")
-	f := decimal.MustParse("3")
-	g := decimal.MustParse("4")
-	fmt.Println(d.Sqrt())
-	fmt.Println(e.Sqrt())
-	fmt.Println(f.Sqrt())
-	fmt.Println(g.Sqrt())
+func ExampleDecimal_Asinh() {
+	d := decimal.MustParse("-1")
+	e := decimal.MustParse("0")
+	f := decimal.MustParse("1")
+	fmt.Println(d.Asinh())
+	fmt.Println(e.Asinh())
+	fmt.Println(f.Asinh())
 	// Output:
-	// 1 <nil>
-	// 1.414213562373095049 <nil>
-	// 1.732050807568877294 <nil>
-	// 2 <nil>
+	// -0.8813735870195430252 <nil>
+	// 0 <nil>
+	// 0.8813735870195430252 <nil>
 }
 
-func ExampleDecimal_Exp() {
-	d := decimal.MustParse("-2.302585092994045684")
+func ExampleDecimal_Acosh() {
+	d := decimal.MustParse("0")
+	e := decimal.MustParse("1")
+	f := decimal.MustParse("2")
+	fmt.Println(d.Acosh())
+	fmt.Println(e.Acosh())
+	fmt.Println(f.Acosh())
+	// Output:
+	// 0 computing acosh(0): invalid operation
+	// 0 <nil>
+	// 1.316957896924816709 <nil>
+}
+
+func ExampleDecimal_Atanh() {
+	d := decimal.MustParse("-0.5")
 	e := decimal.MustParse("0")
-	f := decimal.MustParse("2.302585092994045684")
-	fmt.Println(d.Exp())
-	fmt.Println(e.Exp())
-	fmt.Println(f.Exp())
+	f := decimal.MustParse("1")
+	fmt.Println(d.Atanh())
+	fmt.Println(e.Atanh())
+	fmt.Println(f.Atanh())
 	// Output:
-	// 0.1 <nil>
-	// 1 <nil>
-	// 10 <nil>
+	// -0.5493061443340548457 <nil>
+	// 0 <nil>
+	// 0 computing atanh(1): invalid operation
 }
 
-func ExampleDecimal_Log() {
+func ExampleDecimal_LogExact() {
 	d := decimal.MustParse("1")
-	e := decimal.MustParse("2.718281828459045236")
-	f := decimal.MustParse("10")
-	fmt.Println(d.Log())
-	fmt.Println(e.Log())
-	fmt.Println(f.Log())
+	fmt.Println(d.LogExact(2))
 	// Output:
-	// 0 <nil>
-	// 1 <nil>
-	// 2.302585092994045684 <nil>
+	// 0.00 <nil>
+}
+
+func ExampleExpSlice() {
+	d := []decimal.Decimal{
+		decimal.MustParse("0"),
+		decimal.MustParse("1"),
+		decimal.MustParse("-2.5"),
+	}
+	fmt.Println(decimal.ExpSlice(d))
+	// Output: [1 2.718281828459045235 0.0820849986238987952] <nil>
+}
+
+func ExampleLogSlice() {
+	d := []decimal.Decimal{
+		decimal.MustParse("1"),
+		decimal.MustParse("2.718281828459045236"),
+		decimal.MustParse("10"),
+	}
+	fmt.Println(decimal.LogSlice(d))
+	// Output: [0 1 2.302585092994045684] <nil>
 }
 
 func ExampleDecimal_Add() {
@@ -647,6 +2054,12 @@ func ExampleDecimal_Add() {
 	// Output: 13.67 <nil>
 }
 
+func ExampleDecimal_AddInt64() {
+	d := decimal.MustParse("5.67")
+	fmt.Println(d.AddInt64(8))
+	// Output: 13.67 <nil>
+}
+
 func ExampleDecimal_AddExact() {
 	d := decimal.MustParse("5.67")
 	e := decimal.MustParse("8")
@@ -670,6 +2083,24 @@ func ExampleDecimal_Sub() {
 	// Output: -13.67 <nil>
 }
 
+func ExampleDecimal_SubInt64() {
+	d := decimal.MustParse("-5.67")
+	fmt.Println(d.SubInt64(8))
+	// Output: -13.67 <nil>
+}
+
+func ExampleDecimal_Delta() {
+	d := decimal.MustParse("-15.67")
+	e := decimal.MustParse("23")
+	fmt.Println(d.Delta(e))
+	fmt.Println(e.Delta(d))
+	fmt.Println(e.Delta(e))
+	// Output:
+	// 38.67 -1 <nil>
+	// 38.67 1 <nil>
+	// 0 0 <nil>
+}
+
 func ExampleDecimal_SubAbs() {
 	d := decimal.MustParse("-5.67")
 	e := decimal.MustParse("8")
@@ -700,6 +2131,12 @@ func ExampleDecimal_Quo() {
 	// Output: 2.835 <nil>
 }
 
+func ExampleDecimal_QuoInt64() {
+	d := decimal.MustParse("5.67")
+	fmt.Println(d.QuoInt64(2))
+	// Output: 2.835 <nil>
+}
+
 func ExampleDecimal_QuoExact() {
 	d := decimal.MustParse("5.66")
 	e := decimal.MustParse("2")
@@ -723,6 +2160,32 @@ func ExampleDecimal_QuoRem() {
 	// Output: 2 1.67 <nil>
 }
 
+func ExampleDecimal_Mod() {
+	d := decimal.MustParse("-5.67")
+	e := decimal.MustParse("2")
+	fmt.Println(d.Mod(e))
+	// Output: -1.67 <nil>
+}
+
+func ExampleDecimal_ModEuclid() {
+	d := decimal.MustParse("-5.67")
+	e := decimal.MustParse("2")
+	fmt.Println(d.ModEuclid(e))
+	// Output: 0.33 <nil>
+}
+
+func ExampleDecimal_Rem() {
+	d := decimal.MustParse("5.67")
+	e := decimal.MustParse("2")
+	f := decimal.MustParse("7")
+	g := decimal.MustParse("4")
+	fmt.Println(d.Rem(e))
+	fmt.Println(f.Rem(g))
+	// Output:
+	// -0.33 <nil>
+	// -1 <nil>
+}
+
 func ExampleDecimal_Inv() {
 	d := decimal.MustParse("2")
 	fmt.Println(d.Inv())
@@ -864,6 +2327,45 @@ func ExampleDecimal_CmpTotal_slices() {
 	// 2 false
 }
 
+func ExampleDecimal_CmpInt64() {
+	d := decimal.MustParse("-5.67")
+	fmt.Println(d.CmpInt64(-6))
+	fmt.Println(d.CmpInt64(-5))
+	// Output:
+	// 1
+	// -1
+}
+
+func ExampleDecimal_CmpUint64() {
+	d := decimal.MustParse("23.00")
+	fmt.Println(d.CmpUint64(23))
+	fmt.Println(d.CmpUint64(24))
+	// Output:
+	// 0
+	// -1
+}
+
+func ExampleDecimal_CmpFloat64() {
+	d := decimal.MustParse("0.1")
+	fmt.Println(d.CmpFloat64(0.1))
+	fmt.Println(d.CmpFloat64(math.Inf(1)))
+	fmt.Println(d.CmpFloat64(math.NaN()))
+	// Output:
+	// 0 true
+	// -1 true
+	// 0 false
+}
+
+func ExampleCmpStrings() {
+	fmt.Println(decimal.CmpStrings("-23", "5.67"))
+	fmt.Println(decimal.CmpStrings("5.670", "5.67"))
+	fmt.Println(decimal.CmpStrings("1e3", "999.9"))
+	// Output:
+	// -1 <nil>
+	// 0 <nil>
+	// 1 <nil>
+}
+
 func ExampleDecimal_Max() {
 	d := decimal.MustParse("23")
 	e := decimal.MustParse("-5.67")
@@ -894,6 +2396,59 @@ func ExampleDecimal_Clamp() {
 	// 20 <nil>
 }
 
+func ExampleDecimal_ClampToRange() {
+	min := decimal.MustParse("-20")
+	max := decimal.MustParse("20")
+	d := decimal.MustParse("-5.67")
+	e := decimal.MustParse("23")
+	fmt.Println(d.ClampToRange(min, max))
+	fmt.Println(e.ClampToRange(min, max))
+	fmt.Println(e.ClampToRange(max, min))
+	// Output:
+	// -5.67
+	// 20
+	// 20
+}
+
+func ExampleDecimal_Clamp01() {
+	d := decimal.MustParse("-0.25")
+	e := decimal.MustParse("0.75")
+	f := decimal.MustParse("1.25")
+	fmt.Println(d.Clamp01())
+	fmt.Println(e.Clamp01())
+	fmt.Println(f.Clamp01())
+	// Output:
+	// 0
+	// 0.75
+	// 1
+}
+
+func ExampleDecimal_SaturateAt() {
+	limit := decimal.MustParse("1000")
+	d := decimal.MustParse("-1500.00")
+	e := decimal.MustParse("999.99")
+	fmt.Println(d.SaturateAt(limit))
+	fmt.Println(e.SaturateAt(limit))
+	// Output:
+	// -1000
+	// 999.99
+}
+
+func ExampleDecimal_FirstExceeding() {
+	limits := []decimal.Decimal{
+		decimal.MustParse("10000"), // hard limit
+		decimal.MustParse("5000"),  // soft limit
+		decimal.MustParse("1000"),  // warning
+	}
+	d := decimal.MustParse("5000.00")
+	e := decimal.MustParse("5000.01")
+	fmt.Println(d.FirstExceeding(limits))
+	fmt.Println(e.FirstExceeding(limits))
+	// Output:
+	// 2
+	// 1
+}
+
 func ExampleDecimal_Rescale() {
 	d := decimal.MustParse("5.678")
 	fmt.Println(d.Rescale(0))
@@ -923,6 +2478,19 @@ func ExampleDecimal_Quantize() {
 	// 5.68
 }
 
+func ExampleDecimal_QuantizeMode() {
+	cent := decimal.MustParse("0.01")
+	d := decimal.MustParse("10.235")
+	e := decimal.MustParse("10.225")
+	fmt.Println(d.QuantizeMode(cent, decimal.HalfEven))
+	fmt.Println(e.QuantizeMode(cent, decimal.HalfEven))
+	fmt.Println(cent.QuantizeMode(cent, decimal.HalfEven))
+	// Output:
+	// 10.24 1
+	// 10.22 -1
+	// 0.01 0
+}
+
 func ExampleDecimal_Pad() {
 	d := decimal.MustParse("5.67")
 	fmt.Println(d.Pad(0))
@@ -998,6 +2566,209 @@ func ExampleDecimal_Floor() {
 	// 5.678
 }
 
+func ExampleParseRoundingMode() {
+	fmt.Println(decimal.ParseRoundingMode("half_up"))
+	fmt.Println(decimal.ParseRoundingMode("nearest"))
+	// Output:
+	// half_up <nil>
+	// half_even parsing rounding mode: invalid operation: unknown mode "nearest"
+}
+
+func ExampleRoundingMode_UnmarshalText() {
+	var cfg struct {
+		Scale int                  `json:"scale"`
+		Mode  decimal.RoundingMode `json:"mode"`
+	}
+	_ = json.Unmarshal([]byte(`{"scale":2,"mode":"half_up"}`), &cfg)
+	d := decimal.MustParse("2.345")
+	fmt.Println(d.RoundMode(cfg.Scale, cfg.Mode))
+	// Output: 2.35
+}
+
+func ExampleRoundingMode_MarshalText() {
+	cfg := struct {
+		Mode decimal.RoundingMode `json:"mode"`
+	}{
+		Mode: decimal.Ceiling,
+	}
+	b, _ := json.Marshal(cfg)
+	fmt.Println(string(b))
+	// Output: {"mode":"ceiling"}
+}
+
+func ExampleDecimal_RoundMode() {
+	d := decimal.MustParse("2.5")
+	fmt.Println(d.RoundMode(0, decimal.HalfEven))
+	fmt.Println(d.RoundMode(0, decimal.HalfUp))
+	fmt.Println(d.RoundMode(0, decimal.HalfDown))
+	fmt.Println(d.RoundMode(0, decimal.Up))
+	fmt.Println(d.RoundMode(0, decimal.Down))
+	fmt.Println(d.RoundMode(0, decimal.Ceiling))
+	fmt.Println(d.RoundMode(0, decimal.Floor))
+	// Output:
+	// 2
+	// 3
+	// 2
+	// 3
+	// 2
+	// 3
+	// 2
+}
+
+func ExampleDecimal_RoundSig() {
+	d := decimal.MustParse("0.012345")
+	e := decimal.MustParse("12345")
+	fmt.Println(d.RoundSig(2))
+	fmt.Println(e.RoundSig(2))
+	// Output:
+	// 0.012 <nil>
+	// 12000 <nil>
+}
+
+func ExampleDecimal_RoundPow10() {
+	d := decimal.MustParse("1234567.89")
+	fmt.Println(d.RoundPow10(3, decimal.HalfUp))
+	fmt.Println(d.RoundPow10(6, decimal.Down))
+	fmt.Println(d.RoundPow10(-1, decimal.HalfUp))
+	// Output:
+	// 1235000 <nil>
+	// 1000000 <nil>
+	// 1234567.9 <nil>
+}
+
+func ExampleDecimal_InUnitsOf() {
+	d := decimal.MustParse("1234567.89")
+	fmt.Println(d.InUnitsOf(3, 1))
+	fmt.Println(d.InUnitsOf(6, 2))
+	// Output:
+	// 1234.6
+	// 1.23
+}
+
+func ExampleDecimal_FromUnitsOf() {
+	d := decimal.MustParse("1234.6")
+	fmt.Println(d.FromUnitsOf(3))
+	// Output:
+	// 1234600 <nil>
+}
+
+func ExampleDecimal_CeilSig() {
+	d := decimal.MustParse("0.012345")
+	e := decimal.MustParse("-12345")
+	fmt.Println(d.CeilSig(2))
+	fmt.Println(e.CeilSig(2))
+	// Output:
+	// 0.013 <nil>
+	// -12000 <nil>
+}
+
+func ExampleDecimal_FloorSig() {
+	d := decimal.MustParse("0.012345")
+	e := decimal.MustParse("-12345")
+	fmt.Println(d.FloorSig(2))
+	fmt.Println(e.FloorSig(2))
+	// Output:
+	// 0.012 <nil>
+	// -13000 <nil>
+}
+
+func ExampleDecimal_RoundStochastic() {
+	d := decimal.MustParse("0.25")
+	rnd := rand.New(rand.NewPCG(1, 2))
+	var up int
+	for range 1000 {
+		if d.RoundStochastic(1, rnd).Equal(decimal.MustParse("0.3")) {
+			up++
+		}
+	}
+	fmt.Println(up)
+	// Output: 487
+}
+
+func ExampleRandBetween() {
+	lo := decimal.MustParse("9.99")
+	hi := decimal.MustParse("19.99")
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for range 3 {
+		fmt.Println(decimal.RandBetween(rnd, lo, hi, 2))
+	}
+	// Output:
+	// 17.69 <nil>
+	// 16.16 <nil>
+	// 17.84 <nil>
+}
+
+func ExampleDecimal_Generate() {
+	commutative := func(d, e decimal.Decimal) bool {
+		f, err1 := d.Add(e)
+		g, err2 := e.Add(d)
+		return f == g && (err1 == nil) == (err2 == nil)
+	}
+	fmt.Println(quick.Check(commutative, nil))
+	// Output: <nil>
+}
+
+func ExampleRandBetweenCrypto() {
+	lo := decimal.MustParse("0.95")
+	hi := decimal.MustParse("1.05")
+	d, err := decimal.RandBetweenCrypto(lo, hi, 2)
+	fmt.Println(d.Cmp(lo) >= 0 && d.Cmp(hi) <= 0, err)
+	// Output: true <nil>
+}
+
+func ExampleCurrencySymbol() {
+	fmt.Println(decimal.CurrencySymbol("EUR"))
+	fmt.Println(decimal.CurrencySymbol("CAD"))
+	fmt.Println(decimal.CurrencySymbol("CHF"))
+	// Output:
+	// € true
+	// CA$ true
+	// CHF true
+}
+
+func ExampleDecimal_FormatCurrency() {
+	d := decimal.MustParse("-1234.567")
+	fmt.Println(d.FormatCurrency("USD", "en-US"))
+	fmt.Println(d.FormatCurrency("JPY", "ja-JP"))
+	fmt.Println(d.FormatCurrency("OMR", "en"))
+	// Output:
+	// -$1,234.57 <nil>
+	// -¥1,235 <nil>
+	// -OMR1,234.567 <nil>
+}
+
+func ExampleCurrencyScale() {
+	fmt.Println(decimal.CurrencyScale("USD"))
+	fmt.Println(decimal.CurrencyScale("JPY"))
+	fmt.Println(decimal.CurrencyScale("KWD"))
+	// Output:
+	// 2 true
+	// 0 true
+	// 3 true
+}
+
+func ExampleDecimal_RoundForCurrency() {
+	d := decimal.MustParse("1234.5678")
+	fmt.Println(d.RoundForCurrency("USD"))
+	fmt.Println(d.RoundForCurrency("JPY"))
+	fmt.Println(d.RoundForCurrency("KWD"))
+	// Output:
+	// 1234.57 <nil>
+	// 1235 <nil>
+	// 1234.568 <nil>
+}
+
+func ExampleDecimal_RoundCash() {
+	d := decimal.MustParse("12.525")
+	fmt.Println(d.RoundCash("CHF"))
+	fmt.Println(d.RoundCash("SEK"))
+	fmt.Println(d.RoundCash("USD"))
+	// Output:
+	// 12.55 <nil>
+	// 13.00 <nil>
+	// 12.53 <nil>
+}
+
 func ExampleDecimal_Scale() {
 	d := decimal.MustParse("23")
 	e := decimal.MustParse("5.67")
@@ -1044,6 +2815,27 @@ func ExampleDecimal_Trim() {
 	// 23.400
 }
 
+func ExampleDecimal_MulPow10() {
+	d := decimal.MustParse("1.5")
+	fmt.Println(d.MulPow10(9))
+	fmt.Println(d.MulPow10(-2))
+	fmt.Println(d.MulPow10(19))
+	// Output:
+	// 1500000000 <nil>
+	// 0.015 <nil>
+	// 0 computing [1.5 * 10^19]: decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has 20 digits
+}
+
+func ExampleDecimal_DivPow10() {
+	d := decimal.MustParse("1500000000")
+	e := decimal.MustParse("1.5")
+	fmt.Println(d.DivPow10(9))
+	fmt.Println(e.DivPow10(19))
+	// Output:
+	// 1.500000000 true
+	// 0.0000000000000000002 false
+}
+
 func ExampleDecimal_Abs() {
 	d := decimal.MustParse("-5.67")
 	fmt.Println(d.Abs())
@@ -1163,6 +2955,32 @@ func ExampleDecimal_IsZero_slices() {
 	// [-5.67 23]
 }
 
+func ExampleDecimal_IsZero_json() {
+	type Object struct {
+		Number decimal.Decimal `json:"number,omitzero"`
+	}
+	a, _ := json.Marshal(Object{Number: decimal.MustParse("5.67")})
+	b, _ := json.Marshal(Object{Number: decimal.MustParse("0.00")})
+	fmt.Println(string(a))
+	fmt.Println(string(b))
+	// Output:
+	// {"number":"5.67"}
+	// {}
+}
+
+func ExampleDecimal_NilIfZero() {
+	type Object struct {
+		Number *decimal.Decimal `json:"number,omitempty"`
+	}
+	a, _ := json.Marshal(Object{Number: decimal.MustParse("5.67").NilIfZero()})
+	b, _ := json.Marshal(Object{Number: decimal.MustParse("0.00").NilIfZero()})
+	fmt.Println(string(a))
+	fmt.Println(string(b))
+	// Output:
+	// {"number":"5.67"}
+	// {}
+}
+
 func ExampleDecimal_IsInt() {
 	d := decimal.MustParse("1.00")
 	e := decimal.MustParse("1.01")
@@ -1270,3 +3088,122 @@ func ExampleNullDecimal_Value() {
 	// 5.67 <nil>
 	// <nil> <nil>
 }
+
+func ExampleNewNullFromPtr() {
+	d := decimal.MustParse("5.67")
+	fmt.Println(decimal.NewNullFromPtr(&d))
+	fmt.Println(decimal.NewNullFromPtr(nil))
+	// Output:
+	// {5.67 true}
+	// {0 false}
+}
+
+func ExampleNullDecimal_MarshalJSON() {
+	type Object struct {
+		Price decimal.NullDecimal `json:"price"`
+		Fee   decimal.NullDecimal `json:"fee"`
+	}
+	obj := Object{Price: decimal.NullDecimal{Decimal: decimal.MustParse("5.67"), Valid: true}}
+	b, err := json.Marshal(obj)
+	fmt.Println(string(b), err)
+	// Output: {"price":"5.67","fee":null} <nil>
+}
+
+func ExampleNullDecimal_UnmarshalJSON() {
+	type Object struct {
+		Price decimal.NullDecimal `json:"price"`
+		Fee   decimal.NullDecimal `json:"fee"`
+	}
+	var obj Object
+	err := json.Unmarshal([]byte(`{"price":"5.67","fee":null}`), &obj)
+	fmt.Println(obj.Price, obj.Fee, err)
+	// Output: {5.67 true} {0 false} <nil>
+}
+
+func ExampleNullDecimal_Ptr() {
+	n := decimal.NullDecimal{Decimal: decimal.MustParse("5.67"), Valid: true}
+	m := decimal.NullDecimal{}
+	fmt.Println(*n.Ptr())
+	fmt.Println(m.Ptr())
+	// Output:
+	// 5.67
+	// <nil>
+}
+
+func ExampleNullDecimal_IsZero() {
+	n := decimal.NullDecimal{Decimal: decimal.Zero, Valid: true}
+	m := decimal.NullDecimal{}
+	fmt.Println(n.IsZero())
+	fmt.Println(m.IsZero())
+	// Output:
+	// false
+	// true
+}
+
+func ExampleNullDecimal_Add() {
+	n := decimal.NullDecimal{Decimal: decimal.MustParse("5.67"), Valid: true}
+	m := decimal.NullDecimal{Decimal: decimal.MustParse("8"), Valid: true}
+	var null decimal.NullDecimal
+	fmt.Println(n.Add(m))
+	fmt.Println(n.Add(null))
+	// Output:
+	// {13.67 true} <nil>
+	// {0 false} <nil>
+}
+
+func ExampleNullDecimal_Sub() {
+	n := decimal.NullDecimal{Decimal: decimal.MustParse("5.67"), Valid: true}
+	m := decimal.NullDecimal{Decimal: decimal.MustParse("8"), Valid: true}
+	var null decimal.NullDecimal
+	fmt.Println(n.Sub(m))
+	fmt.Println(null.Sub(m))
+	// Output:
+	// {-2.33 true} <nil>
+	// {0 false} <nil>
+}
+
+func ExampleNullDecimal_Mul() {
+	n := decimal.NullDecimal{Decimal: decimal.MustParse("5.67"), Valid: true}
+	m := decimal.NullDecimal{Decimal: decimal.MustParse("2"), Valid: true}
+	var null decimal.NullDecimal
+	fmt.Println(n.Mul(m))
+	fmt.Println(n.Mul(null))
+	// Output:
+	// {11.34 true} <nil>
+	// {0 false} <nil>
+}
+
+func ExampleNullDecimal_Quo() {
+	n := decimal.NullDecimal{Decimal: decimal.MustParse("5.67"), Valid: true}
+	m := decimal.NullDecimal{Decimal: decimal.MustParse("2"), Valid: true}
+	zero := decimal.NullDecimal{Decimal: decimal.Zero, Valid: true}
+	var null decimal.NullDecimal
+	fmt.Println(n.Quo(m))
+	fmt.Println(null.Quo(zero))
+	fmt.Println(n.Quo(zero))
+	// Output:
+	// {2.835 true} <nil>
+	// {0 false} <nil>
+	// {0 false} computing [5.67 / 0]: division by zero
+}
+
+func ExampleCoalesce() {
+	n := decimal.NullDecimal{Decimal: decimal.MustParse("5.67"), Valid: true}
+	var null decimal.NullDecimal
+	fmt.Println(decimal.Coalesce(null, n))
+	fmt.Println(decimal.Coalesce(null, null))
+	// Output:
+	// 5.67
+	// 0
+}
+
+func ExampleSumNull() {
+	n := decimal.NullDecimal{Decimal: decimal.MustParse("5.67"), Valid: true}
+	m := decimal.NullDecimal{Decimal: decimal.MustParse("2"), Valid: true}
+	var null decimal.NullDecimal
+	fmt.Println(decimal.SumNull(n, null, m))
+	fmt.Println(decimal.SumNull(null, null))
+	// Output:
+	// {7.67 true} <nil>
+	// {0 false} <nil>
+}
@@ -0,0 +1,138 @@
+package decimal
+
+import (
+	"math/rand/v2"
+)
+
+// Domain centralizes the scale and rounding policy shared by a set of
+// decimals, for example, US dollar amounts with 2 digits after the decimal
+// point rounded half away from zero:
+//
+//	usd := decimal.Domain{Scale: 2, Mode: decimal.HalfUp}
+//
+// Constructors and arithmetic methods of a domain validate their results
+// and rescale them to the scale of the domain using its rounding mode.
+// Arithmetic methods compute the exact result and round it only once,
+// so that the result does not depend on intermediate rounding.
+//
+// The zero value is a domain of integers with half-to-even rounding.
+// Domain is designed to be safe for concurrent use by multiple goroutines,
+// provided that Rand is nil, since [rand.Rand] is not.
+type Domain struct {
+	Scale int          // Scale is the number of digits after the decimal point.
+	Mode  RoundingMode // Mode is the method used to round decimals to the scale.
+	Rand  *rand.Rand   // Rand, if not nil, is the source of random numbers when Mode is Stochastic; otherwise, the global generator is used.
+}
+
+// New returns a decimal equal to coef / 10^scale rescaled to the scale
+// of the domain.
+// See also function [New].
+func (m Domain) New(coef int64, scale int) (Decimal, error) {
+	d, err := New(coef, scale)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return m.Rescale(d)
+}
+
+// NewFromFloat64 converts a float to a decimal rescaled to the scale
+// of the domain.
+// See also function [NewFromFloat64].
+func (m Domain) NewFromFloat64(f float64) (Decimal, error) {
+	d, err := NewFromFloat64(f)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return m.Rescale(d)
+}
+
+// Parse converts a string to a decimal rescaled to the scale of the domain.
+// The exact value of the string is rounded only once.
+// See also function [Parse].
+func (m Domain) Parse(s string) (Decimal, error) {
+	return ParseLimits{}.parseMode(s, m.Scale, m.Mode, m.Rand)
+}
+
+// Rescale returns a decimal rounded or zero-padded to the scale of the domain.
+// Rescale returns an error if:
+//   - the scale of the domain is negative or greater than [MaxScale];
+//   - the integer part of the result has more than ([MaxPrec] - [Domain.Scale]) digits.
+//
+// See also method [Decimal.RoundMode].
+func (m Domain) Rescale(d Decimal) (Decimal, error) {
+	if m.Scale < MinScale || m.Scale > MaxScale {
+		return Decimal{}, errorf("rescaling %v: %w", redact(d), scaleRangeError(m.Scale))
+	}
+	f := d.roundMode(m.Scale, m.Mode, m.Rand)
+	f = f.Pad(m.Scale)
+	if f.Scale() != m.Scale {
+		return Decimal{}, errorf("rescaling %v: %w", redact(d), overflowError(f.Prec(), f.Scale(), m.Scale))
+	}
+	return f, nil
+}
+
+// Contains returns true if the decimal has the same scale as the domain.
+func (m Domain) Contains(d Decimal) bool {
+	return d.Scale() == m.Scale
+}
+
+// Add returns the (possibly rounded) sum of decimals d and e rescaled to
+// the scale of the domain.
+// See also method [Decimal.AddExact].
+func (m Domain) Add(d, e Decimal) (Decimal, error) {
+	return m.round("+", d, e)
+}
+
+// Sub returns the (possibly rounded) difference between decimals d and e
+// rescaled to the scale of the domain.
+// See also method [Decimal.SubExact].
+func (m Domain) Sub(d, e Decimal) (Decimal, error) {
+	return m.round("-", d, e)
+}
+
+// Mul returns the (possibly rounded) product of decimals d and e rescaled to
+// the scale of the domain.
+// See also method [Decimal.MulExact].
+func (m Domain) Mul(d, e Decimal) (Decimal, error) {
+	return m.round("*", d, e)
+}
+
+// Quo returns the (possibly rounded) quotient of decimals d and e rescaled to
+// the scale of the domain.
+// See also method [Decimal.QuoExact].
+func (m Domain) Quo(d, e Decimal) (Decimal, error) {
+	return m.round("/", d, e)
+}
+
+// round computes the exact result of the operation on decimals d and e
+// and rounds it to the scale of the domain.
+func (m Domain) round(op string, d, e Decimal) (Decimal, error) {
+	if m.Scale < MinScale || m.Scale > MaxScale {
+		return Decimal{}, errorf("computing [%v %v %v]: %w", redact(d), op, redact(e), scaleRangeError(m.Scale))
+	}
+	f, err := roundOp(op, d, e, m.Scale, MaxPrec, m.Mode, m.Rand)
+	if err == nil && f.Scale() != m.Scale {
+		err = overflowError(f.Prec(), f.Scale(), m.Scale)
+	}
+	if err != nil {
+		return Decimal{}, errorf("computing [%v %v %v]: %w", redact(d), op, redact(e), err)
+	}
+	return f, nil
+}
+
+// Sum returns the (possibly rounded) sum of decimals rescaled to the scale
+// of the domain.
+// See also function [Sum].
+func (m Domain) Sum(d ...Decimal) (Decimal, error) {
+	if m.Scale < MinScale || m.Scale > MaxScale {
+		return Decimal{}, errorf("computing [sum(%v)]: %w", redact(d), scaleRangeError(m.Scale))
+	}
+	f, err := roundSum(d, m.Scale, MaxPrec, m.Mode, m.Rand)
+	if err == nil && f.Scale() != m.Scale {
+		err = overflowError(f.Prec(), f.Scale(), m.Scale)
+	}
+	if err != nil {
+		return Decimal{}, errorf("computing [sum(%v)]: %w", redact(d), err)
+	}
+	return f, nil
+}
@@ -0,0 +1,344 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestDomain_Rescale(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			scale int
+			mode  RoundingMode
+			d     string
+			want  string
+		}{
+			{0, HalfEven, "2.5", "2"},
+			{0, HalfUp, "2.5", "3"},
+			{2, HalfUp, "1.005", "1.01"},
+			{2, HalfUp, "-1.005", "-1.01"},
+			{2, HalfEven, "1.005", "1.00"},
+			{2, Down, "1.009", "1.00"},
+			{2, Ceiling, "1.001", "1.01"},
+			{2, Floor, "-1.001", "-1.01"},
+			{2, HalfUp, "1", "1.00"},
+			{2, HalfUp, "0", "0.00"},
+			{3, HalfUp, "999999999999999.9999", "1000000000000000.000"},
+			{19, HalfEven, "0.1", "0.1000000000000000000"},
+		}
+		for _, tt := range tests {
+			m := Domain{Scale: tt.scale, Mode: tt.mode}
+			d := MustParse(tt.d)
+			got, err := m.Rescale(d)
+			if err != nil {
+				t.Errorf("%v.Rescale(%q) failed: %v", m, d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%v.Rescale(%q) = %q, want %q", m, d, got, want)
+			}
+			if !m.Contains(got) {
+				t.Errorf("%v.Contains(%q) = false, want true", m, got)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			scale int
+			d     string
+		}{
+			{-1, "1"},
+			{20, "1"},
+			{2, "1000000000000000000"},
+			{3, "99999999999999999.99"},
+			{19, "1"},
+		}
+		for _, tt := range tests {
+			m := Domain{Scale: tt.scale}
+			d := MustParse(tt.d)
+			_, err := m.Rescale(d)
+			if err == nil {
+				t.Errorf("%v.Rescale(%q) did not fail", m, d)
+			}
+		}
+	})
+}
+
+func TestDomain_New(t *testing.T) {
+	m := Domain{Scale: 2, Mode: HalfUp}
+
+	t.Run("success", func(t *testing.T) {
+		got, err := m.New(12345, 3)
+		if err != nil {
+			t.Fatalf("New(12345, 3) failed: %v", err)
+		}
+		if want := MustParse("12.35"); got != want {
+			t.Errorf("New(12345, 3) = %q, want %q", got, want)
+		}
+		got, err = m.NewFromFloat64(0.125)
+		if err != nil {
+			t.Fatalf("NewFromFloat64(0.125) failed: %v", err)
+		}
+		if want := MustParse("0.13"); got != want {
+			t.Errorf("NewFromFloat64(0.125) = %q, want %q", got, want)
+		}
+		got, err = m.Parse("7")
+		if err != nil {
+			t.Fatalf("Parse(\"7\") failed: %v", err)
+		}
+		if want := MustParse("7.00"); got != want {
+			t.Errorf("Parse(\"7\") = %q, want %q", got, want)
+		}
+		got, err = m.Parse("-0.005")
+		if err != nil {
+			t.Fatalf("Parse(\"-0.005\") failed: %v", err)
+		}
+		if want := MustParse("-0.01"); got != want {
+			t.Errorf("Parse(\"-0.005\") = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("single rounding", func(t *testing.T) {
+		tests := []struct {
+			m    Domain
+			s    string
+			want string
+		}{
+			{Domain{Scale: 0, Mode: Up}, "1.00000000000000000001", "2"},
+			{Domain{Scale: 18, Mode: HalfUp}, "0.00000000000000000049", "0.000000000000000000"},
+			{Domain{Scale: 2, Mode: Down}, "0.99999999999999999995", "0.99"},
+			{Domain{Scale: 1, Mode: HalfUp}, "0.44999999999999999999", "0.4"},
+		}
+		for _, tt := range tests {
+			got, err := tt.m.Parse(tt.s)
+			if err != nil {
+				t.Errorf("%+v.Parse(%q) failed: %v", tt.m, tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%+v.Parse(%q) = %q, want %q", tt.m, tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		if _, err := m.New(1, -1); err == nil {
+			t.Errorf("New(1, -1) did not fail")
+		}
+		if _, err := m.New(1_000_000_000_000_000_000, 0); err == nil {
+			t.Errorf("New(1000000000000000000, 0) did not fail")
+		}
+		if _, err := m.NewFromFloat64(1e18); err == nil {
+			t.Errorf("NewFromFloat64(1e18) did not fail")
+		}
+		if _, err := m.Parse("abc"); err == nil {
+			t.Errorf("Parse(\"abc\") did not fail")
+		}
+		if _, err := m.Parse("1000000000000000000"); err == nil {
+			t.Errorf("Parse(\"1000000000000000000\") did not fail")
+		}
+	})
+}
+
+func TestDomain_arithmetic(t *testing.T) {
+	m := Domain{Scale: 2, Mode: HalfUp}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			op   string
+			d, e string
+			want string
+		}{
+			{"+", "1.005", "1.000", "2.01"},
+			{"+", "1", "2", "3.00"},
+			{"-", "1.005", "2", "-1.00"},
+			{"-", "1", "0.005", "1.00"},
+			{"*", "0.25", "0.1", "0.03"},
+			{"*", "-0.25", "0.1", "-0.03"},
+			{"*", "3", "4", "12.00"},
+			{"/", "1", "8", "0.13"},
+			{"/", "2", "3", "0.67"},
+			{"/", "-2", "3", "-0.67"},
+		}
+		for _, tt := range tests {
+			d, e := MustParse(tt.d), MustParse(tt.e)
+			var got Decimal
+			var err error
+			switch tt.op {
+			case "+":
+				got, err = m.Add(d, e)
+			case "-":
+				got, err = m.Sub(d, e)
+			case "*":
+				got, err = m.Mul(d, e)
+			case "/":
+				got, err = m.Quo(d, e)
+			}
+			if err != nil {
+				t.Errorf("[%q %v %q] failed: %v", d, tt.op, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("[%q %v %q] = %q, want %q", d, tt.op, e, got, want)
+			}
+		}
+
+		got, err := m.Sum(MustParse("0.005"), MustParse("0.005"), MustParse("0.005"))
+		if err != nil {
+			t.Fatalf("Sum failed: %v", err)
+		}
+		if want := MustParse("0.02"); got != want {
+			t.Errorf("Sum = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("single rounding", func(t *testing.T) {
+		tests := []struct {
+			m    Domain
+			op   string
+			d, e string
+			want string
+		}{
+			{Domain{Scale: 19, Mode: HalfEven}, "*", "0.1234567890123456789", "0.5", "0.0617283945061728394"},
+			{Domain{Scale: 19, Mode: HalfUp}, "*", "0.1234567890123456789", "0.5", "0.0617283945061728395"},
+			{Domain{Scale: 19, Mode: Up}, "*", "0.1234567890123456789", "0.5", "0.0617283945061728395"},
+			{Domain{Scale: 19, Mode: Up}, "*", "0.1234567890123456789", "0.1", "0.0123456789012345679"},
+			{Domain{Scale: 19, Mode: Down}, "*", "0.1234567890123456789", "0.9", "0.1111111101111111110"},
+			{Domain{Scale: 19, Mode: Floor}, "*", "0.1234567890123456789", "0.5", "0.0617283945061728394"},
+			{Domain{Scale: 19, Mode: Floor}, "*", "-0.1234567890123456789", "0.5", "-0.0617283945061728395"},
+			{Domain{Scale: 19, Mode: Up}, "/", "0.0000000000000000001", "3", "0.0000000000000000001"},
+			{Domain{Scale: 0, Mode: Down}, "+", "999999999999999999.9", "0.05", "999999999999999999"},
+			{Domain{Scale: 0, Mode: Down}, "-", "-999999999999999999.9", "0.05", "-999999999999999999"},
+			{Domain{Scale: 0, Mode: Floor}, "+", "999999999999999999.9", "0.05", "999999999999999999"},
+			{Domain{Scale: 0, Mode: HalfUp}, "+", "999999999999999998.4", "0.05", "999999999999999998"},
+			{Domain{Scale: 1, Mode: Up}, "/", "9999999999999999", "0.3", "33333333333333330.0"},
+		}
+		for _, tt := range tests {
+			d, e := MustParse(tt.d), MustParse(tt.e)
+			var got Decimal
+			var err error
+			switch tt.op {
+			case "+":
+				got, err = tt.m.Add(d, e)
+			case "-":
+				got, err = tt.m.Sub(d, e)
+			case "*":
+				got, err = tt.m.Mul(d, e)
+			case "/":
+				got, err = tt.m.Quo(d, e)
+			}
+			if err != nil {
+				t.Errorf("%v[%q %v %q] failed: %v", tt.m, d, tt.op, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%v[%q %v %q] = %q, want %q", tt.m, d, tt.op, e, got, want)
+			}
+		}
+
+		m := Domain{Scale: 0, Mode: Down}
+		got, err := m.Sum(MustParse("999999999999999999.9"), MustParse("0.05"), MustParse("0.04"))
+		if err != nil {
+			t.Fatalf("Sum failed: %v", err)
+		}
+		if want := MustParse("999999999999999999"); got != want {
+			t.Errorf("Sum = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		max := MustParse("99999999999999999.99")
+		if _, err := m.Add(max, One); err == nil {
+			t.Errorf("Add did not fail")
+		}
+		if _, err := m.Sub(max.Neg(), One); err == nil {
+			t.Errorf("Sub did not fail")
+		}
+		if _, err := m.Mul(max, Two); err == nil {
+			t.Errorf("Mul did not fail")
+		}
+		if _, err := m.Quo(One, Zero); err == nil {
+			t.Errorf("Quo did not fail")
+		}
+		if _, err := m.Sum(max, One); err == nil {
+			t.Errorf("Sum did not fail")
+		}
+		bad := Domain{Scale: 20}
+		if _, err := bad.Add(One, One); err == nil {
+			t.Errorf("Add with invalid scale did not fail")
+		}
+	})
+}
+
+func TestDomain_Stochastic(t *testing.T) {
+	ops := map[string]func(m Domain, d, e Decimal) (Decimal, error){
+		"Rescale": func(m Domain, d, _ Decimal) (Decimal, error) { return m.Rescale(d) },
+		"+":       Domain.Add,
+		"*":       Domain.Mul,
+		"/":       Domain.Quo,
+		"sum":     func(m Domain, d, e Decimal) (Decimal, error) { return m.Sum(d, e) },
+	}
+	tests := []struct {
+		scale    int
+		op       string
+		d, e     string
+		down, up string
+		wantProb float64
+	}{
+		{1, "Rescale", "0.25", "0", "0.2", "0.3", 0.5},
+		{0, "+", "-0.37", "0", "0", "-1", 0.37},
+		{1, "/", "1", "4", "0.2", "0.3", 0.5},
+		{0, "/", "0.9999999999999999999", "7", "0", "1", 0.142857},
+		{18, "*", "0.9999999999999999999", "0.3333333333333333333", "0.333333333333333333", "0.333333333333333334", 0.266667},
+		{0, "sum", "9999999999999999998", "0.9", "9999999999999999998", "9999999999999999999", 0.9},
+	}
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for _, tt := range tests {
+		m := Domain{Scale: tt.scale, Mode: Stochastic, Rand: rnd}
+		d, e := MustParse(tt.d), MustParse(tt.e)
+		down, up := MustParse(tt.down), MustParse(tt.up)
+		const n = 10_000
+		count := 0
+		for range n {
+			got, err := ops[tt.op](m, d, e)
+			if err != nil {
+				t.Fatalf("%v.%v(%q, %q) failed: %v", m, tt.op, d, e, err)
+			}
+			switch got {
+			case up:
+				count++
+			case down:
+			default:
+				t.Fatalf("%v.%v(%q, %q) = %q, want %q or %q", m, tt.op, d, e, got, down, up)
+			}
+		}
+		if got := float64(count) / n; math.Abs(got-tt.wantProb) > 0.02 {
+			t.Errorf("%v.%v(%q, %q) rounded away from zero with probability %v, want %v", m, tt.op, d, e, got, tt.wantProb)
+		}
+	}
+
+	t.Run("deterministic", func(t *testing.T) {
+		x := Domain{Scale: 2, Mode: Stochastic, Rand: rand.New(rand.NewPCG(42, 42))}
+		y := Domain{Scale: 2, Mode: Stochastic, Rand: rand.New(rand.NewPCG(42, 42))}
+		d, e := MustParse("1"), MustParse("3")
+		for range 100 {
+			got, _ := x.Quo(d, e)
+			want, _ := y.Quo(d, e)
+			if got != want {
+				t.Errorf("%v.Quo(%q, %q) = %q, want %q", x, d, e, got, want)
+			}
+			got, _ = x.Parse("0.3333333333333333333333")
+			want, _ = y.Parse("0.3333333333333333333333")
+			if got != want {
+				t.Errorf("%v.Parse(%q) = %q, want %q", x, "0.3333333333333333333333", got, want)
+			}
+		}
+	})
+}
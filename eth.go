@@ -0,0 +1,101 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"math/big"
+)
+
+const (
+	weiScale  = 18 // weiScale is a number of wei digits after the decimal point of an ether amount.
+	gweiScale = 9  // gweiScale is a number of gwei digits after the decimal point of an ether amount.
+)
+
+// NewFromWei converts an amount in wei to a decimal amount in ether,
+// where 1 ether is equal to 10^18 wei.
+// The result has 18 digits after the decimal point, unless the amount is
+// too large, in which case trailing zeros are removed from the fractional part.
+// See also method [Decimal.Wei].
+//
+// NewFromWei returns an error if:
+//   - the amount is nil;
+//   - the amount has more than [MaxPrec] significant digits.
+func NewFromWei(w *big.Int) (Decimal, error) {
+	d, err := newFromBigInt(w, weiScale)
+	if err != nil {
+		return Decimal{}, errorf("converting wei: %w", err)
+	}
+	return d, nil
+}
+
+// NewFromGwei converts an amount in gwei to a decimal amount in ether,
+// where 1 ether is equal to 10^9 gwei.
+// The result has 9 digits after the decimal point, unless the amount is
+// too large, in which case trailing zeros are removed from the fractional part.
+// See also method [Decimal.Gwei].
+//
+// NewFromGwei returns an error if:
+//   - the amount is nil;
+//   - the amount has more than [MaxPrec] significant digits.
+func NewFromGwei(g *big.Int) (Decimal, error) {
+	d, err := newFromBigInt(g, gweiScale)
+	if err != nil {
+		return Decimal{}, errorf("converting gwei: %w", err)
+	}
+	return d, nil
+}
+
+// newFromBigInt returns a decimal equal to b / 10^scale.
+// It removes trailing zeros from the fractional part if the coefficient
+// does not fit into [MaxPrec] digits.
+func newFromBigInt(b *big.Int, scale int) (Decimal, error) {
+	if b == nil {
+		return Decimal{}, errorf("%w: nil amount", errInvalidOperation)
+	}
+	coef := (*bint)(new(big.Int).Abs(b))
+	rem := getBint()
+	defer putBint(rem)
+	for scale > 0 && coef.hasPrec(MaxPrec+1) {
+		coef.quoRem(coef, bpow10[1], rem)
+		if rem.sign() != 0 {
+			return Decimal{}, errorf("%w: the amount has more than %v significant digits", errDecimalOverflow, MaxPrec)
+		}
+		scale--
+	}
+	if coef.hasPrec(MaxPrec + 1) {
+		return Decimal{}, overflowError(coef.prec(), scale, 0)
+	}
+	return newSafe(b.Sign() < 0, coef.fint(), scale)
+}
+
+// Wei returns the decimal amount in ether converted to wei,
+// where 1 ether is equal to 10^18 wei.
+// Fractions of wei are rounded using [rounding half to even] (banker's rounding).
+// See also constructor [NewFromWei].
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal) Wei() *big.Int {
+	return d.bigInt(weiScale)
+}
+
+// Gwei returns the decimal amount in ether converted to gwei,
+// where 1 ether is equal to 10^9 gwei.
+// Fractions of gwei are rounded using [rounding half to even] (banker's rounding).
+// See also constructor [NewFromGwei].
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal) Gwei() *big.Int {
+	return d.bigInt(gweiScale)
+}
+
+// bigInt returns the decimal multiplied by 10^scale and rounded to an integer.
+func (d Decimal) bigInt(scale int) *big.Int {
+	d = d.Round(scale)
+	z := new(bint)
+	z.setFint(d.coef)
+	z.mul(z, bpow10[scale-d.Scale()])
+	if d.IsNeg() {
+		return (*big.Int)(z).Neg((*big.Int)(z))
+	}
+	return (*big.Int)(z)
+}
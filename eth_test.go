@@ -0,0 +1,129 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNewFromWei(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			w    string
+			want string
+		}{
+			{"0", "0.000000000000000000"},
+			{"1", "0.000000000000000001"},
+			{"-1", "-0.000000000000000001"},
+			{"1000000000000000000", "1.000000000000000000"},
+			{"1500000000000000000", "1.500000000000000000"},
+			{"9999999999999999999", "9.999999999999999999"},
+			{"10000000000000000000", "10.00000000000000000"},
+			{"-12345678912345678900", "-12.34567891234567890"},
+			{"123456789000000000000000000", "123456789.0000000000"},
+			{"9999999999999999999000000000000000000", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			w, _ := new(big.Int).SetString(tt.w, 10)
+			got, err := NewFromWei(w)
+			if err != nil {
+				t.Errorf("NewFromWei(%v) failed: %v", w, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("NewFromWei(%v) = %q, want %q", w, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"10000000000000000001",
+			"-10000000000000000001",
+			"10000000000000000000000000000000000000",
+		}
+		for _, tt := range tests {
+			w, _ := new(big.Int).SetString(tt, 10)
+			_, err := NewFromWei(w)
+			if err == nil {
+				t.Errorf("NewFromWei(%v) did not fail", w)
+			}
+		}
+		_, err := NewFromWei(nil)
+		if err == nil {
+			t.Errorf("NewFromWei(nil) did not fail")
+		}
+	})
+}
+
+func TestNewFromGwei(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			g    string
+			want string
+		}{
+			{"0", "0.000000000"},
+			{"1", "0.000000001"},
+			{"-21000", "-0.000021000"},
+			{"1000000000", "1.000000000"},
+			{"9999999999999999999", "9999999999.999999999"},
+			{"99999999999999999990", "99999999999.99999999"},
+		}
+		for _, tt := range tests {
+			g, _ := new(big.Int).SetString(tt.g, 10)
+			got, err := NewFromGwei(g)
+			if err != nil {
+				t.Errorf("NewFromGwei(%v) failed: %v", g, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("NewFromGwei(%v) = %q, want %q", g, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"99999999999999999991",
+			"10000000000000000000000000000",
+		}
+		for _, tt := range tests {
+			g, _ := new(big.Int).SetString(tt, 10)
+			_, err := NewFromGwei(g)
+			if err == nil {
+				t.Errorf("NewFromGwei(%v) did not fail", g)
+			}
+		}
+	})
+}
+
+func TestDecimal_Wei(t *testing.T) {
+	tests := []struct {
+		d        string
+		wantWei  string
+		wantGwei string
+	}{
+		{"0", "0", "0"},
+		{"1", "1000000000000000000", "1000000000"},
+		{"-1", "-1000000000000000000", "-1000000000"},
+		{"0.000000000000000001", "1", "0"},
+		{"0.0000000015", "1500000000", "2"},
+		{"0.0000000025", "2500000000", "2"},
+		{"-0.0000000025", "-2500000000", "-2"},
+		{"0.0000000000000000005", "0", "0"},
+		{"0.0000000000000000015", "2", "0"},
+		{"9999999999999999999", "9999999999999999999000000000000000000", "9999999999999999999000000000"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		if got := d.Wei(); got.String() != tt.wantWei {
+			t.Errorf("%q.Wei() = %v, want %v", d, got, tt.wantWei)
+		}
+		if got := d.Gwei(); got.String() != tt.wantGwei {
+			t.Errorf("%q.Gwei() = %v, want %v", d, got, tt.wantGwei)
+		}
+	}
+}
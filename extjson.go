@@ -0,0 +1,95 @@
+package decimal
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// NumberDecimal is a decimal that is marshaled in the MongoDB Extended JSON v2
+// format, for example, {"$numberDecimal":"1.23"}.
+// This format is used by mongoexport and by Atlas triggers for values of
+// the BSON Decimal128 type.
+// Use type conversions to switch between NumberDecimal and [Decimal]:
+//
+//	type Document struct {
+//	  Price decimal.NumberDecimal `json:"price"`
+//	}
+//
+//	price := decimal.Decimal(doc.Price)
+//	doc.Price = decimal.NumberDecimal(price)
+//
+// Decimal128 values have up to 34 significant digits, which is more than
+// [MaxPrec]. Such values are rounded when unmarshaled, as described in [Parse].
+type NumberDecimal Decimal
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The decimal is marshaled as a JSON object with a single "$numberDecimal"
+// key, for example, {"$numberDecimal":"1.23"}.
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (n NumberDecimal) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"$numberDecimal":"`)
+	buf.WriteString(Decimal(n).String())
+	buf.WriteString(`"}`)
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// Both the Extended JSON form, such as {"$numberDecimal":"1.23"},
+// and the quoted string form, such as "1.23", are accepted,
+// so documents can be migrated from one form to the other gradually.
+// The value of the "$numberDecimal" key must be a valid decimal,
+// as described in [Parse]; special values, such as "NaN" and "Infinity",
+// are rejected.
+// As with [Decimal], a JSON null leaves the decimal unchanged.
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (n *NumberDecimal) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok := tok.(type) {
+	case nil:
+		return nil
+	case string:
+		return n.parse(tok)
+	case json.Delim:
+		if tok == '{' {
+			break
+		}
+		return errorf("unmarshaling %T: expected JSON object or string, got %v", n, redact(tok))
+	default:
+		return errorf("unmarshaling %T: expected JSON object or string, got %v", n, redact(tok))
+	}
+	tok, err = dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != "$numberDecimal" {
+		return errorf("unmarshaling %T: expected \"$numberDecimal\" key, got %q", n, redact(tok))
+	}
+	tok, err = dec.Token()
+	if err != nil {
+		return err
+	}
+	s, ok := tok.(string)
+	if !ok {
+		return errorf("unmarshaling %T: expected string value, got %v", n, redact(tok))
+	}
+	if dec.More() {
+		return errorf("unmarshaling %T: unexpected keys after \"$numberDecimal\"", n)
+	}
+	return n.parse(s)
+}
+
+func (n *NumberDecimal) parse(s string) error {
+	d, err := Parse(s)
+	if err != nil {
+		return errorf("unmarshaling %T: %w", n, err)
+	}
+	*n = NumberDecimal(d)
+	return nil
+}
@@ -0,0 +1,97 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNumberDecimal_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		d    string
+		want string
+	}{
+		{"0", `{"$numberDecimal":"0"}`},
+		{"1.23", `{"$numberDecimal":"1.23"}`},
+		{"-1.230", `{"$numberDecimal":"-1.230"}`},
+		{"9999999999999999999", `{"$numberDecimal":"9999999999999999999"}`},
+	}
+	for _, tt := range tests {
+		n := NumberDecimal(MustParse(tt.d))
+		got, err := json.Marshal(n)
+		if err != nil {
+			t.Errorf("json.Marshal(%q) failed: %v", tt.d, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("json.Marshal(%q) = %s, want %s", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestNumberDecimal_UnmarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want string
+		}{
+			{`{"$numberDecimal":"1.23"}`, "1.23"},
+			{`{ "$numberDecimal" : "-0.0010" }`, "-0.0010"},
+			{`"1.23"`, "1.23"},
+			{`null`, "5.67"},
+		}
+		for _, tt := range tests {
+			n := NumberDecimal(MustParse("5.67"))
+			err := json.Unmarshal([]byte(tt.s), &n)
+			if err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", tt.s, err)
+				continue
+			}
+			got := Decimal(n)
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("json.Unmarshal(%s) = %q, want %q", tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			`1.23`,
+			`true`,
+			`[]`,
+			`{}`,
+			`{"$numberDouble":"1.23"}`,
+			`{"$numberDecimal":1.23}`,
+			`{"$numberDecimal":"NaN"}`,
+			`{"$numberDecimal":"Infinity"}`,
+			`{"$numberDecimal":"1.23","$numberDouble":"1.23"}`,
+			`"abc"`,
+		}
+		for _, tt := range tests {
+			var n NumberDecimal
+			err := json.Unmarshal([]byte(tt), &n)
+			if err == nil {
+				t.Errorf("json.Unmarshal(%s) did not fail", tt)
+			}
+		}
+	})
+
+	t.Run("redaction", func(t *testing.T) {
+		tests := map[string]string{
+			`1.23`:                    "unmarshaling *decimal.NumberDecimal: expected JSON object or string, got x.xx",
+			`{"$numberDecimal":1.23}`: "unmarshaling *decimal.NumberDecimal: expected string value, got x.xx",
+			`{"1.23":"1.23"}`:         `unmarshaling *decimal.NumberDecimal: expected "$numberDecimal" key, got "x.xx"`,
+		}
+		for tt, want := range tests {
+			var n NumberDecimal
+			err := RedactError(n.UnmarshalJSON([]byte(tt)))
+			if err == nil {
+				t.Errorf("UnmarshalJSON(%s) did not fail", tt)
+				continue
+			}
+			if got := err.Error(); got != want {
+				t.Errorf("UnmarshalJSON(%s) error = %q, want %q", tt, got, want)
+			}
+		}
+	})
+}
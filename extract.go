@@ -0,0 +1,203 @@
+package decimal
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FindFirst scans free-form text, such as emails or OCR output, and returns
+// the first decimal found in it along with its byte position in the text.
+// If no decimal is found, FindFirst returns false.
+// See function [ExtractAll] for the description of the recognized formats.
+func FindFirst(s string) (d Decimal, pos int, ok bool) {
+	d, pos, _, ok = findDecimal(s, 0)
+	if !ok {
+		return Decimal{}, -1, false
+	}
+	return d, pos, true
+}
+
+// ExtractAll scans free-form text, such as emails or OCR output, and returns
+// all decimals found in it in the order of appearance.
+//
+// ExtractAll uses the following heuristics to recognize numbers written
+// in different locales:
+//
+//   - Digits may be separated into groups of 3 by commas, dots, apostrophes,
+//     spaces, or non-breaking spaces, for example, "1,234,567", "1.234.567",
+//     "1'234'567", or "1 234 567".
+//   - If both a comma and a dot are present, the last one is the decimal
+//     separator, for example, "1,234.56" or "1.234,56".
+//   - A single dot is always a decimal separator, for example, "1.234".
+//   - A single comma is a decimal separator, unless it separates groups of
+//     digits, for example, "12,5" is 12.5, but "1,234" is 1234.
+//   - A number may be preceded by a sign ('+', '-', or '−') or enclosed
+//     in parentheses, which denote a negative amount in accounting.
+//   - Digits immediately preceded by a letter, as in "A123", are considered
+//     part of an identifier and are skipped.
+//   - Numbers that cannot be represented as decimals are skipped.
+//
+// These heuristics cannot resolve all ambiguities, so the result should be
+// reviewed if the locale of the text is unknown.
+func ExtractAll(s string) []Decimal {
+	var res []Decimal
+	for pos := 0; pos < len(s); {
+		d, _, end, ok := findDecimal(s, pos)
+		if !ok {
+			break
+		}
+		res = append(res, d)
+		pos = end
+	}
+	return res
+}
+
+// findDecimal returns the first decimal in s[pos:] along with its start and
+// end positions in s.
+func findDecimal(s string, pos int) (d Decimal, start, end int, ok bool) {
+	for pos < len(s) {
+		// Searching for the first digit of a number
+		if !isDigit(s[pos]) {
+			pos++
+			continue
+		}
+		// Skipping identifiers
+		if r, _ := utf8.DecodeLastRuneInString(s[:pos]); unicode.IsLetter(r) {
+			for pos < len(s) && isDigit(s[pos]) {
+				pos++
+			}
+			continue
+		}
+		var num string
+		var neg bool
+		var err error
+		num, end = scanNumber(s, pos)
+		start, neg = scanSign(s, pos)
+		// Accounting parentheses
+		if start > 0 && s[start-1] == '(' && end < len(s) && s[end] == ')' {
+			start, end = start-1, end+1
+			neg = true
+		}
+		if neg {
+			num = "-" + num
+		}
+		d, err = Parse(num)
+		if err != nil {
+			pos = end
+			continue
+		}
+		return d, start, end, true
+	}
+	return Decimal{}, -1, -1, false
+}
+
+// scanSign returns the position of the sign immediately preceding s[pos]
+// and whether the sign is negative.
+func scanSign(s string, pos int) (start int, neg bool) {
+	r, size := utf8.DecodeLastRuneInString(s[:pos])
+	switch r {
+	case '-', '−':
+		neg = true
+	case '+':
+	default:
+		return pos, false
+	}
+	// The sign must not be preceded by a letter or a digit, as in "A-1" or "2024-01".
+	if p, _ := utf8.DecodeLastRuneInString(s[:pos-size]); unicode.IsLetter(p) || unicode.IsDigit(p) {
+		return pos, false
+	}
+	return pos - size, neg
+}
+
+// scanNumber scans the digits and separators starting at s[pos] and returns
+// the number in the format accepted by [Parse] and the position of its end.
+func scanNumber(s string, pos int) (num string, end int) {
+	// Splitting the number into groups of digits and separators
+	var groups []string
+	var seps []rune
+	i := pos
+	for {
+		j := i
+		for j < len(s) && isDigit(s[j]) {
+			j++
+		}
+		groups = append(groups, s[i:j])
+		sep, size := utf8.DecodeRuneInString(s[j:])
+		if !isSeparator(sep) || j+size >= len(s) || !isDigit(s[j+size]) {
+			break
+		}
+		seps = append(seps, sep)
+		i = j + size
+	}
+
+	// Truncating the number at the first invalid group
+	for {
+		dpoint := decimalSeparator(groups, seps)
+		n := len(seps)
+		if dpoint >= 0 {
+			n = dpoint
+		}
+		valid := true
+		for k := range n {
+			if len(groups[k+1]) != 3 || (k == 0 && len(groups[0]) > 3) || seps[k] != seps[0] {
+				valid = false
+				groups, seps = groups[:k+1], seps[:k]
+				break
+			}
+		}
+		if valid {
+			var b strings.Builder
+			for k, g := range groups {
+				if k == dpoint+1 && dpoint >= 0 {
+					b.WriteByte('.')
+				}
+				b.WriteString(g)
+			}
+			// Recalculating the end position
+			end = pos
+			for k, g := range groups {
+				end += len(g)
+				if k < len(seps) {
+					end += utf8.RuneLen(seps[k])
+				}
+			}
+			return b.String(), end
+		}
+	}
+}
+
+// decimalSeparator returns the index of the decimal separator in seps
+// or -1 if the number has no fractional part.
+func decimalSeparator(groups []string, seps []rune) int {
+	if len(seps) == 0 {
+		return -1
+	}
+	last := len(seps) - 1
+	switch seps[last] {
+	case '.', ',':
+	default:
+		return -1
+	}
+	for k := range last {
+		if seps[k] == seps[last] {
+			return -1 // repeated separator is used for grouping
+		}
+	}
+	if last == 0 && seps[last] == ',' && len(groups[0]) <= 3 && len(groups[1]) == 3 {
+		return -1 // single comma followed by 3 digits is used for grouping
+	}
+	return last
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isSeparator(r rune) bool {
+	switch r {
+	case '.', ',', '\'', ' ', '\u00a0', '\u202f':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package decimal
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFindFirst(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    string
+		wantPos int
+		wantOk  bool
+	}{
+		{"", "0", -1, false},
+		{"no numbers here", "0", -1, false},
+		{"invoice A123 is paid", "0", -1, false},
+		{"total: 12.50 EUR", "12.50", 7, true},
+		{"total: -12.50 EUR", "-12.50", 7, true},
+		{"total: −12.50 EUR", "-12.50", 7, true},
+		{"total: +12.50 EUR", "12.50", 7, true},
+		{"total: (1,234.50)", "-1234.50", 7, true},
+		{"$1,234,567.89 due", "1234567.89", 1, true},
+		{"Betrag 1.234,56 €", "1234.56", 7, true},
+		{"montant 1 234,56 €", "1234.56", 8, true},
+		{"Betrag 1'234.50 CHF", "1234.50", 7, true},
+		{"paid 2024-01-15", "2024", 5, true},
+		{"id A1 amount 7", "7", 13, true},
+		{"price 12.", "12", 6, true},
+		{"99999999999999999999 and 5", "5", 25, true},
+	}
+	for _, tt := range tests {
+		got, pos, ok := FindFirst(tt.s)
+		want := MustParse(tt.want)
+		if got != want || pos != tt.wantPos || ok != tt.wantOk {
+			t.Errorf("FindFirst(%q) = %q, %v, %v, want %q, %v, %v", tt.s, got, pos, ok, want, tt.wantPos, tt.wantOk)
+		}
+	}
+}
+
+func TestExtractAll(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []string
+	}{
+		{"", nil},
+		{"no numbers", nil},
+		{"1, 2, 3", []string{"1", "2", "3"}},
+		{"1 2 3", []string{"1", "2", "3"}},
+		{"12,5 and 1,234", []string{"12.5", "1234"}},
+		{"1234,567", []string{"1234.567"}},
+		{"1.234", []string{"1.234"}},
+		{"1.234.567", []string{"1234567"}},
+		{"1,234,56", []string{"1234", "56"}},
+		{"1 234 567,89", []string{"1234567.89"}},
+		{"1 234,5", []string{"1234.5"}},
+		{"from 10.5 to 20.25.", []string{"10.5", "20.25"}},
+		{"(5) and (6.5)", []string{"-5", "-6.5"}},
+		{"5-3", []string{"5", "3"}},
+		{"x-3 y -3", []string{"3", "-3"}},
+	}
+	for _, tt := range tests {
+		got := ExtractAll(tt.s)
+		var want []Decimal
+		for _, w := range tt.want {
+			want = append(want, MustParse(w))
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("ExtractAll(%q) = %v, want %v", tt.s, got, want)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+//go:build !decimalnobig
+
+package finance_test
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/finance"
+)
+
+func ExampleFutureValue() {
+	pv := decimal.MustParse("1000")
+	rate := decimal.MustParse("0.05")
+	fv, err := finance.FutureValue(pv, rate, 10)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(fv)
+	fmt.Println(fv.Round(2))
+	// Output:
+	// 1628.894626777441406
+	// 1628.89
+}
+
+func ExamplePresentValue() {
+	fv := decimal.MustParse("1000")
+	rate := decimal.MustParse("0.07")
+	fmt.Println(finance.PresentValue(fv, rate, 30))
+	// Output: 131.3671171545898306 <nil>
+}
+
+func ExamplePayment() {
+	// Monthly payment of a 30-year mortgage of 200,000 at 6% per year
+	pv := decimal.MustParse("200000")
+	rate := decimal.MustParse("0.005")
+	pmt, err := finance.Payment(pv, rate, 360)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(pmt)
+	fmt.Println(pmt.RoundForCurrency("USD"))
+	// Output:
+	// 1199.101050305504789
+	// 1199.10 <nil>
+}
+
+func ExampleRate() {
+	pv := decimal.MustParse("100")
+	fv := decimal.MustParse("121")
+	fmt.Println(finance.Rate(pv, fv, 2))
+	// Output: 0.1 <nil>
+}
+
+func ExampleEffectiveRate() {
+	nominal := decimal.MustParse("0.12")
+	fmt.Println(finance.EffectiveRate(nominal, 12))
+	fmt.Println(finance.EffectiveRate(nominal, 1))
+	// Output:
+	// 0.126825030131969721 <nil>
+	// 0.12 <nil>
+}
@@ -0,0 +1,287 @@
+// Package finance implements compound interest and annuity formulas
+// on top of [decimal.Decimal], such as the future value of an investment
+// and the payment that amortizes a loan.
+//
+// # Rounding
+//
+// The formulas are evaluated using the methods of [decimal.Decimal], so every
+// intermediate result is rounded to [decimal.MaxPrec] digits using
+// half-to-even rounding.
+// The documentation of each function lists the intermediate results in the
+// order they are computed, so the result can be reproduced exactly
+// by other implementations.
+// The results are not rounded to any particular scale; amounts are usually
+// rounded to the scale of the currency by the caller, for example,
+// with [decimal.Decimal.RoundForCurrency].
+//
+// Rates are periodic rates expressed as fractions, so a rate of 5% per
+// period is 0.05.
+//
+// # Errors
+//
+// Error messages include the operands of the failed operation only if
+// they come from the methods of [decimal.Decimal], so they can be hidden
+// with [decimal.RedactError].
+package finance
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// FutureValue returns the (possibly rounded) value of the present value pv
+// after it earns compound interest at the given rate for the given number
+// of periods, that is, pv × (1 + rate)^periods.
+// The intermediate results are:
+//
+//  1. the growth factor g = (1 + rate)^periods, see [decimal.Decimal.PowInt];
+//  2. the future value pv × g.
+//
+// See also function [PresentValue].
+//
+// FutureValue returns an error if:
+//   - the number of periods is negative;
+//   - the rate is less than or equal to -1;
+//   - the integer part of any intermediate result has more than [decimal.MaxPrec] digits.
+func FutureValue(pv, rate decimal.Decimal, periods int) (decimal.Decimal, error) {
+	if periods < 0 {
+		return decimal.Decimal{}, fmt.Errorf("computing future value: number of periods %v is negative", periods)
+	}
+	g, err := growth(rate, periods)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing future value: %w", err)
+	}
+	fv, err := pv.Mul(g)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing future value: %w", err)
+	}
+	return fv, nil
+}
+
+// PresentValue returns the (possibly rounded) value that grows into
+// the future value fv when it earns compound interest at the given rate
+// for the given number of periods, that is, fv / (1 + rate)^periods.
+// The intermediate results are:
+//
+//  1. the growth factor g = (1 + rate)^periods, see [decimal.Decimal.PowInt];
+//  2. the present value fv / g.
+//
+// See also function [FutureValue].
+//
+// PresentValue returns an error if:
+//   - the number of periods is negative;
+//   - the rate is less than or equal to -1;
+//   - the integer part of any intermediate result has more than [decimal.MaxPrec] digits.
+func PresentValue(fv, rate decimal.Decimal, periods int) (decimal.Decimal, error) {
+	if periods < 0 {
+		return decimal.Decimal{}, fmt.Errorf("computing present value: number of periods %v is negative", periods)
+	}
+	g, err := growth(rate, periods)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing present value: %w", err)
+	}
+	pv, err := fv.Quo(g)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing present value: %w", err)
+	}
+	return pv, nil
+}
+
+// Payment returns the (possibly rounded) payment of an ordinary annuity,
+// that is, the amount paid at the end of each of the given number of
+// periods to amortize the present value pv at the given rate:
+//
+//	pv × rate / (1 - (1 + rate)^(-periods))
+//
+// If the rate is zero, the payment is pv / periods.
+// If |rate| × periods is less than 0.5, the denominator is close to zero
+// and would lose significant digits to cancellation, so the payment is
+// computed as pv / s + pv × rate, where s = ((1 + rate)^periods - 1) / rate.
+// The intermediate results are:
+//
+//  1. the sum s of the terms t₁ = periods and tₖ₊₁ = tₖ × rate × (periods - k) / (k + 1)
+//     for k from 1 until the term is zero or k is equal to periods, where every
+//     term is computed from left to right;
+//  2. the quotient pv / s;
+//  3. the interest pv × rate;
+//  4. the payment (pv / s) + (pv × rate).
+//
+// Otherwise, the intermediate results are:
+//
+//  1. the discount factor v = (1 + rate)^(-periods), see [decimal.Decimal.PowInt];
+//  2. the denominator 1 - v;
+//  3. the interest pv × rate;
+//  4. the payment (pv × rate) / (1 - v).
+//
+// Payment returns an error if:
+//   - the number of periods is less than 1;
+//   - the rate is less than or equal to -1;
+//   - the integer part of any intermediate result has more than [decimal.MaxPrec] digits.
+func Payment(pv, rate decimal.Decimal, periods int) (decimal.Decimal, error) {
+	if periods < 1 {
+		return decimal.Decimal{}, fmt.Errorf("computing payment: number of periods %v is not positive", periods)
+	}
+
+	// Special case: zero rate
+	if rate.IsZero() {
+		n, err := decimal.New(int64(periods), 0)
+		if err != nil {
+			return decimal.Decimal{}, fmt.Errorf("computing payment: %w", err)
+		}
+		pmt, err := pv.Quo(n)
+		if err != nil {
+			return decimal.Decimal{}, fmt.Errorf("computing payment: %w", err)
+		}
+		return pmt, nil
+	}
+
+	// Special case: small rate
+	if isSmall(rate, periods) {
+		pmt, err := paymentSeries(pv, rate, periods)
+		if err != nil {
+			return decimal.Decimal{}, fmt.Errorf("computing payment: %w", err)
+		}
+		return pmt, nil
+	}
+
+	// General case
+	v, err := growth(rate, -periods)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing payment: %w", err)
+	}
+	den, err := decimal.One.Sub(v)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing payment: %w", err)
+	}
+	num, err := pv.Mul(rate)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing payment: %w", err)
+	}
+	pmt, err := num.Quo(den)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing payment: %w", err)
+	}
+	return pmt, nil
+}
+
+// Rate returns the (possibly rounded) periodic rate at which the present
+// value pv grows into the future value fv over the given number of periods
+// with compound interest, that is, (fv / pv)^(1 / periods) - 1.
+// The result is the same as for [decimal.CAGR], so it is computed with at
+// least double precision and rounded only once.
+// If fv is zero, the result is -1.
+//
+// Rate returns an error if:
+//   - the number of periods is less than 1;
+//   - pv is zero or negative;
+//   - fv is negative;
+//   - the integer part of the result has more than [decimal.MaxPrec] digits.
+func Rate(pv, fv decimal.Decimal, periods int) (decimal.Decimal, error) {
+	r, err := decimal.CAGR(pv, fv, periods)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing rate: %w", err)
+	}
+	return r, nil
+}
+
+// EffectiveRate returns the (possibly rounded) effective annual rate
+// of the nominal annual rate compounded the given number of times per year,
+// that is, (1 + nominal / periodsPerYear)^periodsPerYear - 1.
+// For example, the nominal rate of 12% compounded monthly is equivalent
+// to the effective rate of about 12.68%.
+// The intermediate results are:
+//
+//  1. the periodic rate r = nominal / periodsPerYear;
+//  2. the growth factor g = (1 + r)^periodsPerYear, see [decimal.Decimal.PowInt];
+//  3. the effective rate g - 1.
+//
+// EffectiveRate returns an error if:
+//   - the number of periods per year is less than 1;
+//   - the periodic rate is less than or equal to -1;
+//   - the integer part of any intermediate result has more than [decimal.MaxPrec] digits.
+func EffectiveRate(nominal decimal.Decimal, periodsPerYear int) (decimal.Decimal, error) {
+	if periodsPerYear < 1 {
+		return decimal.Decimal{}, fmt.Errorf("computing effective rate: number of periods per year %v is not positive", periodsPerYear)
+	}
+	m, err := decimal.New(int64(periodsPerYear), 0)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing effective rate: %w", err)
+	}
+	r, err := nominal.Quo(m)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing effective rate: %w", err)
+	}
+	g, err := growth(r, periodsPerYear)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing effective rate: %w", err)
+	}
+	e, err := g.Sub(decimal.One)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing effective rate: %w", err)
+	}
+	return e, nil
+}
+
+// isSmall reports whether |rate| × periods is less than 0.5.
+func isSmall(rate decimal.Decimal, periods int) bool {
+	x, err := rate.Abs().MulInt64(int64(periods))
+	if err != nil {
+		return false
+	}
+	return x.Cmp(smallThreshold) < 0
+}
+
+// smallThreshold is the threshold used by isSmall.
+var smallThreshold = decimal.MustNew(5, 1)
+
+// paymentSeries returns the (possibly rounded) payment of an ordinary annuity
+// computed from the binomial series of ((1 + rate)^periods - 1) / rate,
+// as described in [Payment].
+func paymentSeries(pv, rate decimal.Decimal, periods int) (decimal.Decimal, error) {
+	t, err := decimal.New(int64(periods), 0)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	s := t
+	for k := 1; k < periods && !t.IsZero(); k++ {
+		t, err = t.Mul(rate)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		t, err = t.MulInt64(int64(periods - k))
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		t, err = t.QuoInt64(int64(k + 1))
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		s, err = s.Add(t)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+	}
+	q, err := pv.Quo(s)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	i, err := pv.Mul(rate)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return q.Add(i)
+}
+
+// growth returns the (possibly rounded) growth factor (1 + rate)^periods.
+func growth(rate decimal.Decimal, periods int) (decimal.Decimal, error) {
+	if rate.Cmp(decimal.NegOne) <= 0 {
+		return decimal.Decimal{}, errors.New("rate is less than or equal to -1")
+	}
+	f, err := decimal.One.Add(rate)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return f.PowInt(periods)
+}
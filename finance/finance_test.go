@@ -0,0 +1,266 @@
+//go:build !decimalnobig
+
+package finance
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestFutureValue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			pv, rate string
+			periods  int
+			want     string
+		}{
+			{"1000", "0.05", 0, "1000"},
+			{"1000", "0.05", 1, "1050.00"},
+			{"1000", "0.05", 10, "1628.894626777441406"},
+			{"1000", "0", 10, "1000"},
+			{"-250.50", "0.1", 3, "-333.41550"},
+			{"100", "0.0041666666666666667", 360, "446.7744314006132746"},
+			{"100", "-0.5", 2, "25.00"},
+		}
+		for _, tt := range tests {
+			pv := decimal.MustParse(tt.pv)
+			rate := decimal.MustParse(tt.rate)
+			got, err := FutureValue(pv, rate, tt.periods)
+			if err != nil {
+				t.Errorf("FutureValue(%v, %v, %v) failed: %v", pv, rate, tt.periods, err)
+				continue
+			}
+			want := decimal.MustParse(tt.want)
+			if got != want {
+				t.Errorf("FutureValue(%v, %v, %v) = %v, want %v", pv, rate, tt.periods, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			pv, rate string
+			periods  int
+		}{
+			"negative periods": {"1000", "0.05", -1},
+			"rate -1":          {"1000", "-1", 10},
+			"rate below -1":    {"1000", "-1.5", 10},
+			"overflow":         {"1000", "1", 100},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				pv := decimal.MustParse(tt.pv)
+				rate := decimal.MustParse(tt.rate)
+				_, err := FutureValue(pv, rate, tt.periods)
+				if err == nil {
+					t.Errorf("FutureValue(%v, %v, %v) did not fail", pv, rate, tt.periods)
+				}
+			})
+		}
+	})
+}
+
+func TestPresentValue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			fv, rate string
+			periods  int
+			want     string
+		}{
+			{"1000", "0.05", 0, "1000"},
+			{"1050", "0.05", 1, "1000"},
+			{"1628.89", "0.05", 10, "999.9971595600075375"},
+			{"1000", "0.07", 30, "131.3671171545898306"},
+			{"1000", "0", 30, "1000"},
+		}
+		for _, tt := range tests {
+			fv := decimal.MustParse(tt.fv)
+			rate := decimal.MustParse(tt.rate)
+			got, err := PresentValue(fv, rate, tt.periods)
+			if err != nil {
+				t.Errorf("PresentValue(%v, %v, %v) failed: %v", fv, rate, tt.periods, err)
+				continue
+			}
+			want := decimal.MustParse(tt.want)
+			if got != want {
+				t.Errorf("PresentValue(%v, %v, %v) = %v, want %v", fv, rate, tt.periods, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			fv, rate string
+			periods  int
+		}{
+			"negative periods": {"1000", "0.05", -1},
+			"rate -1":          {"1000", "-1", 10},
+			"overflow":         {"1000", "1", 100},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				fv := decimal.MustParse(tt.fv)
+				rate := decimal.MustParse(tt.rate)
+				_, err := PresentValue(fv, rate, tt.periods)
+				if err == nil {
+					t.Errorf("PresentValue(%v, %v, %v) did not fail", fv, rate, tt.periods)
+				}
+			})
+		}
+	})
+}
+
+func TestPayment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			pv, rate string
+			periods  int
+			want     string
+		}{
+			{"200000", "0.005", 360, "1199.101050305504789"},
+			{"10000", "0.01", 12, "888.4878867834170732"},
+			{"1000", "0.0000000000000000001", 12, "83.33333333333333336"},
+			{"1000", "0.00000001", 12, "83.33333875000009931"},
+			{"1000", "-0.00000001", 12, "83.33332791666676597"},
+			{"1000000", "0.0001", 1000, "1050.883152001146837"},
+			{"1000", "1", 1, "2000"},
+			{"1200", "0", 12, "100"},
+			{"1000", "0", 3, "333.3333333333333333"},
+			{"-1000", "0.1", 2, "-576.1904761904761905"},
+		}
+		for _, tt := range tests {
+			pv := decimal.MustParse(tt.pv)
+			rate := decimal.MustParse(tt.rate)
+			got, err := Payment(pv, rate, tt.periods)
+			if err != nil {
+				t.Errorf("Payment(%v, %v, %v) failed: %v", pv, rate, tt.periods, err)
+				continue
+			}
+			want := decimal.MustParse(tt.want)
+			if got != want {
+				t.Errorf("Payment(%v, %v, %v) = %v, want %v", pv, rate, tt.periods, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			pv, rate string
+			periods  int
+		}{
+			"zero periods":     {"1000", "0.05", 0},
+			"negative periods": {"1000", "0.05", -1},
+			"rate -1":          {"1000", "-1", 10},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				pv := decimal.MustParse(tt.pv)
+				rate := decimal.MustParse(tt.rate)
+				_, err := Payment(pv, rate, tt.periods)
+				if err == nil {
+					t.Errorf("Payment(%v, %v, %v) did not fail", pv, rate, tt.periods)
+				}
+			})
+		}
+	})
+}
+
+func TestRate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			pv, fv  string
+			periods int
+			want    string
+		}{
+			{"1000", "1628.89", 10, "0.049999701753419573"},
+			{"100", "200", 1, "1"},
+			{"100", "100", 5, "0"},
+			{"100", "0", 5, "-1"},
+			{"100", "25", 2, "-0.5"},
+		}
+		for _, tt := range tests {
+			pv := decimal.MustParse(tt.pv)
+			fv := decimal.MustParse(tt.fv)
+			got, err := Rate(pv, fv, tt.periods)
+			if err != nil {
+				t.Errorf("Rate(%v, %v, %v) failed: %v", pv, fv, tt.periods, err)
+				continue
+			}
+			want := decimal.MustParse(tt.want)
+			if got != want {
+				t.Errorf("Rate(%v, %v, %v) = %v, want %v", pv, fv, tt.periods, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			pv, fv  string
+			periods int
+		}{
+			"zero periods": {"100", "200", 0},
+			"zero pv":      {"0", "200", 1},
+			"negative pv":  {"-100", "200", 1},
+			"negative fv":  {"100", "-200", 1},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				pv := decimal.MustParse(tt.pv)
+				fv := decimal.MustParse(tt.fv)
+				_, err := Rate(pv, fv, tt.periods)
+				if err == nil {
+					t.Errorf("Rate(%v, %v, %v) did not fail", pv, fv, tt.periods)
+				}
+			})
+		}
+	})
+}
+
+func TestEffectiveRate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			nominal        string
+			periodsPerYear int
+			want           string
+		}{
+			{"0.1", 1, "0.1"},
+			{"0.06", 4, "0.061363550625"},
+			{"0.12", 12, "0.126825030131969721"},
+			{"0.05", 365, "0.051267496467462545"},
+			{"0", 12, "0"},
+		}
+		for _, tt := range tests {
+			nominal := decimal.MustParse(tt.nominal)
+			got, err := EffectiveRate(nominal, tt.periodsPerYear)
+			if err != nil {
+				t.Errorf("EffectiveRate(%v, %v) failed: %v", nominal, tt.periodsPerYear, err)
+				continue
+			}
+			want := decimal.MustParse(tt.want)
+			if got != want {
+				t.Errorf("EffectiveRate(%v, %v) = %v, want %v", nominal, tt.periodsPerYear, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			nominal        string
+			periodsPerYear int
+		}{
+			"zero periods":     {"0.1", 0},
+			"negative periods": {"0.1", -12},
+			"rate -1":          {"-12", 12},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				nominal := decimal.MustParse(tt.nominal)
+				_, err := EffectiveRate(nominal, tt.periodsPerYear)
+				if err == nil {
+					t.Errorf("EffectiveRate(%v, %v) did not fail", nominal, tt.periodsPerYear)
+				}
+			})
+		}
+	})
+}
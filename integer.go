@@ -1,9 +1,8 @@
 package decimal
 
 import (
-	"fmt"
-	"math/big"
-	"sync"
+	"math"
+	"math/rand/v2"
 )
 
 // fint (Fast INTeger) is a wrapper around uint64.
@@ -90,6 +89,19 @@ func (x fint) subAbs(y fint) fint {
 	return y - x
 }
 
+// sqrt calculates z = ⌊√x⌋ and checks that the square root is exact.
+func (x fint) sqrt() (z fint, ok bool) {
+	z = fint(math.Sqrt(float64(x)))
+	// Correction of floating-point errors
+	for z*z > x {
+		z--
+	}
+	for (z+1)*(z+1) <= x {
+		z++
+	}
+	return z, z*z == x
+}
+
 // lsh (Left Shift) calculates x * 10^shift and checks overflow.
 func (x fint) lsh(shift int) (z fint, ok bool) {
 	// Special cases
@@ -146,6 +158,82 @@ func (x fint) rshHalfEven(shift int) fint {
 	return z
 }
 
+// rshHalfUp (Right Shift) calculates round(x / 10^shift) and rounds result
+// using "half away from zero" rule.
+func (x fint) rshHalfUp(shift int) fint {
+	// Special cases
+	switch {
+	case x == 0:
+		return 0
+	case shift <= 0:
+		return x
+	case shift >= len(pow10):
+		return 0
+	}
+	// General case
+	y := pow10[shift]
+	z := x / y
+	r := x - z*y // r = x % y
+	y = y >> 1   // y = y / 2, which is safe as y is a multiple of 10
+	if y <= r {  // half-up
+		z++
+	}
+	return z
+}
+
+// rshHalfDown (Right Shift) calculates round(x / 10^shift) and rounds result
+// using "half towards zero" rule.
+func (x fint) rshHalfDown(shift int) fint {
+	// Special cases
+	switch {
+	case x == 0:
+		return 0
+	case shift <= 0:
+		return x
+	case shift >= len(pow10):
+		return 0
+	}
+	// General case
+	y := pow10[shift]
+	z := x / y
+	r := x - z*y // r = x % y
+	y = y >> 1   // y = y / 2, which is safe as y is a multiple of 10
+	if y < r {   // half-down
+		z++
+	}
+	return z
+}
+
+// rshRand (Right Shift) calculates x / 10^shift and rounds result away from zero
+// with probability proportional to the remainder, or towards zero otherwise.
+// If rnd is nil, rshRand uses the global random number generator.
+func (x fint) rshRand(shift int, rnd *rand.Rand) fint {
+	// Special cases
+	switch {
+	case x == 0:
+		return 0
+	case shift <= 0:
+		return x
+	case shift >= len(pow10):
+		// Leading digits are sufficient to determine the probability
+		return x.rshDown(shift-len(pow10)+1).rshRand(len(pow10)-1, rnd)
+	}
+	// General case
+	y := pow10[shift]
+	z := x / y
+	r := x - z*y // r = x % y
+	var u uint64
+	if rnd == nil {
+		u = rand.Uint64N(uint64(y))
+	} else {
+		u = rnd.Uint64N(uint64(y))
+	}
+	if fint(u) < r {
+		z++
+	}
+	return z
+}
+
 // rshUp (Right Shift) calculates ⌈x / 10^shift⌉ and rounds result away from zero.
 func (x fint) rshUp(shift int) fint {
 	// Special cases
@@ -228,546 +316,3 @@ func (x fint) hasPrec(prec int) bool {
 	// General case
 	return x >= pow10[prec-1]
 }
-
-// bint (Big INTeger) is a wrapper around big.Int.
-type bint big.Int
-
-// bpow10 is a cache of powers of 10, where bpow10[x] = 10^x.
-var bpow10 = [...]*bint{
-	mustParseBint("1"),
-	mustParseBint("10"),
-	mustParseBint("100"),
-	mustParseBint("1000"),
-	mustParseBint("10000"),
-	mustParseBint("100000"),
-	mustParseBint("1000000"),
-	mustParseBint("10000000"),
-	mustParseBint("100000000"),
-	mustParseBint("1000000000"),
-	mustParseBint("10000000000"),
-	mustParseBint("100000000000"),
-	mustParseBint("1000000000000"),
-	mustParseBint("10000000000000"),
-	mustParseBint("100000000000000"),
-	mustParseBint("1000000000000000"),
-	mustParseBint("10000000000000000"),
-	mustParseBint("100000000000000000"),
-	mustParseBint("1000000000000000000"),
-	mustParseBint("10000000000000000000"),
-	mustParseBint("100000000000000000000"),
-	mustParseBint("1000000000000000000000"),
-	mustParseBint("10000000000000000000000"),
-	mustParseBint("100000000000000000000000"),
-	mustParseBint("1000000000000000000000000"),
-	mustParseBint("10000000000000000000000000"),
-	mustParseBint("100000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("10000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-	mustParseBint("1000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
-}
-
-// bexp is a cache of powers of e, where bexp[x] = round(exp(x) * 10^38).
-var bexp = [...]*bint{
-	mustParseBint("100000000000000000000000000000000000000"),
-	mustParseBint("271828182845904523536028747135266249776"),
-	mustParseBint("738905609893065022723042746057500781318"),
-	mustParseBint("2008553692318766774092852965458171789699"),
-	mustParseBint("5459815003314423907811026120286087840279"),
-	mustParseBint("14841315910257660342111558004055227962349"),
-	mustParseBint("40342879349273512260838718054338827960590"),
-	mustParseBint("109663315842845859926372023828812143244222"),
-	mustParseBint("298095798704172827474359209945288867375597"),
-	mustParseBint("810308392757538400770999668943275996501148"),
-	mustParseBint("2202646579480671651695790064528424436635351"),
-	mustParseBint("5987414171519781845532648579225778161426108"),
-	mustParseBint("16275479141900392080800520489848678317020928"),
-	mustParseBint("44241339200892050332610277594908828178439131"),
-	mustParseBint("120260428416477677774923677076785944941248654"),
-	mustParseBint("326901737247211063930185504609172131550573854"),
-	mustParseBint("888611052050787263676302374078145035080271982"),
-	mustParseBint("2415495275357529821477543518038582387986756735"),
-	mustParseBint("6565996913733051113878650325906003356921635579"),
-	mustParseBint("17848230096318726084491003378872270388361973317"),
-	mustParseBint("48516519540979027796910683054154055868463898894"),
-	mustParseBint("131881573448321469720999888374530278509144443738"),
-	mustParseBint("358491284613159156168115994597842068922269306504"),
-	mustParseBint("974480344624890260003463268482297527764938776404"),
-	mustParseBint("2648912212984347229413916215281188234087019861925"),
-	mustParseBint("7200489933738587252416135146612615791522353381340"),
-	mustParseBint("19572960942883876426977639787609534279203610095070"),
-	mustParseBint("53204824060179861668374730434117744165925580428369"),
-	mustParseBint("144625706429147517367704742299692885690206232950992"),
-	mustParseBint("393133429714404207438862058084352768579694233344390"),
-	mustParseBint("1068647458152446214699046865074140165002449500547305"),
-	mustParseBint("2904884966524742523108568211167982566676469509029698"),
-	mustParseBint("7896296018268069516097802263510822421995619511535233"),
-	mustParseBint("21464357978591606462429776153126088036922590605479790"),
-	mustParseBint("58346174252745488140290273461039101900365923894110811"),
-	mustParseBint("158601345231343072812964462577466012517620395013452615"),
-	mustParseBint("431123154711519522711342229285692539078886361678034773"),
-	mustParseBint("1171914237280261130877293979119019452167536369446182238"),
-	mustParseBint("3185593175711375622032867170129864599954220990518100775"),
-	mustParseBint("8659340042399374695360693271926493424970185470019598659"),
-	mustParseBint("23538526683701998540789991074903480450887161725455546724"),
-	mustParseBint("63984349353005494922266340351557081887933662139685527945"),
-	mustParseBint("173927494152050104739468130361123522614798405772500840104"),
-	mustParseBint("472783946822934656147445756274428037081975196238093817097"),
-	mustParseBint("1285160011435930827580929963214309925780114322075882587192"),
-	mustParseBint("3493427105748509534803479723340609953341165649751815426013"),
-	mustParseBint("9496119420602448874513364911711832310181715892107998785044"),
-	mustParseBint("25813128861900673962328580021527338043163708299304406081061"),
-	mustParseBint("70167359120976317386547159988611740545593799872532198375455"),
-	mustParseBint("190734657249509969052509984095384844738818973054378340247523"),
-}
-
-// bfact is a cache of factorials, where bfact[x] = x!.
-var bfact = [...]*bint{
-	mustParseBint("1"),
-	mustParseBint("1"),
-	mustParseBint("2"),
-	mustParseBint("6"),
-	mustParseBint("24"),
-	mustParseBint("120"),
-	mustParseBint("720"),
-	mustParseBint("5040"),
-	mustParseBint("40320"),
-	mustParseBint("362880"),
-	mustParseBint("3628800"),
-	mustParseBint("39916800"),
-	mustParseBint("479001600"),
-	mustParseBint("6227020800"),
-	mustParseBint("87178291200"),
-	mustParseBint("1307674368000"),
-	mustParseBint("20922789888000"),
-	mustParseBint("355687428096000"),
-	mustParseBint("6402373705728000"),
-	mustParseBint("121645100408832000"),
-	mustParseBint("2432902008176640000"),
-	mustParseBint("51090942171709440000"),
-	mustParseBint("1124000727777607680000"),
-	mustParseBint("25852016738884976640000"),
-	mustParseBint("620448401733239439360000"),
-	mustParseBint("15511210043330985984000000"),
-	mustParseBint("403291461126605635584000000"),
-	mustParseBint("10888869450418352160768000000"),
-	mustParseBint("304888344611713860501504000000"),
-	mustParseBint("8841761993739701954543616000000"),
-	mustParseBint("265252859812191058636308480000000"),
-	mustParseBint("8222838654177922817725562880000000"),
-	mustParseBint("263130836933693530167218012160000000"),
-	mustParseBint("8683317618811886495518194401280000000"),
-	mustParseBint("295232799039604140847618609643520000000"),
-	mustParseBint("10333147966386144929666651337523200000000"),
-	mustParseBint("371993326789901217467999448150835200000000"),
-	mustParseBint("13763753091226345046315979581580902400000000"),
-	mustParseBint("523022617466601111760007224100074291200000000"),
-	mustParseBint("20397882081197443358640281739902897356800000000"),
-	mustParseBint("815915283247897734345611269596115894272000000000"),
-	mustParseBint("33452526613163807108170062053440751665152000000000"),
-	mustParseBint("1405006117752879898543142606244511569936384000000000"),
-	mustParseBint("60415263063373835637355132068513997507264512000000000"),
-	mustParseBint("2658271574788448768043625811014615890319638528000000000"),
-	mustParseBint("119622220865480194561963161495657715064383733760000000000"),
-	mustParseBint("5502622159812088949850305428800254892961651752960000000000"),
-	mustParseBint("258623241511168180642964355153611979969197632389120000000000"),
-	mustParseBint("12413915592536072670862289047373375038521486354677760000000000"),
-	mustParseBint("608281864034267560872252163321295376887552831379210240000000000"),
-}
-
-// bnlog10 is a cache of  multiples of the natural logarithm of 10, where bnlog10[x] = round(x * log(10) * 10^38).
-var bnlog10 = [...]*bint{
-	mustParseBint("000000000000000000000000000000000000000"),
-	mustParseBint("230258509299404568401799145468436420760"),
-	mustParseBint("460517018598809136803598290936872841520"),
-	mustParseBint("690775527898213705205397436405309262280"),
-	mustParseBint("921034037197618273607196581873745683040"),
-	mustParseBint("1151292546497022842008995727342182103801"),
-	mustParseBint("1381551055796427410410794872810618524561"),
-	mustParseBint("1611809565095831978812594018279054945321"),
-	mustParseBint("1842068074395236547214393163747491366081"),
-	mustParseBint("2072326583694641115616192309215927786841"),
-	mustParseBint("2302585092994045684017991454684364207601"),
-	mustParseBint("2532843602293450252419790600152800628361"),
-	mustParseBint("2763102111592854820821589745621237049121"),
-	mustParseBint("2993360620892259389223388891089673469881"),
-	mustParseBint("3223619130191663957625188036558109890642"),
-	mustParseBint("3453877639491068526026987182026546311402"),
-	mustParseBint("3684136148790473094428786327494982732162"),
-	mustParseBint("3914394658089877662830585472963419152922"),
-	mustParseBint("4144653167389282231232384618431855573682"),
-	mustParseBint("4374911676688686799634183763900291994442"),
-	mustParseBint("4605170185988091368035982909368728415202"),
-	mustParseBint("4835428695287495936437782054837164835962"),
-	mustParseBint("5065687204586900504839581200305601256722"),
-	mustParseBint("5295945713886305073241380345774037677483"),
-	mustParseBint("5526204223185709641643179491242474098243"),
-	mustParseBint("5756462732485114210044978636710910519003"),
-	mustParseBint("5986721241784518778446777782179346939763"),
-	mustParseBint("6216979751083923346848576927647783360523"),
-	mustParseBint("6447238260383327915250376073116219781283"),
-	mustParseBint("6677496769682732483652175218584656202043"),
-	mustParseBint("6907755278982137052053974364053092622803"),
-	mustParseBint("7138013788281541620455773509521529043563"),
-	mustParseBint("7368272297580946188857572654989965464324"),
-	mustParseBint("7598530806880350757259371800458401885084"),
-	mustParseBint("7828789316179755325661170945926838305844"),
-	mustParseBint("8059047825479159894062970091395274726604"),
-	mustParseBint("8289306334778564462464769236863711147364"),
-	mustParseBint("8519564844077969030866568382332147568124"),
-	mustParseBint("8749823353377373599268367527800583988884"),
-	mustParseBint("8980081862676778167670166673269020409644"),
-	mustParseBint("9210340371976182736071965818737456830404"),
-	mustParseBint("9440598881275587304473764964205893251165"),
-	mustParseBint("9670857390574991872875564109674329671925"),
-	mustParseBint("9901115899874396441277363255142766092685"),
-	mustParseBint("10131374409173801009679162400611202513445"),
-	mustParseBint("10361632918473205578080961546079638934205"),
-	mustParseBint("10591891427772610146482760691548075354965"),
-	mustParseBint("10822149937072014714884559837016511775725"),
-	mustParseBint("11052408446371419283286358982484948196485"),
-	mustParseBint("11282666955670823851688158127953384617245"),
-}
-
-// mustParseBint converts a string to *big.Int, panicking on error.
-// Use only for package variable initialization and test code!
-func mustParseBint(s string) *bint {
-	z, ok := new(big.Int).SetString(s, 10)
-	if !ok {
-		panic(fmt.Errorf("mustParseBint(%q) failed: parsing error", s))
-	}
-	if z.Sign() < 0 {
-		panic(fmt.Errorf("mustParseBint(%q) failed: negative number", s))
-	}
-	return (*bint)(z)
-}
-
-func (z *bint) sign() int {
-	return (*big.Int)(z).Sign()
-}
-
-func (z *bint) cmp(x *bint) int {
-	return (*big.Int)(z).Cmp((*big.Int)(x))
-}
-
-func (z *bint) string() string {
-	return (*big.Int)(z).String()
-}
-
-func (z *bint) setBint(x *bint) {
-	(*big.Int)(z).Set((*big.Int)(x))
-}
-
-func (z *bint) setInt64(x int64) {
-	(*big.Int)(z).SetInt64(x)
-}
-
-func (z *bint) setFint(x fint) {
-	(*big.Int)(z).SetUint64(uint64(x))
-}
-
-// fint converts *big.Int to uint64.
-// If z cannot be represented as uint64, the result is undefined.
-func (z *bint) fint() fint {
-	f := (*big.Int)(z).Uint64()
-	return fint(f)
-}
-
-// add calculates z = x + y.
-func (z *bint) add(x, y *bint) {
-	(*big.Int)(z).Add((*big.Int)(x), (*big.Int)(y))
-}
-
-// inc calcualtes z = x + 1.
-func (z *bint) inc(x *bint) {
-	y := bpow10[0]
-	z.add(x, y)
-}
-
-// sub calculates z = x - y.
-func (z *bint) sub(x, y *bint) {
-	(*big.Int)(z).Sub((*big.Int)(x), (*big.Int)(y))
-}
-
-// subAbs calculates z = |x - y|.
-func (z *bint) subAbs(x, y *bint) {
-	switch x.cmp(y) {
-	case 1:
-		z.sub(x, y)
-	default:
-		z.sub(y, x)
-	}
-}
-
-// dbl (Double) calculates z = x * 2.
-func (z *bint) dbl(x *bint) {
-	(*big.Int)(z).Lsh((*big.Int)(x), 1)
-}
-
-// hlf (Half) calculates z = ⌊x / 2⌋.
-func (z *bint) hlf(x *bint) {
-	(*big.Int)(z).Rsh((*big.Int)(x), 1)
-}
-
-// mul calculates z = x * y.
-func (z *bint) mul(x, y *bint) {
-	// Copying x, y to prevent heap allocations.
-	if z == x {
-		b := getBint()
-		defer putBint(b)
-		b.setBint(x)
-		x = b
-	}
-	if z == y {
-		b := getBint()
-		defer putBint(b)
-		b.setBint(y)
-		y = b
-	}
-	(*big.Int)(z).Mul((*big.Int)(x), (*big.Int)(y))
-}
-
-// exp calculates z = x^y.
-// If y is negative, the result is unpredictable.
-func (z *bint) exp(x, y *bint) {
-	(*big.Int)(z).Exp((*big.Int)(x), (*big.Int)(y), nil)
-}
-
-// pow10 calculates z = 10^power.
-// If power is negative, the result is unpredictable.
-func (z *bint) pow10(power int) {
-	x := getBint()
-	defer putBint(x)
-	x.setInt64(10)
-	y := getBint()
-	defer putBint(y)
-	y.setInt64(int64(power))
-	z.exp(x, y)
-}
-
-// quo calculates z = ⌊x / y⌋.
-func (z *bint) quo(x, y *bint) {
-	// Passing r to prevent heap allocations.
-	r := getBint()
-	defer putBint(r)
-	z.quoRem(x, y, r)
-}
-
-// quoRem calculates z = ⌊x / y⌋, r = x - y * z.
-func (z *bint) quoRem(x, y, r *bint) {
-	(*big.Int)(z).QuoRem((*big.Int)(x), (*big.Int)(y), (*big.Int)(r))
-}
-
-func (z *bint) isOdd() bool {
-	return (*big.Int)(z).Bit(0) != 0
-}
-
-// lsh (Left Shift) calculates z = x * 10^shift.
-func (z *bint) lsh(x *bint, shift int) {
-	var y *bint
-	if shift < len(bpow10) {
-		y = bpow10[shift]
-	} else {
-		y = getBint()
-		defer putBint(y)
-		y.pow10(shift)
-	}
-	z.mul(x, y)
-}
-
-// fsa (Fused Shift and Addition) calculates z = x * 10^shift + f.
-func (z *bint) fsa(x *bint, shift int, f fint) {
-	y := getBint()
-	defer putBint(y)
-	y.setFint(f)
-	z.lsh(x, shift)
-	z.add(z, y)
-}
-
-// rshDown (Right Shift) calculates z = ⌊x / 10^shift⌋ and rounds
-// result towards zero.
-func (z *bint) rshDown(x *bint, shift int) {
-	// Special cases
-	switch {
-	case x.sign() == 0:
-		z.setFint(0)
-		return
-	case shift <= 0:
-		z.setBint(x)
-		return
-	}
-	// General case
-	var y *bint
-	if shift < len(bpow10) {
-		y = bpow10[shift]
-	} else {
-		y = getBint()
-		defer putBint(y)
-		y.pow10(shift)
-	}
-	z.quo(x, y)
-}
-
-// rshHalfEven (Right Shift) calculates z = round(x / 10^shift) and
-// rounds result using "half to even" rule.
-func (z *bint) rshHalfEven(x *bint, shift int) {
-	// Special cases
-	switch {
-	case x.sign() == 0:
-		z.setFint(0)
-		return
-	case shift <= 0:
-		z.setBint(x)
-		return
-	}
-	// General case
-	var y, r *bint
-	r = getBint()
-	defer putBint(r)
-	if shift < len(bpow10) {
-		y = bpow10[shift]
-	} else {
-		y = getBint()
-		defer putBint(y)
-		y.pow10(shift)
-	}
-	z.quoRem(x, y, r)
-	r.dbl(r) // r = r * 2
-	switch y.cmp(r) {
-	case -1:
-		z.inc(z) // z = z + 1
-	case 0:
-		// half-to-even
-		if z.isOdd() {
-			z.inc(z) // z = z + 1
-		}
-	}
-}
-
-// prec returns length of z in decimal digits.
-// prec assumes that 0 has no digits.
-// If z is negative, the result is unpredictable.
-//
-// z.prec() is significantly faster than len(z.string()),
-// if z has less than len(bpow10) digits.
-func (z *bint) prec() int {
-	// Special case
-	if z.cmp(bpow10[len(bpow10)-1]) > 0 {
-		return len(z.string())
-	}
-	// General case
-	left, right := 0, len(bpow10)
-	for left < right {
-		mid := (left + right) / 2
-		if z.cmp(bpow10[mid]) < 0 {
-			right = mid
-		} else {
-			left = mid + 1
-		}
-	}
-	return left
-}
-
-// hasPrec checks if z has a given number of digits or more.
-// hasPrec assumes that 0 has no digits.
-// If z is negative, the result is unpredictable.
-//
-// z.hasPrec(p) is significantly faster than z.prec() >= p,
-// if z has no more than len(bpow10) digits.
-func (z *bint) hasPrec(prec int) bool {
-	// Special cases
-	switch {
-	case prec < 1:
-		return true
-	case prec > len(bpow10):
-		return len(z.string()) >= prec
-	}
-	// General case
-	return z.cmp(bpow10[prec-1]) >= 0
-}
-
-// bpool is a cache of reusable *big.Int instances.
-var bpool = sync.Pool{
-	New: func() any {
-		return (*bint)(new(big.Int))
-	},
-}
-
-// getBint obtains a *big.Int from the pool.
-func getBint() *bint {
-	return bpool.Get().(*bint)
-}
-
-// putBint returns the *big.Int into the pool.
-func putBint(b *bint) {
-	bpool.Put(b)
-}
@@ -0,0 +1,163 @@
+package decimal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NumericForm specifies one of the numeric representations defined by
+// ISO 6093, which are required by some EDI and regulatory submission formats.
+// See [ParseISO6093] and [Decimal.FormatISO6093].
+// The zero value is [NR1].
+type NumericForm int
+
+const (
+	NR1 NumericForm = iota // NR1 is an integer without a decimal mark, such as "-1234".
+	NR2                    // NR2 is a number with a decimal mark and without an exponent, such as "-12.34".
+	NR3                    // NR3 is a number with a decimal mark and an exponent, such as "-1.234E+1".
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// the name of the numeric representation, such as "NR2".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (f NumericForm) String() string {
+	switch f {
+	case NR1:
+		return "NR1"
+	case NR2:
+		return "NR2"
+	case NR3:
+		return "NR3"
+	}
+	return fmt.Sprintf("NumericForm(%d)", int(f))
+}
+
+// ParseISO6093 converts a string in the given ISO 6093 numeric representation
+// to a decimal.
+// As permitted by the standard, the string may have leading spaces,
+// and either a full stop '.' or a comma ',' may be used as the decimal mark.
+// The forms are checked strictly:
+//
+//   - NR1 must consist of an optional sign and at least one digit, such as "-1234";
+//   - NR2 must also have a decimal mark, such as "-12.34", "12,", or ",5";
+//   - NR3 must have a decimal mark and an exponent, such as "-1.234E+1" or "1,5e-3".
+//
+// Other than that, the string is parsed as described in [Parse].
+// See also method [Decimal.FormatISO6093].
+//
+// ParseISO6093 returns an error if:
+//   - the form is unknown;
+//   - the string does not match the form;
+//   - the string cannot be parsed by [Parse].
+func ParseISO6093(s string, form NumericForm) (Decimal, error) {
+	if form < NR1 || form > NR3 {
+		return Decimal{}, errorf("parsing decimal: %w: unknown form %v", errInvalidOperation, form)
+	}
+	t := strings.TrimLeft(s, " ")
+	if !isISO6093(t, form) {
+		return Decimal{}, errorf("parsing %v decimal: %w: %q does not match the form", form, errInvalidDecimal, redact(s))
+	}
+	d, err := Parse(strings.Replace(t, ",", ".", 1))
+	if err != nil {
+		return Decimal{}, errorf("parsing %v decimal: %w", form, err)
+	}
+	return d, nil
+}
+
+// isISO6093 reports whether a string without leading spaces matches
+// the given numeric representation.
+func isISO6093(s string, form NumericForm) bool {
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+
+	// Mantissa
+	var digits int
+	var mark bool
+	pos := 0
+	for ; pos < len(s); pos++ {
+		switch c := s[pos]; {
+		case c >= '0' && c <= '9':
+			digits++
+			continue
+		case (c == '.' || c == ',') && !mark && form != NR1:
+			mark = true
+			continue
+		}
+		break
+	}
+	if digits == 0 || mark != (form != NR1) {
+		return false
+	}
+	if form != NR3 {
+		return pos == len(s)
+	}
+
+	// Exponent
+	if pos == len(s) || (s[pos] != 'E' && s[pos] != 'e') {
+		return false
+	}
+	pos++
+	if pos < len(s) && (s[pos] == '+' || s[pos] == '-') {
+		pos++
+	}
+	if pos == len(s) {
+		return false
+	}
+	for ; pos < len(s); pos++ {
+		if s[pos] < '0' || s[pos] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatISO6093 returns a string representation of the decimal in the given
+// ISO 6093 numeric representation, using a full stop '.' as the decimal mark
+// and no leading spaces:
+//
+//   - NR1 is the same as [Decimal.String], such as "-1234";
+//   - NR2 is the same as [Decimal.String], but a decimal mark is always present, such as "-12.34" or "12.";
+//   - NR3 has exactly one digit before the decimal mark and a signed exponent,
+//     such as "-1.234E+1" or "5.E+0".
+//
+// All digits of the coefficient, including trailing zeros, are kept,
+// so that [ParseISO6093] returns the same decimal.
+//
+// FormatISO6093 returns an error if:
+//   - the form is unknown;
+//   - the form is NR1 and the decimal has digits after the decimal point.
+func (d Decimal) FormatISO6093(form NumericForm) (string, error) {
+	switch form {
+	case NR1:
+		if d.Scale() != 0 {
+			return "", errorf("formatting %v as %v: %w: fractional part is not allowed", redact(d), form, errInvalidOperation)
+		}
+		return d.String(), nil
+	case NR2:
+		if d.Scale() == 0 {
+			return d.String() + ".", nil
+		}
+		return d.String(), nil
+	case NR3:
+		digits := strconv.FormatUint(d.Coef(), 10)
+		exp := len(digits) - 1 - d.Scale()
+		var b strings.Builder
+		b.Grow(len(digits) + 8)
+		if d.IsNeg() {
+			b.WriteByte('-')
+		}
+		b.WriteString(digits[:1])
+		b.WriteByte('.')
+		b.WriteString(digits[1:])
+		b.WriteByte('E')
+		if exp >= 0 {
+			b.WriteByte('+')
+		}
+		b.WriteString(strconv.Itoa(exp))
+		return b.String(), nil
+	}
+	return "", errorf("formatting %v: %w: unknown form %v", redact(d), errInvalidOperation, form)
+}
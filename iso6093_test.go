@@ -0,0 +1,168 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseISO6093(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			form NumericForm
+			want string
+		}{
+			{"0", NR1, "0"},
+			{"-1234", NR1, "-1234"},
+			{"+1234", NR1, "1234"},
+			{"   0042", NR1, "42"},
+			{"9999999999999999999", NR1, "9999999999999999999"},
+			{"12.34", NR2, "12.34"},
+			{"-12,34", NR2, "-12.34"},
+			{"  12.", NR2, "12"},
+			{",5", NR2, "0.5"},
+			{"-.50", NR2, "-0.50"},
+			{"0.0000000000000000001", NR2, "0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			got, err := ParseISO6093(tt.s, tt.form)
+			if err != nil {
+				t.Errorf("ParseISO6093(%q, %v) failed: %v", tt.s, tt.form, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("ParseISO6093(%q, %v) = %q, want %q", tt.s, tt.form, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			s       string
+			form    NumericForm
+			wantErr error
+		}{
+			{"", NR1, errInvalidDecimal},
+			{"-", NR1, errInvalidDecimal},
+			{"12.34", NR1, errInvalidDecimal},
+			{"1e2", NR1, errInvalidDecimal},
+			{"12 ", NR1, errInvalidDecimal},
+			{"1_000", NR1, errInvalidDecimal},
+			{"\t12", NR1, errInvalidDecimal},
+			{"1234", NR2, errInvalidDecimal},
+			{".", NR2, errInvalidDecimal},
+			{"1.2.3", NR2, errInvalidDecimal},
+			{"1.2,3", NR2, errInvalidDecimal},
+			{"1.2E3", NR2, errInvalidDecimal},
+			{"1E3", NR3, errInvalidDecimal},
+			{"1.5", NR3, errInvalidDecimal},
+			{"1.5E", NR3, errInvalidDecimal},
+			{"1.5E+", NR3, errInvalidDecimal},
+			{"1.5E+-3", NR3, errInvalidDecimal},
+			{"1.5E3.0", NR3, errInvalidDecimal},
+			{"99999999999999999999", NR1, errDecimalOverflow},
+			{"1", -1, errInvalidOperation},
+			{"1", 3, errInvalidOperation},
+		}
+		for _, tt := range tests {
+			_, err := ParseISO6093(tt.s, tt.form)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseISO6093(%q, %v) error = %v, want %v", tt.s, tt.form, err, tt.wantErr)
+			}
+		}
+
+		// Redaction
+		_, err := ParseISO6093("1.25", NR1)
+		err = RedactError(err)
+		if got, want := err.Error(), `parsing NR1 decimal: invalid decimal: "x.xx" does not match the form`; got != want {
+			t.Errorf("RedactError(ParseISO6093(%q, %v)) error = %q, want %q", "1.25", NR1, got, want)
+		}
+	})
+}
+
+func TestDecimal_FormatISO6093(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d                string
+			wantNR1, wantNR2 string
+			wantNR3          string
+		}{
+			{"0", "0", "0.", "0.E+0"},
+			{"-1234", "-1234", "-1234.", "-1.234E+3"},
+			{"1000", "1000", "1000.", "1.000E+3"},
+			{"5", "5", "5.", "5.E+0"},
+			{"9999999999999999999", "9999999999999999999", "9999999999999999999.", "9.999999999999999999E+18"},
+			{"12.34", "", "12.34", "1.234E+1"},
+			{"-0.0015", "", "-0.0015", "-1.5E-3"},
+			{"0.00", "", "0.00", "0.E-2"},
+			{"0.50", "", "0.50", "5.0E-1"},
+			{"0.0000000000000000001", "", "0.0000000000000000001", "1.E-19"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			for form, want := range map[NumericForm]string{NR1: tt.wantNR1, NR2: tt.wantNR2, NR3: tt.wantNR3} {
+				if want == "" {
+					continue
+				}
+				got, err := d.FormatISO6093(form)
+				if err != nil {
+					t.Errorf("%q.FormatISO6093(%v) failed: %v", d, form, err)
+					continue
+				}
+				if got != want {
+					t.Errorf("%q.FormatISO6093(%v) = %q, want %q", d, form, got, want)
+				}
+				// Round trip, NR3 is tested separately since exponents require big.Int
+				if form == NR3 {
+					continue
+				}
+				r, err := ParseISO6093(got, form)
+				if err != nil {
+					t.Errorf("ParseISO6093(%q, %v) failed: %v", got, form, err)
+					continue
+				}
+				if r != d {
+					t.Errorf("ParseISO6093(%q, %v) = %q, want %q", got, form, r, d)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			form NumericForm
+		}{
+			{"12.34", NR1},
+			{"1.0", NR1},
+			{"0.00", NR1},
+			{"1", -1},
+			{"1", 3},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := d.FormatISO6093(tt.form)
+			if !errors.Is(err, errInvalidOperation) {
+				t.Errorf("%q.FormatISO6093(%v) error = %v, want %v", d, tt.form, err, errInvalidOperation)
+			}
+		}
+	})
+}
+
+func TestNumericForm_String(t *testing.T) {
+	tests := []struct {
+		f    NumericForm
+		want string
+	}{
+		{NR1, "NR1"},
+		{NR2, "NR2"},
+		{NR3, "NR3"},
+		{3, "NumericForm(3)"},
+	}
+	for _, tt := range tests {
+		if got := tt.f.String(); got != tt.want {
+			t.Errorf("NumericForm(%d).String() = %q, want %q", int(tt.f), got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package decimal
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ParseLenient is like [Parse], but it also accepts strings copied from
+// human-provided documents, such as PDFs, spreadsheets, or web pages in
+// non-Latin locales.
+// Before parsing, the string is normalized as follows:
+//
+//   - Leading and trailing white space, including non-breaking spaces,
+//     is removed.
+//   - The minus sign '−' (U+2212), the small hyphen-minus '﹣' (U+FE63), and
+//     the full-width hyphen-minus '－' (U+FF0D) are replaced with '-'.
+//   - The full-width plus sign '＋' (U+FF0B) is replaced with '+'.
+//   - Full-width digits '０'-'９' (U+FF10-U+FF19), Arabic-Indic digits
+//     '٠'-'٩' (U+0660-U+0669), and Extended Arabic-Indic digits '۰'-'۹'
+//     (U+06F0-U+06F9) are replaced with the ASCII digits '0'-'9'.
+//   - The Arabic decimal separator '٫' (U+066B) and the full-width full stop
+//     '．' (U+FF0E) are replaced with '.'.
+//
+// Other characters, including group separators, are not removed,
+// so "1,234.56" is still rejected.
+// To parse strings with group separators, use [ParseLocale].
+//
+// ParseLenient returns an error in the same cases as [Parse].
+func ParseLenient(s string) (Decimal, error) {
+	return Parse(normalizeLenient(s))
+}
+
+// normalizeLenient converts a string to the format accepted by [Parse],
+// as described in [ParseLenient].
+func normalizeLenient(s string) string {
+	s = strings.TrimFunc(s, unicode.IsSpace)
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r == '−', r == '﹣', r == '－':
+			r = '-'
+		case r == '＋':
+			r = '+'
+		case r == '٫', r == '．':
+			r = '.'
+		case r >= '０' && r <= '９':
+			r = r - '０' + '0'
+		case r >= '٠' && r <= '٩':
+			r = r - '٠' + '0'
+		case r >= '۰' && r <= '۹':
+			r = r - '۰' + '0'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
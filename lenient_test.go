@@ -0,0 +1,57 @@
+package decimal
+
+import "testing"
+
+func TestParseLenient(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want string
+		}{
+			{"1.23", "1.23"},
+			{"  -1.23\t", "-1.23"},
+			{" 123.45 ", "123.45"},
+			{"−1.23", "-1.23"},
+			{"﹣1.23", "-1.23"},
+			{"－1.23", "-1.23"},
+			{"＋1.23", "1.23"},
+			{"１２３．４５", "123.45"},
+			{"－１２３.４５", "-123.45"},
+			{"١٢٣٫٤٥", "123.45"},
+			{"−٠٫٥", "-0.5"},
+			{"۱۲۳٫۴۵", "123.45"},
+			{"0.1000", "0.1000"},
+		}
+		for _, tt := range tests {
+			got, err := ParseLenient(tt.s)
+			if err != nil {
+				t.Errorf("ParseLenient(%q) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("ParseLenient(%q) = %q, want %q", tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"",
+			"   ",
+			"1,234.56",
+			"1 234",
+			"١٬٢٣٤",
+			"−−1",
+			"1.2.3",
+			"12a",
+			"ⅻ",
+		}
+		for _, tt := range tests {
+			_, err := ParseLenient(tt)
+			if err == nil {
+				t.Errorf("ParseLenient(%q) did not fail", tt)
+			}
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package decimal
+
+// OrderedLevels is a price ladder, such as one side of an order book,
+// which associates price levels with values, such as aggregated quantities.
+// Prices are compared by their numeric values, so 1.5 and 1.50 refer to
+// the same level.
+// The zero value is an empty ladder ready to use.
+// OrderedLevels is not safe for concurrent use by multiple goroutines.
+type OrderedLevels[V any] struct {
+	m Map[V]
+}
+
+// Insert associates the value with the price level, replacing any existing
+// value.
+func (l *OrderedLevels[V]) Insert(price Decimal, v V) {
+	l.m.Set(price, v)
+}
+
+// Remove removes the price level and returns true,
+// or returns false if the level is not present in the ladder.
+func (l *OrderedLevels[V]) Remove(price Decimal) bool {
+	return l.m.Delete(price)
+}
+
+// At returns the value of the price level and true,
+// or the zero value and false if the level is not present in the ladder.
+func (l *OrderedLevels[V]) At(price Decimal) (V, bool) {
+	return l.m.Get(price)
+}
+
+// NearestBelow returns the highest price level that is less than or equal
+// to the given price, along with its value.
+// If there is no such level, NearestBelow returns false.
+// See also method [OrderedLevels.NearestAbove].
+func (l *OrderedLevels[V]) NearestBelow(price Decimal) (Decimal, V, bool) {
+	i, ok := l.m.search(price)
+	if !ok {
+		i--
+	}
+	return l.level(i)
+}
+
+// NearestAbove returns the lowest price level that is greater than or equal
+// to the given price, along with its value.
+// If there is no such level, NearestAbove returns false.
+// See also method [OrderedLevels.NearestBelow].
+func (l *OrderedLevels[V]) NearestAbove(price Decimal) (Decimal, V, bool) {
+	i, _ := l.m.search(price)
+	return l.level(i)
+}
+
+// Lowest returns the lowest price level along with its value.
+// If the ladder is empty, Lowest returns false.
+func (l *OrderedLevels[V]) Lowest() (Decimal, V, bool) {
+	return l.level(0)
+}
+
+// Highest returns the highest price level along with its value.
+// If the ladder is empty, Highest returns false.
+func (l *OrderedLevels[V]) Highest() (Decimal, V, bool) {
+	return l.level(l.m.Len() - 1)
+}
+
+// level returns the i-th price level along with its value.
+func (l *OrderedLevels[V]) level(i int) (Decimal, V, bool) {
+	if i < 0 || i >= l.m.Len() {
+		var zero V
+		return Decimal{}, zero, false
+	}
+	return l.m.keys[i], l.m.vals[i], true
+}
+
+// Len returns the number of price levels in the ladder.
+func (l *OrderedLevels[V]) Len() int {
+	return l.m.Len()
+}
+
+// Levels returns a copy of the price levels in ascending order.
+func (l *OrderedLevels[V]) Levels() []Decimal {
+	return l.m.Keys()
+}
+
+// Range calls f for each price level and its value in ascending order
+// of prices.
+// If f returns false, Range stops the iteration.
+// The ladder must not be modified during the iteration.
+func (l *OrderedLevels[V]) Range(f func(price Decimal, v V) bool) {
+	l.m.Range(f)
+}
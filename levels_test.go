@@ -0,0 +1,90 @@
+package decimal
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOrderedLevels(t *testing.T) {
+	var l OrderedLevels[int]
+	if _, _, ok := l.Lowest(); ok {
+		t.Errorf("Lowest() on empty ladder returned true")
+	}
+	if _, _, ok := l.Highest(); ok {
+		t.Errorf("Highest() on empty ladder returned true")
+	}
+	if _, _, ok := l.NearestBelow(One); ok {
+		t.Errorf("NearestBelow(1) on empty ladder returned true")
+	}
+
+	l.Insert(MustParse("100.10"), 5)
+	l.Insert(MustParse("100.2"), 7)
+	l.Insert(MustParse("99.95"), 3)
+	l.Insert(MustParse("100.1"), 6) // replaces 100.10
+
+	if got, want := l.Len(), 3; got != want {
+		t.Errorf("Len() = %v, want %v", got, want)
+	}
+	if got, ok := l.At(MustParse("100.100")); got != 6 || !ok {
+		t.Errorf("At(100.100) = %v, %v, want 6, true", got, ok)
+	}
+	if got, ok := l.At(MustParse("100.15")); got != 0 || ok {
+		t.Errorf("At(100.15) = %v, %v, want 0, false", got, ok)
+	}
+
+	tests := []struct {
+		price    string
+		below    string
+		belowVal int
+		belowOk  bool
+		above    string
+		aboveVal int
+		aboveOk  bool
+	}{
+		{"99", "0", 0, false, "99.95", 3, true},
+		{"99.95", "99.95", 3, true, "99.95", 3, true},
+		{"100", "99.95", 3, true, "100.1", 6, true},
+		{"100.1", "100.1", 6, true, "100.1", 6, true},
+		{"100.15", "100.1", 6, true, "100.2", 7, true},
+		{"100.2", "100.2", 7, true, "100.2", 7, true},
+		{"101", "100.2", 7, true, "0", 0, false},
+	}
+	for _, tt := range tests {
+		price := MustParse(tt.price)
+		got, gotVal, ok := l.NearestBelow(price)
+		if want := MustParse(tt.below); got != want || gotVal != tt.belowVal || ok != tt.belowOk {
+			t.Errorf("NearestBelow(%q) = %q, %v, %v, want %q, %v, %v", price, got, gotVal, ok, want, tt.belowVal, tt.belowOk)
+		}
+		got, gotVal, ok = l.NearestAbove(price)
+		if want := MustParse(tt.above); got != want || gotVal != tt.aboveVal || ok != tt.aboveOk {
+			t.Errorf("NearestAbove(%q) = %q, %v, %v, want %q, %v, %v", price, got, gotVal, ok, want, tt.aboveVal, tt.aboveOk)
+		}
+	}
+
+	if got, v, ok := l.Lowest(); got != MustParse("99.95") || v != 3 || !ok {
+		t.Errorf("Lowest() = %q, %v, %v, want 99.95, 3, true", got, v, ok)
+	}
+	if got, v, ok := l.Highest(); got != MustParse("100.2") || v != 7 || !ok {
+		t.Errorf("Highest() = %q, %v, %v, want 100.2, 7, true", got, v, ok)
+	}
+
+	var total int
+	l.Range(func(_ Decimal, v int) bool {
+		total += v
+		return true
+	})
+	if total != 16 {
+		t.Errorf("Range() total = %v, want 16", total)
+	}
+
+	if !l.Remove(MustParse("100.20")) {
+		t.Errorf("Remove(100.20) = false, want true")
+	}
+	if l.Remove(MustParse("100.2")) {
+		t.Errorf("Remove(100.2) = true, want false")
+	}
+	want := []Decimal{MustParse("99.95"), MustParse("100.1")}
+	if got := l.Levels(); !slices.Equal(got, want) {
+		t.Errorf("Levels() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,236 @@
+package decimal
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// locale holds the number symbols and the currency format of a locale.
+type locale struct {
+	group   rune // separator of groups of thousands
+	decimal rune // separator of the integer and fractional parts
+	prefix  bool // currency symbol precedes the number
+	space   bool // currency symbol is separated from the number by a no-break space
+}
+
+// locales is a table of number symbols and currency symbol placements
+// from the [Unicode CLDR], keyed by language and, where they differ from
+// the language default, by language and region.
+//
+// [Unicode CLDR]: https://cldr.unicode.org
+var locales = map[string]locale{
+	"cs":    {group: '\u00a0', decimal: ',', space: true},
+	"da":    {group: '.', decimal: ',', space: true},
+	"de":    {group: '.', decimal: ',', space: true},
+	"de-AT": {group: '\u00a0', decimal: ',', prefix: true, space: true},
+	"de-CH": {group: '’', decimal: '.', prefix: true, space: true},
+	"de-LI": {group: '’', decimal: '.', prefix: true, space: true},
+	"el":    {group: '.', decimal: ',', space: true},
+	"en":    {group: ',', decimal: '.', prefix: true},
+	"en-ZA": {group: '\u00a0', decimal: ',', prefix: true},
+	"es":    {group: '.', decimal: ',', space: true},
+	"es-MX": {group: ',', decimal: '.', prefix: true},
+	"es-US": {group: ',', decimal: '.', prefix: true},
+	"fi":    {group: '\u00a0', decimal: ',', space: true},
+	"fr":    {group: '\u202f', decimal: ',', space: true},
+	"fr-CA": {group: '\u00a0', decimal: ',', space: true},
+	"he":    {group: ',', decimal: '.', space: true},
+	"hu":    {group: '\u00a0', decimal: ',', space: true},
+	"id":    {group: '.', decimal: ',', prefix: true},
+	"it":    {group: '.', decimal: ',', space: true},
+	"it-CH": {group: '’', decimal: '.', prefix: true, space: true},
+	"ja":    {group: ',', decimal: '.', prefix: true},
+	"ko":    {group: ',', decimal: '.', prefix: true},
+	"nb":    {group: '\u00a0', decimal: ',', space: true},
+	"nl":    {group: '.', decimal: ',', prefix: true, space: true},
+	"no":    {group: '\u00a0', decimal: ',', space: true},
+	"pl":    {group: '\u00a0', decimal: ',', space: true},
+	"pt":    {group: '.', decimal: ',', prefix: true, space: true},
+	"pt-PT": {group: '\u00a0', decimal: ',', space: true},
+	"ro":    {group: '.', decimal: ',', space: true},
+	"ru":    {group: '\u00a0', decimal: ',', space: true},
+	"sk":    {group: '\u00a0', decimal: ',', space: true},
+	"sv":    {group: '\u00a0', decimal: ',', space: true},
+	"th":    {group: ',', decimal: '.', prefix: true},
+	"tr":    {group: '.', decimal: ',', prefix: true},
+	"uk":    {group: '\u00a0', decimal: ',', space: true},
+	"zh":    {group: ',', decimal: '.', prefix: true},
+}
+
+// lookupLocale returns the number symbols of the locale with the given
+// [BCP 47] language tag.
+// Tags are matched case-insensitively, underscores are treated as hyphens,
+// and script and other subtags are ignored.
+// If there are no symbols specific to the region, the symbols of
+// the language are returned.
+//
+// [BCP 47]: https://www.rfc-editor.org/info/bcp47
+func lookupLocale(tag string) (locale, bool) {
+	subtags := strings.Split(strings.ReplaceAll(tag, "_", "-"), "-")
+	lang := strings.ToLower(subtags[0])
+	for _, s := range subtags[1:] {
+		if len(s) == 2 || len(s) == 3 && s[0] >= '0' && s[0] <= '9' {
+			if loc, ok := locales[lang+"-"+strings.ToUpper(s)]; ok {
+				return loc, true
+			}
+			break
+		}
+	}
+	loc, ok := locales[lang]
+	return loc, ok
+}
+
+// isSpaceGroup reports whether the rune is a space used to separate groups
+// of thousands.
+// Locales that use spaces as group separators disagree on the kind of space,
+// and documents often contain ordinary spaces instead, so all of them
+// are accepted when parsing.
+func isSpaceGroup(r rune) bool {
+	return r == ' ' || r == '\u00a0' || r == '\u202f'
+}
+
+// LocaleOptions returns the format options that render decimals in the style
+// of the locale with the given [BCP 47] language tag, for example,
+// "1.234,56" for "de-DE" and "1 234,56" for "fr-FR", where groups are
+// separated by the narrow no-break space (U+202F).
+// The options enable grouping of thousands and set the separators defined by
+// the [Unicode CLDR]; other fields have their zero values and can be changed
+// by the caller:
+//
+//	opts, _ := decimal.LocaleOptions("de-DE")
+//	opts.Scale, opts.FixedScale = 2, true
+//	s := opts.Format(d)
+//
+// The tag has the same form as the result of the String method of
+// language.Tag from golang.org/x/text, so the package does not depend
+// on that module.
+// If the locale is unknown, LocaleOptions returns false.
+// See also method [Decimal.StringLocale] and function [ParseLocale].
+//
+// [BCP 47]: https://www.rfc-editor.org/info/bcp47
+// [Unicode CLDR]: https://cldr.unicode.org
+func LocaleOptions(tag string) (opts FormatOptions, ok bool) {
+	loc, ok := lookupLocale(tag)
+	if !ok {
+		return FormatOptions{}, false
+	}
+	return FormatOptions{Grouping: true, GroupSeparator: loc.group, DecimalSeparator: loc.decimal}, true
+}
+
+// StringLocale returns a string representation of the decimal in the style
+// of the locale with the given [BCP 47] language tag, with groups of thousands
+// separated, for example, "-1.234,56" for "de-DE".
+// The number of digits after the decimal point is the same as in
+// [Decimal.String].
+// If the locale is unknown, StringLocale returns the same result as
+// [Decimal.String].
+// See also function [LocaleOptions].
+//
+// [BCP 47]: https://www.rfc-editor.org/info/bcp47
+func (d Decimal) StringLocale(tag string) string {
+	opts, ok := LocaleOptions(tag)
+	if !ok {
+		return d.String()
+	}
+	return opts.Format(d)
+}
+
+// ParseLocale converts a string written in the style of the locale with
+// the given [BCP 47] language tag to a decimal, for example, "1.234,56"
+// for "de-DE" or "1 234,56" for "fr-FR".
+// This is useful for reading CSV files and reports exported by regional
+// banks and spreadsheets.
+//
+// The string may have a leading sign '+', '-', or '−' (U+2212), and leading
+// and trailing white space.
+// Groups of thousands in the integer part are optional, but if they are
+// present, each of them must have exactly three digits, and the first group
+// must have one to three digits, so that "1.23,4" is rejected for "de-DE".
+// If the locale separates groups with a space, the ordinary space,
+// the no-break space (U+00A0), and the narrow no-break space (U+202F)
+// are all accepted.
+// Exponents and group separators in the fractional part are not allowed.
+// If the fractional part has more than [MaxScale] digits, the decimal is
+// rounded as described in [Parse].
+//
+// ParseLocale returns an error if:
+//   - the locale is unknown, see [LocaleOptions];
+//   - the string does not represent a valid number in the locale;
+//   - the integer part of the result has more than [MaxPrec] digits.
+//
+// [BCP 47]: https://www.rfc-editor.org/info/bcp47
+func ParseLocale(s, tag string) (Decimal, error) {
+	loc, ok := lookupLocale(tag)
+	if !ok {
+		return Decimal{}, errorf("parsing decimal: %w: unknown locale %q", errInvalidOperation, tag)
+	}
+	t, err := loc.normalize(s)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return Parse(t)
+}
+
+// normalize converts a string written in the style of the locale to
+// the format accepted by [Parse].
+func (loc locale) normalize(s string) (string, error) {
+	s = strings.TrimFunc(s, unicode.IsSpace)
+	b := make([]byte, 0, len(s))
+
+	// Sign
+	r, size := utf8.DecodeRuneInString(s)
+	switch r {
+	case '-', '−':
+		b = append(b, '-')
+		s = s[size:]
+	case '+':
+		s = s[size:]
+	}
+
+	// Integer part
+	var digits, group, groups int // digits in the current group, number of groups
+intg:
+	for s != "" {
+		r, size = utf8.DecodeRuneInString(s)
+		switch {
+		case r >= '0' && r <= '9':
+			b = append(b, byte(r))
+			digits++
+			group++
+		case r == loc.group || isSpaceGroup(loc.group) && isSpaceGroup(r):
+			if group == 0 || group > 3 || groups > 0 && group != 3 {
+				return "", errorf("%w: misplaced group separator %q", errInvalidDecimal, r)
+			}
+			group = 0
+			groups++
+		case r == loc.decimal:
+			break intg
+		default:
+			return "", errorf("%w: unexpected character %q", errInvalidDecimal, r)
+		}
+		s = s[size:]
+	}
+	if groups > 0 && group != 3 {
+		return "", errorf("%w: misplaced group separator %q", errInvalidDecimal, loc.group)
+	}
+
+	// Fractional part
+	if s != "" {
+		s = s[utf8.RuneLen(loc.decimal):]
+		b = append(b, '.')
+		for s != "" {
+			r, size = utf8.DecodeRuneInString(s)
+			if r < '0' || r > '9' {
+				return "", errorf("%w: unexpected character %q", errInvalidDecimal, r)
+			}
+			b = append(b, byte(r))
+			digits++
+			s = s[size:]
+		}
+	}
+	if digits == 0 {
+		return "", errorf("%w: no coefficient", errInvalidDecimal)
+	}
+	return string(b), nil
+}
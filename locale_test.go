@@ -0,0 +1,126 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseLocale(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, tag string
+			want   string
+		}{
+			// Grouping with dots
+			{"1.234,56", "de-DE", "1234.56"},
+			{"-1.234.567,890", "de", "-1234567.890"},
+			{"1234,56", "de-DE", "1234.56"},
+			{"0,5", "it-IT", "0.5"},
+			{",5", "es_ES", "0.5"},
+			{"12.345", "pt-BR", "12345"},
+
+			// Grouping with commas
+			{"1,234.56", "en-US", "1234.56"},
+			{"+1,234,567", "en", "1234567"},
+			{"1,234.56", "es-MX", "1234.56"},
+			{"999.5", "ja-JP", "999.5"},
+
+			// Grouping with spaces
+			{"1 234,56", "fr-FR", "1234.56"},
+			{"1\u202f234,56", "fr-FR", "1234.56"},
+			{"1\u00a0234\u00a0567,5", "ru-RU", "1234567.5"},
+			{" \u221212 345,00\n", "sv-SE", "-12345.00"},
+			{"1 234,56", "pt-PT", "1234.56"},
+
+			// Grouping with apostrophes
+			{"1\u2019234.56", "de-CH", "1234.56"},
+
+			// Tags
+			{"1.234,56", "DE_de", "1234.56"},
+			{"1\u2019234.56", "de-Latn-CH", "1234.56"},
+			{"1.234,56", "de-DE-u-nu-latn", "1234.56"},
+			{"1,234.56", "zh-Hans-CN", "1234.56"},
+		}
+		for _, tt := range tests {
+			got, err := ParseLocale(tt.s, tt.tag)
+			if err != nil {
+				t.Errorf("ParseLocale(%q, %q) failed: %v", tt.s, tt.tag, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("ParseLocale(%q, %q) = %q, want %q", tt.s, tt.tag, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			s, tag  string
+			wantErr error
+		}{
+			{"1.234,56", "xx", errInvalidOperation},
+			{"1.234,56", "", errInvalidOperation},
+			{"", "de", errInvalidDecimal},
+			{"-", "de", errInvalidDecimal},
+			{",", "de", errInvalidDecimal},
+			{"1.234.56", "de", errInvalidDecimal},
+			{"1,234.56", "de", errInvalidDecimal},
+			{"1.23,4", "de", errInvalidDecimal},
+			{"1234.567", "de", errInvalidDecimal},
+			{".234", "de", errInvalidDecimal},
+			{"1..234", "de", errInvalidDecimal},
+			{"1.234.", "de", errInvalidDecimal},
+			{"1,5e3", "de", errInvalidDecimal},
+			{"1,2.345", "de", errInvalidDecimal},
+			{"1 234,56", "de", errInvalidDecimal},
+			{"--1", "de", errInvalidDecimal},
+			{"1 234.56", "en", errInvalidDecimal},
+			{"Inf", "en", errInvalidDecimal},
+			{"NaN", "en", errInvalidDecimal},
+			{"12.345.678.901.234.567.890", "de", errDecimalOverflow},
+		}
+		for _, tt := range tests {
+			_, err := ParseLocale(tt.s, tt.tag)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseLocale(%q, %q) did not fail with %v, got %v", tt.s, tt.tag, tt.wantErr, err)
+			}
+		}
+	})
+}
+
+func TestDecimal_StringLocale(t *testing.T) {
+	tests := []struct {
+		d, tag string
+		want   string
+	}{
+		{"1234.56", "de-DE", "1.234,56"},
+		{"-1234567.890", "de", "-1.234.567,890"},
+		{"1234.56", "en-US", "1,234.56"},
+		{"1234.56", "fr-FR", "1\u202f234,56"},
+		{"1234.56", "fr-CA", "1\u00a0234,56"},
+		{"1234.56", "de-CH", "1\u2019234.56"},
+		{"0.5", "it", "0,5"},
+		{"123", "de", "123"},
+		{"1234.56", "xx", "1234.56"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.StringLocale(tt.tag)
+		if got != tt.want {
+			t.Errorf("%q.StringLocale(%q) = %q, want %q", d, tt.tag, got, tt.want)
+			continue
+		}
+		if _, ok := LocaleOptions(tt.tag); !ok {
+			continue
+		}
+		e, err := ParseLocale(got, tt.tag)
+		if err != nil {
+			t.Errorf("ParseLocale(%q, %q) failed: %v", got, tt.tag, err)
+			continue
+		}
+		if e != d {
+			t.Errorf("ParseLocale(%q, %q) = %q, want %q", got, tt.tag, e, d)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package decimal
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+)
+
+// Map is a collection of values keyed by decimals and ordered by their
+// numeric values.
+// Keys with equal numeric values, such as 1 and 1.00, are considered
+// the same key, and they are stored in the canonical form with trailing zeros
+// removed.
+// Map is useful for tier tables and price ladders.
+// The zero value is an empty map ready to use.
+// Map is not safe for concurrent use by multiple goroutines.
+type Map[V any] struct {
+	keys []Decimal
+	vals []V
+}
+
+// search returns the position where the key is or would be inserted
+// and whether the key is present in the map.
+func (m *Map[V]) search(k Decimal) (int, bool) {
+	return slices.BinarySearchFunc(m.keys, k, Decimal.Cmp)
+}
+
+// Set associates the value with the key, replacing any existing value.
+func (m *Map[V]) Set(k Decimal, v V) {
+	i, ok := m.search(k)
+	if ok {
+		m.vals[i] = v
+		return
+	}
+	m.keys = slices.Insert(m.keys, i, k.Trim(0))
+	m.vals = slices.Insert(m.vals, i, v)
+}
+
+// Get returns the value associated with the key and true,
+// or the zero value and false if the key is not present in the map.
+func (m *Map[V]) Get(k Decimal) (V, bool) {
+	i, ok := m.search(k)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return m.vals[i], true
+}
+
+// Delete removes the key from the map and returns true,
+// or returns false if the key is not present in the map.
+func (m *Map[V]) Delete(k Decimal) bool {
+	i, ok := m.search(k)
+	if !ok {
+		return false
+	}
+	m.keys = slices.Delete(m.keys, i, i+1)
+	m.vals = slices.Delete(m.vals, i, i+1)
+	return true
+}
+
+// Len returns the number of keys in the map.
+func (m *Map[V]) Len() int {
+	return len(m.keys)
+}
+
+// Keys returns a copy of the keys of the map in ascending order.
+func (m *Map[V]) Keys() []Decimal {
+	return slices.Clone(m.keys)
+}
+
+// Range calls f for each key and value of the map in ascending order
+// of keys.
+// If f returns false, Range stops the iteration.
+// The map must not be modified during the iteration.
+func (m *Map[V]) Range(f func(k Decimal, v V) bool) {
+	for i, k := range m.keys {
+		if !f(k, m.vals[i]) {
+			return
+		}
+	}
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The map is marshaled as a JSON object with keys in the canonical form,
+// sorted in ascending order of their numeric values, for example,
+// {"0":"basic","100":"silver"}.
+// Unlike a built-in map[Decimal]V, which uses [Decimal.MarshalText] for keys,
+// Map never emits different keys for numerically equal decimals,
+// such as "1" and "1.0".
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (m Map[V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('"')
+		buf.WriteString(k.String())
+		buf.WriteString(`":`)
+		v, err := json.Marshal(m.vals[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// Keys must be valid decimals, as described in [Parse].
+// Keys with equal numeric values, such as "1" and "1.0", are merged
+// into the same key, and the value that appears last wins.
+// As with built-in maps, the unmarshaled entries are added to the existing
+// entries, and a JSON null leaves the map unchanged.
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (m *Map[V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('{') {
+		return errorf("unmarshaling %T: expected JSON object, got %v", m, redact(tok))
+	}
+	for dec.More() {
+		tok, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		k, err := Parse(tok.(string)) // object keys are always strings
+		if err != nil {
+			return errorf("unmarshaling %T: %w", m, err)
+		}
+		var v V
+		err = dec.Decode(&v)
+		if err != nil {
+			return err
+		}
+		m.Set(k, v)
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// Set is a collection of unique decimals ordered by their numeric values.
+// Decimals with equal numeric values, such as 1 and 1.00, are considered
+// the same element, and they are stored in the canonical form with trailing
+// zeros removed.
+// The zero value is an empty set ready to use.
+// Set is not safe for concurrent use by multiple goroutines.
+type Set struct {
+	m Map[struct{}]
+}
+
+// NewSet returns a set containing the given decimals.
+func NewSet(d ...Decimal) *Set {
+	s := new(Set)
+	for _, e := range d {
+		s.Add(e)
+	}
+	return s
+}
+
+// Add adds the decimal to the set and returns true,
+// or returns false if the set already contains an equal decimal.
+func (s *Set) Add(d Decimal) bool {
+	if s.Contains(d) {
+		return false
+	}
+	s.m.Set(d, struct{}{})
+	return true
+}
+
+// Remove removes the decimal from the set and returns true,
+// or returns false if the set does not contain an equal decimal.
+func (s *Set) Remove(d Decimal) bool {
+	return s.m.Delete(d)
+}
+
+// Contains returns true if the set contains a decimal equal to d.
+func (s *Set) Contains(d Decimal) bool {
+	_, ok := s.m.search(d)
+	return ok
+}
+
+// Len returns the number of decimals in the set.
+func (s *Set) Len() int {
+	return s.m.Len()
+}
+
+// Values returns a copy of the decimals of the set in ascending order.
+func (s *Set) Values() []Decimal {
+	return s.m.Keys()
+}
+
+// Range calls f for each decimal of the set in ascending order.
+// If f returns false, Range stops the iteration.
+// The set must not be modified during the iteration.
+func (s *Set) Range(f func(d Decimal) bool) {
+	s.m.Range(func(k Decimal, _ struct{}) bool {
+		return f(k)
+	})
+}
@@ -0,0 +1,206 @@
+package decimal
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	var m Map[string]
+	if got := m.Len(); got != 0 {
+		t.Errorf("Len() = %v, want 0", got)
+	}
+	if _, ok := m.Get(One); ok {
+		t.Errorf("Get(1) on empty map returned true")
+	}
+
+	m.Set(MustParse("100.00"), "silver")
+	m.Set(MustParse("0"), "basic")
+	m.Set(MustParse("1000"), "gold")
+	m.Set(MustParse("-5.50"), "debt")
+	m.Set(MustParse("100.0"), "bronze") // replaces 100.00
+
+	if got, want := m.Len(), 4; got != want {
+		t.Errorf("Len() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		k    string
+		want string
+		ok   bool
+	}{
+		{"100", "bronze", true},
+		{"100.0000", "bronze", true},
+		{"0.00", "basic", true},
+		{"-5.5", "debt", true},
+		{"1000.0", "gold", true},
+		{"100.01", "", false},
+		{"5.5", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := m.Get(MustParse(tt.k))
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Get(%q) = %q, %v, want %q, %v", tt.k, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	wantKeys := []Decimal{MustParse("-5.5"), MustParse("0"), MustParse("100"), MustParse("1000")}
+	if got := m.Keys(); !slices.Equal(got, wantKeys) {
+		t.Errorf("Keys() = %v, want %v", got, wantKeys)
+	}
+
+	var gotVals []string
+	m.Range(func(_ Decimal, v string) bool {
+		gotVals = append(gotVals, v)
+		return v != "bronze"
+	})
+	if want := []string{"debt", "basic", "bronze"}; !slices.Equal(gotVals, want) {
+		t.Errorf("Range() visited %v, want %v", gotVals, want)
+	}
+
+	if !m.Delete(MustParse("0.000")) {
+		t.Errorf("Delete(0.000) = false, want true")
+	}
+	if m.Delete(MustParse("0")) {
+		t.Errorf("Delete(0) = true, want false")
+	}
+	wantKeys = []Decimal{MustParse("-5.5"), MustParse("100"), MustParse("1000")}
+	if got := m.Keys(); !slices.Equal(got, wantKeys) {
+		t.Errorf("Keys() = %v, want %v", got, wantKeys)
+	}
+}
+
+func TestMap_JSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want string
+		}{
+			{`{}`, `{}`},
+			{`{"1":"a"}`, `{"1":"a"}`},
+			{`{"100.00":"silver","0":"basic","-5.50":"debt"}`, `{"-5.5":"debt","0":"basic","100":"silver"}`},
+			{`{"1":"a","1.0":"b"}`, `{"1":"b"}`},
+			{`{"1.0":"b","1":"a"}`, `{"1":"a"}`},
+			{`{"100":"a","100.0":"b"}`, `{"100":"b"}`},
+		}
+		for _, tt := range tests {
+			var m Map[string]
+			err := json.Unmarshal([]byte(tt.s), &m)
+			if err != nil {
+				t.Errorf("json.Unmarshal(%q) failed: %v", tt.s, err)
+				continue
+			}
+			got, err := json.Marshal(m)
+			if err != nil {
+				t.Errorf("json.Marshal(%v) failed: %v", m.Keys(), err)
+				continue
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal(%v) = %s, want %s", m.Keys(), got, tt.want)
+			}
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		var m Map[int]
+		m.Set(MustParse("1.00"), 1)
+		m.Set(MustParse("2"), 2)
+		err := json.Unmarshal([]byte(`{"2.0":20,"3":30}`), &m)
+		if err != nil {
+			t.Fatalf("json.Unmarshal() failed: %v", err)
+		}
+		err = json.Unmarshal([]byte(`null`), &m)
+		if err != nil {
+			t.Fatalf("json.Unmarshal(null) failed: %v", err)
+		}
+		got, err := json.Marshal(struct{ Tiers Map[int] }{m})
+		if err != nil {
+			t.Fatalf("json.Marshal() failed: %v", err)
+		}
+		want := `{"Tiers":{"1":1,"2":20,"3":30}}`
+		if string(got) != want {
+			t.Errorf("json.Marshal() = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("builtin", func(t *testing.T) {
+		m := map[Decimal]string{}
+		err := json.Unmarshal([]byte(`{"1":"a","2.50":"b"}`), &m)
+		if err != nil {
+			t.Fatalf("json.Unmarshal() failed: %v", err)
+		}
+		if got := m[MustParse("2.50")]; got != "b" {
+			t.Errorf("m[2.50] = %q, want %q", got, "b")
+		}
+		got, err := json.Marshal(m)
+		if err != nil {
+			t.Fatalf("json.Marshal() failed: %v", err)
+		}
+		want := `{"1":"a","2.50":"b"}`
+		if string(got) != want {
+			t.Errorf("json.Marshal() = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			`[]`,
+			`"1"`,
+			`{"a":"b"}`,
+			`{"1":1}`,
+			`{"1":"a"`,
+			`{"1":"a"}}`,
+		}
+		for _, tt := range tests {
+			var m Map[string]
+			err := json.Unmarshal([]byte(tt), &m)
+			if err == nil {
+				t.Errorf("json.Unmarshal(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestSet(t *testing.T) {
+	s := NewSet(MustParse("1.00"), MustParse("0.5"), MustParse("1"), MustParse("-2"))
+	if got, want := s.Len(), 3; got != want {
+		t.Errorf("Len() = %v, want %v", got, want)
+	}
+	if s.Add(MustParse("0.50")) {
+		t.Errorf("Add(0.50) = true, want false")
+	}
+	if !s.Add(MustParse("0.25")) {
+		t.Errorf("Add(0.25) = false, want true")
+	}
+	if !s.Contains(MustParse("1.0")) {
+		t.Errorf("Contains(1.0) = false, want true")
+	}
+	if s.Contains(MustParse("2")) {
+		t.Errorf("Contains(2) = true, want false")
+	}
+
+	want := []Decimal{MustParse("-2"), MustParse("0.25"), MustParse("0.5"), MustParse("1")}
+	if got := s.Values(); !slices.Equal(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+
+	var got []Decimal
+	s.Range(func(d Decimal) bool {
+		got = append(got, d)
+		return len(got) < 2
+	})
+	if !slices.Equal(got, want[:2]) {
+		t.Errorf("Range() visited %v, want %v", got, want[:2])
+	}
+
+	if !s.Remove(MustParse("-2.000")) {
+		t.Errorf("Remove(-2.000) = false, want true")
+	}
+	if s.Remove(MustParse("-2")) {
+		t.Errorf("Remove(-2) = true, want false")
+	}
+	if got, want := s.Len(), 3; got != want {
+		t.Errorf("Len() = %v, want %v", got, want)
+	}
+}
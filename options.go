@@ -0,0 +1,215 @@
+package decimal
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Option configures [ParseWith], [MarshalJSONWith], and [UnmarshalJSONWith].
+// Options that do not apply to an entry point are ignored by it,
+// so the same set of options can be shared by parsing and marshaling code:
+//
+//	opts := []decimal.Option{decimal.WithScale(2), decimal.WithRounding(decimal.HalfUp)}
+//	d, err := decimal.ParseWith(s, opts...)
+//	b, err := decimal.MarshalJSONWith(d, opts...)
+//
+// Options are applied in order, and later options override earlier ones.
+type Option func(*options)
+
+// options holds the configuration built from a list of [Option] values.
+type options struct {
+	scale    int
+	rescale  bool
+	mode     RoundingMode
+	lenient  bool
+	limits   ParseLimits
+	unquoted bool
+	redact   bool
+}
+
+// newOptions applies the options to the default configuration.
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// apply rounds or zero-pads the decimal to the scale of the options,
+// as described in [WithScale].
+func (o options) apply(d Decimal) (Decimal, error) {
+	if !o.rescale {
+		return d, nil
+	}
+	return Domain{Scale: o.scale, Mode: o.mode}.Rescale(d)
+}
+
+// error redacts the error, as described in [WithRedaction].
+func (o options) error(err error) error {
+	if o.redact {
+		return RedactError(err)
+	}
+	return err
+}
+
+// WithScale returns an option that rounds or zero-pads decimals to the given
+// number of digits after the decimal point, as in [Domain.Rescale].
+// Parsed decimals are rounded only once from the exact value of the string,
+// and marshaled decimals are rescaled before marshaling.
+// The rounding mode is specified by [WithRounding].
+func WithScale(scale int) Option {
+	return func(o *options) {
+		o.scale, o.rescale = scale, true
+	}
+}
+
+// WithRounding returns an option that specifies the rounding mode used by
+// [WithScale].
+// Without WithScale, this option has no effect.
+// The default mode is [HalfEven].
+func WithRounding(mode RoundingMode) Option {
+	return func(o *options) {
+		o.mode = mode
+	}
+}
+
+// WithLenient returns an option that normalizes strings before parsing,
+// as described in [ParseLenient].
+// Marshaling ignores this option.
+func WithLenient() Option {
+	return func(o *options) {
+		o.lenient = true
+	}
+}
+
+// WithLimits returns an option that enforces the given limits on the input
+// of parsing, as described in [ParseLimits].
+// Marshaling ignores this option.
+func WithLimits(l ParseLimits) Option {
+	return func(o *options) {
+		o.limits = l
+	}
+}
+
+// WithUnquoted returns an option that represents decimals as JSON numbers,
+// such as 1.23, instead of JSON strings, such as "1.23".
+// [MarshalJSONWith] produces JSON numbers, and [UnmarshalJSONWith]
+// accepts both JSON numbers and JSON strings.
+// Note that many JSON decoders convert numbers to float64, which may lose
+// precision.
+// [ParseWith] ignores this option.
+func WithUnquoted() Option {
+	return func(o *options) {
+		o.unquoted = true
+	}
+}
+
+// WithRedaction returns an option that hides the digits of decimals
+// in error messages, as described in [RedactError].
+func WithRedaction() Option {
+	return func(o *options) {
+		o.redact = true
+	}
+}
+
+// ParseWith converts a string to a decimal as described in [Parse],
+// configured by the given options:
+//
+//	d, err := decimal.ParseWith(s, decimal.WithScale(2), decimal.WithRounding(decimal.HalfUp), decimal.WithLenient())
+//
+// Without options, ParseWith is equivalent to [Parse].
+// The applicable options are [WithScale], [WithRounding], [WithLenient],
+// [WithLimits], and [WithRedaction].
+//
+// ParseWith returns an error if:
+//   - the string cannot be parsed by [ParseLimits.Parse];
+//   - the decimal cannot be rescaled as described in [Domain.Rescale].
+func ParseWith(s string, opts ...Option) (Decimal, error) {
+	o := newOptions(opts)
+	d, err := o.parse(s)
+	if err != nil {
+		return Decimal{}, o.error(err)
+	}
+	return d, nil
+}
+
+// parse is like [ParseWith], but it does not redact errors.
+func (o options) parse(s string) (Decimal, error) {
+	if o.lenient {
+		s = normalizeLenient(s)
+	}
+	if o.rescale {
+		return o.limits.parseMode(s, o.scale, o.mode, nil)
+	}
+	return o.limits.Parse(s)
+}
+
+// MarshalJSONWith returns the JSON encoding of the decimal configured by
+// the given options.
+// Without options, the decimal is encoded as a JSON string, such as "1.23",
+// the same way as [encoding/json] encodes it using [Decimal.MarshalText].
+// The applicable options are [WithScale], [WithRounding], [WithUnquoted],
+// and [WithRedaction].
+//
+// MarshalJSONWith returns an error if the decimal cannot be rescaled
+// as described in [Domain.Rescale].
+func MarshalJSONWith(d Decimal, opts ...Option) ([]byte, error) {
+	o := newOptions(opts)
+	f, err := o.apply(d)
+	if err != nil {
+		return nil, o.error(errorf("marshaling %v: %w", redact(d), err))
+	}
+	text, err := f.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	if o.unquoted {
+		return text, nil
+	}
+	b := make([]byte, 0, len(text)+2)
+	b = append(b, '"')
+	b = append(b, text...)
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSONWith converts the JSON encoding of a decimal to a decimal
+// configured by the given options.
+// Without options, only JSON strings, such as "1.23", are accepted,
+// the same way as [encoding/json] decodes them using [Decimal.UnmarshalText].
+// JSON null is converted to zero.
+// The applicable options are [WithScale], [WithRounding], [WithLenient],
+// [WithLimits], [WithUnquoted], and [WithRedaction].
+//
+// UnmarshalJSONWith returns an error if:
+//   - the data is not a JSON string, or a JSON number with [WithUnquoted];
+//   - the decimal cannot be parsed as described in [ParseWith].
+func UnmarshalJSONWith(data []byte, opts ...Option) (Decimal, error) {
+	o := newOptions(opts)
+	d, err := o.unmarshalJSON(data)
+	if err != nil {
+		return Decimal{}, o.error(err)
+	}
+	return d, nil
+}
+
+// unmarshalJSON is like [UnmarshalJSONWith], but it does not redact errors.
+func (o options) unmarshalJSON(data []byte) (Decimal, error) {
+	data = bytes.TrimSpace(data)
+	switch {
+	case string(data) == "null":
+		return o.apply(Decimal{})
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return Decimal{}, errorf("unmarshaling decimal: %w: %w", errInvalidDecimal, err)
+		}
+		return o.parse(s)
+	case o.unquoted && json.Valid(data):
+		return o.parse(string(data))
+	}
+	return Decimal{}, errorf("unmarshaling decimal: %w: unexpected JSON value %.20q", errInvalidDecimal, redact(data))
+}
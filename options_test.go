@@ -0,0 +1,196 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseWith(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			opts []Option
+			want string
+		}{
+			{"1.235", nil, "1.235"},
+			{"1.235", []Option{WithScale(2)}, "1.24"},
+			{"1.225", []Option{WithScale(2)}, "1.22"},
+			{"1.225", []Option{WithScale(2), WithRounding(HalfUp)}, "1.23"},
+			{"1.225", []Option{WithRounding(HalfUp), WithScale(2)}, "1.23"},
+			{"1.229", []Option{WithScale(2), WithRounding(Down)}, "1.22"},
+			{"1", []Option{WithScale(2)}, "1.00"},
+			{"1.5", []Option{WithRounding(Up)}, "1.5"},
+			{"0.9999999999999999999", []Option{WithScale(19)}, "0.9999999999999999999"},
+			{" −1.23 ", []Option{WithLenient()}, "-1.23"},
+			{"１．２３５", []Option{WithLenient(), WithScale(2), WithRounding(Floor)}, "1.23"},
+			{"1.23", []Option{WithLimits(ParseLimits{MaxLength: 4})}, "1.23"},
+			{"1.23", []Option{nil, WithScale(5), WithScale(1)}, "1.2"},
+			{"1.23", []Option{WithUnquoted()}, "1.23"},
+		}
+		for _, tt := range tests {
+			got, err := ParseWith(tt.s, tt.opts...)
+			if err != nil {
+				t.Errorf("ParseWith(%q) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseWith(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("single rounding", func(t *testing.T) {
+		if !hasBint {
+			t.Skip("exact parsing of long strings requires *big.Int arithmetic")
+		}
+		tests := []struct {
+			s    string
+			opts []Option
+			want string
+		}{
+			{"0.99999999999999999995", []Option{WithScale(2), WithRounding(Down)}, "0.99"},
+			{"-0.99999999999999999995", []Option{WithScale(2), WithRounding(Ceiling)}, "-0.99"},
+			{"0.12345678901234567895", []Option{WithScale(19), WithRounding(HalfEven)}, "0.1234567890123456790"},
+			{"0.12345678901234567885", []Option{WithScale(19), WithRounding(HalfEven)}, "0.1234567890123456788"},
+			{"0.12345678901234567885", []Option{WithScale(19), WithRounding(Up)}, "0.1234567890123456789"},
+			{"0.12345678901234567894", []Option{WithScale(19), WithRounding(HalfUp)}, "0.1234567890123456789"},
+			{"0.44999999999999999999", []Option{WithScale(1), WithRounding(HalfUp)}, "0.4"},
+			{"0.00000000000000000049", []Option{WithScale(18), WithRounding(HalfUp)}, "0.000000000000000000"},
+			{"1.00000000000000000001", []Option{WithScale(0), WithRounding(Up)}, "2"},
+			{"999999999999999999.95", []Option{WithScale(0), WithRounding(Floor)}, "999999999999999999"},
+			{"1.5e-20", []Option{WithScale(19), WithRounding(Up)}, "0.0000000000000000001"},
+			{"12e2", []Option{WithScale(1)}, "1200.0"},
+		}
+		for _, tt := range tests {
+			got, err := ParseWith(tt.s, tt.opts...)
+			if err != nil {
+				t.Errorf("ParseWith(%q) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseWith(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			s       string
+			opts    []Option
+			wantErr error
+		}{
+			{"", nil, nil},
+			{" 1.23", nil, nil},
+			{"−1.23", nil, nil},
+			{"1.23", []Option{WithScale(-1)}, errScaleRange},
+			{"1.23", []Option{WithScale(20)}, errScaleRange},
+			{"1000000000000000000", []Option{WithScale(2)}, errDecimalOverflow},
+			{"9999999999999999999.5", []Option{WithScale(0), WithRounding(Up)}, errDecimalOverflow},
+			{"12.345", []Option{WithLimits(ParseLimits{MaxLength: 4})}, errInvalidDecimal},
+		}
+		for _, tt := range tests {
+			_, err := ParseWith(tt.s, tt.opts...)
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Errorf("ParseWith(%q) error = %v, want %v", tt.s, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestMarshalJSONWith(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			opts []Option
+			want string
+		}{
+			{"1.235", nil, `"1.235"`},
+			{"-1.235", []Option{WithUnquoted()}, `-1.235`},
+			{"1.225", []Option{WithScale(2), WithRounding(HalfUp)}, `"1.23"`},
+			{"1", []Option{WithScale(2), WithUnquoted()}, `1.00`},
+			{"1.23", []Option{WithLenient(), WithLimits(ParseLimits{MaxLength: 1})}, `"1.23"`},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := MarshalJSONWith(d, tt.opts...)
+			if err != nil {
+				t.Errorf("MarshalJSONWith(%q) failed: %v", d, err)
+				continue
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSONWith(%q) = %s, want %s", d, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d       string
+			opts    []Option
+			wantErr error
+		}{
+			{"1", []Option{WithScale(20)}, errScaleRange},
+			{"1000000000000000000", []Option{WithScale(2)}, errDecimalOverflow},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := MarshalJSONWith(d, tt.opts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("MarshalJSONWith(%q) error = %v, want %v", d, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestUnmarshalJSONWith(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data string
+			opts []Option
+			want string
+		}{
+			{`"1.235"`, nil, "1.235"},
+			{` "1.235" `, nil, "1.235"},
+			{`"1.5"`, nil, "1.5"},
+			{`null`, nil, "0"},
+			{`null`, []Option{WithScale(2)}, "0.00"},
+			{`1.235`, []Option{WithUnquoted()}, "1.235"},
+			{`-1.225`, []Option{WithUnquoted(), WithScale(2), WithRounding(HalfUp)}, "-1.23"},
+			{`"1.225"`, []Option{WithUnquoted(), WithScale(2), WithRounding(HalfUp)}, "1.23"},
+			{`" −1.23"`, []Option{WithLenient()}, "-1.23"},
+		}
+		for _, tt := range tests {
+			got, err := UnmarshalJSONWith([]byte(tt.data), tt.opts...)
+			if err != nil {
+				t.Errorf("UnmarshalJSONWith(%s) failed: %v", tt.data, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("UnmarshalJSONWith(%s) = %q, want %q", tt.data, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			data    string
+			opts    []Option
+			wantErr error
+		}{
+			{``, nil, errInvalidDecimal},
+			{`1.23`, nil, errInvalidDecimal},
+			{`"1.23`, nil, errInvalidDecimal},
+			{`""`, nil, nil},
+			{`true`, []Option{WithUnquoted()}, nil},
+			{`[1]`, []Option{WithUnquoted()}, nil},
+			{`1.23.4`, []Option{WithUnquoted()}, nil},
+			{`"1.23"`, []Option{WithScale(20)}, errScaleRange},
+		}
+		for _, tt := range tests {
+			_, err := UnmarshalJSONWith([]byte(tt.data), tt.opts...)
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Errorf("UnmarshalJSONWith(%s) error = %v, want %v", tt.data, err, tt.wantErr)
+			}
+		}
+	})
+}
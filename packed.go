@@ -0,0 +1,134 @@
+package decimal
+
+import (
+	"encoding/binary"
+)
+
+// Packed is a compact 9-byte representation of a decimal, intended for
+// storing large numbers of decimals in memory, for example, in slices
+// backed by memory-mapped files.
+// A slice of Packed values takes 9 bytes per decimal, while a slice of
+// [Decimal] values takes 16 bytes per decimal.
+//
+// The first byte holds the sign in the most significant bit and the scale
+// in the 5 least significant bits.
+// The remaining 8 bytes hold the coefficient in little-endian byte order.
+// The layout does not depend on the platform, so Packed values can be
+// shared between processes and machines.
+// The zero value of Packed represents the decimal value of 0.
+//
+// Packed does not provide any arithmetic: use [Pack] to create a Packed value
+// and [Packed.Unpack] to convert it back to a decimal.
+type Packed [9]byte
+
+// Pack returns the compact representation of a decimal.
+// See also method [Packed.Unpack].
+func Pack(d Decimal) Packed {
+	var p Packed
+	//nolint:gosec
+	p[0] = byte(d.Scale())
+	if d.IsNeg() {
+		p[0] |= 0x80
+	}
+	binary.LittleEndian.PutUint64(p[1:], uint64(d.coef))
+	return p
+}
+
+// Unpack converts the compact representation back to a decimal.
+// See also function [Pack].
+//
+// Unpack returns an error if the representation was not created by [Pack],
+// for example, if the underlying memory is corrupted:
+//   - the reserved bits of the first byte are set;
+//   - the scale is greater than [MaxScale];
+//   - the coefficient has more than [MaxPrec] digits;
+//   - the sign is negative and the coefficient is zero.
+func (p Packed) Unpack() (Decimal, error) {
+	if p[0]&0x60 != 0 {
+		return Decimal{}, errorf("unpacking decimal: %w: reserved bits \"%x\"", errInvalidDecimal, p[0])
+	}
+	neg := p[0]&0x80 != 0
+	scale := int(p[0] & 0x1f)
+	coef := fint(binary.LittleEndian.Uint64(p[1:]))
+	if neg && coef == 0 {
+		return Decimal{}, errorf("unpacking decimal: %w: negative zero", errInvalidDecimal)
+	}
+	d, err := newSafe(neg, coef, scale)
+	if err != nil {
+		return Decimal{}, errorf("unpacking decimal: %w", err)
+	}
+	return d, nil
+}
+
+// ReadonlyArray is a read-only view of decimals stored one after another
+// in the [Packed] representation, for example, in a rate table backed by
+// a memory-mapped file shared between processes.
+// Decimals are decoded lazily by [ReadonlyArray.At], so the table does not
+// need to be loaded into the heap as a slice of decimals.
+// The zero value of ReadonlyArray is an empty array.
+// ReadonlyArray is safe for concurrent use by multiple goroutines,
+// as long as the underlying bytes are not modified.
+type ReadonlyArray struct {
+	b []byte
+}
+
+// NewReadonlyArray returns a view of the decimals stored in the given bytes.
+// The bytes are not copied, so they must not be modified while the view is used.
+// To build the bytes, append the results of [Pack] one after another.
+// The decimals are not decoded, so NewReadonlyArray takes constant time
+// regardless of the size of the array: each decimal is validated when
+// it is read by [ReadonlyArray.At].
+// Use [ReadonlyArray.Validate] to validate all decimals up front.
+//
+// NewReadonlyArray returns an error if the length of the bytes is not
+// a multiple of the size of [Packed].
+func NewReadonlyArray(b []byte) (ReadonlyArray, error) {
+	var p Packed
+	if len(b)%len(p) != 0 {
+		return ReadonlyArray{}, errorf("creating array: %w: length %v is not a multiple of %v", errInvalidDecimal, len(b), len(p))
+	}
+	// Clip the capacity, so that Packed panics for out-of-range indices
+	// instead of reading past the end of the bytes.
+	return ReadonlyArray{b: b[:len(b):len(b)]}, nil
+}
+
+// Validate decodes all decimals in the array and returns an error
+// if any of them is not a valid [Packed] representation.
+// Validate takes time proportional to the size of the array.
+// See also method [Packed.Unpack].
+func (a ReadonlyArray) Validate() error {
+	for i := range a.Len() {
+		if _, err := a.Packed(i).Unpack(); err != nil {
+			return errorf("validating array: element %v: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Len returns the number of decimals in the array.
+func (a ReadonlyArray) Len() int {
+	var p Packed
+	return len(a.b) / len(p)
+}
+
+// Packed returns the compact representation of the i-th decimal.
+// Packed panics if i is out of range.
+func (a ReadonlyArray) Packed(i int) Packed {
+	var p Packed
+	copy(p[:], a.b[i*len(p):(i+1)*len(p)])
+	return p
+}
+
+// At returns the i-th decimal.
+// At panics if i is out of range.
+// See also method [Packed.Unpack].
+//
+// At returns an error if the i-th decimal is not a valid [Packed]
+// representation, for example, if the underlying memory is corrupted.
+func (a ReadonlyArray) At(i int) (Decimal, error) {
+	d, err := a.Packed(i).Unpack()
+	if err != nil {
+		return Decimal{}, errorf("reading element %v: %w", i, err)
+	}
+	return d, nil
+}
@@ -0,0 +1,200 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+)
+
+func TestPacked(t *testing.T) {
+	if got := unsafe.Sizeof(Packed{}); got != 9 {
+		t.Errorf("unsafe.Sizeof(Packed{}) = %v, want %v", got, 9)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []string{
+			"0", "0.00", "1", "-1", "0.1", "-0.1", "1.23", "-1.23",
+			"9999999999999999999", "-9999999999999999999",
+			"0.0000000000000000001", "-0.0000000000000000001",
+			"0.9999999999999999999", "-0.9999999999999999999",
+		}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			got, err := Pack(d).Unpack()
+			if err != nil {
+				t.Errorf("Pack(%q).Unpack() failed: %v", d, err)
+				continue
+			}
+			if got != d {
+				t.Errorf("Pack(%q).Unpack() = %q, want %q", d, got, d)
+			}
+		}
+
+		var p Packed
+		got, err := p.Unpack()
+		if err != nil {
+			t.Errorf("Packed{}.Unpack() failed: %v", err)
+		} else if got != Zero {
+			t.Errorf("Packed{}.Unpack() = %q, want %q", got, Zero)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]Packed{
+			"reserved bit 5": {0x20, 1},
+			"reserved bit 6": {0x40, 1},
+			"scale range":    {0x14, 1},
+			"negative zero":  {0x80},
+			"overflow":       {0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		}
+		for name, p := range tests {
+			_, err := p.Unpack()
+			if err == nil {
+				t.Errorf("Unpack(%v) did not fail", name)
+			}
+		}
+	})
+}
+
+func TestReadonlyArray(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []string{"0", "-1.23", "9999999999999999999", "0.0000000000000000001", "1.50"}
+		var b []byte
+		for _, tt := range tests {
+			p := Pack(MustParse(tt))
+			b = append(b, p[:]...)
+		}
+		a, err := NewReadonlyArray(b)
+		if err != nil {
+			t.Fatalf("NewReadonlyArray() failed: %v", err)
+		}
+		if err := a.Validate(); err != nil {
+			t.Errorf("Validate() failed: %v", err)
+		}
+		if got := a.Len(); got != len(tests) {
+			t.Errorf("Len() = %v, want %v", got, len(tests))
+		}
+		for i, tt := range tests {
+			want := MustParse(tt)
+			got, err := a.At(i)
+			if err != nil {
+				t.Errorf("At(%v) failed: %v", i, err)
+			} else if got != want {
+				t.Errorf("At(%v) = %q, want %q", i, got, want)
+			}
+			if got := a.Packed(i); got != Pack(want) {
+				t.Errorf("Packed(%v) = %x, want %x", i, got, Pack(want))
+			}
+		}
+
+		// Lazy decoding does not allocate
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = a.At(1)
+		})
+		if allocs != 0 {
+			t.Errorf("At() allocations = %v, want 0", allocs)
+		}
+
+		// Empty arrays
+		for _, b := range [][]byte{nil, {}} {
+			a, err := NewReadonlyArray(b)
+			if err != nil {
+				t.Errorf("NewReadonlyArray(%v) failed: %v", b, err)
+				continue
+			}
+			if err := a.Validate(); err != nil {
+				t.Errorf("NewReadonlyArray(%v).Validate() failed: %v", b, err)
+			}
+			if got := a.Len(); got != 0 {
+				t.Errorf("NewReadonlyArray(%v).Len() = %v, want 0", b, got)
+			}
+		}
+		if got := (ReadonlyArray{}).Len(); got != 0 {
+			t.Errorf("ReadonlyArray{}.Len() = %v, want 0", got)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		valid := Pack(MustParse("1.23"))
+		for name, b := range map[string][]byte{
+			"short": valid[:8],
+			"long":  append(valid[:], 0),
+		} {
+			_, err := NewReadonlyArray(b)
+			if !errors.Is(err, errInvalidDecimal) {
+				t.Errorf("NewReadonlyArray(%v) error = %v, want %v", name, err, errInvalidDecimal)
+			}
+		}
+
+		// Invalid decimals are reported lazily
+		tests := map[string][]byte{
+			"negative zero": append(valid[:], 0x80, 0, 0, 0, 0, 0, 0, 0, 0),
+			"scale range":   append(valid[:], 0x14, 1, 0, 0, 0, 0, 0, 0, 0),
+		}
+		for name, b := range tests {
+			a, err := NewReadonlyArray(b)
+			if err != nil {
+				t.Errorf("NewReadonlyArray(%v) failed: %v", name, err)
+				continue
+			}
+			if _, err := a.At(0); err != nil {
+				t.Errorf("NewReadonlyArray(%v).At(0) failed: %v", name, err)
+			}
+			_, err = a.At(1)
+			if !errors.Is(err, errInvalidDecimal) && !errors.Is(err, errScaleRange) {
+				t.Errorf("NewReadonlyArray(%v).At(1) error = %v, want %v", name, err, errInvalidDecimal)
+			}
+			err = a.Validate()
+			if !errors.Is(err, errInvalidDecimal) && !errors.Is(err, errScaleRange) {
+				t.Errorf("NewReadonlyArray(%v).Validate() error = %v, want %v", name, err, errInvalidDecimal)
+			}
+		}
+
+		// Memory modified after the array was created
+		p := Pack(MustParse("1.23"))
+		b := append([]byte(nil), p[:]...)
+		a, err := NewReadonlyArray(b)
+		if err != nil {
+			t.Fatalf("NewReadonlyArray() failed: %v", err)
+		}
+		b[0] = 0x80
+		b[1], b[2] = 0, 0
+		if _, err := a.At(0); !errors.Is(err, errInvalidDecimal) {
+			t.Errorf("At(0) error = %v, want %v", err, errInvalidDecimal)
+		}
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		p := Pack(MustParse("1.23"))
+		b := append([]byte(nil), p[:]...)
+		a, err := NewReadonlyArray(b)
+		if err != nil {
+			t.Fatalf("NewReadonlyArray() failed: %v", err)
+		}
+		// Spare capacity beyond the view
+		q := Pack(MustParse("4.56"))
+		spare := append(append([]byte(nil), p[:]...), q[:]...)
+		c, err := NewReadonlyArray(spare[:len(p)])
+		if err != nil {
+			t.Fatalf("NewReadonlyArray() failed: %v", err)
+		}
+		for name, i := range map[string]int{"negative": -1, "out of range": 1} {
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("At(%v) did not panic for %v index", i, name)
+					}
+				}()
+				_, _ = a.At(i)
+			}()
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("Packed(%v) did not panic for %v index with spare capacity", i, name)
+					}
+				}()
+				_ = c.Packed(i)
+			}()
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package decimal
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math"
+	"math/bits"
+	mrand "math/rand"
+	"math/rand/v2"
+	"reflect"
+)
+
+// RandBetween returns a uniformly distributed random decimal between lo and hi,
+// inclusive, with the specified number of digits after the decimal point.
+// All decimals with the given scale in the range are equally likely,
+// so, unlike scaling a random float64, the result is free of binary
+// floating-point bias.
+// The bounds are not required to have the given scale: lo is rounded
+// towards positive infinity and hi is rounded towards negative infinity.
+//
+// Random numbers are drawn from rnd, so the results are reproducible if rnd
+// is seeded deterministically.
+// If rnd is nil, the global random number generator is used.
+// A generator r from the math/rand package can be used as rand.New(r),
+// since it implements the [rand.Source] interface.
+// See also function [RandBetweenCrypto] and method [Decimal.Generate].
+//
+// RandBetween returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - the range does not contain any decimal with the given scale;
+//   - the bounds with the given scale have more than [MaxPrec] digits.
+func RandBetween(rnd *rand.Rand, lo, hi Decimal, scale int) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, errorf("computing [rand(%v, %v)]: %w", redact(lo), redact(hi), scaleRangeError(scale))
+	}
+
+	// Alignment
+	dlo, dhi := lo.Ceil(scale).Pad(scale), hi.Floor(scale).Pad(scale)
+	if dlo.Scale() != scale {
+		return Decimal{}, errorf("computing [rand(%v, %v)]: %w", redact(lo), redact(hi), overflowError(dlo.Prec(), dlo.Scale(), scale))
+	}
+	if dhi.Scale() != scale {
+		return Decimal{}, errorf("computing [rand(%v, %v)]: %w", redact(lo), redact(hi), overflowError(dhi.Prec(), dhi.Scale(), scale))
+	}
+	if dlo.Cmp(dhi) > 0 {
+		return Decimal{}, errorf("computing [rand(%v, %v)]: %w: empty range", redact(lo), redact(hi), errInvalidOperation)
+	}
+
+	// Compute n = hi - lo in units of 10^(-scale) as a 65-bit number
+	var carry, n uint64
+	if dlo.IsNeg() == dhi.IsNeg() {
+		n = uint64(dhi.coef.subAbs(dlo.coef))
+	} else {
+		n, carry = bits.Add64(uint64(dhi.coef), uint64(dlo.coef), 0)
+	}
+
+	// Draw the offset u uniformly from [0, n]
+	c, u := randOffset(rnd, carry, n)
+
+	// Compute d = lo + u, where the offset is split into parts that fit
+	// into the coefficient
+	d := dlo
+	var err error
+	if c != 0 || u > uint64(maxCoef) {
+		d, err = d.Add(newUnsafe(false, maxCoef, scale))
+		if err != nil {
+			return Decimal{}, errorf("computing [rand(%v, %v)]: %w", redact(lo), redact(hi), err) // Should never happen
+		}
+		u -= uint64(maxCoef) // u wraps around if c is not zero
+	}
+	d, err = d.Add(newUnsafe(false, fint(u), scale))
+	if err != nil {
+		return Decimal{}, errorf("computing [rand(%v, %v)]: %w", redact(lo), redact(hi), err) // Should never happen
+	}
+
+	return d, nil
+}
+
+// randOffset returns a uniformly distributed random 65-bit number c * 2^64 + u
+// in the range [0, carry * 2^64 + n].
+// If rnd is nil, randOffset uses the global random number generator.
+func randOffset(rnd *rand.Rand, carry, n uint64) (c, u uint64) {
+	if carry == 0 && n < math.MaxUint64 {
+		if rnd == nil {
+			return 0, rand.Uint64N(n + 1)
+		}
+		return 0, rnd.Uint64N(n + 1)
+	}
+	// Rejection sampling, which succeeds with probability of at least 1/2
+	for {
+		if rnd == nil {
+			c, u = rand.Uint64()&1, rand.Uint64()
+		} else {
+			c, u = rnd.Uint64()&1, rnd.Uint64()
+		}
+		if c < carry || (c == carry && u <= n) {
+			return c, u
+		}
+	}
+}
+
+// Generate implements the [quick.Generator] interface, so that decimals can
+// be used as arguments of properties checked by [quick.Check]:
+//
+//	commutative := func(d, e decimal.Decimal) bool {
+//		f, err1 := d.Add(e)
+//		g, err2 := e.Add(d)
+//		return f == g && (err1 == nil) == (err2 == nil)
+//	}
+//	err := quick.Check(commutative, nil)
+//
+// The result has a random sign, a random scale between 0 and [MaxScale],
+// and a random number of digits between 1 and the smaller of size and
+// [MaxPrec], with the coefficient uniformly distributed among the integers
+// with that many digits, so that short and long decimals are equally likely.
+// Like other generators in [testing/quick], Generate draws all random numbers
+// from rnd.
+// To draw decimals from a given range, use [RandBetween].
+//
+// [quick.Generator]: https://pkg.go.dev/testing/quick#Generator
+// [quick.Check]: https://pkg.go.dev/testing/quick#Check
+// [testing/quick]: https://pkg.go.dev/testing/quick
+func (Decimal) Generate(rnd *mrand.Rand, size int) reflect.Value {
+	prec := 1 + rnd.Intn(min(max(size, 1), MaxPrec))
+	coef := fint(rand.New(rnd).Uint64N(uint64(pow10[prec])))
+	scale := rnd.Intn(MaxScale + 1)
+	neg := rnd.Intn(2) == 1
+	return reflect.ValueOf(newUnsafe(neg, coef, scale))
+}
+
+// RandBetweenCrypto is similar to [RandBetween], but it draws random numbers
+// from a cryptographically secure random number generator.
+// It is intended for cases where the result must be unpredictable,
+// for example, for randomized pricing experiments.
+//
+// RandBetweenCrypto returns an error if:
+//   - any of the conditions described in [RandBetween] occur;
+//   - the secure random number generator fails.
+func RandBetweenCrypto(lo, hi Decimal, scale int) (Decimal, error) {
+	src := new(cryptoSource)
+	d, err := RandBetween(rand.New(src), lo, hi, scale)
+	if err != nil {
+		return Decimal{}, err
+	}
+	if src.err != nil {
+		return Decimal{}, errorf("computing [rand(%v, %v)]: %w", redact(lo), redact(hi), src.err)
+	}
+	return d, nil
+}
+
+// cryptoSource is a [rand.Source] that reads random numbers from
+// the [crypto/rand] package and records the first error.
+type cryptoSource struct {
+	err error
+}
+
+func (s *cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	_, err := crand.Read(b[:])
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+	return binary.LittleEndian.Uint64(b[:])
+}
@@ -0,0 +1,195 @@
+package decimal
+
+import (
+	mrand "math/rand"
+	"math/rand/v2"
+	"testing"
+	"testing/quick"
+)
+
+func TestRandBetween(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			lo, hi string
+			scale  int
+		}{
+			{"0", "0", 0},
+			{"1", "1", 2},
+			{"0", "1", 0},
+			{"0", "1", 2},
+			{"-1", "1", 1},
+			{"-2.5", "-1.5", 1},
+			{"0.001", "0.999", 1},
+			{"1.05", "1.06", 2},
+			{"-9999999999999999999", "9999999999999999999", 0},
+			{"0", "9999999999999999999", 0},
+			{"-0.9999999999999999999", "0.9999999999999999999", 19},
+			{"0", "0.0000000000000000001", 19},
+		}
+		rnd := rand.New(rand.NewPCG(1, 2))
+		for _, tt := range tests {
+			lo, hi := MustParse(tt.lo), MustParse(tt.hi)
+			for range 100 {
+				got, err := RandBetween(rnd, lo, hi, tt.scale)
+				if err != nil {
+					t.Errorf("RandBetween(%q, %q, %v) failed: %v", lo, hi, tt.scale, err)
+					break
+				}
+				if got.Scale() != tt.scale || got.Cmp(lo) < 0 || got.Cmp(hi) > 0 {
+					t.Errorf("RandBetween(%q, %q, %v) = %q, want scale %v and value in range", lo, hi, tt.scale, got, tt.scale)
+					break
+				}
+			}
+		}
+	})
+
+	t.Run("uniform", func(t *testing.T) {
+		lo, hi := MustParse("-0.5"), MustParse("0.5")
+		rnd := rand.New(rand.NewPCG(3, 4))
+		counts := map[Decimal]int{}
+		const draws = 110000
+		for range draws {
+			d, err := RandBetween(rnd, lo, hi, 1)
+			if err != nil {
+				t.Fatalf("RandBetween(%q, %q, 1) failed: %v", lo, hi, err)
+			}
+			counts[d]++
+		}
+		if len(counts) != 11 {
+			t.Errorf("RandBetween(%q, %q, 1) produced %v distinct values, want 11", lo, hi, len(counts))
+		}
+		for d, n := range counts {
+			if n < draws/11*9/10 || n > draws/11*11/10 {
+				t.Errorf("RandBetween(%q, %q, 1) produced %q %v times, want about %v", lo, hi, d, n, draws/11)
+			}
+		}
+	})
+
+	t.Run("reproducible", func(t *testing.T) {
+		lo, hi := MustParse("-9999999999999999999"), MustParse("9999999999999999999")
+		rnd1 := rand.New(rand.NewPCG(5, 6))
+		rnd2 := rand.New(rand.NewPCG(5, 6))
+		for range 100 {
+			d, err := RandBetween(rnd1, lo, hi, 0)
+			if err != nil {
+				t.Fatalf("RandBetween(%q, %q, 0) failed: %v", lo, hi, err)
+			}
+			e, err := RandBetween(rnd2, lo, hi, 0)
+			if err != nil {
+				t.Fatalf("RandBetween(%q, %q, 0) failed: %v", lo, hi, err)
+			}
+			if d != e {
+				t.Errorf("RandBetween(%q, %q, 0) = %q and %q with the same seed", lo, hi, d, e)
+			}
+		}
+	})
+
+	t.Run("global", func(t *testing.T) {
+		lo, hi := MustParse("1"), MustParse("2")
+		got, err := RandBetween(nil, lo, hi, 2)
+		if err != nil {
+			t.Fatalf("RandBetween(nil, %q, %q, 2) failed: %v", lo, hi, err)
+		}
+		if got.Cmp(lo) < 0 || got.Cmp(hi) > 0 {
+			t.Errorf("RandBetween(nil, %q, %q, 2) = %q, want value in range", lo, hi, got)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			lo, hi string
+			scale  int
+		}{
+			{"1", "0", 0},
+			{"0.01", "0.09", 1},
+			{"0", "1", -1},
+			{"0", "1", MaxScale + 1},
+			{"0", "10", MaxScale},
+			{"-10", "0", MaxScale},
+		}
+		for _, tt := range tests {
+			lo, hi := MustParse(tt.lo), MustParse(tt.hi)
+			_, err := RandBetween(nil, lo, hi, tt.scale)
+			if err == nil {
+				t.Errorf("RandBetween(%q, %q, %v) did not fail", lo, hi, tt.scale)
+			}
+		}
+	})
+}
+
+func TestRandBetweenCrypto(t *testing.T) {
+	lo, hi := MustParse("-9999999999999999999"), MustParse("9999999999999999999")
+	for range 100 {
+		got, err := RandBetweenCrypto(lo, hi, 0)
+		if err != nil {
+			t.Fatalf("RandBetweenCrypto(%q, %q, 0) failed: %v", lo, hi, err)
+		}
+		if got.Cmp(lo) < 0 || got.Cmp(hi) > 0 {
+			t.Errorf("RandBetweenCrypto(%q, %q, 0) = %q, want value in range", lo, hi, got)
+		}
+	}
+
+	_, err := RandBetweenCrypto(One, Zero, 0)
+	if err == nil {
+		t.Errorf("RandBetweenCrypto(1, 0, 0) did not fail")
+	}
+}
+
+func TestDecimal_Generate(t *testing.T) {
+	t.Run("quick", func(t *testing.T) {
+		roundtrip := func(d Decimal) bool {
+			e, err := Parse(d.String())
+			return err == nil && e == d
+		}
+		err := quick.Check(roundtrip, &quick.Config{Rand: mrand.New(mrand.NewSource(1))})
+		if err != nil {
+			t.Errorf("quick.Check() failed: %v", err)
+		}
+	})
+
+	t.Run("size", func(t *testing.T) {
+		rnd := mrand.New(mrand.NewSource(1))
+		tests := []struct {
+			size     int
+			wantPrec int
+		}{
+			{-1, 1},
+			{0, 1},
+			{1, 1},
+			{5, 5},
+			{50, MaxPrec},
+		}
+		for _, tt := range tests {
+			var scales, negs int
+			for range 100 {
+				d := Decimal{}.Generate(rnd, tt.size).Interface().(Decimal)
+				if d.Prec() > tt.wantPrec {
+					t.Errorf("Generate(%v) = %q, want at most %v digits", tt.size, d, tt.wantPrec)
+				}
+				if d.Scale() > 0 {
+					scales++
+				}
+				if d.IsNeg() {
+					negs++
+				}
+			}
+			if scales == 0 || negs == 0 {
+				t.Errorf("Generate(%v) produced %v decimals with a positive scale and %v negative decimals, want some of each", tt.size, scales, negs)
+			}
+		}
+	})
+
+	t.Run("v1", func(t *testing.T) {
+		rnd := rand.New(mrand.New(mrand.NewSource(1)))
+		lo, hi := MustParse("-1"), MustParse("1")
+		for range 100 {
+			got, err := RandBetween(rnd, lo, hi, 2)
+			if err != nil {
+				t.Fatalf("RandBetween(%q, %q, 2) failed: %v", lo, hi, err)
+			}
+			if got.Cmp(lo) < 0 || got.Cmp(hi) > 0 || got.Scale() != 2 {
+				t.Errorf("RandBetween(%q, %q, 2) = %q, want value in range", lo, hi, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,312 @@
+package decimal
+
+import (
+	"strings"
+	"time"
+)
+
+// Percent returns the (possibly rounded) decimal multiplied by 100,
+// that is, the number of percents.
+// The result is rounded to the specified number of digits after the decimal
+// point using half-to-even rounding.
+// If the given scale is negative, it is redefined to zero.
+// The percentage is computed by shifting the decimal point, so the result
+// has the same digits as the %k verb of [Decimal.Format].
+// See also function [ParseRate].
+//
+// Percent returns an error if the integer part of the result has more than
+// [MaxPrec] digits.
+func (d Decimal) Percent(scale int) (Decimal, error) {
+	e, err := d.rate(2, scale)
+	if err != nil {
+		return Decimal{}, errorf("computing [%v * 100]: %w", redact(d), err)
+	}
+	return e, nil
+}
+
+// PerMille returns the (possibly rounded) decimal multiplied by 1000,
+// that is, the number of per-mille (‰).
+// The result is rounded to the specified number of digits after the decimal
+// point using half-to-even rounding.
+// If the given scale is negative, it is redefined to zero.
+// See also function [ParseRate].
+//
+// PerMille returns an error if the integer part of the result has more than
+// [MaxPrec] digits.
+func (d Decimal) PerMille(scale int) (Decimal, error) {
+	e, err := d.rate(3, scale)
+	if err != nil {
+		return Decimal{}, errorf("computing [%v * 1000]: %w", redact(d), err)
+	}
+	return e, nil
+}
+
+// PPM returns the (possibly rounded) decimal multiplied by 1000000,
+// that is, the number of parts per million.
+// The result is rounded to the specified number of digits after the decimal
+// point using half-to-even rounding.
+// If the given scale is negative, it is redefined to zero.
+// See also function [ParseRate].
+//
+// PPM returns an error if the integer part of the result has more than
+// [MaxPrec] digits.
+func (d Decimal) PPM(scale int) (Decimal, error) {
+	e, err := d.rate(6, scale)
+	if err != nil {
+		return Decimal{}, errorf("computing [%v * 1000000]: %w", redact(d), err)
+	}
+	return e, nil
+}
+
+// rate computes d * 10^shift by shifting the decimal point and rounds
+// the result to the given scale.
+func (d Decimal) rate(shift, scale int) (Decimal, error) {
+	if d.Scale() >= shift {
+		e := newUnsafe(d.IsNeg(), d.coef, d.Scale()-shift)
+		return e.Round(scale), nil
+	}
+	coef, ok := d.coef.lsh(shift - d.Scale())
+	if !ok {
+		return Decimal{}, overflowError(d.Prec()+shift, d.Scale(), 0)
+	}
+	return newUnsafe(d.IsNeg(), coef, 0), nil
+}
+
+// PctChange returns the percentage change from one decimal to another,
+// that is, 100 * (to - from) / from, rounded to the specified number of digits
+// after the decimal point using half-to-even rounding.
+// If the result does not fit into [MaxPrec] digits, it is rounded to fewer
+// digits after the decimal point.
+// The result is rounded only once, so it does not depend on how
+// the intermediate difference and quotient would be rounded.
+// The sign of the change is relative to the magnitude of from,
+// so the change from -10 to -5 is 50%.
+//
+// PctChange returns an error if:
+//   - from is 0;
+//   - the scale is negative or greater than [MaxScale];
+//   - the integer part of the result has more than [MaxPrec] digits.
+func PctChange(from, to Decimal, scale int) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, errorf("computing [pctchange(%v, %v)]: %w", redact(from), redact(to), scaleRangeError(scale))
+	}
+	if from.IsZero() {
+		return Decimal{}, errorf("computing [pctchange(%v, %v)]: %w", redact(from), redact(to), errDivisionByZero)
+	}
+
+	// General case
+	e, err := pctChangeFint(from, to, scale)
+	if err != nil {
+		e, err = pctChangeBint(from, to, scale)
+		if err != nil {
+			return Decimal{}, errorf("computing [pctchange(%v, %v)]: %w", redact(from), redact(to), err)
+		}
+	}
+
+	return e, nil
+}
+
+// pctChangeFint computes the percentage change using uint64 arithmetic.
+func pctChangeFint(from, to Decimal, scale int) (Decimal, error) {
+	// Alignment
+	var ok bool
+	fcoef, tcoef := from.coef, to.coef
+	switch {
+	case from.Scale() > to.Scale():
+		tcoef, ok = tcoef.lsh(from.Scale() - to.Scale())
+		if !ok {
+			return Decimal{}, errDecimalOverflow
+		}
+	case from.Scale() < to.Scale():
+		fcoef, ok = fcoef.lsh(to.Scale() - from.Scale())
+		if !ok {
+			return Decimal{}, errDecimalOverflow
+		}
+	}
+
+	// Compute n = to - from
+	var ncoef fint
+	var nneg bool
+	if to.IsNeg() == from.IsNeg() {
+		ncoef = tcoef.subAbs(fcoef)
+		nneg = to.IsNeg() != (tcoef < fcoef)
+	} else {
+		ncoef, ok = tcoef.add(fcoef)
+		if !ok {
+			return Decimal{}, errDecimalOverflow
+		}
+		nneg = to.IsNeg()
+	}
+
+	// Compute q = round(n * 10^(scale + 2) / |from|)
+	ncoef, ok = ncoef.lsh(scale + 2)
+	if !ok {
+		return Decimal{}, errDecimalOverflow
+	}
+	qcoef, rcoef, ok := ncoef.quoRem(fcoef)
+	if !ok {
+		return Decimal{}, errDivisionByZero
+	}
+	if half := fcoef - rcoef; rcoef > half || (rcoef == half && qcoef.isOdd()) { // half-to-even
+		qcoef++
+	}
+
+	return newSafe(nneg, qcoef, scale)
+}
+
+// rateUnits maps the suffixes recognized by [ParseRate] to the corresponding
+// powers of ten.
+var rateUnits = []struct {
+	suffix string
+	shift  int
+}{
+	{"%", 2},
+	{"‰", 3},
+	{"ppm", 6},
+}
+
+// ParseRate converts a string with an optional unit suffix to a decimal.
+// The following suffixes are recognized:
+//
+//	| Suffix | Example | Result  |
+//	| ------ | ------- | ------- |
+//	| %      | 12.5%   | 0.125   |
+//	| ‰      | 125‰    | 0.125   |
+//	| ppm    | 1250ppm | 0.00125 |
+//
+// A string without a suffix is parsed as is, like in [Parse].
+// If the result has more than [MaxScale] digits after the decimal point,
+// it is rounded using half-to-even rounding.
+// See also methods [Decimal.Percent], [Decimal.PerMille], [Decimal.PPM].
+//
+// ParseRate returns an error if the number is not a valid decimal
+// as described in [Parse].
+func ParseRate(s string) (Decimal, error) {
+	shift := 0
+	for _, u := range rateUnits {
+		if t, ok := strings.CutSuffix(s, u.suffix); ok {
+			s, shift = t, u.shift
+			break
+		}
+	}
+	d, err := Parse(s)
+	if err != nil {
+		return Decimal{}, err
+	}
+	if shift == 0 {
+		return d, nil
+	}
+	scale := d.Scale() + shift
+	if scale <= MaxScale {
+		return newUnsafe(d.IsNeg(), d.coef, scale), nil
+	}
+	coef := d.coef.rshHalfEven(scale - MaxScale)
+	return newUnsafe(d.IsNeg(), coef, MaxScale), nil
+}
+
+// PerAnnumToPerDay returns the (possibly rounded) rate per day equivalent to
+// the given rate per annum, that is, rate / n, where n is the number of days
+// in a year assumed by the day count convention.
+// The result is rounded only once, so it is the same for all services
+// that spread annual rates to daily accrual.
+// See also functions [PerDayToPerAnnum], [ConvertRate].
+//
+// PerAnnumToPerDay returns an error if the day count convention is unknown.
+func PerAnnumToPerDay(rate Decimal, basis DayCount) (Decimal, error) {
+	days := basis.DaysPerYear()
+	if days == 0 {
+		return Decimal{}, errorf("converting %v per annum to per day: %w: unknown %v", redact(rate), errInvalidOperation, basis)
+	}
+	d, err := convertRate(rate, 1, fint(days))
+	if err != nil {
+		return Decimal{}, errorf("converting %v per annum to per day: %w", redact(rate), err)
+	}
+	return d, nil
+}
+
+// PerDayToPerAnnum returns the (possibly rounded) rate per annum equivalent to
+// the given rate per day, that is, rate * n, where n is the number of days
+// in a year assumed by the day count convention.
+// See also functions [PerAnnumToPerDay], [ConvertRate].
+//
+// PerDayToPerAnnum returns an error if:
+//   - the day count convention is unknown;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func PerDayToPerAnnum(rate Decimal, basis DayCount) (Decimal, error) {
+	days := basis.DaysPerYear()
+	if days == 0 {
+		return Decimal{}, errorf("converting %v per day to per annum: %w: unknown %v", redact(rate), errInvalidOperation, basis)
+	}
+	d, err := convertRate(rate, fint(days), 1)
+	if err != nil {
+		return Decimal{}, errorf("converting %v per day to per annum: %w", redact(rate), err)
+	}
+	return d, nil
+}
+
+// ConvertRate returns the (possibly rounded) rate per period "to" equivalent
+// to the given rate per period "from", that is, rate * to / from.
+// The result is rounded only once, even if the ratio of the periods,
+// such as 1/24 for hours per day, cannot be represented exactly.
+// To convert annual rates, use [DayCount.Year] as one of the periods:
+//
+//	decimal.ConvertRate(rate, decimal.Actual365Fixed.Year(), time.Hour)
+//
+// See also functions [PerAnnumToPerDay], [PerDayToPerAnnum].
+//
+// ConvertRate returns an error if:
+//   - any of the periods is not positive;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func ConvertRate(rate Decimal, from, to time.Duration) (Decimal, error) {
+	if from <= 0 || to <= 0 {
+		return Decimal{}, errorf("converting %v per %v to per %v: %w", redact(rate), from, to, errInvalidOperation)
+	}
+	d, err := convertRate(rate, fint(to), fint(from)) //nolint:gosec
+	if err != nil {
+		return Decimal{}, errorf("converting %v per %v to per %v: %w", redact(rate), from, to, err)
+	}
+	return d, nil
+}
+
+// convertRate computes rate * num / den, where num and den are positive.
+func convertRate(rate Decimal, num, den fint) (Decimal, error) {
+	// Reduce the ratio to avoid unnecessary overflows
+	g := gcd(num, den)
+	num, den = num/g, den/g
+
+	// General case
+	d, err := convertRateFint(rate, num, den)
+	if err != nil {
+		d, err = convertRateBint(rate, num, den)
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+
+	// Preferred scale
+	d = d.Trim(rate.Scale())
+
+	return d, nil
+}
+
+// convertRateFint computes rate * num / den using uint64 arithmetic.
+// convertRateFint succeeds only if the result is exact.
+func convertRateFint(rate Decimal, num, den fint) (Decimal, error) {
+	// Compute d = rate * num
+	dcoef, ok := rate.coef.mul(num)
+	if !ok {
+		return Decimal{}, errDecimalOverflow
+	}
+	d := newUnsafe(rate.IsNeg(), dcoef, rate.Scale())
+
+	// Compute d = d / den
+	return d.quoFint(newUnsafe(false, den, 0), 0)
+}
+
+// gcd returns the greatest common divisor of x and y.
+func gcd(x, y fint) fint {
+	for y != 0 {
+		x, y = y, x%y
+	}
+	return x
+}
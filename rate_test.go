@@ -0,0 +1,394 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDecimal_Percent(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			want  string
+		}{
+			{"0", 0, "0"},
+			{"0", 2, "0"},
+			{"0.00", 2, "0"},
+			{"1", 2, "100"},
+			{"-1", 2, "-100"},
+			{"0.5", 2, "50"},
+			{"0.230", 2, "23.0"},
+			{"0.12345", 2, "12.34"},
+			{"0.12355", 2, "12.36"},
+			{"0.12345", 0, "12"},
+			{"0.12345", -1, "12"},
+			{"99999999999999999.99", 0, "9999999999999999999"},
+			{"0.9999999999999999999", 19, "99.99999999999999999"},
+			{"0.0000000000000000001", 19, "0.00000000000000001"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Percent(tt.scale)
+			if err != nil {
+				t.Errorf("%q.Percent(%v) failed: %v", d, tt.scale, err)
+				continue
+			}
+			// Percent must agree with the %k verb
+			if s := fmt.Sprintf("%.*k", got.Scale(), d); s != tt.want+"%" {
+				t.Errorf("fmt.Sprintf(\"%%.%vk\", %q) = %q, want %q", got.Scale(), d, s, tt.want+"%")
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Percent(%v) = %q, want %q", d, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"9999999999999999999",
+			"-9999999999999999999",
+			"100000000000000000.0",
+			"999999999999999999.9",
+		}
+		for _, s := range tests {
+			d := MustParse(s)
+			_, err := d.Percent(2)
+			if err == nil {
+				t.Errorf("%q.Percent(2) did not fail", d)
+			}
+		}
+	})
+}
+
+func TestDecimal_PerMille(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			want  string
+		}{
+			{"0", 0, "0"},
+			{"0.125", 0, "125"},
+			{"-0.125", 3, "-125"},
+			{"0.0125", 1, "12.5"},
+			{"0.01255", 1, "12.6"},
+			{"2", 0, "2000"},
+			{"9999999999999999.999", 0, "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.PerMille(tt.scale)
+			if err != nil {
+				t.Errorf("%q.PerMille(%v) failed: %v", d, tt.scale, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.PerMille(%v) = %q, want %q", d, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"9999999999999999999",
+			"10000000000000000",
+		}
+		for _, s := range tests {
+			d := MustParse(s)
+			_, err := d.PerMille(0)
+			if err == nil {
+				t.Errorf("%q.PerMille(0) did not fail", d)
+			}
+		}
+	})
+}
+
+func TestDecimal_PPM(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			want  string
+		}{
+			{"0", 0, "0"},
+			{"0.00125", 0, "1250"},
+			{"-0.00125", 0, "-1250"},
+			{"0.0000001", 1, "0.1"},
+			{"0.00000015", 0, "0"},
+			{"0.0000015", 0, "2"},
+			{"1", 0, "1000000"},
+			{"0.0000000000000000001", 13, "0.0000000000001"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.PPM(tt.scale)
+			if err != nil {
+				t.Errorf("%q.PPM(%v) failed: %v", d, tt.scale, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.PPM(%v) = %q, want %q", d, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"9999999999999999999",
+			"10000000000000",
+		}
+		for _, s := range tests {
+			d := MustParse(s)
+			_, err := d.PPM(0)
+			if err == nil {
+				t.Errorf("%q.PPM(0) did not fail", d)
+			}
+		}
+	})
+}
+
+func TestParseRate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want string
+		}{
+			{"0.125", "0.125"},
+			{"12.5%", "0.125"},
+			{"-12.5%", "-0.125"},
+			{"100%", "1.00"},
+			{"0%", "0.00"},
+			{"125‰", "0.125"},
+			{"1250ppm", "0.001250"},
+			{"9999999999999999999%", "99999999999999999.99"},
+			{"0.0000000000000000001%", "0.0000000000000000000"},
+			{"0.0000000000000000005%", "0.0000000000000000000"},
+			{"0.0000000000000000015%", "0.0000000000000000000"},
+			{"0.000000000000000005ppm", "0.0000000000000000000"},
+			{"0.00000000000005ppm", "0.0000000000000000000"},
+			{"0.0000000000006ppm", "0.0000000000000000006"},
+		}
+		for _, tt := range tests {
+			got, err := ParseRate(tt.s)
+			if err != nil {
+				t.Errorf("ParseRate(%q) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("ParseRate(%q) = %q, want %q", tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"",
+			"%",
+			"‰",
+			"ppm",
+			"12.5 %",
+			"12.5%%",
+			"12.5%ppm",
+			"12.5bp",
+		}
+		for _, s := range tests {
+			_, err := ParseRate(s)
+			if err == nil {
+				t.Errorf("ParseRate(%q) did not fail", s)
+			}
+		}
+	})
+}
+
+func TestPctChange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			from, to string
+			scale    int
+			want     string
+		}{
+			{"100", "100", 2, "0.00"},
+			{"100", "110", 2, "10.00"},
+			{"100", "90", 0, "-10"},
+			{"100", "0", 0, "-100"},
+			{"3", "4", 2, "33.33"},
+			{"3", "5", 2, "66.67"},
+			{"-10", "-5", 0, "50"},
+			{"-10", "-15", 0, "-50"},
+			{"-10", "10", 0, "200"},
+			{"10", "-10", 0, "-200"},
+			{"8", "8.001", 2, "0.01"},
+			{"8", "8.0004", 3, "0.005"},
+			{"8", "8.0012", 3, "0.015"},
+			{"8", "8.0002", 3, "0.002"},
+			{"8", "8.0006", 3, "0.008"},
+			{"0.0000000000000000001", "0.0000000000000000002", 0, "100"},
+		}
+		for _, tt := range tests {
+			from, to := MustParse(tt.from), MustParse(tt.to)
+			got, err := PctChange(from, to, tt.scale)
+			if err != nil {
+				t.Errorf("PctChange(%q, %q, %v) failed: %v", from, to, tt.scale, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("PctChange(%q, %q, %v) = %q, want %q", from, to, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			from, to string
+			scale    int
+		}{
+			{"0", "1", 0},
+			{"0.00", "0", 0},
+			{"1", "2", -1},
+			{"1", "2", MaxScale + 1},
+		}
+		for _, tt := range tests {
+			from, to := MustParse(tt.from), MustParse(tt.to)
+			_, err := PctChange(from, to, tt.scale)
+			if err == nil {
+				t.Errorf("PctChange(%q, %q, %v) did not fail", from, to, tt.scale)
+			}
+		}
+	})
+}
+
+func TestPerAnnumToPerDay(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			rate  string
+			basis DayCount
+			want  string
+		}{
+			{"0", Actual360, "0"},
+			{"0.036", Actual360, "0.0001"},
+			{"0.036", Thirty360, "0.0001"},
+			{"0.0365", Actual365Fixed, "0.0001"},
+			{"-0.0730", Actual365Fixed, "-0.0002"},
+			{"3.6", Actual360, "0.01"},
+			{"0.045", Actual360, "0.000125"},
+		}
+		for _, tt := range tests {
+			rate := MustParse(tt.rate)
+			got, err := PerAnnumToPerDay(rate, tt.basis)
+			if err != nil {
+				t.Errorf("PerAnnumToPerDay(%q, %v) failed: %v", rate, tt.basis, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("PerAnnumToPerDay(%q, %v) = %q, want %q", rate, tt.basis, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		rate := MustParse("0.05")
+		_, err := PerAnnumToPerDay(rate, DayCount(-1))
+		if err == nil {
+			t.Errorf("PerAnnumToPerDay(%q, %v) did not fail", rate, DayCount(-1))
+		}
+	})
+}
+
+func TestPerDayToPerAnnum(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			rate  string
+			basis DayCount
+			want  string
+		}{
+			{"0", Actual360, "0"},
+			{"0.0001", Actual360, "0.0360"},
+			{"0.0001", Actual365Fixed, "0.0365"},
+			{"-0.000125", Thirty360, "-0.045000"},
+		}
+		for _, tt := range tests {
+			rate := MustParse(tt.rate)
+			got, err := PerDayToPerAnnum(rate, tt.basis)
+			if err != nil {
+				t.Errorf("PerDayToPerAnnum(%q, %v) failed: %v", rate, tt.basis, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("PerDayToPerAnnum(%q, %v) = %q, want %q", rate, tt.basis, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			rate  string
+			basis DayCount
+		}{
+			{"0.05", DayCount(3)},
+			{"9999999999999999999", Actual360},
+		}
+		for _, tt := range tests {
+			rate := MustParse(tt.rate)
+			_, err := PerDayToPerAnnum(rate, tt.basis)
+			if err == nil {
+				t.Errorf("PerDayToPerAnnum(%q, %v) did not fail", rate, tt.basis)
+			}
+		}
+	})
+}
+
+func TestConvertRate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			rate     string
+			from, to time.Duration
+			want     string
+		}{
+			{"0", time.Hour, time.Minute, "0"},
+			{"6", time.Hour, time.Minute, "0.1"},
+			{"0.1", time.Minute, time.Hour, "6.0"},
+			{"2.4", 24 * time.Hour, time.Hour, "0.1"},
+			{"-1.5", time.Second, time.Millisecond, "-0.0015"},
+			{"0.0365", Actual365Fixed.Year(), 24 * time.Hour, "0.0001"},
+			{"1", time.Nanosecond, time.Duration(math.MaxInt64), "9223372036854775807"},
+		}
+		for _, tt := range tests {
+			rate := MustParse(tt.rate)
+			got, err := ConvertRate(rate, tt.from, tt.to)
+			if err != nil {
+				t.Errorf("ConvertRate(%q, %v, %v) failed: %v", rate, tt.from, tt.to, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("ConvertRate(%q, %v, %v) = %q, want %q", rate, tt.from, tt.to, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			rate     string
+			from, to time.Duration
+		}{
+			{"1", 0, time.Hour},
+			{"1", time.Hour, 0},
+			{"1", -time.Hour, time.Hour},
+			{"10", time.Nanosecond, time.Duration(math.MaxInt64)},
+		}
+		for _, tt := range tests {
+			rate := MustParse(tt.rate)
+			_, err := ConvertRate(rate, tt.from, tt.to)
+			if err == nil {
+				t.Errorf("ConvertRate(%q, %v, %v) did not fail", rate, tt.from, tt.to)
+			}
+		}
+	})
+}
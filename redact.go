@@ -0,0 +1,153 @@
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RedactError returns an error with the same message as err, except that
+// every digit of the operands of the failed operation is replaced with 'x',
+// for example, "computing [xxxx.xx / x]: division by zero" instead of
+// "computing [1234.56 / 0]: division by zero",
+// so that sensitive amounts do not leak into logs.
+// The sign, the number of digits, and the scale of an operand are kept,
+// since they are often enough to find the cause of the error.
+// Limits, such as the requested scale or the number of digits in
+// an overflow error, are never redacted.
+//
+// Redaction applies only to the errors returned by this package and to
+// errors that wrap them by adding a prefix, as [fmt.Errorf] does with
+// "prefix: %w"; the prefix itself is not redacted.
+// The returned error wraps err, so [errors.Is] and [errors.As] work as usual.
+// RedactError returns nil if err is nil.
+// See also [Context.Redact] and [WithRedaction].
+//
+// [fmt.Errorf]: https://pkg.go.dev/fmt#Errorf
+// [errors.Is]: https://pkg.go.dev/errors#Is
+// [errors.As]: https://pkg.go.dev/errors#As
+func RedactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*redactedError); ok {
+		return err
+	}
+	return &redactedError{err: err}
+}
+
+// redactedError is an error whose message hides the digits of operands.
+type redactedError struct {
+	err error
+}
+
+func (e *redactedError) Error() string {
+	return redactedMessage(e.err)
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactor is implemented by errors that can hide the digits of operands
+// in their messages.
+type redactor interface {
+	redactedError() string
+}
+
+// redactedMessage returns the message of err with the digits of operands
+// replaced with 'x'.
+func redactedMessage(err error) string {
+	if r, ok := err.(redactor); ok {
+		return r.redactedError()
+	}
+	msg := err.Error()
+	inner := errors.Unwrap(err)
+	if inner == nil {
+		return msg
+	}
+	if s := inner.Error(); strings.HasSuffix(msg, s) {
+		return msg[:len(msg)-len(s)] + redactedMessage(inner)
+	}
+	return msg
+}
+
+// operand is a value printed in an error message that is hidden
+// by [RedactError].
+type operand struct {
+	v any    // v is the value printed by Error.
+	s string // s is the string representation of v at the time of the error.
+}
+
+// redact marks v as an operand of an error message created by errorf,
+// so that its digits can be hidden by [RedactError].
+func redact(v any) operand {
+	if b, ok := v.([]byte); ok {
+		v = string(b)
+	}
+	return operand{v: v, s: fmt.Sprint(v)}
+}
+
+// mask replaces every digit of s with 'x'.
+func mask(s string) string {
+	return strings.Map(func(r rune) rune {
+		if '0' <= r && r <= '9' {
+			return 'x'
+		}
+		return r
+	}, s)
+}
+
+// opError is an error created by errorf.
+type opError struct {
+	err    error  // err is the error created by fmt.Errorf with the values of operands.
+	format string // format is the format of the message.
+	args   []any  // args are the arguments of the message, including operands.
+}
+
+// errorf is like [fmt.Errorf], but the digits of the arguments marked
+// by redact can be hidden by [RedactError].
+func errorf(format string, a ...any) error {
+	args := make([]any, len(a))
+	for i, v := range a {
+		switch v := v.(type) {
+		case operand:
+			args[i] = v.v
+		case []byte:
+			a[i] = string(v)
+			args[i] = v
+		default:
+			args[i] = v
+		}
+	}
+	return &opError{err: fmt.Errorf(format, args...), format: format, args: a}
+}
+
+func (e *opError) Error() string {
+	return e.err.Error()
+}
+
+func (e *opError) Unwrap() []error {
+	switch u := e.err.(type) {
+	case interface{ Unwrap() error }:
+		return []error{u.Unwrap()}
+	case interface{ Unwrap() []error }:
+		return u.Unwrap()
+	}
+	return nil
+}
+
+func (e *opError) redactedError() string {
+	args := make([]any, len(e.args))
+	for i, v := range e.args {
+		switch v := v.(type) {
+		case operand:
+			args[i] = mask(v.s)
+		case error:
+			args[i] = redactedMessage(v)
+		default:
+			args[i] = v
+		}
+	}
+	return fmt.Sprintf(strings.ReplaceAll(e.format, "%w", "%v"), args...)
+}
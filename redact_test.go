@@ -0,0 +1,95 @@
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestRedactError(t *testing.T) {
+	d := MustParse("-1234.56")
+	e := MustParse("0")
+
+	_, err := d.Quo(e)
+	if got, want := err.Error(), "computing [-1234.56 / 0]: division by zero"; got != want {
+		t.Errorf("%q.Quo(%q) error = %q, want %q", d, e, got, want)
+	}
+
+	err = RedactError(err)
+	if got, want := err.Error(), "computing [-xxxx.xx / x]: division by zero"; got != want {
+		t.Errorf("RedactError(%q.Quo(%q)) error = %q, want %q", d, e, got, want)
+	}
+	if !errors.Is(err, errDivisionByZero) {
+		t.Errorf("RedactError(%q.Quo(%q)) error = %v, want %v", d, e, err, errDivisionByZero)
+	}
+	if got := RedactError(err); got != err {
+		t.Errorf("RedactError(RedactError(err)) = %v, want %v", got, err)
+	}
+
+	_, err = d.AddExact(e, 20)
+	err = RedactError(err)
+	if got, want := err.Error(), "computing [-xxxx.xx + x]: scale out of range: scale 20 is not within the range [0, 19]"; got != want {
+		t.Errorf("RedactError(%q.AddExact(%q, 20)) error = %q, want %q", d, e, got, want)
+	}
+
+	// Wrapped by other packages
+	_, err = d.Quo(e)
+	err = RedactError(fmt.Errorf("pricing order 42: %w", err))
+	if got, want := err.Error(), "pricing order 42: computing [-xxxx.xx / x]: division by zero"; got != want {
+		t.Errorf("RedactError(fmt.Errorf()) error = %q, want %q", got, want)
+	}
+
+	// Limit errors
+	var lerr *LimitError
+	_, err = ParseLimits{MaxLength: 4}.Parse("12345")
+	err = RedactError(err)
+	if !errors.As(err, &lerr) || lerr.Max != 4 {
+		t.Errorf("RedactError(Parse()) error = %v, want *LimitError", err)
+	}
+
+	if got := RedactError(nil); got != nil {
+		t.Errorf("RedactError(nil) = %v, want nil", got)
+	}
+}
+
+func TestContext_Redact(t *testing.T) {
+	d := MustParse("-1234.56")
+	e := MustParse("0")
+
+	_, err := Context{}.Quo(d, e)
+	if got, want := err.Error(), "computing [-1234.56 / 0]: division by zero"; got != want {
+		t.Errorf("Context{}.Quo(%q, %q) error = %q, want %q", d, e, got, want)
+	}
+	_, err = Context{Redact: true}.Quo(d, e)
+	if got, want := err.Error(), "computing [-xxxx.xx / x]: division by zero"; got != want {
+		t.Errorf("Context{Redact: true}.Quo(%q, %q) error = %q, want %q", d, e, got, want)
+	}
+	if !errors.Is(err, errDivisionByZero) {
+		t.Errorf("Context{Redact: true}.Quo(%q, %q) error = %v, want %v", d, e, err, errDivisionByZero)
+	}
+}
+
+func TestWithRedaction(t *testing.T) {
+	_, err := ParseWith("12.3a", WithRedaction())
+	if err == nil {
+		t.Fatalf("ParseWith(%q) did not fail", "12.3a")
+	}
+	_, err = UnmarshalJSONWith([]byte("123"), WithRedaction())
+	if got, want := err.Error(), `unmarshaling decimal: invalid decimal: unexpected JSON value "xxx"`; got != want {
+		t.Errorf("UnmarshalJSONWith(%q) error = %q, want %q", "123", got, want)
+	}
+	_, err = MarshalJSONWith(MustParse("123.45"), WithScale(20), WithRedaction())
+	if got, want := err.Error(), "marshaling xxx.xx: rescaling xxx.xx: scale out of range: scale 20 is not within the range [0, 19]"; got != want {
+		t.Errorf("MarshalJSONWith(%q) error = %q, want %q", "123.45", got, want)
+	}
+}
+
+func TestScaleRangeError(t *testing.T) {
+	d := MustParse("1")
+	for _, scale := range []int{MinScale - 1, MaxScale + 1} {
+		_, err := d.QuoExact(d, scale)
+		if !errors.Is(err, errScaleRange) {
+			t.Errorf("%q.QuoExact(%q, %v) error = %v, want %v", d, d, scale, err, errScaleRange)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package decimal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// RewriteJSON copies a stream of JSON values from src to dst, replacing
+// the values of the object members with the given names by canonical
+// decimal strings, such as "1.23".
+// It is intended for gateway services that sanitize third-party payloads
+// containing amounts formatted as floats before persisting them:
+//
+//	{"id":7,"price":19.990,"tax":1.4e0}  =>  {"id":7,"price":"19.990","tax":"1.4"}
+//
+// Members are matched by name at any depth of the document.
+// Their values are converted as follows:
+//
+//   - a JSON number is parsed as described in [Parse] and written as a JSON
+//     string containing the result of [Decimal.String];
+//   - a JSON string is parsed and rewritten in the same way, so that
+//     different representations of the same decimal are unified;
+//   - null is kept as is.
+//
+// If no names are given, all JSON numbers in the stream are rewritten,
+// and JSON strings are kept as is.
+// Other values are copied without changes.
+// The output is compact: insignificant white space is removed, and each
+// top-level value is followed by a newline, so the output of a stream of
+// values is a valid JSON Lines document.
+// Since the stream is processed token by token, the entire document is
+// never held in memory.
+//
+// RewriteJSON returns an error if:
+//   - the stream is not a valid sequence of JSON values;
+//   - the value of a member with a given name is a boolean, an object,
+//     or an array;
+//   - the value of a member with a given name, or any number if no names
+//     are given, cannot be parsed by [Parse].
+//
+// In case of an error, part of the output may have already been written to dst.
+func RewriteJSON(dst io.Writer, src io.Reader, names ...string) error {
+	dec := json.NewDecoder(src)
+	dec.UseNumber()
+	rw := jsonRewriter{
+		w:     bufio.NewWriter(dst),
+		names: make(map[string]bool, len(names)),
+		all:   len(names) == 0,
+	}
+	for _, name := range names {
+		rw.names[name] = true
+	}
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errorf("rewriting JSON: %w", err)
+		}
+		if err = rw.write(tok); err != nil {
+			return errorf("rewriting JSON: %w", err)
+		}
+	}
+	if len(rw.stack) != 0 {
+		return errorf("rewriting JSON: %w", io.ErrUnexpectedEOF)
+	}
+	return rw.w.Flush()
+}
+
+// jsonFrame describes an object or an array being rewritten.
+type jsonFrame struct {
+	delim   json.Delim // '{' or '['
+	n       int        // number of members or elements written so far
+	wantKey bool       // the next token is a member name
+	key     string     // name of the current member
+}
+
+// jsonRewriter writes JSON tokens in the compact form, rewriting decimals
+// as described in [RewriteJSON].
+type jsonRewriter struct {
+	w     *bufio.Writer
+	names map[string]bool
+	all   bool
+	stack []jsonFrame
+	buf   bytes.Buffer
+}
+
+// write writes the token along with the separator preceding it.
+func (rw *jsonRewriter) write(tok json.Token) error {
+	// Member names
+	if len(rw.stack) > 0 {
+		f := &rw.stack[len(rw.stack)-1]
+		if f.wantKey {
+			if d, ok := tok.(json.Delim); ok && d == '}' {
+				return rw.close()
+			}
+			if f.n > 0 {
+				rw.w.WriteByte(',')
+			}
+			f.n++
+			f.key, f.wantKey = tok.(string), false
+			if err := rw.writeString(f.key); err != nil {
+				return err
+			}
+			return rw.w.WriteByte(':')
+		}
+	}
+
+	// Values
+	if d, ok := tok.(json.Delim); ok && (d == '}' || d == ']') {
+		return rw.close()
+	}
+	key, selected := rw.begin()
+	switch v := tok.(type) {
+	case json.Delim:
+		if selected && !rw.all {
+			return errorf("member %q: %w: %v is not a decimal", key, errInvalidDecimal, kindOfDelim(v))
+		}
+		rw.w.WriteByte(byte(v))
+		rw.stack = append(rw.stack, jsonFrame{delim: v, wantKey: v == '{'})
+		return nil
+	case json.Number:
+		if selected {
+			return rw.writeDecimal(key, string(v))
+		}
+		rw.w.WriteString(string(v))
+	case string:
+		if selected && !rw.all {
+			return rw.writeDecimal(key, v)
+		}
+		if err := rw.writeString(v); err != nil {
+			return err
+		}
+	case bool:
+		if selected && !rw.all {
+			return errorf("member %q: %w: boolean is not a decimal", key, errInvalidDecimal)
+		}
+		if v {
+			rw.w.WriteString("true")
+		} else {
+			rw.w.WriteString("false")
+		}
+	case nil:
+		rw.w.WriteString("null")
+	}
+	return rw.end()
+}
+
+// begin writes the separator preceding a value and reports whether
+// the value has to be rewritten.
+func (rw *jsonRewriter) begin() (key string, selected bool) {
+	if len(rw.stack) == 0 {
+		return "", rw.all
+	}
+	f := &rw.stack[len(rw.stack)-1]
+	if f.delim == '[' {
+		if f.n > 0 {
+			rw.w.WriteByte(',')
+		}
+		f.n++
+		return "", rw.all
+	}
+	f.wantKey = true
+	return f.key, rw.all || rw.names[f.key]
+}
+
+// end terminates top-level values with a newline.
+func (rw *jsonRewriter) end() error {
+	if len(rw.stack) == 0 {
+		return rw.w.WriteByte('\n')
+	}
+	return nil
+}
+
+// close writes the closing delimiter of the innermost object or array.
+func (rw *jsonRewriter) close() error {
+	f := rw.stack[len(rw.stack)-1]
+	rw.stack = rw.stack[:len(rw.stack)-1]
+	if f.delim == '{' {
+		rw.w.WriteByte('}')
+	} else {
+		rw.w.WriteByte(']')
+	}
+	return rw.end()
+}
+
+// writeDecimal parses the string and writes the canonical representation
+// of the decimal as a JSON string.
+func (rw *jsonRewriter) writeDecimal(key, s string) error {
+	d, err := Parse(s)
+	if err != nil {
+		if key == "" {
+			return err
+		}
+		return errorf("member %q: %w", key, err)
+	}
+	rw.w.WriteByte('"')
+	rw.w.WriteString(d.String())
+	rw.w.WriteByte('"')
+	return rw.end()
+}
+
+// writeString writes the string as a JSON string without escaping
+// HTML characters.
+func (rw *jsonRewriter) writeString(s string) error {
+	rw.buf.Reset()
+	enc := json.NewEncoder(&rw.buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return err
+	}
+	_, err := rw.w.Write(bytes.TrimSuffix(rw.buf.Bytes(), []byte{'\n'}))
+	return err
+}
+
+// kindOfDelim returns the kind of the JSON value started by the delimiter.
+func kindOfDelim(d json.Delim) string {
+	if d == '{' {
+		return "object"
+	}
+	return "array"
+}
@@ -0,0 +1,69 @@
+package decimal
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRewriteJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			src   string
+			names []string
+			want  string
+		}{
+			{`{"id":7,"price":19.990,"qty":3}`, []string{"price"}, "{\"id\":7,\"price\":\"19.990\",\"qty\":3}\n"},
+			{` { "price" : 1.2300000000000002 } `, []string{"price"}, "{\"price\":\"1.2300000000000002\"}\n"},
+			{`{"price":"+0019.99","name":"19.99"}`, []string{"price"}, "{\"price\":\"19.99\",\"name\":\"19.99\"}\n"},
+			{`{"price":null}`, []string{"price"}, "{\"price\":null}\n"},
+			{`{"price":-0.0}`, []string{"price"}, "{\"price\":\"0.0\"}\n"},
+			{`{"lines":[{"price":1.5,"tags":["<a&b>",true,false,null]},{"price":2}]}`, []string{"price"}, "{\"lines\":[{\"price\":\"1.5\",\"tags\":[\"<a&b>\",true,false,null]},{\"price\":\"2\"}]}\n"},
+			{`{"a":{},"b":[],"price":{"amount":1.0}}`, []string{"amount"}, "{\"a\":{},\"b\":[],\"price\":{\"amount\":\"1.0\"}}\n"},
+			{`{"price":1}{"price":2} [3]`, []string{"price"}, "{\"price\":\"1\"}\n{\"price\":\"2\"}\n[3]\n"},
+			{`{"id":7,"price":"1.50","items":[1,2.0,{"x":true}]}`, nil, "{\"id\":\"7\",\"price\":\"1.50\",\"items\":[\"1\",\"2.0\",{\"x\":true}]}\n"},
+			{`1.10 "a" null`, nil, "\"1.10\"\n\"a\"\nnull\n"},
+			{`{"priceA":1}`, []string{"priceA"}, "{\"priceA\":\"1\"}\n"},
+			{``, []string{"price"}, ""},
+		}
+		for _, tt := range tests {
+			var b strings.Builder
+			err := RewriteJSON(&b, strings.NewReader(tt.src), tt.names...)
+			if err != nil {
+				t.Errorf("RewriteJSON(%q, %v) failed: %v", tt.src, tt.names, err)
+				continue
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("RewriteJSON(%q, %v) = %q, want %q", tt.src, tt.names, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			src     string
+			names   []string
+			wantErr error
+		}{
+			{`{"price":true}`, []string{"price"}, errInvalidDecimal},
+			{`{"price":{"amount":1}}`, []string{"price"}, errInvalidDecimal},
+			{`{"price":[1]}`, []string{"price"}, errInvalidDecimal},
+			{`{"price":"abc"}`, []string{"price"}, nil},
+			{`{"price":""}`, []string{"price"}, nil},
+			{`{"price":99999999999999999999}`, []string{"price"}, errDecimalOverflow},
+			{`[99999999999999999999]`, nil, errDecimalOverflow},
+			{`{"price":1`, []string{"price"}, io.ErrUnexpectedEOF},
+			{`{"price":1,}`, []string{"price"}, nil},
+			{`{"price"}`, []string{"price"}, nil},
+			{`[1]]`, []string{"price"}, nil},
+		}
+		for _, tt := range tests {
+			var b strings.Builder
+			err := RewriteJSON(&b, strings.NewReader(tt.src), tt.names...)
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Errorf("RewriteJSON(%q, %v) error = %v, want %v", tt.src, tt.names, err, tt.wantErr)
+			}
+		}
+	})
+}
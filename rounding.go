@@ -0,0 +1,565 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"strings"
+)
+
+// RoundingMode specifies the method used by [Decimal.RoundMode] to round
+// decimals.
+// The zero value is [HalfEven], which is the default rounding method
+// of this package.
+//
+// [Stochastic] rounding draws random numbers from the global random number
+// generator, unless a source is supplied, for example, by [Domain.Rand],
+// [Context.Rand], or [Decimal.RoundStochastic].
+type RoundingMode int
+
+const (
+	HalfEven   RoundingMode = iota // HalfEven rounds to the nearest neighbor, ties to even. It is used by Decimal.Round.
+	HalfUp                         // HalfUp rounds to the nearest neighbor, ties away from zero.
+	HalfDown                       // HalfDown rounds to the nearest neighbor, ties towards zero.
+	Up                             // Up rounds away from zero.
+	Down                           // Down rounds towards zero. It is used by Decimal.Trunc.
+	Ceiling                        // Ceiling rounds towards positive infinity. It is used by Decimal.Ceil.
+	Floor                          // Floor rounds towards negative infinity. It is used by Decimal.Floor.
+	Stochastic                     // Stochastic rounds away from zero with probability proportional to the discarded digits, and towards zero otherwise. It is used by Decimal.RoundStochastic.
+)
+
+// roundingModeNames holds the names of rounding modes indexed by their values.
+var roundingModeNames = [...]string{
+	HalfEven:   "half_even",
+	HalfUp:     "half_up",
+	HalfDown:   "half_down",
+	Up:         "up",
+	Down:       "down",
+	Ceiling:    "ceiling",
+	Floor:      "floor",
+	Stochastic: "stochastic",
+}
+
+// ParseRoundingMode converts a name of a rounding mode, such as "half_even"
+// or "down", to the rounding mode.
+// The name is case-insensitive.
+// See also method [RoundingMode.String].
+//
+// ParseRoundingMode returns an error if the name is unknown.
+func ParseRoundingMode(s string) (RoundingMode, error) {
+	for m, name := range roundingModeNames {
+		if strings.EqualFold(s, name) {
+			return RoundingMode(m), nil
+		}
+	}
+	return 0, errorf("parsing rounding mode: %w: unknown mode %q", errInvalidOperation, s)
+}
+
+// String implements the [fmt.Stringer] interface and returns
+// the name of the rounding mode, such as "half_even" or "down".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (m RoundingMode) String() string {
+	if m >= 0 && int(m) < len(roundingModeNames) {
+		return roundingModeNames[m]
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int(m))
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface,
+// so that rounding modes can be read from JSON, YAML, or TOML configuration files.
+// See also constructor [ParseRoundingMode].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (m *RoundingMode) UnmarshalText(text []byte) error {
+	var err error
+	*m, err = ParseRoundingMode(string(text))
+	return err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// See also method [RoundingMode.String].
+//
+// MarshalText returns an error if the rounding mode is unknown.
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (m RoundingMode) MarshalText() ([]byte, error) {
+	if m < 0 || int(m) >= len(roundingModeNames) {
+		return nil, errorf("marshaling rounding mode: %w: unknown mode %d", errInvalidOperation, int(m))
+	}
+	return []byte(roundingModeNames[m]), nil
+}
+
+// RoundMode returns a decimal rounded to the specified number of digits after
+// the decimal point using the given rounding mode.
+// If the given scale is negative, it is redefined to zero.
+// If the given mode is unknown, [HalfEven] is used.
+// For financial calculations, the scale should be equal to or greater than
+// the scale of the currency.
+// See also methods [Decimal.Round], [Decimal.RoundStochastic].
+func (d Decimal) RoundMode(scale int, mode RoundingMode) Decimal {
+	return d.roundMode(scale, mode, nil)
+}
+
+// roundMode is like [Decimal.RoundMode], but it draws random numbers for
+// stochastic rounding from rnd.
+// If rnd is nil, the global random number generator is used.
+func (d Decimal) roundMode(scale int, mode RoundingMode, rnd *rand.Rand) Decimal {
+	scale = max(scale, MinScale)
+	if scale >= d.Scale() {
+		return d
+	}
+	coef := rshMode(d.IsNeg(), d.coef, d.Scale()-scale, mode, rnd)
+	return newUnsafe(d.IsNeg(), coef, scale)
+}
+
+// QuantizeMode returns a decimal rescaled to the same scale as decimal e
+// using the given rounding mode, and the direction of rounding:
+//
+//	-1 if the result is less than d (rounded down);
+//	 0 if the result is equal to d (exact);
+//	+1 if the result is greater than d (rounded up).
+//
+// The direction is relative to positive infinity, so that the rounding
+// difference can be booked to the correct account.
+// The sign and the coefficient of decimal e are ignored.
+// If the given mode is unknown, [HalfEven] is used.
+// See also methods [Decimal.Quantize], [Decimal.RoundMode].
+func (d Decimal) QuantizeMode(e Decimal, mode RoundingMode) (Decimal, int) {
+	if e.Scale() >= d.Scale() {
+		return d.Pad(e.Scale()), 0
+	}
+	f := d.RoundMode(e.Scale(), mode)
+	return f, f.Cmp(d)
+}
+
+// rshMode (Right Shift) calculates round(coef / 10^shift) using the given
+// rounding mode, where neg is the sign of the decimal.
+// If rnd is nil, the global random number generator is used for
+// stochastic rounding.
+func rshMode(neg bool, coef fint, shift int, mode RoundingMode, rnd *rand.Rand) fint {
+	switch mode {
+	case HalfUp:
+		return coef.rshHalfUp(shift)
+	case HalfDown:
+		return coef.rshHalfDown(shift)
+	case Up:
+		return coef.rshUp(shift)
+	case Down:
+		return coef.rshDown(shift)
+	case Ceiling:
+		if neg {
+			return coef.rshDown(shift)
+		}
+		return coef.rshUp(shift)
+	case Floor:
+		if neg {
+			return coef.rshUp(shift)
+		}
+		return coef.rshDown(shift)
+	case Stochastic:
+		return coef.rshRand(shift, rnd)
+	default:
+		return coef.rshHalfEven(shift)
+	}
+}
+
+// RoundSig returns a decimal rounded to the specified number of significant
+// digits using half-to-even rounding.
+// If the decimal has fewer significant digits, it is returned unchanged.
+// Digits of the integer part that are rounded off are replaced with zeros,
+// for example, 12345 rounded to 2 significant digits is 12000.
+// Unlike [Decimal.Round], which rounds to a number of digits after
+// the decimal point, RoundSig is useful for displaying rates and matching
+// quotes given to a number of significant figures.
+// See also methods [Decimal.CeilSig], [Decimal.FloorSig], [Decimal.Prec].
+//
+// RoundSig returns an error if:
+//   - the number of digits is not positive;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) RoundSig(digits int) (Decimal, error) {
+	e, err := d.roundSig(digits, HalfEven)
+	if err != nil {
+		return Decimal{}, errorf("rounding %v to %v significant digits: %w", redact(d), digits, err)
+	}
+	return e, nil
+}
+
+// CeilSig returns a decimal rounded up to the specified number of significant
+// digits, that is, towards positive infinity.
+// See also methods [Decimal.RoundSig], [Decimal.FloorSig].
+//
+// CeilSig returns an error if:
+//   - the number of digits is not positive;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) CeilSig(digits int) (Decimal, error) {
+	e, err := d.roundSig(digits, Ceiling)
+	if err != nil {
+		return Decimal{}, errorf("rounding %v up to %v significant digits: %w", redact(d), digits, err)
+	}
+	return e, nil
+}
+
+// FloorSig returns a decimal rounded down to the specified number of significant
+// digits, that is, towards negative infinity.
+// See also methods [Decimal.RoundSig], [Decimal.CeilSig].
+//
+// FloorSig returns an error if:
+//   - the number of digits is not positive;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) FloorSig(digits int) (Decimal, error) {
+	e, err := d.roundSig(digits, Floor)
+	if err != nil {
+		return Decimal{}, errorf("rounding %v down to %v significant digits: %w", redact(d), digits, err)
+	}
+	return e, nil
+}
+
+// roundSig rounds a decimal to the specified number of significant digits
+// using the given rounding mode.
+func (d Decimal) roundSig(digits int, mode RoundingMode) (Decimal, error) {
+	if digits < 1 {
+		return Decimal{}, errorf("%w: number of significant digits must be positive", errInvalidOperation)
+	}
+	shift := d.Prec() - digits
+	if shift <= 0 {
+		return d, nil
+	}
+
+	// Rounding in the fractional part
+	if shift <= d.Scale() {
+		e := d.RoundMode(d.Scale()-shift, mode)
+		// Handling the case when 9.99 was rounded to 10.0
+		if e.Prec() > digits && e.Scale() > 0 {
+			e = e.Trim(e.Scale() - 1)
+		}
+		return e, nil
+	}
+
+	// Rounding in the integer part
+	coef := rshMode(d.IsNeg(), d.coef, shift, mode, nil)
+	coef, ok := coef.lsh(shift - d.Scale())
+	if !ok {
+		return Decimal{}, overflowError(MaxPrec+1, 0, 0)
+	}
+	return newSafe(d.IsNeg(), coef, 0)
+}
+
+// RoundPow10 returns a decimal rounded to a multiple of 10^n using the given
+// rounding mode, for example, 1234567.89 rounded to a multiple of 10^3
+// is 1235000.
+// Unlike [Decimal.Round], which redefines negative scales to zero, RoundPow10
+// rounds digits of the integer part, which is useful for reporting figures
+// in thousands or millions without dividing them and losing the unit.
+// If n is positive, the result has zero scale.
+// If n is zero or negative, RoundPow10 is equivalent to [Decimal.RoundMode]
+// with scale -n.
+// If the given mode is unknown, [HalfEven] is used.
+// See also methods [Decimal.RoundSig], [Decimal.DivPow10].
+//
+// RoundPow10 returns an error if the integer part of the result has more
+// than [MaxPrec] digits.
+func (d Decimal) RoundPow10(n int, mode RoundingMode) (Decimal, error) {
+	if n <= 0 {
+		return d.RoundMode(-max(n, -MaxScale), mode), nil
+	}
+	n = min(n, maxPow10Shift)
+	coef := rshMode(d.IsNeg(), d.coef, d.Scale()+n, mode, nil)
+	if coef == 0 {
+		return Zero, nil
+	}
+	coef, ok := coef.lsh(n)
+	if !ok || coef > maxCoef {
+		return Decimal{}, errorf("rounding %v to a multiple of 10^%v: %w", redact(d), n, overflowError(MaxPrec+1, 0, 0))
+	}
+	return newUnsafe(d.IsNeg(), coef, 0), nil
+}
+
+// InUnitsOf returns the decimal expressed in units of 10^pow10, such as
+// thousands (pow10 = 3) or millions (pow10 = 6), rounded to the specified
+// number of digits after the decimal point using half-to-even rounding.
+// For example, 1234567.89 in thousands with 1 digit after the decimal point
+// is 1234.6, which is useful for financial statements with
+// "amounts in $000s".
+// The decimal is rounded only once, so the result does not depend on
+// how an intermediate quotient would be rounded.
+// If pow10 is negative, it is redefined to zero.
+// If the given scale is negative, it is redefined to zero.
+// See also methods [Decimal.FromUnitsOf], [Decimal.RoundPow10].
+func (d Decimal) InUnitsOf(pow10, scale int) Decimal {
+	pow10 = min(max(pow10, 0), maxPow10Shift)
+	scale = min(max(scale, MinScale), MaxScale)
+	if d.Scale()+pow10 <= scale {
+		e, _ := d.DivPow10(pow10)
+		return e
+	}
+	coef := d.coef.rshHalfEven(d.Scale() + pow10 - scale)
+	return newUnsafe(d.IsNeg(), coef, scale)
+}
+
+// FromUnitsOf is the inverse of [Decimal.InUnitsOf].
+// It returns the decimal multiplied by 10^pow10, that is, the amount
+// in the original units of an amount expressed in units of 10^pow10.
+// For example, 1234.6 thousands is 1234600.
+// If pow10 is negative, it is redefined to zero.
+// See also method [Decimal.MulPow10].
+//
+// FromUnitsOf returns an error if the integer part of the result has more
+// than [MaxPrec] digits.
+func (d Decimal) FromUnitsOf(pow10 int) (Decimal, error) {
+	return d.MulPow10(max(pow10, 0))
+}
+
+// RoundStochastic returns a decimal rounded to the specified number of digits
+// after the decimal point using [stochastic rounding].
+// The decimal is rounded away from zero with probability proportional to the
+// discarded digits, and towards zero otherwise, so the expected value of the
+// result is equal to the original decimal.
+// For example, 0.25 is rounded to 0.3 with probability 0.5 and
+// to 0.2 with probability 0.5.
+//
+// Random numbers are drawn from rnd, so the results are reproducible if rnd
+// is seeded deterministically.
+// If rnd is nil, the global random number generator is used.
+// If the given scale is negative, it is redefined to zero.
+// See also method [Decimal.RoundMode] with the [Stochastic] mode.
+//
+// [stochastic rounding]: https://en.wikipedia.org/wiki/Rounding#Stochastic_rounding
+func (d Decimal) RoundStochastic(scale int, rnd *rand.Rand) Decimal {
+	return d.roundMode(scale, Stochastic, rnd)
+}
+
+// roundUp reports whether a coefficient truncated towards zero should be
+// incremented to round it using the given mode, where neg is the sign of
+// the decimal, odd reports whether the truncated coefficient is odd,
+// and half is the result of comparing the non-zero discarded fraction
+// with one half:
+//
+//	-1 if the fraction is less than one half;
+//	 0 if the fraction is equal to one half;
+//	+1 if the fraction is greater than one half.
+//
+// Stochastic rounding depends on the exact fraction, see roundUpRand.
+func roundUp(neg bool, mode RoundingMode, half int, odd bool) bool {
+	switch mode {
+	case HalfUp:
+		return half >= 0
+	case HalfDown:
+		return half > 0
+	case Up:
+		return true
+	case Down:
+		return false
+	case Ceiling:
+		return !neg
+	case Floor:
+		return neg
+	default:
+		return half > 0 || half == 0 && odd
+	}
+}
+
+// roundUpRand reports whether a coefficient truncated towards zero should be
+// incremented to round it stochastically, where r / y is the non-zero
+// discarded fraction.
+// If rnd is nil, the global random number generator is used.
+func roundUpRand(r, y uint64, rnd *rand.Rand) bool {
+	var u uint64
+	if rnd == nil {
+		u = rand.Uint64N(y)
+	} else {
+		u = rnd.Uint64N(y)
+	}
+	return u < r
+}
+
+// roundOp computes the exact result of the operation on decimals d and e,
+// and rounds it only once using the given rounding mode, so that the result
+// has at most scale digits after the decimal point and at most prec
+// significant digits.
+// If the exact result has fewer digits after the decimal point, it is padded
+// with zeros to the given scale.
+// The operator is "+", "-", "*", or "/".
+// The scale must be within the range [MinScale, MaxScale] and prec must be
+// within the range [1, MaxPrec].
+// If rnd is nil, the global random number generator is used for
+// stochastic rounding.
+func roundOp(op string, d, e Decimal, scale, prec int, mode RoundingMode, rnd *rand.Rand) (Decimal, error) {
+	if op == "-" {
+		op, e = "+", e.Neg()
+	}
+	if op == "/" && e.IsZero() {
+		return Decimal{}, errDivisionByZero
+	}
+	f, err := roundOpFint(op, d, e, scale, prec, mode, rnd)
+	if err != nil {
+		f, err = roundOpBint(op, d, e, scale, prec, mode, rnd)
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+	return f, nil
+}
+
+// roundOpFint computes the rounded result of the operation using uint64
+// arithmetic.
+func roundOpFint(op string, d, e Decimal, scale, prec int, mode RoundingMode, rnd *rand.Rand) (Decimal, error) {
+	var ok bool
+	var neg bool
+	var num, den fint = 0, 1
+	var xscale int
+
+	// Compute x = num / den / 10^xscale, where x is the exact result
+	switch op {
+	case "+":
+		xscale = max(d.Scale(), e.Scale())
+		dcoef, ok := d.coef.lsh(xscale - d.Scale())
+		if !ok {
+			return Decimal{}, errDecimalOverflow
+		}
+		ecoef, ok := e.coef.lsh(xscale - e.Scale())
+		if !ok {
+			return Decimal{}, errDecimalOverflow
+		}
+		neg, num, ok = addSigned(d.IsNeg(), dcoef, e.IsNeg(), ecoef)
+		if !ok {
+			return Decimal{}, errDecimalOverflow
+		}
+	case "*":
+		num, ok = d.coef.mul(e.coef)
+		if !ok {
+			return Decimal{}, errDecimalOverflow
+		}
+		neg, xscale = d.IsNeg() != e.IsNeg(), d.Scale()+e.Scale()
+	case "/":
+		num, den = d.coef, e.coef
+		neg, xscale = d.IsNeg() != e.IsNeg(), d.Scale()-e.Scale()
+	default:
+		return Decimal{}, errInvalidOperation
+	}
+	return roundQuoFint(neg, num, den, xscale, scale, prec, mode, rnd)
+}
+
+// roundQuoFint computes num / den / 10^xscale rounded as described
+// in roundOp using uint64 arithmetic.
+func roundQuoFint(neg bool, num, den fint, xscale, scale, prec int, mode RoundingMode, rnd *rand.Rand) (Decimal, error) {
+	switch {
+	case den == 0:
+		return Decimal{}, errDivisionByZero
+	case num == 0:
+		return newSafe(false, 0, scale)
+	}
+	for {
+		shift := scale - xscale
+		if shift >= len(pow10) {
+			return Decimal{}, errDecimalOverflow
+		}
+		q, ok := quoModeFint(neg, num, den, shift, mode, rnd)
+		if !ok {
+			// The result has more than MaxPrec digits
+			if scale == MinScale {
+				return Decimal{}, errDecimalOverflow
+			}
+			scale--
+			continue
+		}
+		p := q.prec()
+		if p <= prec {
+			return newSafe(neg, q, scale)
+		}
+		if scale-(p-prec) < MinScale {
+			return Decimal{}, precOverflowError(prec, p-scale)
+		}
+		scale -= p - prec
+	}
+}
+
+// quoModeFint calculates round(num * 10^shift / den) using the given
+// rounding mode, where neg is the sign of the result.
+// Intermediate results are 128-bit, so the result is exact if it fits
+// into uint64.
+// quoModeFint reports false if the result does not fit into uint64
+// or the divisor is 0.
+func quoModeFint(neg bool, num, den fint, shift int, mode RoundingMode, rnd *rand.Rand) (fint, bool) {
+	// Compute n = num * 10^shift and y = den * 10^(-shift)
+	var nhi, nlo, yhi, ylo uint64
+	switch {
+	case den == 0 || shift >= len(pow10):
+		return 0, false
+	case shift >= 0:
+		nhi, nlo = bits.Mul64(uint64(num), uint64(pow10[shift]))
+		ylo = uint64(den)
+	case -shift >= len(pow10):
+		// The fraction n / y is less than one half, and less than 10^-19
+		// for stochastic rounding, which is therefore done towards zero
+		if num != 0 && mode != Stochastic && roundUp(neg, mode, -1, false) {
+			return 1, true
+		}
+		return 0, true
+	default:
+		nlo = uint64(num)
+		yhi, ylo = bits.Mul64(uint64(den), uint64(pow10[-shift]))
+	}
+
+	// Compute q = ⌊n / y⌋, r = n - y * q
+	var q, r uint64
+	switch {
+	case yhi != 0:
+		q, r = 0, nlo // y > n, since nhi = 0
+	case nhi >= ylo:
+		return 0, false
+	default:
+		q, r = bits.Div64(nhi, nlo, ylo)
+	}
+	if r == 0 {
+		return fint(q), true
+	}
+
+	// Compare r with y / 2
+	rhi, rlo := r>>63, r<<1
+	half := 0
+	switch {
+	case rhi > yhi || rhi == yhi && rlo > ylo:
+		half = 1
+	case rhi < yhi || rlo < ylo:
+		half = -1
+	}
+	var up bool
+	if mode == Stochastic {
+		// Leading bits are sufficient to determine the probability
+		k := 64 - bits.LeadingZeros64(yhi)
+		up = roundUpRand(r>>k, yhi<<(64-k)|ylo>>k, rnd)
+	} else {
+		up = roundUp(neg, mode, half, q&1 != 0)
+	}
+	if up {
+		if q == math.MaxUint64 {
+			return 0, false
+		}
+		q++
+	}
+	return fint(q), true
+}
+
+// roundSum computes the exact sum of decimals and rounds it as described
+// in roundOp.
+func roundSum(d []Decimal, scale, prec int, mode RoundingMode, rnd *rand.Rand) (Decimal, error) {
+	f, err := sumFint(d...)
+	if err == nil {
+		f, err = roundQuoFint(f.IsNeg(), f.coef, 1, f.Scale(), scale, prec, mode, rnd)
+	}
+	if err != nil {
+		f, err = roundSumBint(d, scale, prec, mode, rnd)
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+	return f, nil
+}
+
+// precOverflowError returns an error for a result whose integer part has
+// more digits than the given precision.
+func precOverflowError(prec, gotDigits int) error {
+	return errorf("%w: the integer part of a result can have at most %v digits, but it has %v digits", errDecimalOverflow, prec, gotDigits)
+}
@@ -0,0 +1,489 @@
+package decimal
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestDecimal_RoundMode(t *testing.T) {
+	modes := [...]RoundingMode{HalfEven, HalfUp, HalfDown, Up, Down, Ceiling, Floor}
+	tests := []struct {
+		d     string
+		scale int
+		want  [len(modes)]string
+	}{
+		{"5.5", 0, [...]string{"6", "6", "5", "6", "5", "6", "5"}},
+		{"2.5", 0, [...]string{"2", "3", "2", "3", "2", "3", "2"}},
+		{"1.6", 0, [...]string{"2", "2", "2", "2", "1", "2", "1"}},
+		{"1.1", 0, [...]string{"1", "1", "1", "2", "1", "2", "1"}},
+		{"1.0", 0, [...]string{"1", "1", "1", "1", "1", "1", "1"}},
+		{"-1.0", 0, [...]string{"-1", "-1", "-1", "-1", "-1", "-1", "-1"}},
+		{"-1.1", 0, [...]string{"-1", "-1", "-1", "-2", "-1", "-1", "-2"}},
+		{"-1.6", 0, [...]string{"-2", "-2", "-2", "-2", "-1", "-1", "-2"}},
+		{"-2.5", 0, [...]string{"-2", "-3", "-2", "-3", "-2", "-2", "-3"}},
+		{"-5.5", 0, [...]string{"-6", "-6", "-5", "-6", "-5", "-5", "-6"}},
+		{"0.125", 2, [...]string{"0.12", "0.13", "0.12", "0.13", "0.12", "0.13", "0.12"}},
+		{"0.125", -1, [...]string{"0", "0", "0", "1", "0", "1", "0"}},
+		{"0.125", 3, [...]string{"0.125", "0.125", "0.125", "0.125", "0.125", "0.125", "0.125"}},
+		{"0.125", 4, [...]string{"0.125", "0.125", "0.125", "0.125", "0.125", "0.125", "0.125"}},
+		{"9.999999999999999999", 0, [...]string{"10", "10", "10", "10", "9", "10", "9"}},
+		{"0.0000000000000000005", 18, [...]string{"0.000000000000000000", "0.000000000000000001", "0.000000000000000000", "0.000000000000000001", "0.000000000000000000", "0.000000000000000001", "0.000000000000000000"}},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		for i, mode := range modes {
+			got := d.RoundMode(tt.scale, mode)
+			want := MustParse(tt.want[i])
+			if got != want {
+				t.Errorf("%q.RoundMode(%v, %v) = %q, want %q", d, tt.scale, mode, got, want)
+			}
+		}
+	}
+
+	// Unknown mode
+	got := MustParse("2.5").RoundMode(0, RoundingMode(-1))
+	if want := MustParse("2"); got != want {
+		t.Errorf("RoundMode with unknown mode = %q, want %q", got, want)
+	}
+}
+
+func TestParseRoundingMode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want RoundingMode
+		}{
+			{"half_even", HalfEven},
+			{"half_up", HalfUp},
+			{"half_down", HalfDown},
+			{"up", Up},
+			{"down", Down},
+			{"ceiling", Ceiling},
+			{"floor", Floor},
+			{"stochastic", Stochastic},
+			{"HALF_EVEN", HalfEven},
+			{"Down", Down},
+		}
+		for _, tt := range tests {
+			got, err := ParseRoundingMode(tt.s)
+			if err != nil {
+				t.Errorf("ParseRoundingMode(%q) failed: %v", tt.s, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("ParseRoundingMode(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"", "halfeven", "half-even", " down", "truncate"}
+		for _, s := range tests {
+			_, err := ParseRoundingMode(s)
+			if !errors.Is(err, errInvalidOperation) {
+				t.Errorf("ParseRoundingMode(%q) error = %v, want %v", s, err, errInvalidOperation)
+			}
+		}
+	})
+}
+
+func TestRoundingMode_String(t *testing.T) {
+	tests := []struct {
+		m    RoundingMode
+		want string
+	}{
+		{HalfEven, "half_even"},
+		{HalfUp, "half_up"},
+		{HalfDown, "half_down"},
+		{Up, "up"},
+		{Down, "down"},
+		{Ceiling, "ceiling"},
+		{Floor, "floor"},
+		{Stochastic, "stochastic"},
+		{-1, "RoundingMode(-1)"},
+		{8, "RoundingMode(8)"},
+	}
+	for _, tt := range tests {
+		if got := tt.m.String(); got != tt.want {
+			t.Errorf("RoundingMode(%d).String() = %q, want %q", int(tt.m), got, tt.want)
+		}
+	}
+}
+
+func TestRoundingMode_Text(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		for _, m := range [...]RoundingMode{HalfEven, HalfUp, HalfDown, Up, Down, Ceiling, Floor, Stochastic} {
+			text, err := m.MarshalText()
+			if err != nil {
+				t.Errorf("%v.MarshalText() failed: %v", m, err)
+				continue
+			}
+			var got RoundingMode
+			if err := got.UnmarshalText(text); err != nil {
+				t.Errorf("UnmarshalText(%q) failed: %v", text, err)
+				continue
+			}
+			if got != m {
+				t.Errorf("UnmarshalText(%q) = %v, want %v", text, got, m)
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var cfg struct {
+			Mode RoundingMode `json:"mode"`
+		}
+		if err := json.Unmarshal([]byte(`{"mode":"half_up"}`), &cfg); err != nil {
+			t.Fatalf("json.Unmarshal() failed: %v", err)
+		}
+		if cfg.Mode != HalfUp {
+			t.Errorf("json.Unmarshal() = %v, want %v", cfg.Mode, HalfUp)
+		}
+		got, err := json.Marshal(cfg)
+		if err != nil {
+			t.Fatalf("json.Marshal() failed: %v", err)
+		}
+		if want := `{"mode":"half_up"}`; string(got) != want {
+			t.Errorf("json.Marshal() = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		for _, m := range [...]RoundingMode{-1, 8} {
+			_, err := m.MarshalText()
+			if !errors.Is(err, errInvalidOperation) {
+				t.Errorf("%v.MarshalText() error = %v, want %v", m, err, errInvalidOperation)
+			}
+		}
+		var m RoundingMode
+		err := m.UnmarshalText([]byte("nearest"))
+		if !errors.Is(err, errInvalidOperation) {
+			t.Errorf("UnmarshalText(%q) error = %v, want %v", "nearest", err, errInvalidOperation)
+		}
+	})
+}
+
+func TestDecimal_QuantizeMode(t *testing.T) {
+	tests := []struct {
+		d, e    string
+		mode    RoundingMode
+		want    string
+		wantDir int
+	}{
+		{"1.235", "0.01", HalfEven, "1.24", 1},
+		{"1.225", "0.01", HalfEven, "1.22", -1},
+		{"-1.235", "0.01", HalfEven, "-1.24", -1},
+		{"-1.225", "0.01", HalfEven, "-1.22", 1},
+		{"1.231", "0.01", Ceiling, "1.24", 1},
+		{"-1.231", "0.01", Ceiling, "-1.23", 1},
+		{"1.239", "0.01", Floor, "1.23", -1},
+		{"-1.231", "0.01", Up, "-1.24", -1},
+		{"1.230", "0.01", Up, "1.23", 0},
+		{"1.23", "0.01", Down, "1.23", 0},
+		{"1.2", "-0.001", HalfEven, "1.200", 0},
+		{"0.004", "1", HalfUp, "0", -1},
+		{"-0.004", "1", Up, "-1", -1},
+		{"9999999999999999999", "0.01", HalfEven, "9999999999999999999", 0},
+		{"9.999999999999999999", "1", HalfEven, "10", 1},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		e := MustParse(tt.e)
+		got, gotDir := d.QuantizeMode(e, tt.mode)
+		want := MustParse(tt.want)
+		if got != want || gotDir != tt.wantDir {
+			t.Errorf("%q.QuantizeMode(%q, %v) = (%q, %v), want (%q, %v)", d, e, tt.mode, got, gotDir, want, tt.wantDir)
+		}
+	}
+}
+
+func TestDecimal_RoundSig(t *testing.T) {
+	methods := [...]func(Decimal, int) (Decimal, error){Decimal.RoundSig, Decimal.CeilSig, Decimal.FloorSig}
+	names := [len(methods)]string{"RoundSig", "CeilSig", "FloorSig"}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d      string
+			digits int
+			want   [len(methods)]string
+		}{
+			{"0", 1, [...]string{"0", "0", "0"}},
+			{"0.00", 1, [...]string{"0.00", "0.00", "0.00"}},
+			{"1.25", 3, [...]string{"1.25", "1.25", "1.25"}},
+			{"1.25", 5, [...]string{"1.25", "1.25", "1.25"}},
+			{"1.25", 2, [...]string{"1.2", "1.3", "1.2"}},
+			{"-1.25", 2, [...]string{"-1.2", "-1.2", "-1.3"}},
+			{"0.012345", 2, [...]string{"0.012", "0.013", "0.012"}},
+			{"0.0999", 2, [...]string{"0.10", "0.10", "0.099"}},
+			{"9.99", 2, [...]string{"10", "10", "9.9"}},
+			{"-9.99", 1, [...]string{"-10", "-9", "-10"}},
+			{"12345", 2, [...]string{"12000", "13000", "12000"}},
+			{"-12345.678", 2, [...]string{"-12000", "-12000", "-13000"}},
+			{"12500", 2, [...]string{"12000", "13000", "12000"}},
+			{"99999", 3, [...]string{"100000", "100000", "99900"}},
+			{"9999999999999999999", 19, [...]string{"9999999999999999999", "9999999999999999999", "9999999999999999999"}},
+			{"1234567890123456789", 1, [...]string{"1000000000000000000", "2000000000000000000", "1000000000000000000"}},
+			{"0.0000000000000000005", 1, [...]string{"0.0000000000000000005", "0.0000000000000000005", "0.0000000000000000005"}},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			for i, method := range methods {
+				got, err := method(d, tt.digits)
+				if err != nil {
+					t.Errorf("%q.%v(%v) failed: %v", d, names[i], tt.digits, err)
+					continue
+				}
+				want := MustParse(tt.want[i])
+				if got != want {
+					t.Errorf("%q.%v(%v) = %q, want %q", d, names[i], tt.digits, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d      string
+			digits int
+		}{
+			{"1", 0},
+			{"1", -1},
+			{"9999999999999999999", 1},
+			{"9500000000000000000", 1},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := d.RoundSig(tt.digits)
+			if err == nil {
+				t.Errorf("%q.RoundSig(%v) did not fail", d, tt.digits)
+			}
+		}
+	})
+}
+
+func TestDecimal_RoundPow10(t *testing.T) {
+	modes := [...]RoundingMode{HalfEven, HalfUp, Down, Ceiling, Floor}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			n    int
+			want [len(modes)]string
+		}{
+			{"0", 3, [...]string{"0", "0", "0", "0", "0"}},
+			{"0.00", 3, [...]string{"0", "0", "0", "0", "0"}},
+			{"1234567.89", 3, [...]string{"1235000", "1235000", "1234000", "1235000", "1234000"}},
+			{"-1234567.89", 3, [...]string{"-1235000", "-1235000", "-1234000", "-1234000", "-1235000"}},
+			{"2500", 3, [...]string{"2000", "3000", "2000", "3000", "2000"}},
+			{"499.99", 3, [...]string{"0", "0", "0", "1000", "0"}},
+			{"1234567.89", 1, [...]string{"1234570", "1234570", "1234560", "1234570", "1234560"}},
+			{"1234567.89", 0, [...]string{"1234568", "1234568", "1234567", "1234568", "1234567"}},
+			{"1234567.89", -1, [...]string{"1234567.9", "1234567.9", "1234567.8", "1234567.9", "1234567.8"}},
+			{"1.25", -5, [...]string{"1.25", "1.25", "1.25", "1.25", "1.25"}},
+			{"1.25", math.MinInt, [...]string{"1.25", "1.25", "1.25", "1.25", "1.25"}},
+			{"9999999999999999999", 1, [...]string{"", "", "9999999999999999990", "", "9999999999999999990"}},
+			{"1", 19, [...]string{"0", "0", "0", "", "0"}},
+			{"1", math.MaxInt, [...]string{"0", "0", "0", "", "0"}},
+			{"-1", math.MaxInt, [...]string{"0", "0", "0", "0", ""}},
+			{"1234567890123456789", 18, [...]string{"1000000000000000000", "1000000000000000000", "1000000000000000000", "2000000000000000000", "1000000000000000000"}},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			for i, mode := range modes {
+				if tt.want[i] == "" {
+					continue
+				}
+				got, err := d.RoundPow10(tt.n, mode)
+				if err != nil {
+					t.Errorf("%q.RoundPow10(%v, %v) failed: %v", d, tt.n, mode, err)
+					continue
+				}
+				want := MustParse(tt.want[i])
+				if got != want {
+					t.Errorf("%q.RoundPow10(%v, %v) = %q, want %q", d, tt.n, mode, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			n    int
+			mode RoundingMode
+		}{
+			{"9999999999999999999", 1, HalfEven},
+			{"9999999999999999999", 1, Ceiling},
+			{"9500000000000000000", 19, HalfUp},
+			{"1", 19, Ceiling},
+			{"-1", math.MaxInt, Floor},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := d.RoundPow10(tt.n, tt.mode)
+			if err == nil {
+				t.Errorf("%q.RoundPow10(%v, %v) did not fail", d, tt.n, tt.mode)
+			}
+		}
+	})
+}
+
+func TestDecimal_InUnitsOf(t *testing.T) {
+	tests := []struct {
+		d            string
+		pow10, scale int
+		want         string
+	}{
+		{"0", 3, 0, "0"},
+		{"1234567.89", 3, 0, "1235"},
+		{"1234567.89", 3, 1, "1234.6"},
+		{"1234567.89", 3, 5, "1234.56789"},
+		{"1234567.89", 3, 10, "1234.56789"},
+		{"-1234567.89", 6, 2, "-1.23"},
+		{"2500", 3, 0, "2"},
+		{"3500", 3, 0, "4"},
+		{"2500.0001", 3, 0, "3"},
+		{"1234567.89", 0, 1, "1234567.9"},
+		{"1234567.89", -3, 1, "1234567.9"},
+		{"1234567.89", 3, -1, "1235"},
+		{"1234567.89", 3, 100, "1234.56789"},
+		{"1", 19, 19, "0.0000000000000000001"},
+		{"1", 20, 19, "0.0000000000000000000"},
+		{"5", 20, 19, "0.0000000000000000000"},
+		{"0.1234567890123456789", 2, 19, "0.0012345678901234568"},
+		{"9999999999999999999", math.MaxInt, 2, "0.00"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.InUnitsOf(tt.pow10, tt.scale)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.InUnitsOf(%v, %v) = %q, want %q", d, tt.pow10, tt.scale, got, want)
+		}
+	}
+}
+
+func TestDecimal_FromUnitsOf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			pow10 int
+			want  string
+		}{
+			{"0", 3, "0"},
+			{"1234.6", 3, "1234600"},
+			{"-1.23", 6, "-1230000"},
+			{"1.23", 0, "1.23"},
+			{"1.23", -3, "1.23"},
+			{"0.0012345678901234568", 2, "0.12345678901234568"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.FromUnitsOf(tt.pow10)
+			if err != nil {
+				t.Errorf("%q.FromUnitsOf(%v) failed: %v", d, tt.pow10, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.FromUnitsOf(%v) = %q, want %q", d, tt.pow10, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		d := MustParse("12345678901234.5")
+		_, err := d.FromUnitsOf(6)
+		if err == nil {
+			t.Errorf("%q.FromUnitsOf(6) did not fail", d)
+		}
+	})
+}
+
+func TestDecimal_RoundStochastic(t *testing.T) {
+	t.Run("exact", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			want  string
+		}{
+			{"0", 0, "0"},
+			{"0.00", 1, "0.0"},
+			{"1.20", 1, "1.2"},
+			{"-1.20", 1, "-1.2"},
+			{"1.25", 2, "1.25"},
+			{"1.25", 3, "1.25"},
+		}
+		rnd := rand.New(rand.NewPCG(1, 2))
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got := d.RoundStochastic(tt.scale, rnd)
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.RoundStochastic(%v) = %q, want %q", d, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		d := MustParse("-0.37")
+		x := rand.New(rand.NewPCG(42, 42))
+		y := rand.New(rand.NewPCG(42, 42))
+		for range 100 {
+			got, want := d.RoundStochastic(1, x), d.RoundStochastic(1, y)
+			if got != want {
+				t.Errorf("%q.RoundStochastic(1) = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("unbiased", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			up    string
+			prob  float64
+		}{
+			{"0.25", 1, "0.3", 0.5},
+			{"-0.37", 1, "-0.4", 0.7},
+			{"0.0000000000000000001", 18, "0.000000000000000001", 0.1},
+			{"1.000000000000000001", 0, "2", 0},
+		}
+		rnd := rand.New(rand.NewPCG(1, 2))
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			up := MustParse(tt.up)
+			down := d.Trunc(tt.scale)
+			const n = 10_000
+			count := 0
+			for range n {
+				got := d.RoundStochastic(tt.scale, rnd)
+				switch got {
+				case up:
+					count++
+				case down:
+				default:
+					t.Errorf("%q.RoundStochastic(%v) = %q, want %q or %q", d, tt.scale, got, down, up)
+				}
+			}
+			if got := float64(count) / n; math.Abs(got-tt.prob) > 0.02 {
+				t.Errorf("%q.RoundStochastic(%v) rounded away from zero with probability %v, want %v", d, tt.scale, got, tt.prob)
+			}
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		d := MustParse("0.5")
+		got := d.RoundStochastic(0, nil)
+		if got != Zero && got != One {
+			t.Errorf("%q.RoundStochastic(0, nil) = %q, want 0 or 1", d, got)
+		}
+		got = d.RoundMode(0, Stochastic)
+		if got != Zero && got != One {
+			t.Errorf("%q.RoundMode(0, %v) = %q, want 0 or 1", d, Stochastic, got)
+		}
+	})
+}
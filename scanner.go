@@ -0,0 +1,166 @@
+package decimal
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Scanner reads decimals from a stream of text, such as a file with
+// one exchange rate per line:
+//
+//	s := decimal.NewScanner(f)
+//	for {
+//		d, err := s.Next()
+//		if err == io.EOF {
+//			break
+//		}
+//		...
+//	}
+//
+// By default, decimals are separated by white space.
+// Scanner reuses its buffer, so decimals that can be parsed using uint64
+// arithmetic do not allocate memory.
+// Decimals are parsed as described in [Parse].
+// Scanner is not safe for concurrent use by multiple goroutines.
+type Scanner struct {
+	s      *bufio.Scanner
+	scale  int // scale is the enforced number of digits after the decimal point, or -1.
+	tokens int // tokens is the number of decimals read so far.
+}
+
+// NewScanner returns a new Scanner that reads decimals from r.
+// The Scanner uses a buffer of [bufio.MaxScanTokenSize] bytes.
+func NewScanner(r io.Reader) *Scanner {
+	s := bufio.NewScanner(r)
+	s.Split(bufio.ScanWords)
+	return &Scanner{s: s, scale: -1}
+}
+
+// Delimiters sets the characters that separate decimals.
+// Consecutive delimiters are treated as a single one, like in [strings.FieldsFunc],
+// so that empty lines and trailing delimiters are skipped.
+// If delims is empty, decimals are separated by white space.
+// Delimiters must be called before the first call to [Scanner.Next].
+func (s *Scanner) Delimiters(delims string) {
+	if delims == "" {
+		s.s.Split(bufio.ScanWords)
+		return
+	}
+	s.s.Split(splitDelims(delims))
+}
+
+// EnforceScale sets the number of digits after the decimal point of
+// decimals returned by [Scanner.Next].
+// Decimals with fewer digits are padded with zeros,
+// while decimals with more significant digits are reported as errors
+// instead of being rounded.
+// If the given scale is negative, the scale is not enforced, which is the default.
+// EnforceScale must be called before the first call to [Scanner.Next].
+func (s *Scanner) EnforceScale(scale int) {
+	s.scale = scale
+}
+
+// Buffer sets the initial buffer and the maximum length of a token,
+// as described in [bufio.Scanner.Buffer].
+// Buffer must be called before the first call to [Scanner.Next].
+func (s *Scanner) Buffer(buf []byte, maxLen int) {
+	s.s.Buffer(buf, maxLen)
+}
+
+// Next reads the next decimal.
+// At the end of the input, Next returns [io.EOF].
+//
+// Next returns an error if:
+//   - the underlying reader returns an error other than [io.EOF];
+//   - the token is not a valid decimal as described in [Parse];
+//   - the token has more significant digits after the decimal point
+//     than allowed by [Scanner.EnforceScale].
+func (s *Scanner) Next() (Decimal, error) {
+	if !s.s.Scan() {
+		if err := s.s.Err(); err != nil {
+			return Decimal{}, errorf("scanning decimal %v: %w", s.tokens+1, err)
+		}
+		return Decimal{}, io.EOF
+	}
+	s.tokens++
+	tok := s.s.Bytes()
+
+	if s.scale > MaxScale {
+		return Decimal{}, errorf("scanning decimal %v: %w", s.tokens, scaleRangeError(s.scale))
+	}
+	minScale := max(s.scale, 0)
+
+	// General case
+	d, err := parseFint(tok, minScale)
+	if err != nil || len(tok) > maxParseLength {
+		d, err = ParseExact(string(tok), minScale)
+		if err != nil {
+			return Decimal{}, errorf("scanning decimal %v: %w", s.tokens, err)
+		}
+	}
+
+	// Enforced scale
+	if s.scale >= 0 {
+		if scale := tokenScale(tok); scale > s.scale {
+			return Decimal{}, errorf("scanning decimal %v: %w: %v significant digits after the decimal point, but at most %v are allowed", s.tokens, errInvalidDecimal, scale, s.scale)
+		}
+		d = d.Trim(s.scale)
+	}
+
+	return d, nil
+}
+
+// tokenScale returns the number of significant digits after the decimal point
+// in a valid decimal token, not counting trailing zeros.
+// Unlike the scale of the parsed decimal, it is not affected by rounding.
+func tokenScale(tok []byte) int {
+	exp := 0
+	if i := bytes.IndexAny(tok, "eE"); i >= 0 {
+		exp, _ = strconv.Atoi(string(tok[i+1:]))
+		tok = tok[:i]
+	}
+	scale := 0
+	if i := bytes.IndexByte(tok, '.'); i >= 0 {
+		scale = len(bytes.TrimRight(tok[i+1:], "0"))
+	}
+	return scale - exp
+}
+
+// splitDelims returns a [bufio.SplitFunc] that splits the input into tokens
+// separated by any of the given characters.
+// It is similar to [bufio.ScanWords], but uses the given delimiters
+// instead of white space.
+func splitDelims(delims string) bufio.SplitFunc {
+	isDelim := func(r rune) bool {
+		return strings.ContainsRune(delims, r)
+	}
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		// Skip leading delimiters
+		start := 0
+		for start < len(data) {
+			r, width := utf8.DecodeRune(data[start:])
+			if !isDelim(r) {
+				break
+			}
+			start += width
+		}
+		// Scan until delimiter, marking end of token
+		for i := start; i < len(data); {
+			r, width := utf8.DecodeRune(data[i:])
+			if isDelim(r) {
+				return i + width, data[start:i], nil
+			}
+			i += width
+		}
+		// Final non-empty token at EOF
+		if atEOF && len(data) > start {
+			return len(data), data[start:], nil
+		}
+		// Request more data
+		return start, nil, nil
+	}
+}
@@ -0,0 +1,129 @@
+package decimal
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestScanner(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			input  string
+			delims string
+			scale  int
+			want   []string
+		}{
+			{"", "", -1, nil},
+			{"1.23\n4.5\n-0.001\n", "", -1, []string{"1.23", "4.5", "-0.001"}},
+			{"  1.23 \t 4.5\r\n\n-0.001", "", -1, []string{"1.23", "4.5", "-0.001"}},
+			{"1.23,4.5;-0.001\n", ",;\n", -1, []string{"1.23", "4.5", "-0.001"}},
+			{"1.23,,4.5,", ",", -1, []string{"1.23", "4.5"}},
+			{"1.23→4.5", "→", -1, []string{"1.23", "4.5"}},
+			{"1.23 4.5 7 1.2300", "", 2, []string{"1.23", "4.50", "7.00", "1.23"}},
+			{"0.0000000000000000001", "", 19, []string{"0.0000000000000000001"}},
+		}
+		for _, tt := range tests {
+			s := NewScanner(strings.NewReader(tt.input))
+			s.Delimiters(tt.delims)
+			s.EnforceScale(tt.scale)
+			var got []string
+			for {
+				d, err := s.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Errorf("NewScanner(%q).Next() failed: %v", tt.input, err)
+					break
+				}
+				got = append(got, d.String())
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("NewScanner(%q) = %q, want %q", tt.input, got, tt.want)
+				continue
+			}
+			for i := range got {
+				want := MustParse(tt.want[i]).String()
+				if got[i] != want {
+					t.Errorf("NewScanner(%q) = %q, want %q", tt.input, got, tt.want)
+					break
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			input string
+			scale int
+		}{
+			"invalid":        {"1 x 2", -1},
+			"overflow":       {"99999999999999999999", -1},
+			"scale 1":        {"1.234", 2},
+			"scale 2":        {"0.00000000000000000001", 19},
+			"scale overflow": {"99999999999999999", 3},
+			"scale range":    {"1", MaxScale + 1},
+			"length":         {strings.Repeat("0", 331), -1},
+		}
+		for name, tt := range tests {
+			s := NewScanner(strings.NewReader(tt.input))
+			s.EnforceScale(tt.scale)
+			var err error
+			for err == nil {
+				_, err = s.Next()
+			}
+			if err == io.EOF {
+				t.Errorf("NewScanner(%q).Next() did not fail for %v", tt.input, name)
+			}
+		}
+	})
+
+	t.Run("reader", func(t *testing.T) {
+		r := iotest.ErrReader(errors.New("boom"))
+		s := NewScanner(r)
+		_, err := s.Next()
+		if err == nil || err == io.EOF {
+			t.Errorf("Scanner.Next() error = %v, want reader error", err)
+		}
+	})
+
+	t.Run("token", func(t *testing.T) {
+		s := NewScanner(strings.NewReader("1 2 x"))
+		var err error
+		for err == nil {
+			_, err = s.Next()
+		}
+		want := "scanning decimal 3: "
+		if !strings.HasPrefix(err.Error(), want) {
+			t.Errorf("Scanner.Next() error = %q, want prefix %q", err, want)
+		}
+	})
+
+	t.Run("buffer", func(t *testing.T) {
+		s := NewScanner(strings.NewReader("1.23 4.5678"))
+		s.Buffer(make([]byte, 4), 5)
+		if _, err := s.Next(); err != nil {
+			t.Errorf("Scanner.Next() failed: %v", err)
+		}
+		if _, err := s.Next(); err == nil || err == io.EOF {
+			t.Errorf("Scanner.Next() error = %v, want token too long", err)
+		}
+	})
+
+	t.Run("allocs", func(t *testing.T) {
+		input := strings.Repeat("1234.5678\n", 200)
+		s := NewScanner(strings.NewReader(input))
+		s.Delimiters("\n")
+		s.EnforceScale(4)
+		_, _ = s.Next()
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = s.Next()
+		})
+		if allocs != 0 {
+			t.Errorf("AllocsPerRun = %v, want 0", allocs)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package decimal
+
+import (
+	"slices"
+)
+
+// Sort sorts decimals in ascending order.
+// Numerically equal decimals are ordered as in [Decimal.CmpTotal],
+// for example, 1.50 precedes 1.5, so the result does not depend
+// on the initial order of the decimals.
+// The sorted slice can be searched with [BinarySearch].
+// To sort decimals using the functions of the [slices] package,
+// use [Decimal.Cmp] or [Decimal.CmpTotal] as the comparison function:
+//
+//	slices.SortStableFunc(d, decimal.Decimal.Cmp)
+func Sort(d []Decimal) {
+	slices.SortFunc(d, Decimal.CmpTotal)
+}
+
+// IsSorted reports whether decimals are sorted in ascending numeric order.
+// Numerically equal decimals, such as 1.5 and 1.50, may be in any order.
+func IsSorted(d []Decimal) bool {
+	return slices.IsSortedFunc(d, Decimal.Cmp)
+}
+
+// BinarySearch searches for the decimal e in the decimals d sorted in
+// ascending numeric order, for example, by [Sort].
+// It returns the position of the first decimal that is numerically equal
+// to e and true, or the position where e would be inserted and false.
+// Decimals are compared by their numeric values, so 1.5 is found in
+// a slice that contains 1.50.
+// The running time is O(log n).
+func BinarySearch(d []Decimal, e Decimal) (int, bool) {
+	return slices.BinarySearchFunc(d, e, Decimal.Cmp)
+}
+
+// Index returns the position of the first decimal that is numerically equal
+// to e, or -1 if there is no such decimal.
+// Unlike [slices.Index], it compares decimals by their numeric values,
+// so 1.5 is found in a slice that contains 1.50.
+// See also function [Contains].
+func Index(d []Decimal, e Decimal) int {
+	return slices.IndexFunc(d, e.Equal)
+}
+
+// Contains reports whether any of the decimals is numerically equal to e.
+// Unlike [slices.Contains], it compares decimals by their numeric values,
+// so 1.5 is found in a slice that contains 1.50.
+// See also function [Index].
+func Contains(d []Decimal, e Decimal) bool {
+	return Index(d, e) >= 0
+}
+
+// Min returns the smallest of decimals.
+// If several decimals are numerically equal to the smallest one,
+// the first of them is returned.
+// See also method [Decimal.Min].
+//
+// Min returns an error if no arguments are provided.
+func Min(d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Decimal{}, errorf("computing [min([])]: %w: no arguments", errInvalidOperation)
+	}
+	e := d[0]
+	for _, f := range d[1:] {
+		if f.Cmp(e) < 0 {
+			e = f
+		}
+	}
+	return e, nil
+}
+
+// Max returns the largest of decimals.
+// If several decimals are numerically equal to the largest one,
+// the first of them is returned.
+// See also method [Decimal.Max].
+//
+// Max returns an error if no arguments are provided.
+func Max(d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Decimal{}, errorf("computing [max([])]: %w: no arguments", errInvalidOperation)
+	}
+	e := d[0]
+	for _, f := range d[1:] {
+		if f.Cmp(e) > 0 {
+			e = f
+		}
+	}
+	return e, nil
+}
@@ -0,0 +1,165 @@
+package decimal
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		d    []string
+		want []string
+	}{
+		{nil, nil},
+		{[]string{"1"}, []string{"1"}},
+		{[]string{"3", "-1", "2", "0"}, []string{"-1", "0", "2", "3"}},
+		{[]string{"1.5", "1.50", "-0.1", "1.500"}, []string{"-0.1", "1.500", "1.50", "1.5"}},
+		{[]string{"1.50", "1.5", "1.500", "-0.1"}, []string{"-0.1", "1.500", "1.50", "1.5"}},
+		{[]string{"9999999999999999999", "-9999999999999999999", "0.0000000000000000001"}, []string{"-9999999999999999999", "0.0000000000000000001", "9999999999999999999"}},
+	}
+	for _, tt := range tests {
+		got := mustParseAll(tt.d)
+		Sort(got)
+		want := mustParseAll(tt.want)
+		if !slices.Equal(got, want) {
+			t.Errorf("Sort(%v) = %v, want %v", tt.d, got, want)
+		}
+		if !IsSorted(got) {
+			t.Errorf("IsSorted(%v) = false, want true", got)
+		}
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	tests := []struct {
+		d    []string
+		want bool
+	}{
+		{nil, true},
+		{[]string{"1"}, true},
+		{[]string{"1", "1.0", "1.00"}, true},
+		{[]string{"1.00", "1", "1.0"}, true},
+		{[]string{"-1", "0", "0.5"}, true},
+		{[]string{"0.5", "0"}, false},
+		{[]string{"1", "2", "1.9"}, false},
+	}
+	for _, tt := range tests {
+		d := mustParseAll(tt.d)
+		got := IsSorted(d)
+		if got != tt.want {
+			t.Errorf("IsSorted(%v) = %v, want %v", d, got, tt.want)
+		}
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	d := mustParseAll([]string{"-1", "0.5", "1.50", "1.5", "2", "10"})
+	tests := []struct {
+		e      string
+		want   int
+		wantOk bool
+	}{
+		{"-2", 0, false},
+		{"-1", 0, true},
+		{"-1.00", 0, true},
+		{"0", 1, false},
+		{"0.50", 1, true},
+		{"1.5", 2, true},
+		{"1.500", 2, true},
+		{"1.6", 4, false},
+		{"10", 5, true},
+		{"11", 6, false},
+	}
+	for _, tt := range tests {
+		e := MustParse(tt.e)
+		got, ok := BinarySearch(d, e)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("BinarySearch(%v, %q) = %v, %v, want %v, %v", d, e, got, ok, tt.want, tt.wantOk)
+		}
+	}
+
+	got, ok := BinarySearch(nil, One)
+	if got != 0 || ok {
+		t.Errorf("BinarySearch([], 1) = %v, %v, want 0, false", got, ok)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	d := mustParseAll([]string{"3", "1.50", "-2", "1.5"})
+	tests := []struct {
+		e    string
+		want int
+	}{
+		{"3.0", 0},
+		{"1.5", 1},
+		{"1.500", 1},
+		{"-2", 2},
+		{"2", -1},
+		{"0", -1},
+	}
+	for _, tt := range tests {
+		e := MustParse(tt.e)
+		got := Index(d, e)
+		if got != tt.want {
+			t.Errorf("Index(%v, %q) = %v, want %v", d, e, got, tt.want)
+		}
+		ok := Contains(d, e)
+		if ok != (tt.want >= 0) {
+			t.Errorf("Contains(%v, %q) = %v, want %v", d, e, ok, tt.want >= 0)
+		}
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d                []string
+			wantMin, wantMax string
+		}{
+			{[]string{"1"}, "1", "1"},
+			{[]string{"3", "-1", "2"}, "-1", "3"},
+			{[]string{"1.0", "1", "1.00"}, "1.0", "1.0"},
+			{[]string{"-0.0000000000000000001", "0", "0.0000000000000000001"}, "-0.0000000000000000001", "0.0000000000000000001"},
+			{[]string{"9999999999999999999", "-9999999999999999999"}, "-9999999999999999999", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := mustParseAll(tt.d)
+			gotMin, err := Min(d...)
+			if err != nil {
+				t.Errorf("Min(%v) failed: %v", d, err)
+				continue
+			}
+			gotMax, err := Max(d...)
+			if err != nil {
+				t.Errorf("Max(%v) failed: %v", d, err)
+				continue
+			}
+			wantMin, wantMax := MustParse(tt.wantMin), MustParse(tt.wantMax)
+			if gotMin != wantMin {
+				t.Errorf("Min(%v) = %q, want %q", d, gotMin, wantMin)
+			}
+			if gotMax != wantMax {
+				t.Errorf("Max(%v) = %q, want %q", d, gotMax, wantMax)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Min()
+		if err == nil {
+			t.Errorf("Min() did not fail")
+		}
+		_, err = Max()
+		if err == nil {
+			t.Errorf("Max() did not fail")
+		}
+	})
+}
+
+func mustParseAll(ss []string) []Decimal {
+	d := make([]Decimal, len(ss))
+	for i, s := range ss {
+		d[i] = MustParse(s)
+	}
+	return d
+}
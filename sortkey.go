@@ -0,0 +1,91 @@
+package decimal
+
+// sortKeyLen is a length of the sort key: a sign, 19 digits of the integer
+// part, a decimal point, and 19 digits of the fractional part.
+const sortKeyLen = 1 + MaxPrec + 1 + MaxScale
+
+// SortKey returns a fixed-length string whose lexicographic (byte-wise)
+// order matches the numeric order of decimals.
+// It is intended for databases that can only compare strings or
+// binary floating-point numbers, such as Google Cloud Firestore,
+// where such keys support range queries and ordering of exact amounts.
+// See also constructor [ParseSortKey].
+//
+// The key consists of a sign, which is '-' for negative decimals and '0'
+// otherwise, followed by 19 digits of the integer part, a decimal point,
+// and 19 digits of the fractional part.
+// The digits of negative decimals are replaced by their nines' complements.
+// For example, the key of 1.5 is "00000000000000000001.5000000000000000000",
+// and the key of -1.5 is "-9999999999999999998.4999999999999999999".
+// Numerically equal decimals, such as 1.5 and 1.50, have the same key.
+func (d Decimal) SortKey() string {
+	var buf [sortKeyLen]byte
+	ipart, fpart := d.fixed()
+	buf[0] = '0'
+	buf[1+MaxPrec] = '.'
+	for i := 0; i < MaxPrec; i++ {
+		buf[MaxPrec-i] = byte(ipart%10) + '0'
+		buf[sortKeyLen-1-i] = byte(fpart%10) + '0'
+		ipart /= 10
+		fpart /= 10
+	}
+	if d.IsNeg() {
+		buf[0] = '-'
+		for i := 1; i < sortKeyLen; i++ {
+			if buf[i] != '.' {
+				buf[i] = '9' - buf[i] + '0'
+			}
+		}
+	}
+	return string(buf[:])
+}
+
+// ParseSortKey converts a key created by [Decimal.SortKey] back to a decimal.
+// Trailing zeros are removed from the fractional part of the result.
+//
+// ParseSortKey returns an error if the key was not created by [Decimal.SortKey].
+func ParseSortKey(s string) (Decimal, error) {
+	if len(s) != sortKeyLen || (s[0] != '0' && s[0] != '-') || s[1+MaxPrec] != '.' {
+		return Decimal{}, errorf("parsing sort key: %w", errInvalidDecimal)
+	}
+	neg := s[0] == '-'
+	var ipart, fpart fint
+	for i := 1; i < sortKeyLen; i++ {
+		if i == 1+MaxPrec {
+			continue
+		}
+		c := s[i]
+		if c < '0' || c > '9' {
+			return Decimal{}, errorf("parsing sort key: %w", errInvalidDecimal)
+		}
+		if neg {
+			c = '9' - c + '0'
+		}
+		if i < 1+MaxPrec {
+			ipart = ipart*10 + fint(c-'0')
+		} else {
+			fpart = fpart*10 + fint(c-'0')
+		}
+	}
+	scale := MaxScale
+	for scale > 0 && fpart%10 == 0 {
+		fpart /= 10
+		scale--
+	}
+	coef, ok := ipart.lsh(scale)
+	if ok {
+		coef, ok = coef.add(fpart)
+	}
+	if !ok || coef > maxCoef || (neg && coef == 0) {
+		return Decimal{}, errorf("parsing sort key: %w", errInvalidDecimal)
+	}
+	return newUnsafe(neg, coef, scale), nil
+}
+
+// fixed returns the integer part of the absolute value of the decimal
+// and its fractional part multiplied by 10^MaxScale.
+func (d Decimal) fixed() (ipart, fpart fint) {
+	ipart = d.coef / pow10[d.Scale()]
+	fpart = d.coef % pow10[d.Scale()] * pow10[MaxScale-d.Scale()]
+	return ipart, fpart
+}
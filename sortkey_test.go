@@ -0,0 +1,88 @@
+package decimal
+
+import "testing"
+
+func TestDecimal_SortKey(t *testing.T) {
+	tests := []struct {
+		d    string
+		want string
+	}{
+		{"0", "00000000000000000000.0000000000000000000"},
+		{"0.00", "00000000000000000000.0000000000000000000"},
+		{"1.5", "00000000000000000001.5000000000000000000"},
+		{"1.50", "00000000000000000001.5000000000000000000"},
+		{"-1.5", "-9999999999999999998.4999999999999999999"},
+		{"9999999999999999999", "09999999999999999999.0000000000000000000"},
+		{"-9999999999999999999", "-0000000000000000000.9999999999999999999"},
+		{"0.0000000000000000001", "00000000000000000000.0000000000000000001"},
+		{"-0.0000000000000000001", "-9999999999999999999.9999999999999999998"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.SortKey()
+		if got != tt.want {
+			t.Errorf("%q.SortKey() = %q, want %q", d, got, tt.want)
+		}
+	}
+
+	// Order
+	sorted := []string{
+		"-9999999999999999999", "-1000", "-999.9999999999999999", "-1.5", "-1.499999999999999999",
+		"-1", "-0.1", "-0.0000000000000000001", "0", "0.0000000000000000001",
+		"0.1", "1", "1.000000000000000001", "1.5", "999.9999999999999999", "1000",
+		"9999999999999999999",
+	}
+	for i := 1; i < len(sorted); i++ {
+		d, e := MustParse(sorted[i-1]), MustParse(sorted[i])
+		if d.SortKey() >= e.SortKey() {
+			t.Errorf("%q.SortKey() >= %q.SortKey(), want <", d, e)
+		}
+	}
+}
+
+func TestParseSortKey(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want string
+		}{
+			{"00000000000000000000.0000000000000000000", "0"},
+			{"00000000000000000001.5000000000000000000", "1.5"},
+			{"-9999999999999999998.4999999999999999999", "-1.5"},
+			{"09999999999999999999.0000000000000000000", "9999999999999999999"},
+			{"-0000000000000000000.9999999999999999999", "-9999999999999999999"},
+			{"00000000000000000000.0000000000000000001", "0.0000000000000000001"},
+			{"-9999999999999999999.9999999999999999998", "-0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			got, err := ParseSortKey(tt.s)
+			if err != nil {
+				t.Errorf("ParseSortKey(%q) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("ParseSortKey(%q) = %q, want %q", tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"empty":          "",
+			"short":          "00000000000000000001.500000000000000000",
+			"sign":           "+0000000000000000001.5000000000000000000",
+			"decimal point":  "000000000000000000015000000000000000000.",
+			"digit":          "0000000000000000000a.5000000000000000000",
+			"negative zero":  "-9999999999999999999.9999999999999999999",
+			"overflow":       "09999999999999999999.1000000000000000000",
+			"negative digit": "-999999999999999999a.9999999999999999999",
+		}
+		for name, s := range tests {
+			_, err := ParseSortKey(s)
+			if err == nil {
+				t.Errorf("ParseSortKey(%q) did not fail, %v", s, name)
+			}
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package decimal
+
+// spannerScale is a maximum number of digits after the decimal point
+// of the Google Cloud Spanner NUMERIC type.
+const spannerScale = 9
+
+// SpannerNumeric returns the decimal as a string accepted by the
+// Google Cloud Spanner NUMERIC type, which has a precision of 38 digits
+// and a scale of 9 digits.
+// Trailing zeros beyond 9 digits after the decimal point are removed.
+// The integer part of a decimal never exceeds the range of NUMERIC,
+// since it has at most [MaxPrec] digits.
+// See also method [Decimal.SpannerRat].
+//
+// SpannerNumeric returns an error if the decimal has non-zero digits
+// beyond 9 digits after the decimal point.
+// Use [Decimal.Round] or [Decimal.RoundMode] to round the decimal first.
+func (d Decimal) SpannerNumeric() (string, error) {
+	if d.MinScale() > spannerScale {
+		return "", errorf("converting %v to Spanner NUMERIC: %w: more than %v digits after the decimal point", redact(d), errInvalidOperation, spannerScale)
+	}
+	return d.Trim(spannerScale).String(), nil
+}
@@ -0,0 +1,54 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"math/big"
+)
+
+// NewFromSpannerRat converts a rational number, as returned by
+// the Google Cloud Spanner client for NUMERIC columns, to a decimal.
+// Trailing zeros are removed from the fractional part of the result.
+// See also method [Decimal.SpannerRat].
+//
+// NewFromSpannerRat returns an error if:
+//   - the number is nil;
+//   - the number cannot be represented exactly with 9 digits after the decimal point;
+//   - the number has more than [MaxPrec] significant digits.
+func NewFromSpannerRat(r *big.Rat) (Decimal, error) {
+	if r == nil {
+		return Decimal{}, errorf("converting Spanner NUMERIC: %w: nil number", errInvalidOperation)
+	}
+	num := new(bint)
+	num.mul((*bint)(r.Num()), bpow10[spannerScale])
+	rem := getBint()
+	defer putBint(rem)
+	num.quoRem(num, (*bint)(r.Denom()), rem)
+	if rem.sign() != 0 {
+		return Decimal{}, errorf("converting Spanner NUMERIC %v: %w: more than %v digits after the decimal point", redact(r), errInvalidOperation, spannerScale)
+	}
+	d, err := newFromBigInt((*big.Int)(num), spannerScale)
+	if err != nil {
+		return Decimal{}, errorf("converting Spanner NUMERIC %v: %w", redact(r), err)
+	}
+	return d.Trim(0), nil
+}
+
+// SpannerRat returns the decimal as a rational number accepted by
+// the Google Cloud Spanner client for NUMERIC columns.
+// See also constructor [NewFromSpannerRat] and method [Decimal.SpannerNumeric].
+//
+// SpannerRat returns an error if the decimal has non-zero digits
+// beyond 9 digits after the decimal point.
+// Use [Decimal.Round] or [Decimal.RoundMode] to round the decimal first.
+func (d Decimal) SpannerRat() (*big.Rat, error) {
+	if d.MinScale() > spannerScale {
+		return nil, errorf("converting %v to Spanner NUMERIC: %w: more than %v digits after the decimal point", redact(d), errInvalidOperation, spannerScale)
+	}
+	num := new(bint)
+	num.setFint(d.coef)
+	if d.IsNeg() {
+		(*big.Int)(num).Neg((*big.Int)(num))
+	}
+	return new(big.Rat).SetFrac((*big.Int)(num), (*big.Int)(bpow10[d.Scale()])), nil
+}
@@ -0,0 +1,96 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNewFromSpannerRat(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			r    string
+			want string
+		}{
+			{"0", "0"},
+			{"123/100", "1.23"},
+			{"-1/8", "-0.125"},
+			{"1/1000000000", "0.000000001"},
+			{"9999999999999999999", "9999999999999999999"},
+			{"1234567890.123456789", "1234567890.123456789"},
+		}
+		for _, tt := range tests {
+			r, ok := new(big.Rat).SetString(tt.r)
+			if !ok {
+				t.Fatalf("SetString(%q) failed", tt.r)
+			}
+			got, err := NewFromSpannerRat(r)
+			if err != nil {
+				t.Errorf("NewFromSpannerRat(%v) failed: %v", r, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("NewFromSpannerRat(%v) = %q, want %q", r, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"1/3",
+			"1/10000000000",
+			"10000000000000000000",
+			"12345678901.123456789",
+		}
+		for _, tt := range tests {
+			r, ok := new(big.Rat).SetString(tt)
+			if !ok {
+				t.Fatalf("SetString(%q) failed", tt)
+			}
+			_, err := NewFromSpannerRat(r)
+			if err == nil {
+				t.Errorf("NewFromSpannerRat(%v) did not fail", r)
+			}
+		}
+		_, err := NewFromSpannerRat(nil)
+		if err == nil {
+			t.Errorf("NewFromSpannerRat(nil) did not fail")
+		}
+	})
+}
+
+func TestDecimal_SpannerRat(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			want string
+		}{
+			{"0", "0/1"},
+			{"1.23", "123/100"},
+			{"-0.125", "-1/8"},
+			{"0.1234567890000000000", "123456789/1000000000"},
+			{"9999999999999999999", "9999999999999999999/1"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.SpannerRat()
+			if err != nil {
+				t.Errorf("%q.SpannerRat() failed: %v", d, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.SpannerRat() = %v, want %v", d, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		d := MustParse("0.1234567891")
+		_, err := d.SpannerRat()
+		if err == nil {
+			t.Errorf("%q.SpannerRat() did not fail", d)
+		}
+	})
+}
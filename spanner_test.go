@@ -0,0 +1,44 @@
+package decimal
+
+import "testing"
+
+func TestDecimal_SpannerNumeric(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			want string
+		}{
+			{"0", "0"},
+			{"-1.23", "-1.23"},
+			{"0.123456789", "0.123456789"},
+			{"0.1234567890000000000", "0.123456789"},
+			{"1.0000000000", "1.000000000"},
+			{"9999999999999999999", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.SpannerNumeric()
+			if err != nil {
+				t.Errorf("%q.SpannerNumeric() failed: %v", d, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.SpannerNumeric() = %q, want %q", d, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"0.1234567891",
+			"-0.0000000000000000001",
+		}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			_, err := d.SpannerNumeric()
+			if err == nil {
+				t.Errorf("%q.SpannerNumeric() did not fail", d)
+			}
+		}
+	})
+}
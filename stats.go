@@ -0,0 +1,328 @@
+package decimal
+
+import (
+	"slices"
+)
+
+// Median returns the (possibly rounded) median of decimals.
+// If the number of decimals is even, the median is the arithmetic mean
+// of the two middle decimals, which is computed exactly and rounded only
+// once, as in [Mean].
+// The decimals are not modified.
+//
+// Median returns an error if:
+//   - no arguments are provided;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func Median(d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Decimal{}, errorf("computing [median([])]: %w: no arguments", errInvalidOperation)
+	}
+	e, err := median(slices.Clone(d))
+	if err != nil {
+		return Decimal{}, errorf("computing [median(%v)]: %w", redact(d), err)
+	}
+	return e, nil
+}
+
+// median sorts decimals in place and returns their median.
+func median(d []Decimal) (Decimal, error) {
+	slices.SortFunc(d, Decimal.Cmp)
+	n := len(d)
+	if n%2 == 1 {
+		return d[n/2], nil
+	}
+	return midpoint(d[n/2-1], d[n/2])
+}
+
+// midpoint returns the (possibly rounded) arithmetic mean of decimals d and e.
+// Like [Mean], midpoint computes the exact sum of decimals and rounds
+// the result only once.
+func midpoint(d, e Decimal) (Decimal, error) {
+	f, err := sumFint(d, e)
+	if err == nil {
+		f, err = f.QuoInt64(2)
+	} else {
+		f, err = meanBint(d, e)
+	}
+	if err != nil {
+		return Decimal{}, err
+	}
+	return f, nil
+}
+
+// Mode returns the most frequent decimal.
+// Decimals are compared by their numerical values, so 1.0 and 1.00 are
+// counted as the same decimal.
+// If several decimals are equally frequent, the smallest one is returned.
+// If the most frequent decimal has several representations, the one that
+// occurs first is returned.
+// The decimals are not modified.
+//
+// Mode returns an error if no arguments are provided.
+func Mode(d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Decimal{}, errorf("computing [mode([])]: %w: no arguments", errInvalidOperation)
+	}
+	s := slices.Clone(d)
+	slices.SortStableFunc(s, Decimal.Cmp)
+	var mode Decimal
+	var best int
+	for i := 0; i < len(s); {
+		j := i + 1
+		for j < len(s) && s[j].Cmp(s[i]) == 0 {
+			j++
+		}
+		if j-i > best {
+			mode, best = s[i], j-i
+		}
+		i = j
+	}
+	return mode, nil
+}
+
+// MedianAbsoluteDeviation returns the (possibly rounded) [median absolute deviation]
+// of decimals, that is, the median of absolute deviations of the decimals
+// from their median.
+// It is a robust measure of variability, which is useful for detecting
+// outliers.
+// The decimals are not modified.
+// See also function [Median].
+//
+// MedianAbsoluteDeviation returns an error if:
+//   - no arguments are provided;
+//   - the integer part of any intermediate result has more than [MaxPrec] digits.
+//
+// [median absolute deviation]: https://en.wikipedia.org/wiki/Median_absolute_deviation
+func MedianAbsoluteDeviation(d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Decimal{}, errorf("computing [mad([])]: %w: no arguments", errInvalidOperation)
+	}
+	s := slices.Clone(d)
+	m, err := median(s)
+	if err != nil {
+		return Decimal{}, errorf("computing [mad(%v)]: %w", redact(d), err)
+	}
+	for i := range s {
+		s[i], err = s[i].SubAbs(m)
+		if err != nil {
+			return Decimal{}, errorf("computing [mad(%v)]: %w", redact(d), err)
+		}
+	}
+	e, err := median(s)
+	if err != nil {
+		return Decimal{}, errorf("computing [mad(%v)]: %w", redact(d), err)
+	}
+	return e, nil
+}
+
+// GeoMean returns the (possibly rounded) geometric mean of decimals.
+// The result is computed as exp((log(d[0]) + ... + log(d[n-1])) / n)
+// with at least double precision using [big.Int] arithmetic and rounded
+// only once, so it is not affected by the overflow of the product of
+// decimals.
+// If any of the decimals is 0, the result is 0.
+// See also function [HarmonicMean].
+//
+// GeoMean returns an error if:
+//   - no arguments are provided;
+//   - any of the decimals is negative;
+//   - the integer part of the result has more than [MaxPrec] digits.
+//
+// [big.Int]: https://pkg.go.dev/math/big#Int
+func GeoMean(d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Decimal{}, errorf("computing [geomean([])]: %w: no arguments", errInvalidOperation)
+	}
+	var zero bool
+	for _, f := range d {
+		if f.IsNeg() {
+			return Decimal{}, errorf("computing [geomean(%v)]: %w: negative argument %v", redact(d), errInvalidOperation, redact(f))
+		}
+		zero = zero || f.IsZero()
+	}
+
+	// Special cases
+	switch {
+	case zero:
+		return Zero, nil
+	case len(d) == 1:
+		return d[0], nil
+	}
+
+	// General case
+	e, err := geoMeanBint(d...)
+	if err != nil {
+		return Decimal{}, errorf("computing [geomean(%v)]: %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// HarmonicMean returns the (possibly rounded) harmonic mean of decimals.
+// The result is computed as n / (1 / d[0] + ... + 1 / d[n-1]) with
+// 38 digits after the decimal point and then rounded.
+// Harmonic mean is used to average rates, for example, the average price
+// of a security bought for the same amount of money at different prices.
+// See also function [GeoMean].
+//
+// HarmonicMean returns an error if:
+//   - no arguments are provided;
+//   - any of the decimals is zero or negative;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func HarmonicMean(d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Decimal{}, errorf("computing [harmean([])]: %w: no arguments", errInvalidOperation)
+	}
+	for _, f := range d {
+		if !f.IsPos() {
+			return Decimal{}, errorf("computing [harmean(%v)]: %w: non-positive argument %v", redact(d), errInvalidOperation, redact(f))
+		}
+	}
+
+	// Special case
+	if len(d) == 1 {
+		return d[0], nil
+	}
+
+	// General case
+	e, err := harmonicMeanBint(d...)
+	if err != nil {
+		return Decimal{}, errorf("computing [harmean(%v)]: %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// VWAP returns the (possibly rounded) volume-weighted average price of trades,
+// that is, (prices[0] × quantities[0] + ... + prices[n-1] × quantities[n-1]) /
+// (quantities[0] + ... + quantities[n-1]).
+// The products and the sums are computed without any rounding, and the result
+// is rounded only once, so it may differ from the result of composing
+// [Decimal.Mul], [Sum], and [Decimal.Quo], which round every step.
+// Trailing zeros are removed from the result unless they are required
+// to preserve the largest scale of the prices.
+// Trades with zero quantity are allowed and do not affect the result.
+//
+// VWAP returns an error if:
+//   - no prices are provided;
+//   - the numbers of prices and quantities differ;
+//   - any of the quantities is negative;
+//   - the total quantity is zero;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func VWAP(prices, quantities []Decimal) (Decimal, error) {
+	if len(prices) == 0 {
+		return Decimal{}, errorf("computing [vwap([], %v)]: %w: no prices", redact(quantities), errInvalidOperation)
+	}
+	if len(prices) != len(quantities) {
+		return Decimal{}, errorf("computing [vwap(%v, %v)]: %w: %v prices and %v quantities", redact(prices), redact(quantities), errInvalidOperation, len(prices), len(quantities))
+	}
+	var total bool
+	for _, q := range quantities {
+		if q.IsNeg() {
+			return Decimal{}, errorf("computing [vwap(%v, %v)]: %w: negative quantity %v", redact(prices), redact(quantities), errInvalidOperation, redact(q))
+		}
+		total = total || q.IsPos()
+	}
+	if !total {
+		return Decimal{}, errorf("computing [vwap(%v, %v)]: %w: total quantity is zero", redact(prices), redact(quantities), errDivisionByZero)
+	}
+
+	// General case
+	e, err := vwapFint(prices, quantities)
+	if err != nil {
+		e, err = vwapBint(prices, quantities)
+		if err != nil {
+			return Decimal{}, errorf("computing [vwap(%v, %v)]: %w", redact(prices), redact(quantities), err)
+		}
+	}
+
+	// Preferred scale
+	scale := 0
+	for _, p := range prices {
+		scale = max(scale, p.Scale())
+	}
+	e = e.Trim(scale)
+
+	return e, nil
+}
+
+// vwapFint computes the volume-weighted average price using uint64
+// arithmetic for the products and the sums.
+// It returns an error if any of them cannot be computed exactly.
+func vwapFint(prices, quantities []Decimal) (Decimal, error) {
+	num := Zero
+	for i, p := range prices {
+		q := quantities[i]
+		coef, ok := p.coef.mul(q.coef)
+		scale := p.Scale() + q.Scale()
+		if !ok || scale > MaxScale {
+			return Decimal{}, errDecimalOverflow
+		}
+		f, err := newSafe(p.IsNeg(), coef, scale)
+		if err != nil {
+			return Decimal{}, err
+		}
+		num, err = sumFint(num, f)
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+	den, err := sumFint(quantities...)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return num.Quo(den)
+}
+
+// CAGR returns the (possibly rounded) compound annual growth rate
+// of an investment that grows from begin to end over the given number
+// of periods, that is, (end / begin)^(1 / periods) - 1.
+// The rate is returned as a fraction, so a growth of 10% per period is 0.1.
+// The result is computed as exp((log(end) - log(begin)) / periods) - 1
+// with at least double precision using [big.Int] arithmetic and rounded
+// only once.
+// If end is 0, the result is -1.
+// See also functions [GeoMean], [PctChange].
+//
+// CAGR returns an error if:
+//   - the number of periods is less than 1;
+//   - begin is zero or negative;
+//   - end is negative;
+//   - the integer part of the result has more than [MaxPrec] digits.
+//
+// [big.Int]: https://pkg.go.dev/math/big#Int
+func CAGR(begin, end Decimal, periods int) (Decimal, error) {
+	switch {
+	case periods < 1:
+		return Decimal{}, errorf("computing [cagr(%v, %v, %v)]: %w: non-positive number of periods", redact(begin), redact(end), periods, errInvalidOperation)
+	case !begin.IsPos():
+		return Decimal{}, errorf("computing [cagr(%v, %v, %v)]: %w: non-positive begin", redact(begin), redact(end), periods, errInvalidOperation)
+	case end.IsNeg():
+		return Decimal{}, errorf("computing [cagr(%v, %v, %v)]: %w: negative end", redact(begin), redact(end), periods, errInvalidOperation)
+	}
+
+	// Special cases
+	switch {
+	case end.IsZero():
+		return NegOne, nil
+	case begin.Cmp(end) == 0:
+		return Zero, nil
+	}
+
+	// General case
+	e, err := cagrBint(begin, end, periods)
+	if err != nil {
+		return Decimal{}, errorf("computing [cagr(%v, %v, %v)]: %w", redact(begin), redact(end), periods, err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
@@ -0,0 +1,345 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestMedian(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"5.67"}, "5.67"},
+			{[]string{"3", "1", "2"}, "2"},
+			{[]string{"4", "1", "3", "2"}, "2.5"},
+			{[]string{"1.00", "2", "3", "4"}, "2.50"},
+			{[]string{"-1", "1"}, "0"},
+			{[]string{"2", "2.0", "2.00", "1"}, "2.0"},
+			{[]string{"9999999999999999999", "9999999999999999999"}, "9999999999999999999"},
+			{[]string{"9999999999999999999", "9999999999999999997"}, "9999999999999999998"},
+			{[]string{"-9999999999999999999", "-9999999999999999997"}, "-9999999999999999998"},
+			{[]string{"-9999999999999999999", "9999999999999999999"}, "0"},
+			{[]string{"0.0000000000000000001", "0.0000000000000000004"}, "0.0000000000000000002"},
+			{[]string{"0.0000000000000000001", "0.0000000000000000002"}, "0.0000000000000000002"},
+			{[]string{"-0.0000000000000000001", "-0.0000000000000000002"}, "-0.0000000000000000002"},
+			{[]string{"1", "1.0000000000000000001"}, "1.000000000000000000"},
+		}
+		for _, tt := range tests {
+			d := mustParseAll(tt.d)
+			orig := slices.Clone(d)
+			got, err := Median(d...)
+			if err != nil {
+				t.Errorf("Median(%v) failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got.Cmp(want) != 0 {
+				t.Errorf("Median(%v) = %q, want %q", d, got, want)
+			}
+			if !slices.Equal(d, orig) {
+				t.Errorf("Median(%v) modified its arguments", orig)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Median()
+		if err == nil {
+			t.Errorf("Median() did not fail")
+		}
+	})
+}
+
+func TestMode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"5.67"}, "5.67"},
+			{[]string{"1", "2", "2", "3"}, "2"},
+			{[]string{"3", "2", "1"}, "1"},
+			{[]string{"3", "3", "1", "1", "2"}, "1"},
+			{[]string{"2.00", "1", "2", "2.0"}, "2.00"},
+			{[]string{"-1", "-1", "1", "1"}, "-1"},
+		}
+		for _, tt := range tests {
+			d := mustParseAll(tt.d)
+			orig := slices.Clone(d)
+			got, err := Mode(d...)
+			if err != nil {
+				t.Errorf("Mode(%v) failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("Mode(%v) = %q, want %q", d, got, want)
+			}
+			if !slices.Equal(d, orig) {
+				t.Errorf("Mode(%v) modified its arguments", orig)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Mode()
+		if err == nil {
+			t.Errorf("Mode() did not fail")
+		}
+	})
+}
+
+func TestMedianAbsoluteDeviation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"5.67"}, "0"},
+			{[]string{"1", "1", "2", "2", "4", "6", "9"}, "1"},
+			{[]string{"1", "2", "3", "4"}, "1"},
+			{[]string{"1.5", "2.5", "100"}, "1.0"},
+			{[]string{"-5", "0", "5"}, "5"},
+		}
+		for _, tt := range tests {
+			d := mustParseAll(tt.d)
+			orig := slices.Clone(d)
+			got, err := MedianAbsoluteDeviation(d...)
+			if err != nil {
+				t.Errorf("MedianAbsoluteDeviation(%v) failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got.Cmp(want) != 0 {
+				t.Errorf("MedianAbsoluteDeviation(%v) = %q, want %q", d, got, want)
+			}
+			if !slices.Equal(d, orig) {
+				t.Errorf("MedianAbsoluteDeviation(%v) modified its arguments", orig)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := [][]string{
+			{},
+			{"-9999999999999999999", "-9999999999999999999", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := mustParseAll(tt)
+			_, err := MedianAbsoluteDeviation(d...)
+			if err == nil {
+				t.Errorf("MedianAbsoluteDeviation(%v) did not fail", d)
+			}
+		}
+	})
+}
+
+func TestGeoMean(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"5.67"}, "5.67"},
+			{[]string{"1", "1"}, "1"},
+			{[]string{"4", "9"}, "6"},
+			{[]string{"2", "8"}, "4"},
+			{[]string{"0.5", "2"}, "1"},
+			{[]string{"0.01", "0.04"}, "0.02"},
+			{[]string{"1", "2"}, "1.414213562373095049"},
+			{[]string{"1.05", "1.10", "0.95"}, "1.031419164168325905"},
+			{[]string{"0", "5"}, "0"},
+			{[]string{"9999999999999999999", "9999999999999999999"}, "9999999999999999999"},
+			{[]string{"0.0000000000000000001", "0.0000000000000000001"}, "0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			d := mustParseAll(tt.d)
+			got, err := GeoMean(d...)
+			if err != nil {
+				t.Errorf("GeoMean(%v) failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("GeoMean(%v) = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := [][]string{
+			{},
+			{"-1"},
+			{"1", "-4"},
+		}
+		for _, tt := range tests {
+			d := mustParseAll(tt)
+			_, err := GeoMean(d...)
+			if err == nil {
+				t.Errorf("GeoMean(%v) did not fail", d)
+			}
+		}
+	})
+}
+
+func TestHarmonicMean(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"5.67"}, "5.67"},
+			{[]string{"1", "1"}, "1"},
+			{[]string{"2", "8"}, "3.2"},
+			{[]string{"0.5", "2"}, "0.8"},
+			{[]string{"1", "2"}, "1.333333333333333333"},
+			{[]string{"1.05", "1.10", "0.95"}, "1.029476153244722439"},
+			{[]string{"9999999999999999999", "9999999999999999999"}, "9999999999999999999"},
+			{[]string{"0.0000000000000000001", "0.0000000000000000001"}, "0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			d := mustParseAll(tt.d)
+			got, err := HarmonicMean(d...)
+			if err != nil {
+				t.Errorf("HarmonicMean(%v) failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("HarmonicMean(%v) = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := [][]string{
+			{},
+			{"0"},
+			{"1", "-4"},
+			{"1", "0"},
+		}
+		for _, tt := range tests {
+			d := mustParseAll(tt)
+			_, err := HarmonicMean(d...)
+			if err == nil {
+				t.Errorf("HarmonicMean(%v) did not fail", d)
+			}
+		}
+	})
+}
+
+func TestVWAP(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			prices, quantities []string
+			want               string
+		}{
+			{[]string{"5.67"}, []string{"1"}, "5.67"},
+			{[]string{"1.5"}, []string{"2"}, "1.5"},
+			{[]string{"10.00", "10.50"}, []string{"1", "1"}, "10.25"},
+			{[]string{"10.00", "20.00"}, []string{"0", "5"}, "20.00"},
+			{[]string{"100", "101"}, []string{"3", "1"}, "100.25"},
+			{[]string{"1", "2"}, []string{"1", "2"}, "1.666666666666666667"},
+			{[]string{"-5", "5"}, []string{"1", "3"}, "2.5"},
+			{[]string{"1.23", "4.56"}, []string{"0.333", "0.667"}, "3.45111"},
+			{[]string{"0.1234567890123456789", "0.9876543210987654321"}, []string{"0.5", "1.5"}, "0.7716049380771604938"},
+			{[]string{"9999999999999999999", "9999999999999999999"}, []string{"9999999999999999999", "1"}, "9999999999999999999"},
+			{[]string{"9999999999999999999", "1"}, []string{"1", "9999999999999999999"}, "2"},
+		}
+		for _, tt := range tests {
+			prices, quantities := mustParseAll(tt.prices), mustParseAll(tt.quantities)
+			got, err := VWAP(prices, quantities)
+			if err != nil {
+				t.Errorf("VWAP(%v, %v) failed: %v", prices, quantities, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("VWAP(%v, %v) = %q, want %q", prices, quantities, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			prices, quantities []string
+			wantErr            error
+		}{
+			{[]string{}, []string{}, errInvalidOperation},
+			{[]string{"1"}, []string{}, errInvalidOperation},
+			{[]string{"1", "2"}, []string{"1"}, errInvalidOperation},
+			{[]string{"1", "2"}, []string{"1", "-1"}, errInvalidOperation},
+			{[]string{"1"}, []string{"0"}, errDivisionByZero},
+			{[]string{"1", "2"}, []string{"0.0", "0"}, errDivisionByZero},
+		}
+		for _, tt := range tests {
+			prices, quantities := mustParseAll(tt.prices), mustParseAll(tt.quantities)
+			_, err := VWAP(prices, quantities)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VWAP(%v, %v) error = %v, want %v", prices, quantities, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestCAGR(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			begin, end string
+			periods    int
+			want       string
+		}{
+			{"100", "121", 2, "0.1"},
+			{"100", "100", 5, "0"},
+			{"100", "100.00", 5, "0"},
+			{"100", "0", 3, "-1"},
+			{"100", "50", 1, "-0.5"},
+			{"1", "2", 1, "1"},
+			{"1", "2", 12, "0.0594630943592952646"},
+			{"1000", "1500", 7, "0.0596340226670483814"},
+			{"100", "80", 3, "-0.0716822332774442215"},
+			{"1", "9999999999999999999", 1, "9999999999999999998"},
+			{"0.25", "1", 2, "1"},
+			{"0.0000000000000000001", "1", 19, "9"},
+			{"9999999999999999999", "0.0000000000000000001", 1, "-1"},
+		}
+		for _, tt := range tests {
+			begin, end := MustParse(tt.begin), MustParse(tt.end)
+			got, err := CAGR(begin, end, tt.periods)
+			if err != nil {
+				t.Errorf("CAGR(%q, %q, %v) failed: %v", begin, end, tt.periods, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("CAGR(%q, %q, %v) = %q, want %q", begin, end, tt.periods, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			begin, end string
+			periods    int
+		}{
+			{"100", "121", 0},
+			{"100", "121", -1},
+			{"0", "121", 2},
+			{"-100", "121", 2},
+			{"100", "-121", 2},
+			{"0.0000000000000000001", "9999999999999999999", 1},
+		}
+		for _, tt := range tests {
+			begin, end := MustParse(tt.begin), MustParse(tt.end)
+			_, err := CAGR(begin, end, tt.periods)
+			if err == nil {
+				t.Errorf("CAGR(%q, %q, %v) did not fail", begin, end, tt.periods)
+			}
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package decimal
+
+// Steps returns an iterator over the arithmetic sequence
+// start, start + step, start + 2 × step, ... that does not go past stop.
+// Every element is computed exactly and has the scale of the longer of start
+// and step, so price ladders and strike grids do not drift as they do
+// with floats.
+// The stop is included only if it is an element of the sequence,
+// so the last element is never greater than stop if step is positive,
+// and never less than stop if step is negative.
+// If the step moves away from stop, the sequence is empty.
+//
+// The iterator has the type iter.Seq[Decimal], so with Go 1.23 or later
+// it can be used in a range loop:
+//
+//	for price := range seq {
+//		...
+//	}
+//
+// With earlier versions, the iterator is called with a function that returns
+// false to stop the iteration, like [OrderedLevels.Range].
+//
+// Steps returns an error if:
+//   - the step is zero;
+//   - start or stop cannot be represented with the scale of the sequence.
+func Steps(start, stop, step Decimal) (func(yield func(Decimal) bool), error) {
+	if step.IsZero() {
+		return nil, errorf("computing steps from %v to %v by %v: %w: step is zero", redact(start), redact(stop), redact(step), errInvalidOperation)
+	}
+
+	// Scale of the sequence
+	scale := max(start.Scale(), step.Scale())
+	first, err := start.padExact(scale)
+	if err != nil {
+		return nil, errorf("computing steps from %v to %v by %v: %w", redact(start), redact(stop), redact(step), err)
+	}
+	_, err = stop.padExact(scale)
+	if err != nil {
+		return nil, errorf("computing steps from %v to %v by %v: %w", redact(start), redact(stop), redact(step), err)
+	}
+
+	// Iterator
+	return func(yield func(Decimal) bool) {
+		d := first
+		for d.Cmp(stop) != step.Sign() {
+			if !yield(d) {
+				return
+			}
+			// The next element cannot be represented only if it is past stop.
+			next, err := d.AddExact(step, scale)
+			if err != nil {
+				return
+			}
+			d = next
+		}
+	}, nil
+}
+
+// PartitionRange splits the range [lo, hi] into n partitions and returns
+// n + 1 boundaries b₀ = lo, b₁, ..., bₙ = hi, such as those used in
+// amount-based shard maps and histogram buckets.
+// All partitions have the same width, which is rounded down to the scale of
+// the longer of lo and hi, and the last partition absorbs the remainder.
+// For example, the range [0.00, 10.00] split into 3 partitions has
+// boundaries 0.00, 3.33, 6.66, and 10.00.
+// To use narrower partitions, pad lo or hi with zeros using [Decimal.Pad].
+// The boundaries are computed exactly, so all services partitioning the same
+// range obtain the same boundaries.
+//
+// PartitionRange returns an error if:
+//   - n is not positive;
+//   - lo is greater than hi;
+//   - the range is too narrow to split it into n partitions of non-zero width;
+//   - lo, hi, or hi - lo cannot be represented with the scale of the partitions.
+func PartitionRange(lo, hi Decimal, n int) ([]Decimal, error) {
+	if n <= 0 {
+		return nil, errorf("partitioning [%v, %v]: %w: number of partitions %v is not positive", redact(lo), redact(hi), errInvalidOperation, n)
+	}
+	if lo.Cmp(hi) > 0 {
+		return nil, errorf("partitioning [%v, %v]: %w: lower bound is greater than upper bound", redact(lo), redact(hi), errInvalidOperation)
+	}
+
+	// Scale of the partitions
+	scale := max(lo.Scale(), hi.Scale())
+	first, err := lo.padExact(scale)
+	if err != nil {
+		return nil, errorf("partitioning [%v, %v]: %w", redact(lo), redact(hi), err)
+	}
+	last, err := hi.padExact(scale)
+	if err != nil {
+		return nil, errorf("partitioning [%v, %v]: %w", redact(lo), redact(hi), err)
+	}
+
+	// Width of the partitions
+	dist, err := last.SubExact(first, scale)
+	if err != nil {
+		return nil, errorf("partitioning [%v, %v]: %w", redact(lo), redact(hi), err)
+	}
+	ulp := MustNew(1, scale)
+	units, _, err := dist.QuoRem(MustNew(int64(n), scale))
+	if err != nil {
+		return nil, errorf("partitioning [%v, %v]: %w", redact(lo), redact(hi), err)
+	}
+	if units.IsZero() {
+		return nil, errorf("partitioning [%v, %v]: %w: range is too narrow for %v partitions with scale %v", redact(lo), redact(hi), errInvalidOperation, n, scale)
+	}
+	width, err := units.Mul(ulp)
+	if err != nil {
+		return nil, errorf("partitioning [%v, %v]: %w", redact(lo), redact(hi), err)
+	}
+
+	// Boundaries
+	bounds := make([]Decimal, n+1)
+	bounds[0] = first
+	for i := 1; i < n; i++ {
+		bounds[i], err = bounds[i-1].AddExact(width, scale)
+		if err != nil {
+			return nil, errorf("partitioning [%v, %v]: %w", redact(lo), redact(hi), err)
+		}
+	}
+	bounds[n] = last
+	return bounds, nil
+}
@@ -0,0 +1,142 @@
+package decimal
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSteps(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			start, stop, step string
+			want              []string
+		}{
+			{"0", "1", "0.25", []string{"0.00", "0.25", "0.50", "0.75", "1.00"}},
+			{"0", "1", "0.3", []string{"0.0", "0.3", "0.6", "0.9"}},
+			{"0.1", "0.3", "0.1", []string{"0.1", "0.2", "0.3"}},
+			{"1", "0", "-0.5", []string{"1.0", "0.5", "0.0"}},
+			{"-1", "-2", "-0.4", []string{"-1.0", "-1.4", "-1.8"}},
+			{"1.5", "1.5", "1", []string{"1.5"}},
+			{"1.5", "1.5", "-1", []string{"1.5"}},
+			{"100.00", "100.0", "0.05", []string{"100.00"}},
+			{"0", "1", "-0.1", nil},
+			{"1", "0", "0.1", nil},
+			{"9999999999999999997", "9999999999999999999", "1", []string{"9999999999999999997", "9999999999999999998", "9999999999999999999"}},
+			{"-9999999999999999998", "-9999999999999999999", "-2", []string{"-9999999999999999998"}},
+			{"0.9999999999999999998", "0.9999999999999999999", "0.0000000000000000001", []string{"0.9999999999999999998", "0.9999999999999999999"}},
+			{"0", "0.9999999999999999999", "0.6", []string{"0.0", "0.6"}},
+		}
+		for _, tt := range tests {
+			start, stop, step := MustParse(tt.start), MustParse(tt.stop), MustParse(tt.step)
+			seq, err := Steps(start, stop, step)
+			if err != nil {
+				t.Errorf("Steps(%q, %q, %q) failed: %v", start, stop, step, err)
+				continue
+			}
+			var got []string
+			seq(func(d Decimal) bool {
+				got = append(got, d.String())
+				return true
+			})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Steps(%q, %q, %q) = %v, want %v", start, stop, step, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("break", func(t *testing.T) {
+		seq, err := Steps(MustParse("0"), MustParse("10"), MustParse("1"))
+		if err != nil {
+			t.Fatalf("Steps() failed: %v", err)
+		}
+		var got []string
+		seq(func(d Decimal) bool {
+			got = append(got, d.String())
+			return len(got) < 3
+		})
+		if want := []string{"0", "1", "2"}; !slices.Equal(got, want) {
+			t.Errorf("Steps() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			start, stop, step string
+			wantErr           error
+		}{
+			{"0", "1", "0", errInvalidOperation},
+			{"1", "1", "0.00", errInvalidOperation},
+			{"1", "9999999999999999999", "0.1", errDecimalOverflow},
+			{"1000000000000000000", "0", "-0.1", errDecimalOverflow},
+		}
+		for _, tt := range tests {
+			start, stop, step := MustParse(tt.start), MustParse(tt.stop), MustParse(tt.step)
+			_, err := Steps(start, stop, step)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Steps(%q, %q, %q) error = %v, want %v", start, stop, step, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestPartitionRange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			lo, hi string
+			n      int
+			want   []string
+		}{
+			{"0.00", "10.00", 3, []string{"0.00", "3.33", "6.66", "10.00"}},
+			{"0", "10.00", 4, []string{"0.00", "2.50", "5.00", "7.50", "10.00"}},
+			{"0", "100", 3, []string{"0", "33", "66", "100"}},
+			{"-5", "5", 2, []string{"-5", "0", "5"}},
+			{"-10", "-1", 2, []string{"-10", "-6", "-1"}},
+			{"1.5", "2.5", 1, []string{"1.5", "2.5"}},
+			{"0", "0.0000000000000000003", 3, []string{"0.0000000000000000000", "0.0000000000000000001", "0.0000000000000000002", "0.0000000000000000003"}},
+			{"0", "9999999999999999999", 2, []string{"0", "4999999999999999999", "9999999999999999999"}},
+		}
+		for _, tt := range tests {
+			lo, hi := MustParse(tt.lo), MustParse(tt.hi)
+			got, err := PartitionRange(lo, hi, tt.n)
+			if err != nil {
+				t.Errorf("PartitionRange(%q, %q, %v) failed: %v", lo, hi, tt.n, err)
+				continue
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("PartitionRange(%q, %q, %v) = %v, want %v", lo, hi, tt.n, got, tt.want)
+				continue
+			}
+			for i := range got {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("PartitionRange(%q, %q, %v) = %v, want %v", lo, hi, tt.n, got, tt.want)
+					break
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			lo, hi  string
+			n       int
+			wantErr error
+		}{
+			{"0", "1", 0, errInvalidOperation},
+			{"0", "1", -1, errInvalidOperation},
+			{"1", "0", 1, errInvalidOperation},
+			{"1", "1", 1, errInvalidOperation},
+			{"0", "1", 2, errInvalidOperation},
+			{"0.00", "0.01", 2, errInvalidOperation},
+			{"-9999999999999999999", "9999999999999999999", 2, errDecimalOverflow},
+			{"0.1", "9999999999999999999", 2, errDecimalOverflow},
+		}
+		for _, tt := range tests {
+			lo, hi := MustParse(tt.lo), MustParse(tt.hi)
+			_, err := PartitionRange(lo, hi, tt.n)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("PartitionRange(%q, %q, %v) error = %v, want %v", lo, hi, tt.n, err, tt.wantErr)
+			}
+		}
+	})
+}
@@ -0,0 +1,216 @@
+package decimal
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// defaultTrackedLimit is the number of steps kept by a [TrackedDecimal]
+// created with a non-positive limit.
+const defaultTrackedLimit = 100
+
+// TrackedDecimal is a decimal that records the lineage of the operations
+// applied to it, so that disputed values, such as invoice line items,
+// can be explained step by step:
+//
+//	t := decimal.NewTrackedDecimal(price, usd, 10)
+//	t, err = t.Mul(qty)
+//	t, err = t.Sub(discount)
+//	log.Println(t) // 19.99 * 3 = 59.97; 59.97 - 5.00 = 54.97
+//
+// Operations are performed by the given [Context], so every step also records
+// whether the result was rounded and the rounding remainder,
+// as reported to [Context.OnRounded].
+// The history is bounded: only the last steps are kept, and the number of
+// dropped steps is reported by [TrackedDecimal.Dropped].
+//
+// Like [Decimal], TrackedDecimal is immutable: operations return a new value
+// and never modify the history of the original one.
+// The zero value is a decimal of 0 without history that uses the zero [Context].
+// TrackedDecimal is designed to be safe for concurrent use by multiple goroutines.
+type TrackedDecimal struct {
+	origin  Decimal
+	value   Decimal
+	ctx     Context
+	limit   int
+	steps   []TrackedStep
+	dropped int
+}
+
+// TrackedStep describes an operation recorded by a [TrackedDecimal].
+type TrackedStep struct {
+	Op        string  `json:"op"`        // Op is the operator: "+", "-", "*", "/", or "round".
+	D         Decimal `json:"d"`         // D is the value before the operation.
+	E         Decimal `json:"e"`         // E is the operand, or the quantum, such as 0.01, for "round".
+	Result    Decimal `json:"result"`    // Result is the value after the operation.
+	Remainder Decimal `json:"remainder"` // Remainder is the exact result minus Result, or 0 if the result is exact.
+	Rounded   bool    `json:"rounded"`   // Rounded reports whether the result is not equal to the exact result.
+}
+
+// String implements the [fmt.Stringer] interface and returns a string
+// like "1 / 3 = 0.33 (remainder 0.0033333333333333333)" for rounded steps
+// and "1 + 2 = 3" for exact ones.
+func (s TrackedStep) String() string {
+	if !s.Rounded {
+		return fmt.Sprintf("%v %v %v = %v", s.D, s.Op, s.E, s.Result)
+	}
+	return fmt.Sprintf("%v %v %v = %v (remainder %v)", s.D, s.Op, s.E, s.Result, s.Remainder)
+}
+
+// NewTrackedDecimal returns a tracked decimal with the given value and
+// no history, whose operations are performed by the given context.
+// At most limit last steps are kept in the history.
+// If the limit is not positive, 100 steps are kept.
+func NewTrackedDecimal(d Decimal, ctx Context, limit int) TrackedDecimal {
+	return TrackedDecimal{origin: d, value: d, ctx: ctx, limit: limit}
+}
+
+// Value returns the current value.
+func (t TrackedDecimal) Value() Decimal {
+	return t.value
+}
+
+// Origin returns the value given to [NewTrackedDecimal].
+func (t TrackedDecimal) Origin() Decimal {
+	return t.origin
+}
+
+// Steps returns the recorded steps, from the oldest to the newest.
+// The returned slice is a copy and can be modified by the caller.
+func (t TrackedDecimal) Steps() []TrackedStep {
+	return slices.Clone(t.steps)
+}
+
+// Dropped returns the number of the oldest steps removed from the history
+// because of its limit.
+func (t TrackedDecimal) Dropped() int {
+	return t.dropped
+}
+
+// Rounded reports whether any of the recorded steps was rounded.
+// Dropped steps are not taken into account.
+func (t TrackedDecimal) Rounded() bool {
+	return slices.ContainsFunc(t.steps, func(s TrackedStep) bool {
+		return s.Rounded
+	})
+}
+
+// String implements the [fmt.Stringer] interface and returns the recorded
+// steps separated by semicolons, or the value if there are no steps.
+func (t TrackedDecimal) String() string {
+	if len(t.steps) == 0 {
+		return t.value.String()
+	}
+	steps := make([]string, 0, len(t.steps)+1)
+	if t.dropped > 0 {
+		steps = append(steps, fmt.Sprintf("(%v steps dropped)", t.dropped))
+	}
+	for _, s := range t.steps {
+		steps = append(steps, s.String())
+	}
+	return strings.Join(steps, "; ")
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The tracked decimal is marshaled as a JSON object for audit logs, for example,
+// {"origin":"1","value":"0.33","dropped":0,"steps":[{"op":"/",...}]}.
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (t TrackedDecimal) MarshalJSON() ([]byte, error) {
+	steps := t.steps
+	if steps == nil {
+		steps = []TrackedStep{}
+	}
+	return json.Marshal(struct {
+		Origin  Decimal       `json:"origin"`
+		Value   Decimal       `json:"value"`
+		Dropped int           `json:"dropped"`
+		Steps   []TrackedStep `json:"steps"`
+	}{t.origin, t.value, t.dropped, steps})
+}
+
+// record returns a copy of the tracked decimal with the given step appended
+// to its history.
+func (t TrackedDecimal) record(s TrackedStep) TrackedDecimal {
+	limit := t.limit
+	if limit <= 0 {
+		limit = defaultTrackedLimit
+	}
+	steps := slices.Clip(t.steps)
+	if len(steps) >= limit {
+		t.dropped += len(steps) - limit + 1
+		steps = steps[len(steps)-limit+1:]
+	}
+	t.steps = append(steps, s)
+	t.value = s.Result
+	return t
+}
+
+// apply performs the operation using the context and records the result.
+func (t TrackedDecimal) apply(op string, e Decimal, f func(Context, Decimal, Decimal) (Decimal, error)) (TrackedDecimal, error) {
+	s := TrackedStep{Op: op, D: t.value, E: e}
+	ctx := t.ctx
+	hook := ctx.OnRounded
+	ctx.OnRounded = func(ev RoundingEvent) {
+		s.Rounded, s.Remainder = true, ev.Remainder
+		if hook != nil {
+			hook(ev)
+		}
+	}
+	r, err := f(ctx, t.value, e)
+	if err != nil {
+		return TrackedDecimal{}, err
+	}
+	s.Result = r
+	return t.record(s), nil
+}
+
+// Add returns a tracked decimal equal to the sum of the value and e computed
+// by [Context.Add], with the step appended to the history.
+func (t TrackedDecimal) Add(e Decimal) (TrackedDecimal, error) {
+	return t.apply("+", e, Context.Add)
+}
+
+// Sub returns a tracked decimal equal to the difference between the value
+// and e computed by [Context.Sub], with the step appended to the history.
+func (t TrackedDecimal) Sub(e Decimal) (TrackedDecimal, error) {
+	return t.apply("-", e, Context.Sub)
+}
+
+// Mul returns a tracked decimal equal to the product of the value and e
+// computed by [Context.Mul], with the step appended to the history.
+func (t TrackedDecimal) Mul(e Decimal) (TrackedDecimal, error) {
+	return t.apply("*", e, Context.Mul)
+}
+
+// Quo returns a tracked decimal equal to the quotient of the value and e
+// computed by [Context.Quo], with the step appended to the history.
+func (t TrackedDecimal) Quo(e Decimal) (TrackedDecimal, error) {
+	return t.apply("/", e, Context.Quo)
+}
+
+// Round returns a tracked decimal equal to the value rounded to the given
+// number of digits after the decimal point using the rounding mode
+// of the context, as in [Decimal.RoundMode], with the step appended
+// to the history.
+// The step records the quantum, such as 0.01 for the scale of 2, as its operand.
+// Round does not call [Context.OnRounded], which only reports arithmetic operations.
+//
+// Round returns an error if the scale is negative or greater than [MaxScale].
+func (t TrackedDecimal) Round(scale int) (TrackedDecimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return TrackedDecimal{}, errorf("rounding %v: %w", redact(t.value), scaleRangeError(scale))
+	}
+	f := t.value.RoundMode(scale, t.ctx.Mode)
+	s := TrackedStep{Op: "round", D: t.value, E: newUnsafe(false, 1, scale), Result: f}
+	if f.Cmp(t.value) != 0 {
+		r, err := t.value.Sub(f)
+		if err != nil {
+			return TrackedDecimal{}, errorf("rounding %v: %w", redact(t.value), err)
+		}
+		s.Rounded, s.Remainder = true, r
+	}
+	return t.record(s), nil
+}
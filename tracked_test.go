@@ -0,0 +1,189 @@
+package decimal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestTrackedDecimal(t *testing.T) {
+	usd := Context{ScalePolicy: ScaleFixed, Scale: 2, Mode: HalfUp}
+
+	t.Run("success", func(t *testing.T) {
+		var events []RoundingEvent
+		ctx := usd
+		ctx.OnRounded = func(ev RoundingEvent) {
+			events = append(events, ev)
+		}
+
+		d := NewTrackedDecimal(MustParse("19.99"), ctx, 10)
+		d, err := d.Mul(MustParse("3"))
+		if err != nil {
+			t.Fatalf("Mul() failed: %v", err)
+		}
+		d, err = d.Mul(MustParse("0.175"))
+		if err != nil {
+			t.Fatalf("Mul() failed: %v", err)
+		}
+		d, err = d.Quo(MustParse("16"))
+		if err != nil {
+			t.Fatalf("Quo() failed: %v", err)
+		}
+		d, err = d.Add(MustParse("0.005"))
+		if err != nil {
+			t.Fatalf("Add() failed: %v", err)
+		}
+		d, err = d.Sub(MustParse("1"))
+		if err != nil {
+			t.Fatalf("Sub() failed: %v", err)
+		}
+		d, err = d.Round(1)
+		if err != nil {
+			t.Fatalf("Round() failed: %v", err)
+		}
+
+		want := []struct {
+			op, d, e, result, remainder string
+			rounded                     bool
+		}{
+			{"*", "19.99", "3", "59.97", "0", false},
+			{"*", "59.97", "0.175", "10.49", "0.00475", true},
+			{"/", "10.49", "16", "0.66", "-0.004375", true},
+			{"+", "0.66", "0.005", "0.67", "-0.005", true},
+			{"-", "0.67", "1", "-0.33", "0", false},
+			{"round", "-0.33", "0.1", "-0.3", "-0.03", true},
+		}
+		steps := d.Steps()
+		if len(steps) != len(want) {
+			t.Fatalf("Steps() = %v, want %v steps", steps, len(want))
+		}
+		for i, w := range want {
+			s := steps[i]
+			if s.Op != w.op || s.D != MustParse(w.d) || s.E != MustParse(w.e) || s.Result != MustParse(w.result) || s.Remainder != MustParse(w.remainder) || s.Rounded != w.rounded {
+				t.Errorf("Steps()[%v] = %+v, want %+v", i, s, w)
+			}
+		}
+		if got, want := d.Value(), MustParse("-0.3"); got != want {
+			t.Errorf("Value() = %q, want %q", got, want)
+		}
+		if got, want := d.Origin(), MustParse("19.99"); got != want {
+			t.Errorf("Origin() = %q, want %q", got, want)
+		}
+		if !d.Rounded() {
+			t.Errorf("Rounded() = false, want true")
+		}
+		if d.Dropped() != 0 {
+			t.Errorf("Dropped() = %v, want 0", d.Dropped())
+		}
+
+		// The hook of the context is still called
+		if len(events) != 3 {
+			t.Errorf("OnRounded was called %v times, want 3", len(events))
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		d := NewTrackedDecimal(MustParse("0"), usd, 2)
+		one := MustParse("1")
+		var err error
+		for range 5 {
+			d, err = d.Add(one)
+			if err != nil {
+				t.Fatalf("Add() failed: %v", err)
+			}
+		}
+		steps := d.Steps()
+		if len(steps) != 2 || steps[0].D != MustParse("3.00") || steps[1].Result != MustParse("5.00") {
+			t.Errorf("Steps() = %v, want last 2 steps", steps)
+		}
+		if d.Dropped() != 3 {
+			t.Errorf("Dropped() = %v, want 3", d.Dropped())
+		}
+		if d.Rounded() {
+			t.Errorf("Rounded() = true, want false")
+		}
+
+		// Zero value keeps the default number of steps
+		var z TrackedDecimal
+		for range defaultTrackedLimit + 1 {
+			z, err = z.Add(one)
+			if err != nil {
+				t.Fatalf("Add() failed: %v", err)
+			}
+		}
+		if len(z.Steps()) != defaultTrackedLimit || z.Dropped() != 1 {
+			t.Errorf("len(Steps()) = %v, Dropped() = %v, want %v and 1", len(z.Steps()), z.Dropped(), defaultTrackedLimit)
+		}
+	})
+
+	t.Run("immutable", func(t *testing.T) {
+		d := NewTrackedDecimal(MustParse("1"), Context{}, 10)
+		d, _ = d.Add(MustParse("1"))
+		e, _ := d.Add(MustParse("2"))
+		f, _ := d.Mul(MustParse("3"))
+		if len(d.Steps()) != 1 || len(e.Steps()) != 2 || len(f.Steps()) != 2 {
+			t.Fatalf("len(Steps()) = %v %v %v, want 1 2 2", len(d.Steps()), len(e.Steps()), len(f.Steps()))
+		}
+		if e.Steps()[1].Op != "+" || f.Steps()[1].Op != "*" {
+			t.Errorf("Steps() of e and f share history: %v %v", e.Steps(), f.Steps())
+		}
+		steps := d.Steps()
+		steps[0] = TrackedStep{}
+		if d.Steps()[0].Op != "+" {
+			t.Errorf("Steps() returned the history itself")
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		d := NewTrackedDecimal(MustParse("1"), usd, 10)
+		if _, err := d.Quo(Zero); !errors.Is(err, errDivisionByZero) {
+			t.Errorf("Quo(0) error = %v, want %v", err, errDivisionByZero)
+		}
+		for _, scale := range []int{-1, MaxScale + 1} {
+			if _, err := d.Round(scale); !errors.Is(err, errScaleRange) {
+				t.Errorf("Round(%v) error = %v, want %v", scale, err, errScaleRange)
+			}
+		}
+	})
+}
+
+func TestTrackedDecimal_String(t *testing.T) {
+	usd := Context{ScalePolicy: ScaleFixed, Scale: 2, Mode: HalfUp}
+	d := NewTrackedDecimal(MustParse("59.97"), usd, 2)
+	if got, want := d.String(), "59.97"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	d, _ = d.Mul(MustParse("0.175"))
+	d, _ = d.Sub(MustParse("5"))
+	if got, want := d.String(), "59.97 * 0.175 = 10.49 (remainder 0.00475); 10.49 - 5 = 5.49"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	d, _ = d.Round(1)
+	if got, want := d.String(), "(1 steps dropped); 10.49 - 5 = 5.49; 5.49 round 0.1 = 5.5 (remainder -0.01)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestTrackedDecimal_MarshalJSON(t *testing.T) {
+	d := NewTrackedDecimal(MustParse("1"), Context{}, 10)
+	got, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("json.Marshal() failed: %v", err)
+	}
+	if want := `{"origin":"1","value":"1","dropped":0,"steps":[]}`; string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+
+	d, _ = d.Round(0)
+	d, _ = d.Mul(MustParse("0.5"))
+	got, err = json.Marshal(d)
+	if err != nil {
+		t.Fatalf("json.Marshal() failed: %v", err)
+	}
+	want := `{"origin":"1","value":"0.5","dropped":0,"steps":[` +
+		`{"op":"round","d":"1","e":"1","result":"1","remainder":"0","rounded":false},` +
+		`{"op":"*","d":"1","e":"0.5","result":"0.5","remainder":"0","rounded":false}]}`
+	if string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,299 @@
+package decimal
+
+// Sin returns the (possibly rounded) sine of a decimal, where the decimal
+// is an angle in radians.
+// Like other transcendental functions, the result is computed with
+// double precision before being rounded to [MaxPrec] digits,
+// even for large angles such as 10^18.
+func (d Decimal) Sin() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.sinBint()
+	if err != nil {
+		return Decimal{}, errorf("computing sin(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Cos returns the (possibly rounded) cosine of a decimal, where the decimal
+// is an angle in radians.
+// See [Decimal.Sin] for details on precision.
+func (d Decimal) Cos() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 1, 0)
+	}
+
+	// General case
+	e, err := d.cosBint()
+	if err != nil {
+		return Decimal{}, errorf("computing cos(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Tan returns the (possibly rounded) tangent of a decimal, where the decimal
+// is an angle in radians.
+// See [Decimal.Sin] for details on precision.
+//
+// Tan returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Tan() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.tanBint()
+	if err != nil {
+		return Decimal{}, errorf("computing tan(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Asin returns the (possibly rounded) arcsine of a decimal in radians,
+// in the range [-π/2, π/2].
+//
+// Asin returns an error if the decimal is less than -1 or greater than 1.
+func (d Decimal) Asin() (Decimal, error) {
+	// Special case: out of domain
+	if d.CmpAbs(One) > 0 {
+		return Decimal{}, errorf("computing asin(%v): %w", redact(d), errInvalidOperation)
+	}
+
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.asinBint()
+	if err != nil {
+		return Decimal{}, errorf("computing asin(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Acos returns the (possibly rounded) arccosine of a decimal in radians,
+// in the range [0, π].
+//
+// Acos returns an error if the decimal is less than -1 or greater than 1.
+func (d Decimal) Acos() (Decimal, error) {
+	// Special case: out of domain
+	if d.CmpAbs(One) > 0 {
+		return Decimal{}, errorf("computing acos(%v): %w", redact(d), errInvalidOperation)
+	}
+
+	// Special case: one
+	if d.Equal(One) {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.acosBint()
+	if err != nil {
+		return Decimal{}, errorf("computing acos(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Atan returns the (possibly rounded) arctangent of a decimal in radians,
+// in the range (-π/2, π/2).
+func (d Decimal) Atan() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.atanBint()
+	if err != nil {
+		return Decimal{}, errorf("computing atan(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Atan2 returns the (possibly rounded) arctangent of d / e in radians,
+// in the range (-π, π], using the signs of both decimals to determine
+// the quadrant of the result, similar to [math.Atan2].
+// Here d is the y-coordinate and e is the x-coordinate of a point.
+// If both decimals are zero, Atan2 returns 0.
+func (d Decimal) Atan2(e Decimal) (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() && !e.IsNeg() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	f, err := d.atan2Bint(e)
+	if err != nil {
+		return Decimal{}, errorf("computing [atan2(%v, %v)]: %w", redact(d), redact(e), err)
+	}
+
+	// Preferred scale
+	f = f.Trim(0)
+
+	return f, nil
+}
+
+// Sinh returns the (possibly rounded) hyperbolic sine of a decimal.
+//
+// Sinh returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Sinh() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.sinhBint()
+	if err != nil {
+		return Decimal{}, errorf("computing sinh(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Cosh returns the (possibly rounded) hyperbolic cosine of a decimal.
+//
+// Cosh returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Cosh() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 1, 0)
+	}
+
+	// General case
+	e, err := d.coshBint()
+	if err != nil {
+		return Decimal{}, errorf("computing cosh(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Tanh returns the (possibly rounded) hyperbolic tangent of a decimal,
+// in the range [-1, 1].
+func (d Decimal) Tanh() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.tanhBint()
+	if err != nil {
+		return Decimal{}, errorf("computing tanh(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Asinh returns the (possibly rounded) inverse hyperbolic sine of a decimal.
+func (d Decimal) Asinh() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.asinhBint()
+	if err != nil {
+		return Decimal{}, errorf("computing asinh(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Acosh returns the (possibly rounded) inverse hyperbolic cosine of a decimal.
+//
+// Acosh returns an error if the decimal is less than 1.
+func (d Decimal) Acosh() (Decimal, error) {
+	// Special case: out of domain
+	if d.Cmp(One) < 0 {
+		return Decimal{}, errorf("computing acosh(%v): %w", redact(d), errInvalidOperation)
+	}
+
+	// Special case: one
+	if d.IsOne() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.acoshBint()
+	if err != nil {
+		return Decimal{}, errorf("computing acosh(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// Atanh returns the (possibly rounded) inverse hyperbolic tangent of a decimal.
+//
+// Atanh returns an error if the decimal is less than or equal to -1,
+// or greater than or equal to 1.
+func (d Decimal) Atanh() (Decimal, error) {
+	// Special case: out of domain
+	if d.CmpAbs(One) >= 0 {
+		return Decimal{}, errorf("computing atanh(%v): %w", redact(d), errInvalidOperation)
+	}
+
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.atanhBint()
+	if err != nil {
+		return Decimal{}, errorf("computing atanh(%v): %w", redact(d), err)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
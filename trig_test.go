@@ -0,0 +1,534 @@
+//go:build !decimalnobig
+
+package decimal
+
+import "testing"
+
+func TestDecimal_Sin(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"0", "0"},
+		{"0.00", "0"},
+		{"1", "0.8414709848078965067"},
+		{"-1", "-0.8414709848078965067"},
+		{"0.5", "0.4794255386042030003"},
+		{"2", "0.9092974268256816954"},
+		{"3", "0.1411200080598672221"},
+		{"3.141592653589793238", "0.0000000000000000005"},
+		{"1.570796326794896619", "1"},
+		{"0.0000000000000000001", "0.0000000000000000001"},
+		{"10", "-0.5440211108893698134"},
+		{"100", "-0.5063656411097587937"},
+		{"1000000", "-0.3499935021712929521"},
+		{"1000000000000000000", "-0.9929693207404050762"},
+		{"9999999999999999999", "-0.185422544070089953"},
+		{"-7.5", "-0.9379999767747388579"},
+		{"0.7853981633974483096", "0.7071067811865475244"},
+		{"355", "-0.0000301443533594884"},
+		{"1.23456789", "0.9440057250045345945"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.Sin()
+		if err != nil {
+			t.Errorf("%q.Sin() failed: %v", d, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Sin() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_Cos(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"0", "1"},
+		{"0.00", "1"},
+		{"1", "0.5403023058681397174"},
+		{"-1", "0.5403023058681397174"},
+		{"0.5", "0.8775825618903727161"},
+		{"2", "-0.416146836547142387"},
+		{"3", "-0.9899924966004454573"},
+		{"3.141592653589793238", "-1"},
+		{"1.570796326794896619", "0.0000000000000000002"},
+		{"0.0000000000000000001", "1"},
+		{"10", "-0.8390715290764524523"},
+		{"100", "0.8623188722876839341"},
+		{"1000000", "0.9367521275331447869"},
+		{"1000000000000000000", "0.1183719902187107326"},
+		{"9999999999999999999", "-0.9826588829042230508"},
+		{"-7.5", "0.346635317835025811"},
+		{"0.7853981633974483096", "0.7071067811865475244"},
+		{"355", "-0.9999999995456589802"},
+		{"1.23456789", "0.3299290698902765806"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.Cos()
+		if err != nil {
+			t.Errorf("%q.Cos() failed: %v", d, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Cos() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_Tan(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"0", "0"},
+		{"0.00", "0"},
+		{"1", "1.557407724654902231"},
+		{"-1", "-1.557407724654902231"},
+		{"0.5", "0.5463024898437905133"},
+		{"2", "-2.185039863261518992"},
+		{"3", "-0.1425465430742778053"},
+		{"3.141592653589793238", "-0.0000000000000000005"},
+		{"1.570796326794896619", "4322984121858095330"},
+		{"0.0000000000000000001", "0.0000000000000000001"},
+		{"10", "0.6483608274590866713"},
+		{"100", "-0.5872139151569290767"},
+		{"1000000", "-0.3736244539875990292"},
+		{"1000000000000000000", "-8.388549680593688"},
+		{"9999999999999999999", "0.1886947213279936917"},
+		{"-7.5", "-2.706013866772690777"},
+		{"0.7853981633974483096", "1"},
+		{"355", "0.0000301443533731843"},
+		{"1.23456789", "2.861238402904234707"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.Tan()
+		if err != nil {
+			t.Errorf("%q.Tan() failed: %v", d, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Tan() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_Asin(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0"},
+			{"0.00", "0"},
+			{"1", "1.570796326794896619"},
+			{"-1", "-1.570796326794896619"},
+			{"0.5", "0.5235987755982988731"},
+			{"-0.5", "-0.5235987755982988731"},
+			{"0.9999999999999999999", "1.570796326347683024"},
+			{"-0.9999999999999999999", "-1.570796326347683024"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+			{"0.7071067811865475244", "0.7853981633974483096"},
+			{"0.1", "0.1001674211615597963"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Asin()
+			if err != nil {
+				t.Errorf("%q.Asin() failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Asin() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"out of domain 1": "1.000000000000000001",
+			"out of domain 2": "-1.000000000000000001",
+			"out of domain 3": "2",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(d)
+				_, err := d.Asin()
+				if err == nil {
+					t.Errorf("%q.Asin() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Acos(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "1.570796326794896619"},
+			{"0.00", "1.570796326794896619"},
+			{"1", "0"},
+			{"-1", "3.141592653589793238"},
+			{"0.5", "1.047197551196597746"},
+			{"-0.5", "2.094395102393195492"},
+			{"0.9999999999999999999", "0.0000000004472135955"},
+			{"-0.9999999999999999999", "3.141592653142579643"},
+			{"0.0000000000000000001", "1.570796326794896619"},
+			{"0.7071067811865475244", "0.7853981633974483096"},
+			{"0.1", "1.470628905633336823"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Acos()
+			if err != nil {
+				t.Errorf("%q.Acos() failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Acos() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"out of domain 1": "1.000000000000000001",
+			"out of domain 2": "-1.000000000000000001",
+			"out of domain 3": "2",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(d)
+				_, err := d.Acos()
+				if err == nil {
+					t.Errorf("%q.Acos() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Atan(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"0", "0"},
+		{"0.00", "0"},
+		{"1", "0.7853981633974483096"},
+		{"-1", "-0.7853981633974483096"},
+		{"0.5", "0.4636476090008061162"},
+		{"2", "1.107148717794090503"},
+		{"-10", "-1.471127674303734592"},
+		{"0.0000000000000000001", "0.0000000000000000001"},
+		{"9999999999999999999", "1.570796326794896619"},
+		{"1000", "1.569796327128229753"},
+		{"0.9999999999999999999", "0.7853981633974483096"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.Atan()
+		if err != nil {
+			t.Errorf("%q.Atan() failed: %v", d, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Atan() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_Atan2(t *testing.T) {
+	tests := []struct {
+		d, e, want string
+	}{
+		{"1", "1", "0.7853981633974483096"},
+		{"1", "-1", "2.356194490192344929"},
+		{"-1", "-1", "-2.356194490192344929"},
+		{"-1", "1", "-0.7853981633974483096"},
+		{"0", "-1", "3.141592653589793238"},
+		{"1", "0", "1.570796326794896619"},
+		{"-1", "0", "-1.570796326794896619"},
+		{"0", "1", "0"},
+		{"0", "0", "0"},
+		{"3", "4", "0.6435011087932843868"},
+		{"-4", "3", "-0.9272952180016122324"},
+		{"0.0000000000000000001", "9999999999999999999", "0"},
+		{"9999999999999999999", "0.0000000000000000001", "1.570796326794896619"},
+		{"2", "-0.5", "1.815774989921760773"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		e := MustParse(tt.e)
+		got, err := d.Atan2(e)
+		if err != nil {
+			t.Errorf("%q.Atan2(%q) failed: %v", d, e, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Atan2(%q) = %q, want %q", d, e, got, want)
+		}
+	}
+}
+
+func TestDecimal_Sinh(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0"},
+			{"0.00", "0"},
+			{"1", "1.175201193643801457"},
+			{"-1", "-1.175201193643801457"},
+			{"0.5", "0.5210953054937473616"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+			{"2", "3.626860407847018768"},
+			{"10", "11013.23287470339338"},
+			{"-10", "-11013.23287470339338"},
+			{"43", "2363919734114673281"},
+			{"0.1234567890123456789", "0.1237706408254757856"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Sinh()
+			if err != nil {
+				t.Errorf("%q.Sinh() failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Sinh() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"overflow 1": "44.5",
+			"overflow 2": "-50",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(d)
+				_, err := d.Sinh()
+				if err == nil {
+					t.Errorf("%q.Sinh() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Cosh(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "1"},
+			{"0.00", "1"},
+			{"1", "1.543080634815243778"},
+			{"-1", "1.543080634815243778"},
+			{"0.5", "1.127625965206380785"},
+			{"0.0000000000000000001", "1"},
+			{"2", "3.76219569108363146"},
+			{"10", "11013.23292010332314"},
+			{"-10", "11013.23292010332314"},
+			{"43", "2363919734114673281"},
+			{"0.1234567890123456789", "1.007630473700725733"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Cosh()
+			if err != nil {
+				t.Errorf("%q.Cosh() failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Cosh() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"overflow 1": "44.5",
+			"overflow 2": "-9999999999999999999",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(d)
+				_, err := d.Cosh()
+				if err == nil {
+					t.Errorf("%q.Cosh() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Tanh(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"0", "0"},
+		{"0.00", "0"},
+		{"1", "0.7615941559557648881"},
+		{"-1", "-0.7615941559557648881"},
+		{"0.5", "0.4621171572600097585"},
+		{"0.0000000000000000001", "0.0000000000000000001"},
+		{"2", "0.9640275800758168839"},
+		{"10", "0.9999999958776927636"},
+		{"-10", "-0.9999999958776927636"},
+		{"22", "0.9999999999999999998"},
+		{"23", "1"},
+		{"49.9", "1"},
+		{"50", "1"},
+		{"1000", "1"},
+		{"-9999999999999999999", "-1"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.Tanh()
+		if err != nil {
+			t.Errorf("%q.Tanh() failed: %v", d, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Tanh() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_Asinh(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"0", "0"},
+		{"0.00", "0"},
+		{"1", "0.8813735870195430252"},
+		{"-1", "-0.8813735870195430252"},
+		{"0.5", "0.4812118250596034475"},
+		{"0.0000000000000000001", "0.0000000000000000001"},
+		{"2", "1.443635475178810342"},
+		{"10", "2.998222950297969739"},
+		{"-1000", "-7.600902709541988612"},
+		{"9999999999999999999", "44.44226394744681331"},
+		{"-9999999999999999999", "-44.44226394744681331"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.Asinh()
+		if err != nil {
+			t.Errorf("%q.Asinh() failed: %v", d, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Asinh() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_Acosh(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"1", "0"},
+			{"1.00", "0"},
+			{"1.000000000000000001", "0.0000000014142135624"},
+			{"1.5", "0.962423650119206895"},
+			{"2", "1.316957896924816709"},
+			{"10", "2.993222846126380898"},
+			{"1000", "7.600902209541988611"},
+			{"9999999999999999999", "44.44226394744681331"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Acosh()
+			if err != nil {
+				t.Errorf("%q.Acosh() failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Acosh() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"out of domain 1": "0.9999999999999999999",
+			"out of domain 2": "0",
+			"out of domain 3": "-1",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(d)
+				_, err := d.Acosh()
+				if err == nil {
+					t.Errorf("%q.Acosh() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Atanh(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0"},
+			{"0.00", "0"},
+			{"0.5", "0.5493061443340548457"},
+			{"-0.5", "-0.5493061443340548457"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+			{"0.9", "1.47221948958322023"},
+			{"0.9999999999999999999", "22.22113197372340665"},
+			{"-0.9999999999999999999", "-22.22113197372340665"},
+			{"0.1234567890123456789", "0.1240898136094589986"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Atanh()
+			if err != nil {
+				t.Errorf("%q.Atanh() failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Atanh() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"out of domain 1": "1",
+			"out of domain 2": "-1",
+			"out of domain 3": "2",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(d)
+				_, err := d.Atanh()
+				if err == nil {
+					t.Errorf("%q.Atanh() did not fail", d)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,478 @@
+//go:build !decimalnobig
+
+package decimal
+
+import "fmt"
+
+// xpScale is the number of digits after the decimal point used to display
+// extended-precision decimals that have no finite decimal representation.
+const xpScale = 2 * MaxScale
+
+// Xp is an extended-precision decimal used to evaluate formulas with
+// a single rounding, see [EvaluateExact].
+// Unlike [Decimal], Xp has no limit on the number of digits, and all its
+// arithmetic methods are exact.
+// Quotients that have no finite decimal representation, such as 1 / 3,
+// are kept as fractions, so that 1 / 3 * 3 is exactly 1.
+//
+// Xp follows the conventions of [big.Int]: arithmetic methods set
+// the receiver to the result and return it, so operations can be chained.
+// Instead of panicking, Xp records the first error, such as division by zero,
+// and propagates it to the results of all subsequent operations.
+//
+// The zero value for an Xp represents 0.
+// Xp is not thread-safe.
+//
+// [big.Int]: https://pkg.go.dev/math/big#Int
+type Xp struct {
+	// The value is (-1)^neg * num / (den * 10^scale).
+	neg   bool
+	num   *bint
+	den   *bint // nil means 1
+	scale int
+	err   error
+}
+
+// Xp converts a decimal to an extended-precision decimal.
+func (d Decimal) Xp() *Xp {
+	return new(Xp).SetDecimal(d)
+}
+
+// init allocates the numerator of the zero value.
+func (z *Xp) init() {
+	if z.num == nil {
+		z.num = new(bint)
+	}
+}
+
+// setDen sets the denominator of z to a copy of den, which may be nil.
+func (z *Xp) setDen(den *bint) {
+	switch {
+	case den == nil:
+		z.den = nil
+	case z.den == nil:
+		z.den = new(bint)
+		fallthrough
+	default:
+		z.den.setBint(den)
+	}
+}
+
+// SetDecimal sets z to d and returns z.
+func (z *Xp) SetDecimal(d Decimal) *Xp {
+	z.init()
+	z.neg = d.IsNeg()
+	z.num.setFint(d.coef)
+	z.den = nil
+	z.scale = d.Scale()
+	z.err = nil
+	return z
+}
+
+// Set sets z to x and returns z.
+func (z *Xp) Set(x *Xp) *Xp {
+	if z != x {
+		x.init()
+		z.init()
+		z.neg = x.neg
+		z.num.setBint(x.num)
+		z.setDen(x.den)
+		z.scale = x.scale
+		z.err = x.err
+	}
+	return z
+}
+
+// failed propagates the error of x or y to z and reports whether
+// there was an error.
+func (z *Xp) failed(x, y *Xp) bool {
+	switch {
+	case x.err != nil:
+		z.err = x.err
+	case y.err != nil:
+		z.err = y.err
+	default:
+		x.init()
+		y.init()
+		z.init()
+		return false
+	}
+	return true
+}
+
+// Add sets z to the sum x + y and returns z.
+func (z *Xp) Add(x, y *Xp) *Xp {
+	if z.failed(x, y) {
+		return z
+	}
+	return z.add(x, y.neg, y)
+}
+
+// Sub sets z to the difference x - y and returns z.
+func (z *Xp) Sub(x, y *Xp) *Xp {
+	if z.failed(x, y) {
+		return z
+	}
+	return z.add(x, !y.neg, y)
+}
+
+// add sets z to the sum x + y, where the sign of y is given by yneg.
+func (z *Xp) add(x *Xp, yneg bool, y *Xp) *Xp {
+	xnum := getBint()
+	defer putBint(xnum)
+	ynum := getBint()
+	defer putBint(ynum)
+
+	// Alignment
+	scale := max(x.scale, y.scale)
+	xnum.lsh(x.num, scale-x.scale)
+	ynum.lsh(y.num, scale-y.scale)
+
+	// Common denominator
+	var den *bint
+	if x.den != nil || y.den != nil {
+		den = getBint()
+		defer putBint(den)
+		den.setFint(1)
+		if y.den != nil {
+			xnum.mul(xnum, y.den)
+			den.mul(den, y.den)
+		}
+		if x.den != nil {
+			ynum.mul(ynum, x.den)
+			den.mul(den, x.den)
+		}
+	}
+
+	// Compute z = x + y
+	zneg := x.neg
+	if zneg == yneg {
+		z.num.add(xnum, ynum)
+	} else {
+		if ynum.cmp(xnum) > 0 {
+			zneg = yneg
+		}
+		z.num.subAbs(xnum, ynum)
+	}
+	z.neg = zneg
+	z.setDen(den)
+	z.scale = scale
+	z.err = nil
+	z.reduce()
+	return z
+}
+
+// Mul sets z to the product x * y and returns z.
+func (z *Xp) Mul(x, y *Xp) *Xp {
+	if z.failed(x, y) {
+		return z
+	}
+	var den *bint
+	if x.den != nil || y.den != nil {
+		den = getBint()
+		defer putBint(den)
+		den.setFint(1)
+		if x.den != nil {
+			den.mul(den, x.den)
+		}
+		if y.den != nil {
+			den.mul(den, y.den)
+		}
+	}
+	zneg := x.neg != y.neg
+	zscale := x.scale + y.scale
+	z.num.mul(x.num, y.num)
+	z.neg = zneg
+	z.setDen(den)
+	z.scale = zscale
+	z.err = nil
+	z.reduce()
+	return z
+}
+
+// Quo sets z to the quotient x / y and returns z.
+// If the quotient has no finite decimal representation, it is kept
+// as a fraction.
+// If y is 0, the division by zero error is recorded in z.
+func (z *Xp) Quo(x, y *Xp) *Xp {
+	if z.failed(x, y) {
+		return z
+	}
+	if y.num.sign() == 0 {
+		z.err = errorf("computing [%v / %v]: %w", redact(x), redact(y), errDivisionByZero)
+		return z
+	}
+	num := getBint()
+	defer putBint(num)
+	den := getBint()
+	defer putBint(den)
+	zneg := x.neg != y.neg
+	zscale := max(x.scale-y.scale, MinScale)
+
+	// Compute z = (x.num * y.den) / (x.den * y.num)
+	num.setBint(x.num)
+	if y.den != nil {
+		num.mul(num, y.den)
+	}
+	den.setBint(y.num)
+	if x.den != nil {
+		den.mul(den, x.den)
+	}
+
+	// Alignment
+	scale := x.scale - y.scale
+	if scale < 0 {
+		num.lsh(num, -scale)
+		scale = 0
+	}
+
+	z.num.setBint(num)
+	z.neg = zneg
+	z.setDen(den)
+	z.scale = scale
+	z.err = nil
+	z.reduce()
+
+	// Preferred scale
+	if z.den == nil {
+		z.trim(zscale)
+	} else if z.scale < xpScale {
+		z.num.lsh(z.num, xpScale-z.scale)
+		z.scale = xpScale
+	}
+	return z
+}
+
+// Neg sets z to -x and returns z.
+func (z *Xp) Neg(x *Xp) *Xp {
+	z.Set(x)
+	z.neg = !z.neg
+	return z
+}
+
+// Abs sets z to |x| and returns z.
+func (z *Xp) Abs(x *Xp) *Xp {
+	z.Set(x)
+	z.neg = false
+	return z
+}
+
+// reduce divides the numerator and the denominator of z by their greatest
+// common divisor.
+// If the remaining denominator has no prime factors other than 2 and 5,
+// z is converted to a decimal with a finite number of digits.
+func (z *Xp) reduce() {
+	if z.den == nil {
+		return
+	}
+	g := getBint()
+	defer putBint(g)
+	g.gcd(z.num, z.den)
+	z.num.quo(z.num, g)
+	z.den.quo(z.den, g)
+
+	// Factorization of the denominator
+	t := getBint()
+	defer putBint(t)
+	r := getBint()
+	defer putBint(r)
+	five := getBint()
+	defer putBint(five)
+	five.setFint(5)
+	t.setBint(z.den)
+	twos, fives := 0, 0
+	for t.cmp(bpow10[0]) > 0 && !t.isOdd() {
+		t.hlf(t)
+		twos++
+	}
+	for t.cmp(bpow10[0]) > 0 {
+		g.quoRem(t, five, r)
+		if r.sign() != 0 {
+			return
+		}
+		t.setBint(g)
+		fives++
+	}
+	if t.cmp(bpow10[0]) != 0 {
+		return
+	}
+
+	// Compute z = num * (10^shift / den) / 10^(scale + shift)
+	shift := max(twos, fives)
+	g.lsh(bpow10[0], shift)
+	g.quo(g, z.den)
+	z.num.mul(z.num, g)
+	z.den = nil
+	z.scale = z.scale + shift
+}
+
+// quoRem sets q to the absolute value of z multiplied by 10^scale and
+// rounded towards zero, r to the remainder and den to the divisor.
+func (z *Xp) quoRem(q, r, den *bint, scale int) {
+	num := getBint()
+	defer putBint(num)
+	den.setFint(1)
+	if z.den != nil {
+		den.setBint(z.den)
+	}
+	if scale >= z.scale {
+		num.lsh(z.num, scale-z.scale)
+	} else {
+		num.setBint(z.num)
+		den.lsh(den, z.scale-scale)
+	}
+	q.quoRem(num, den, r)
+}
+
+// quoRound sets q to the absolute value of z multiplied by 10^scale
+// and rounded to an integer using half-to-even rounding.
+func (z *Xp) quoRound(q *bint, scale int) {
+	r := getBint()
+	defer putBint(r)
+	den := getBint()
+	defer putBint(den)
+	z.quoRem(q, r, den, scale)
+	r.dbl(r)
+	switch den.cmp(r) {
+	case -1:
+		q.inc(q)
+	case 0:
+		if q.isOdd() {
+			q.inc(q)
+		}
+	}
+}
+
+// round rounds z to the given scale using half-to-even rounding.
+// Fractions are always rounded, decimals only if they have more digits
+// after the decimal point.
+func (z *Xp) round(scale int) {
+	if z.den == nil && scale >= z.scale {
+		return
+	}
+	q := getBint()
+	defer putBint(q)
+	z.quoRound(q, scale)
+	z.num.setBint(q)
+	z.den = nil
+	z.scale = scale
+}
+
+// trim removes trailing zeros from z until its scale is reduced
+// to the given scale.
+func (z *Xp) trim(scale int) {
+	q := getBint()
+	defer putBint(q)
+	r := getBint()
+	defer putBint(r)
+	for z.scale > scale {
+		q.quoRem(z.num, bpow10[1], r)
+		if r.sign() != 0 {
+			return
+		}
+		z.num.setBint(q)
+		z.scale--
+	}
+}
+
+// decimal converts z to a (possibly rounded) decimal.
+// See also function [newFromBint].
+func (z *Xp) decimal(minScale int) (Decimal, error) {
+	if z.err != nil {
+		return Decimal{}, z.err
+	}
+	z.init()
+	coef := getBint()
+	defer putBint(coef)
+	scale := z.scale
+	if z.den == nil {
+		coef.setBint(z.num)
+	} else {
+		// Fractions are rounded directly to the number of digits
+		// that fit into a decimal
+		r := getBint()
+		defer putBint(r)
+		den := getBint()
+		defer putBint(den)
+		z.quoRem(coef, r, den, 0)
+		scale = MaxScale
+		if coef.sign() != 0 {
+			scale = max(MaxPrec-coef.prec(), 0)
+		}
+		z.quoRound(coef, scale)
+	}
+	return newFromBint(z.neg, coef, scale, minScale)
+}
+
+// String returns the value of z as a string.
+// Decimals are formatted exactly, fractions are rounded to 38 digits
+// after the decimal point using half-to-even rounding.
+func (z *Xp) String() string {
+	if z.err != nil {
+		return "NaN"
+	}
+	z.init()
+	coef := getBint()
+	defer putBint(coef)
+	scale := z.scale
+	if z.den == nil {
+		coef.setBint(z.num)
+	} else {
+		scale = max(scale, xpScale)
+		z.quoRound(coef, scale)
+	}
+	s := coef.string()
+	if scale > 0 {
+		if len(s) <= scale {
+			s = fmt.Sprintf("%0*s", scale+1, s)
+		}
+		s = s[:len(s)-scale] + "." + s[len(s)-scale:]
+	}
+	if z.neg && coef.sign() != 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// EvaluateExact evaluates a formula in extended precision and rounds its
+// result only once, to the specified number of digits after the decimal
+// point, using half-to-even rounding.
+// If the result has fewer digits after the decimal point, it is zero-padded
+// to the right, as in [Decimal.AddExact] and similar methods.
+// The function fn receives a zero-valued [Xp], which can be used to store
+// the result, for example:
+//
+//	total, err := decimal.EvaluateExact(2, func(x *decimal.Xp) *decimal.Xp {
+//		return x.Mul(price.Xp(), qty.Xp()).Sub(x, discount.Xp())
+//	})
+//
+// This eliminates the double rounding that occurs when a formula is computed
+// using a sequence of [Decimal] methods.
+// Since the operations of [Xp] are exact, this holds for formulas with
+// divisions too, for example, 1 / 3 * 3 + 0.5 is rounded to 2.
+// See also method [Decimal.Calc].
+//
+// EvaluateExact returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - fn returns nil;
+//   - any of the operations in the formula failed;
+//   - the integer part of the result has more than ([MaxPrec] - scale) digits.
+func EvaluateExact(scale int, fn func(x *Xp) *Xp) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, errorf("evaluating formula: %w", scaleRangeError(scale))
+	}
+	x := fn(new(Xp))
+	if x == nil {
+		return Decimal{}, errorf("evaluating formula: %w: nil result", errInvalidOperation)
+	}
+	if x.err != nil {
+		return Decimal{}, errorf("evaluating formula: %w", x.err)
+	}
+	y := new(Xp).Set(x)
+	y.round(scale)
+	d, err := y.decimal(scale)
+	if err != nil {
+		return Decimal{}, errorf("evaluating formula [%v]: %w", redact(x), err)
+	}
+	return d, nil
+}
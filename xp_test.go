@@ -0,0 +1,168 @@
+//go:build !decimalnobig
+
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestXp(t *testing.T) {
+	ops := map[string]func(z, x, y *Xp) *Xp{
+		"Add": (*Xp).Add,
+		"Sub": (*Xp).Sub,
+		"Mul": (*Xp).Mul,
+		"Quo": (*Xp).Quo,
+	}
+
+	tests := []struct {
+		op   string
+		x, y string
+		want string
+	}{
+		{"Add", "1.5", "2.25", "3.75"},
+		{"Add", "-1.5", "1.5", "0.0"},
+		{"Add", "9999999999999999999", "1", "10000000000000000000"},
+		{"Sub", "1.5", "2.25", "-0.75"},
+		{"Sub", "-1.5", "-2.25", "0.75"},
+		{"Mul", "1.5", "-2", "-3.0"},
+		{"Mul", "9999999999999999999", "9999999999999999999", "99999999999999999980000000000000000001"},
+		{"Mul", "0.0000000000000000001", "0.0000000000000000001", "0.00000000000000000000000000000000000001"},
+		{"Quo", "1.5", "-2", "-0.75"},
+		{"Quo", "1", "3", "0.33333333333333333333333333333333333333"},
+		{"Quo", "2", "3", "0.66666666666666666666666666666666666667"},
+		{"Quo", "1.50", "0.5", "3.0"},
+		{"Quo", "0.00", "7", "0.00"},
+		{"Quo", "1", "0.5", "2"},
+		{"Quo", "1", "0.03", "33.33333333333333333333333333333333333333"},
+		{"Quo", "0.3", "6", "0.05"},
+	}
+	for _, tt := range tests {
+		x := MustParse(tt.x).Xp()
+		y := MustParse(tt.y).Xp()
+
+		// Separate receiver
+		got := ops[tt.op](new(Xp), x, y).String()
+		if got != tt.want {
+			t.Errorf("%v(%v, %v) = %v, want %v", tt.op, x, y, got, tt.want)
+		}
+
+		// Receiver aliased with arguments
+		got = ops[tt.op](new(Xp).Set(x), new(Xp).Set(x), y).String()
+		if got != tt.want {
+			t.Errorf("x.%v(x, %v) = %v, want %v", tt.op, y, got, tt.want)
+		}
+		z := new(Xp).Set(y)
+		got = ops[tt.op](z, x, z).String()
+		if got != tt.want {
+			t.Errorf("y.%v(%v, y) = %v, want %v", tt.op, x, got, tt.want)
+		}
+		if x.String() != MustParse(tt.x).Xp().String() {
+			t.Errorf("%v(%v, %v) modified its argument", tt.op, tt.x, y)
+		}
+	}
+
+	t.Run("error", func(t *testing.T) {
+		x := new(Xp).Quo(One.Xp(), Zero.Xp())
+		if !errors.Is(x.err, errDivisionByZero) {
+			t.Errorf("Quo(1, 0) error = %v, want %v", x.err, errDivisionByZero)
+		}
+		for name, op := range ops {
+			if got := op(new(Xp), x, One.Xp()); got.err == nil {
+				t.Errorf("%v(NaN, 1) did not fail", name)
+			}
+			if got := op(new(Xp), One.Xp(), x); got.err == nil {
+				t.Errorf("%v(1, NaN) did not fail", name)
+			}
+		}
+		if got := new(Xp).Neg(x); got.err == nil {
+			t.Errorf("Neg(NaN) did not fail")
+		}
+		if got := new(Xp).Abs(x); got.err == nil {
+			t.Errorf("Abs(NaN) did not fail")
+		}
+		if got := x.String(); got != "NaN" {
+			t.Errorf("String() = %v, want NaN", got)
+		}
+	})
+}
+
+func TestEvaluateExact(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			scale   int
+			a, b, c string
+			want    string
+		}{
+			// (a * b - c) / b
+			{2, "1", "3", "0", "1.00"},
+			{0, "2.5", "1", "0", "2"},
+			{0, "3.5", "1", "0", "4"},
+			{2, "19.99", "3", "0.01", "19.99"},
+			{19, "0.1", "3", "0", "0.1000000000000000000"},
+			{0, "9999999999999999999", "10", "0", "9999999999999999999"},
+			{0, "9999999999999999999", "9999999999999999999", "9999999999999999999", "9999999999999999998"},
+		}
+		for _, tt := range tests {
+			a, b, c := MustParse(tt.a), MustParse(tt.b), MustParse(tt.c)
+			got, err := EvaluateExact(tt.scale, func(x *Xp) *Xp {
+				return x.Mul(a.Xp(), b.Xp()).Sub(x, c.Xp()).Quo(x, b.Xp())
+			})
+			if err != nil {
+				t.Errorf("EvaluateExact(%v, (%q * %q - %q) / %q) failed: %v", tt.scale, a, b, c, b, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("EvaluateExact(%v, (%q * %q - %q) / %q) = %q, want %q", tt.scale, a, b, c, b, got, want)
+			}
+		}
+	})
+
+	t.Run("fractions", func(t *testing.T) {
+		third := func(x *Xp) *Xp { return x.Quo(One.Xp(), MustParse("3").Xp()) }
+		tests := []struct {
+			scale int
+			fn    func(x *Xp) *Xp
+			want  string
+		}{
+			{0, func(x *Xp) *Xp { return third(x).Mul(x, MustParse("3").Xp()).Add(x, MustParse("0.5").Xp()) }, "2"},
+			{0, func(x *Xp) *Xp { return third(x).Mul(x, MustParse("3").Xp()).Add(x, MustParse("1.5").Xp()) }, "2"},
+			{2, func(x *Xp) *Xp { return third(x).Add(x, new(Xp).Quo(One.Xp(), MustParse("6").Xp())) }, "0.50"},
+			{18, func(x *Xp) *Xp { return third(x).Add(x, x) }, "0.666666666666666667"},
+			{19, func(x *Xp) *Xp { return third(x).Sub(x, MustParse("0.3333333333333333333").Xp()) }, "0.0000000000000000000"},
+			{0, func(x *Xp) *Xp { return third(x).Quo(x, third(new(Xp))) }, "1"},
+		}
+		for i, tt := range tests {
+			got, err := EvaluateExact(tt.scale, tt.fn)
+			if err != nil {
+				t.Errorf("EvaluateExact(%v, fn%v) failed: %v", tt.scale, i, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("EvaluateExact(%v, fn%v) = %q, want %q", tt.scale, i, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			scale int
+			fn    func(x *Xp) *Xp
+		}{
+			{-1, func(x *Xp) *Xp { return x }},
+			{20, func(x *Xp) *Xp { return x }},
+			{0, func(*Xp) *Xp { return nil }},
+			{0, func(x *Xp) *Xp { return x.Quo(One.Xp(), x) }},
+			{0, func(x *Xp) *Xp { return x.Add(MustParse("9999999999999999999").Xp(), One.Xp()) }},
+			{1, func(x *Xp) *Xp { return x.SetDecimal(MustParse("9999999999999999999")) }},
+		}
+		for _, tt := range tests {
+			_, err := EvaluateExact(tt.scale, tt.fn)
+			if err == nil {
+				t.Errorf("EvaluateExact(%v, fn) did not fail", tt.scale)
+			}
+		}
+	})
+}